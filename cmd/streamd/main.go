@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"runtime/pprof"
 	"sync"
+	"sync/atomic"
 
 	"github.com/facebookincubator/go-belt"
 	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
@@ -54,6 +55,11 @@ func main() {
 		"",
 		"address to listen to for net/pprof requests",
 	)
+	metricsAddr := pflag.String(
+		"metrics-addr",
+		"",
+		"address to listen to for Prometheus/OpenMetrics scrapes (at path '/metrics')",
+	)
 	cpuProfile := pflag.String("go-profile-cpu", "", "file to write cpu profile to")
 	heapProfile := pflag.String("go-profile-heap", "", "file to write memory profile to")
 	sentryDSN := pflag.String("sentry-dsn", "", "DSN of a Sentry instance to send error reports")
@@ -100,6 +106,23 @@ func main() {
 		})
 	}
 
+	var currentStreamD atomic.Pointer[streamd.StreamD]
+	if *metricsAddr != "" {
+		observability.Go(ctx, func() {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+				streamD := currentStreamD.Load()
+				if streamD == nil {
+					http.Error(w, "streamd is not initialized, yet", http.StatusServiceUnavailable)
+					return
+				}
+				streamD.MetricsHandler().ServeHTTP(w, r)
+			})
+			l.Infof("starting to listen for metrics requests at '%s'", *metricsAddr)
+			l.Error(http.ListenAndServe(*metricsAddr, mux))
+		})
+	}
+
 	if oldValue := runtime.GOMAXPROCS(0); oldValue < 16 {
 		l.Infof("increased GOMAXPROCS from %d to %d", oldValue, 16)
 		runtime.GOMAXPROCS(16)
@@ -172,6 +195,7 @@ func main() {
 		if err != nil {
 			l.Fatalf("unable to initialize the streamd instance: %v", err)
 		}
+		currentStreamD.Store(streamD)
 
 		observability.Go(ctx, func() {
 			if err = streamD.Run(ctx); err != nil {
@@ -27,6 +27,7 @@ require (
 	github.com/facebookincubator/go-belt v0.0.0-20240804203001-846c4409d41c
 	github.com/go-git/go-billy/v5 v5.5.0
 	github.com/goccy/go-yaml v1.15.13
+	github.com/gorilla/websocket v1.5.3
 	github.com/hashicorp/go-multierror v1.1.1
 	github.com/nicklaw5/helix/v2 v2.30.1-0.20240715193454-0151ccccf980
 	github.com/spf13/cobra v1.8.1
@@ -60,8 +61,6 @@ require (
 	github.com/benburkert/openpgp v0.0.0-20160410205803-c2471f86866c // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/blang/mpv v0.0.0-20160810175505-d56d7352e068 // indirect
-	github.com/bluenviron/gohlslib/v2 v2.0.0 // indirect
-	github.com/bluenviron/mediacommon v1.13.1 // indirect
 	github.com/buger/jsonparser v1.1.1 // indirect
 	github.com/bytedance/sonic v1.11.6 // indirect
 	github.com/bytedance/sonic/loader v0.1.1 // indirect
@@ -71,7 +70,6 @@ require (
 	github.com/cloudwego/iasm v0.2.0 // indirect
 	github.com/coreos/go-oidc/v3 v3.11.0 // indirect
 	github.com/cyphar/filepath-securejoin v0.2.4 // indirect
-	github.com/datarhei/gosrt v0.7.0 // indirect
 	github.com/dexterlb/mpvipc v0.0.0-20241005113212-7cdefca0e933 // indirect
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
 	github.com/dsnet/compress v0.0.1 // indirect
@@ -121,7 +119,6 @@ require (
 	github.com/googleapis/gax-go/v2 v2.13.0 // indirect
 	github.com/gookit/color v1.5.4 // indirect
 	github.com/gopherjs/gopherjs v1.17.2 // indirect
-	github.com/gorilla/websocket v1.5.3 // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
 	github.com/hashicorp/logutils v1.0.0 // indirect
 	github.com/huandu/go-tls v0.0.0-20200109070953-6f75fb441850 // indirect
@@ -153,7 +150,6 @@ require (
 	github.com/pion/interceptor v0.1.37 // indirect
 	github.com/pion/logging v0.2.2 // indirect
 	github.com/pion/rtcp v1.2.14 // indirect
-	github.com/pion/rtp v1.8.9 // indirect
 	github.com/pion/sdp/v3 v3.0.9 // indirect
 	github.com/pjbgf/sha1cd v0.3.0 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
@@ -229,8 +225,11 @@ require (
 	github.com/asaskevich/EventBus v0.0.0-20200907212545-49d423059eef
 	github.com/asticode/go-astiav v0.30.0
 	github.com/bamiaux/rez v0.0.0-20170731184118-29f4463c688b
+	github.com/bluenviron/gohlslib/v2 v2.0.0
 	github.com/bluenviron/gortsplib/v4 v4.11.0
+	github.com/bluenviron/mediacommon v1.13.1
 	github.com/chai2010/webp v1.1.1
+	github.com/datarhei/gosrt v0.7.0
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc
 	github.com/dustin/go-humanize v1.0.1
 	github.com/getsentry/sentry-go v0.28.1
@@ -248,6 +247,7 @@ require (
 	github.com/kbinani/screenshot v0.0.0-20230812210009-b87d31814237
 	github.com/klauspost/compress v1.17.7
 	github.com/lusingander/colorpicker v0.7.3
+	github.com/pion/rtp v1.8.9
 	github.com/pojntfx/weron v0.2.7
 	github.com/prometheus/client_golang v1.18.0
 	github.com/rs/zerolog v1.33.0
@@ -299,5 +299,5 @@ require (
 	github.com/pion/stun v0.6.1 // indirect
 	github.com/pion/transport/v2 v2.2.10 // indirect
 	github.com/pion/turn/v2 v2.1.6 // indirect
-	github.com/pion/webrtc/v3 v3.3.0 // indirect
+	github.com/pion/webrtc/v3 v3.3.0
 )
@@ -0,0 +1,217 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expression is a parsed standard 5-field cron expression:
+//
+//	minute hour day-of-month month day-of-week
+//
+// Each field supports "*", lists ("1,2,3"), ranges ("1-5"), steps ("*/15", "1-30/5")
+// and (for months and days of week) three-letter English names. The macros
+// "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight" and
+// "@hourly" are supported as well.
+type Expression struct {
+	Minute     uint64
+	Hour       uint64
+	DayOfMonth uint64
+	Month      uint64
+	DayOfWeek  uint64
+
+	// IsDayOfMonthStar and IsDayOfWeekStar are needed to follow the classic
+	// cron semantics: if both day fields are restricted, then a day matches
+	// if either of the fields matches.
+	IsDayOfMonthStar bool
+	IsDayOfWeekStar  bool
+}
+
+type fieldBounds struct {
+	Name  string
+	Min   uint
+	Max   uint
+	Names map[string]uint
+}
+
+var (
+	boundsMinute     = fieldBounds{Name: "minute", Min: 0, Max: 59}
+	boundsHour       = fieldBounds{Name: "hour", Min: 0, Max: 23}
+	boundsDayOfMonth = fieldBounds{Name: "day-of-month", Min: 1, Max: 31}
+	boundsMonth      = fieldBounds{Name: "month", Min: 1, Max: 12, Names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	boundsDayOfWeek = fieldBounds{Name: "day-of-week", Min: 0, Max: 7, Names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron expression.
+func Parse(s string) (*Expression, error) {
+	s = strings.TrimSpace(s)
+	if expanded, ok := macros[strings.ToLower(s)]; ok {
+		s = expanded
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, but got %d in '%s'", len(fields), s)
+	}
+
+	var (
+		expr Expression
+		err  error
+	)
+	if expr.Minute, err = parseField(fields[0], boundsMinute); err != nil {
+		return nil, err
+	}
+	if expr.Hour, err = parseField(fields[1], boundsHour); err != nil {
+		return nil, err
+	}
+	if expr.DayOfMonth, err = parseField(fields[2], boundsDayOfMonth); err != nil {
+		return nil, err
+	}
+	if expr.Month, err = parseField(fields[3], boundsMonth); err != nil {
+		return nil, err
+	}
+	if expr.DayOfWeek, err = parseField(fields[4], boundsDayOfWeek); err != nil {
+		return nil, err
+	}
+	if expr.DayOfWeek&(1<<7) != 0 {
+		// both 0 and 7 mean Sunday
+		expr.DayOfWeek |= 1 << 0
+		expr.DayOfWeek &^= 1 << 7
+	}
+	expr.IsDayOfMonthStar = strings.HasPrefix(fields[2], "*")
+	expr.IsDayOfWeekStar = strings.HasPrefix(fields[4], "*")
+	return &expr, nil
+}
+
+func parseField(s string, bounds fieldBounds) (uint64, error) {
+	var result uint64
+	for _, part := range strings.Split(s, ",") {
+		bits, err := parseFieldPart(part, bounds)
+		if err != nil {
+			return 0, fmt.Errorf("unable to parse the %s field '%s': %w", bounds.Name, s, err)
+		}
+		result |= bits
+	}
+	return result, nil
+}
+
+func parseFieldPart(s string, bounds fieldBounds) (uint64, error) {
+	rangeString, stepString, hasStep := strings.Cut(s, "/")
+
+	step := uint(1)
+	if hasStep {
+		v, err := strconv.ParseUint(stepString, 10, 8)
+		if err != nil || v == 0 {
+			return 0, fmt.Errorf("invalid step '%s'", stepString)
+		}
+		step = uint(v)
+	}
+
+	var lo, hi uint
+	switch {
+	case rangeString == "*":
+		lo, hi = bounds.Min, bounds.Max
+	case strings.Contains(rangeString, "-"):
+		loString, hiString, _ := strings.Cut(rangeString, "-")
+		var err error
+		if lo, err = parseValue(loString, bounds); err != nil {
+			return 0, err
+		}
+		if hi, err = parseValue(hiString, bounds); err != nil {
+			return 0, err
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("invalid range '%s'", rangeString)
+		}
+	default:
+		v, err := parseValue(rangeString, bounds)
+		if err != nil {
+			return 0, err
+		}
+		lo, hi = v, v
+		if hasStep {
+			hi = bounds.Max
+		}
+	}
+
+	var result uint64
+	for v := lo; v <= hi; v += step {
+		result |= 1 << v
+	}
+	return result, nil
+}
+
+func parseValue(s string, bounds fieldBounds) (uint, error) {
+	if v, ok := bounds.Names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s'", s)
+	}
+	if uint(v) < bounds.Min || uint(v) > bounds.Max {
+		return 0, fmt.Errorf("value %d is out of range [%d, %d]", v, bounds.Min, bounds.Max)
+	}
+	return uint(v), nil
+}
+
+// maxSearchYears limits the search of the next matching moment, to
+// avoid infinite loops on expressions which never match (like "0 0 30 2 *").
+const maxSearchYears = 5
+
+// Next returns the first moment strictly after "after" that matches
+// the expression, or a zero time.Time if there is no such moment.
+//
+// The result has the same location as "after".
+func (expr *Expression) Next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + maxSearchYears
+
+	for t.Year() <= yearLimit {
+		if expr.Month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !expr.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if expr.Hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if expr.Minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (expr *Expression) matchesDay(t time.Time) bool {
+	domMatch := expr.DayOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := expr.DayOfWeek&(1<<uint(t.Weekday())) != 0
+	if expr.IsDayOfMonthStar || expr.IsDayOfWeekStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
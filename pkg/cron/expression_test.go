@@ -0,0 +1,59 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNext(t *testing.T) {
+	// 2024-01-01 is a Monday
+	base := time.Date(2024, 1, 1, 10, 30, 15, 0, time.UTC)
+	for _, tc := range []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 1, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{"0 23 * * *", time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)},
+		{"0 20 * * fri", time.Date(2024, 1, 5, 20, 0, 0, 0, time.UTC)},
+		{"0 20 * * 7", time.Date(2024, 1, 7, 20, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 12 15 * mon", time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"30 18 * * 1-5", time.Date(2024, 1, 1, 18, 30, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+			expr, err := Parse(tc.expr)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, expr.Next(base))
+		})
+	}
+}
+
+func TestNextNever(t *testing.T) {
+	expr, err := Parse("0 0 30 2 *")
+	require.NoError(t, err)
+	require.True(t, expr.Next(time.Now()).IsZero())
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * * abc",
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
@@ -9,10 +9,28 @@ import (
 func Eval[T any](
 	expr Expression,
 	evalCtx any,
+) (T, error) {
+	return eval[T](expr, evalCtx, funcMap)
+}
+
+// EvalDryRun is Eval, but the functions with side effects (e.g. network
+// requests) are not executed; instead the evaluation fails with
+// ErrNotEvaluatedInDryRun.
+func EvalDryRun[T any](
+	expr Expression,
+	evalCtx any,
+) (T, error) {
+	return eval[T](expr, evalCtx, dryRunFuncMap)
+}
+
+func eval[T any](
+	expr Expression,
+	evalCtx any,
+	funcs template.FuncMap,
 ) (T, error) {
 	var result T
 
-	tmpl, err := template.New("").Funcs(funcMap).Parse(string(expr))
+	tmpl, err := template.New("").Funcs(funcs).Parse(string(expr))
 	if err != nil {
 		return result, fmt.Errorf("unable to parse the template: %w", err)
 	}
@@ -0,0 +1,58 @@
+package expression
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	for _, tc := range []struct {
+		expr     Expression
+		expected string
+	}{
+		{expr: "plain", expected: "plain"},
+		{expr: "{{ .Value }}", expected: "value"},
+		{expr: "{{ devnull .Value }}", expected: ""},
+	} {
+		t.Run(string(tc.expr), func(t *testing.T) {
+			evalCtx := map[string]any{"Value": "value"}
+
+			value, err := Eval[string](tc.expr, evalCtx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, value)
+
+			value, err = EvalDryRun[string](tc.expr, evalCtx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, value)
+		})
+	}
+}
+
+func TestEvalDryRunNoNetwork(t *testing.T) {
+	var requests atomic.Uint64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte("response"))
+	}))
+	defer srv.Close()
+
+	for _, funcName := range []string{"httpGET", "httpGETIgnoreErrors"} {
+		expr := Expression(fmt.Sprintf(`{{ %s "%s" }}`, funcName, srv.URL))
+
+		requests.Store(0)
+		_, err := EvalDryRun[string](expr, nil)
+		require.ErrorAs(t, err, &ErrNotEvaluatedInDryRun{}, funcName)
+		require.Zero(t, requests.Load(), funcName)
+
+		// to make sure the test server would receive a request, if sent:
+		value, err := Eval[string](expr, nil)
+		require.NoError(t, err)
+		require.Equal(t, "response", value)
+		require.Equal(t, uint64(1), requests.Load(), funcName)
+	}
+}
@@ -1,6 +1,7 @@
 package expression
 
 import (
+	"fmt"
 	"io"
 	"net/http"
 )
@@ -38,3 +39,35 @@ var funcMap = map[string]interface{}{
 		return string(b)
 	},
 }
+
+// ErrNotEvaluatedInDryRun is returned by the functions, which are not
+// executed in a dry run (see EvalDryRun).
+type ErrNotEvaluatedInDryRun struct {
+	Func string
+}
+
+func (e ErrNotEvaluatedInDryRun) Error() string {
+	return fmt.Sprintf("'%s' is not evaluated in dry run", e.Func)
+}
+
+// sideEffectFreeFuncs are the functions of funcMap, which are safe
+// to execute in a dry run.
+var sideEffectFreeFuncs = map[string]struct{}{
+	"devnull": {},
+}
+
+// dryRunFuncMap is funcMap with all the functions, which are not known
+// to be free of side effects, replaced by stubs.
+var dryRunFuncMap = func() map[string]interface{} {
+	m := map[string]interface{}{}
+	for name, fn := range funcMap {
+		if _, ok := sideEffectFreeFuncs[name]; ok {
+			m[name] = fn
+			continue
+		}
+		m[name] = func(args ...any) (string, error) {
+			return "", ErrNotEvaluatedInDryRun{Func: name}
+		}
+	}
+	return m
+}()
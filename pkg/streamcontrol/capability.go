@@ -7,5 +7,7 @@ const (
 	CapabilitySendChatMessage
 	CapabilityDeleteChatMessage
 	CapabilityBanUser
+	CapabilityTimeoutUser
+	CapabilityManageModerators
 	EndOfCapability
 )
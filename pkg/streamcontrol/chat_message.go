@@ -0,0 +1,99 @@
+package streamcontrol
+
+import (
+	"fmt"
+	"slices"
+)
+
+type ChatUserRole uint
+
+const (
+	ChatUserRoleUndefined = ChatUserRole(iota)
+	ChatUserRoleBroadcaster
+	ChatUserRoleModerator
+	ChatUserRoleVIP
+	ChatUserRoleSubscriber
+	EndOfChatUserRole
+)
+
+func (r ChatUserRole) String() string {
+	switch r {
+	case ChatUserRoleUndefined:
+		return "<undefined>"
+	case ChatUserRoleBroadcaster:
+		return "broadcaster"
+	case ChatUserRoleModerator:
+		return "moderator"
+	case ChatUserRoleVIP:
+		return "vip"
+	case ChatUserRoleSubscriber:
+		return "subscriber"
+	}
+	return "<unknown>"
+}
+
+// MarshalText implements encoding.TextMarshaler, so that the roles are
+// readable in configs (e.g. "moderator").
+func (r ChatUserRole) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (r *ChatUserRole) UnmarshalText(b []byte) error {
+	for candidate := ChatUserRoleUndefined + 1; candidate < EndOfChatUserRole; candidate++ {
+		if candidate.String() == string(b) {
+			*r = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown chat user role '%s'", b)
+}
+
+// HasRole returns true if the author of the message has the role.
+func (m ChatMessage) HasRole(role ChatUserRole) bool {
+	return slices.Contains(m.Roles, role)
+}
+
+// ChatBadge is a badge of a chat user, for example:
+// {ID: "subscriber", Version: "12"}.
+type ChatBadge struct {
+	ID      string
+	Version string
+}
+
+type ChatMessageFragmentType uint
+
+const (
+	ChatMessageFragmentTypeUndefined = ChatMessageFragmentType(iota)
+	ChatMessageFragmentTypeText
+	ChatMessageFragmentTypeEmote
+	EndOfChatMessageFragmentType
+)
+
+// ChatMessageFragment is a part of a chat message: either a plain text
+// or an emote.
+type ChatMessageFragment struct {
+	Type ChatMessageFragmentType
+
+	// Text is the text of the fragment as it is in the message (for
+	// emotes it is the emote code, e.g. "Kappa").
+	Text string
+
+	// EmoteID is the platform-specific ID of the emote.
+	EmoteID string
+}
+
+// ChatMessagePaid is the payment attached to a chat message.
+type ChatMessagePaid struct {
+	// Amount is the amount of the payment in Currency.
+	Amount float64
+
+	// Currency is either an ISO 4217 code (e.g. "USD") or
+	// a platform-specific currency (e.g. "bits" on Twitch, or "kicks"
+	// on Kick).
+	Currency string
+
+	// Description is a human-readable description of the payment
+	// (e.g. "$5.00"), if the platform provides it.
+	Description string
+}
@@ -3,6 +3,7 @@ package kick
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"slices"
 	"strconv"
 	"time"
@@ -125,15 +126,102 @@ func (h *ChatHandler) sendMessage(
 ) {
 	h.lastMessageID = msg.ID
 	select {
-	case h.messagesOutChan <- streamcontrol.ChatMessage{
+	case h.messagesOutChan <- chatMessageFromKick(msg):
+	default:
+	}
+}
+
+var kickBadgeRoles = map[string]streamcontrol.ChatUserRole{
+	"broadcaster": streamcontrol.ChatUserRoleBroadcaster,
+	"moderator":   streamcontrol.ChatUserRoleModerator,
+	"vip":         streamcontrol.ChatUserRoleVIP,
+	"subscriber":  streamcontrol.ChatUserRoleSubscriber,
+	"founder":     streamcontrol.ChatUserRoleSubscriber,
+}
+
+func chatMessageFromKick(msg kickcom.ChatMessageV2) streamcontrol.ChatMessage {
+	result := streamcontrol.ChatMessage{
 		CreatedAt: msg.CreatedAt,
 		UserID:    streamcontrol.ChatUserID(fmt.Sprintf("%d", msg.UserID)),
 		Username:  msg.Sender.Slug,
 		MessageID: streamcontrol.ChatMessageID(msg.ID),
 		Message:   msg.Content,
-	}:
-	default:
+		Color:     msg.Sender.Identity.Color,
+		Fragments: parseEmotes(msg.Content),
+	}
+
+	for _, badge := range msg.Sender.Identity.Badges {
+		if !badge.Active {
+			continue
+		}
+		chatBadge := streamcontrol.ChatBadge{
+			ID: badge.Type,
+		}
+		if badge.Count != 0 {
+			chatBadge.Version = strconv.FormatInt(int64(badge.Count), 10)
+		}
+		result.Badges = append(result.Badges, chatBadge)
+		if role, ok := kickBadgeRoles[badge.Type]; ok && !result.HasRole(role) {
+			result.Roles = append(result.Roles, role)
+		}
+	}
+
+	metadata, _ := msg.Metadata.(map[string]any)
+	if msg.Type == "reply" {
+		if original, ok := metadata["original_message"].(map[string]any); ok {
+			if id, ok := original["id"].(string); ok {
+				result.ReplyToMessageID = streamcontrol.ChatMessageID(id)
+			}
+		}
+	}
+	if gift, ok := metadata["gift"].(map[string]any); ok {
+		if amount, ok := gift["amount"].(float64); ok && amount > 0 {
+			name, _ := gift["name"].(string)
+			result.Paid = &streamcontrol.ChatMessagePaid{
+				Amount:      amount,
+				Currency:    "kicks",
+				Description: name,
+			}
+		}
+	}
+	return result
+}
+
+// kickEmoteRegexp matches the emotes, which are sent in the text of
+// a message like "[emote:37226:KEKW]".
+var kickEmoteRegexp = regexp.MustCompile(`\[emote:(\d+):([^\]]*)\]`)
+
+// parseEmotes splits the text into fragments; returns nil if there are
+// no emotes.
+func parseEmotes(text string) []streamcontrol.ChatMessageFragment {
+	matches := kickEmoteRegexp.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	var result []streamcontrol.ChatMessageFragment
+	pos := 0
+	for _, m := range matches {
+		if m[0] > pos {
+			result = append(result, streamcontrol.ChatMessageFragment{
+				Type: streamcontrol.ChatMessageFragmentTypeText,
+				Text: text[pos:m[0]],
+			})
+		}
+		result = append(result, streamcontrol.ChatMessageFragment{
+			Type:    streamcontrol.ChatMessageFragmentTypeEmote,
+			Text:    text[m[4]:m[5]],
+			EmoteID: text[m[2]:m[3]],
+		})
+		pos = m[1]
+	}
+	if pos < len(text) {
+		result = append(result, streamcontrol.ChatMessageFragment{
+			Type: streamcontrol.ChatMessageFragmentTypeText,
+			Text: text[pos:],
+		})
 	}
+	return result
 }
 func (h *ChatHandler) MessagesChan() <-chan streamcontrol.ChatMessage {
 	return h.messagesOutChan
@@ -0,0 +1,68 @@
+package kick
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/kickcom"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+)
+
+func TestChatMessageFromKick(t *testing.T) {
+	var msg kickcom.ChatMessageV2
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "msg-id",
+		"user_id": 42,
+		"content": "hi [emote:37226:KEKW]!",
+		"type": "reply",
+		"metadata": {
+			"original_sender": {"id": 7, "username": "Other"},
+			"original_message": {"id": "parent-id", "content": "hello"},
+			"gift": {"amount": 100, "name": "Full Send"}
+		},
+		"created_at": "2024-01-02T03:04:05Z",
+		"sender": {
+			"id": 42,
+			"slug": "someone",
+			"username": "SomeOne",
+			"identity": {
+				"color": "#FF0000",
+				"badges": [
+					{"type": "moderator", "text": "Moderator", "active": true},
+					{"type": "subscriber", "text": "Subscriber", "active": true, "count": 3},
+					{"type": "vip", "text": "VIP", "active": false}
+				]
+			}
+		}
+	}`), &msg))
+
+	require.Equal(t, streamcontrol.ChatMessage{
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		UserID:    "42",
+		Username:  "someone",
+		MessageID: "msg-id",
+		Message:   "hi [emote:37226:KEKW]!",
+		Roles: []streamcontrol.ChatUserRole{
+			streamcontrol.ChatUserRoleModerator,
+			streamcontrol.ChatUserRoleSubscriber,
+		},
+		Badges: []streamcontrol.ChatBadge{
+			{ID: "moderator"},
+			{ID: "subscriber", Version: "3"},
+		},
+		Color: "#FF0000",
+		Fragments: []streamcontrol.ChatMessageFragment{
+			{Type: streamcontrol.ChatMessageFragmentTypeText, Text: "hi "},
+			{Type: streamcontrol.ChatMessageFragmentTypeEmote, Text: "KEKW", EmoteID: "37226"},
+			{Type: streamcontrol.ChatMessageFragmentTypeText, Text: "!"},
+		},
+		ReplyToMessageID: "parent-id",
+		Paid: &streamcontrol.ChatMessagePaid{
+			Amount:      100,
+			Currency:    "kicks",
+			Description: "Full Send",
+		},
+	}, chatMessageFromKick(msg))
+}
@@ -0,0 +1,475 @@
+package kick
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/streamctl/pkg/secret"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	DefaultAPIURL  = "https://api.kick.com"
+	DefaultAuthURL = "https://id.kick.com"
+)
+
+var oauthScopes = []string{
+	"user:read",
+	"channel:read",
+	"channel:write",
+	"chat:write",
+	"moderation:ban",
+	"moderation:chat_message:manage",
+}
+
+// ErrUnauthorized is returned when Kick rejected the access token.
+type ErrUnauthorized struct {
+	Body string
+}
+
+func (e ErrUnauthorized) Error() string {
+	return fmt.Sprintf("unauthorized: '%s'", e.Body)
+}
+
+// ClientOAuth is a client to the official (OAuth-authenticated) Kick API.
+type ClientOAuth struct {
+	HTTPClient   *http.Client
+	APIURL       string
+	AuthURL      string
+	ClientID     string
+	ClientSecret secret.String
+
+	// OnTokensUpdated is called each time the access token
+	// and refresh token are renewed, so that they could be persisted.
+	OnTokensUpdated func(accessToken, refreshToken string)
+
+	tokenLocker  xsync.Mutex
+	accessToken  secret.String
+	refreshToken secret.String
+
+	// refreshLocker serializes refreshing the tokens: Kick rotates
+	// the refresh token on each use, so concurrent refreshes would
+	// invalidate each other.
+	refreshLocker xsync.Mutex
+}
+
+func NewClientOAuth(
+	clientID string,
+	clientSecret string,
+	accessToken string,
+	refreshToken string,
+) *ClientOAuth {
+	c := &ClientOAuth{
+		HTTPClient: &http.Client{
+			Timeout: time.Minute,
+		},
+		APIURL:       DefaultAPIURL,
+		AuthURL:      DefaultAuthURL,
+		ClientID:     clientID,
+		ClientSecret: secret.New(clientSecret),
+	}
+	c.accessToken.Set(accessToken)
+	c.refreshToken.Set(refreshToken)
+	return c
+}
+
+func (c *ClientOAuth) HasAccessToken(ctx context.Context) bool {
+	return xsync.DoR1(ctx, &c.tokenLocker, func() bool {
+		return c.accessToken.Get() != ""
+	})
+}
+
+func (c *ClientOAuth) setTokens(
+	ctx context.Context,
+	accessToken string,
+	refreshToken string,
+) {
+	c.tokenLocker.Do(ctx, func() {
+		c.accessToken.Set(accessToken)
+		c.refreshToken.Set(refreshToken)
+	})
+	if c.OnTokensUpdated != nil {
+		c.OnTokensUpdated(accessToken, refreshToken)
+	}
+}
+
+// PKCE is a pair of a code verifier and a code challenge, see RFC 7636.
+type PKCE struct {
+	Verifier  string
+	Challenge string
+}
+
+func NewPKCE() (PKCE, error) {
+	verifier, err := newRandomString(32)
+	if err != nil {
+		return PKCE{}, fmt.Errorf("unable to generate a code verifier: %w", err)
+	}
+	challenge := sha256.Sum256([]byte(verifier))
+	return PKCE{
+		Verifier:  verifier,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge[:]),
+	}, nil
+}
+
+func newRandomString(numBytes uint) (string, error) {
+	buf := make([]byte, numBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("unable to read random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func (c *ClientOAuth) GetAuthorizationURL(
+	redirectURI string,
+	state string,
+	pkce PKCE,
+) string {
+	values := url.Values{}
+	values.Set("response_type", "code")
+	values.Set("client_id", c.ClientID)
+	values.Set("redirect_uri", redirectURI)
+	values.Set("scope", strings.Join(oauthScopes, " "))
+	values.Set("code_challenge", pkce.Challenge)
+	values.Set("code_challenge_method", "S256")
+	values.Set("state", state)
+	return c.AuthURL + "/oauth/authorize?" + values.Encode()
+}
+
+type TokenReply struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Scope        string `json:"scope"`
+}
+
+func (c *ClientOAuth) ExchangeCode(
+	ctx context.Context,
+	code string,
+	redirectURI string,
+	pkce PKCE,
+) (_err error) {
+	logger.Debugf(ctx, "ExchangeCode")
+	defer func() { logger.Debugf(ctx, "/ExchangeCode: %v", _err) }()
+
+	values := url.Values{}
+	values.Set("grant_type", "authorization_code")
+	values.Set("client_id", c.ClientID)
+	values.Set("client_secret", c.ClientSecret.Get())
+	values.Set("redirect_uri", redirectURI)
+	values.Set("code_verifier", pkce.Verifier)
+	values.Set("code", code)
+	return c.requestToken(ctx, values)
+}
+
+func (c *ClientOAuth) RefreshAccessToken(
+	ctx context.Context,
+) (_err error) {
+	logger.Debugf(ctx, "RefreshAccessToken")
+	defer func() { logger.Debugf(ctx, "/RefreshAccessToken: %v", _err) }()
+
+	return xsync.DoA1R1(ctx, &c.refreshLocker, c.refreshAccessTokenNoLock, ctx)
+}
+
+// refreshAccessTokenIfRejected refreshes the access token only if it is
+// still the one that was rejected; otherwise it was already refreshed
+// by a concurrent request.
+func (c *ClientOAuth) refreshAccessTokenIfRejected(
+	ctx context.Context,
+	rejectedAccessToken string,
+) error {
+	return xsync.DoR1(ctx, &c.refreshLocker, func() error {
+		if xsync.DoR1(ctx, &c.tokenLocker, c.accessToken.Get) != rejectedAccessToken {
+			logger.Debugf(ctx, "the access token was already refreshed")
+			return nil
+		}
+		return c.refreshAccessTokenNoLock(ctx)
+	})
+}
+
+func (c *ClientOAuth) refreshAccessTokenNoLock(
+	ctx context.Context,
+) error {
+	refreshToken := xsync.DoR1(ctx, &c.tokenLocker, c.refreshToken.Get)
+	if refreshToken == "" {
+		return fmt.Errorf("there is no refresh token")
+	}
+
+	values := url.Values{}
+	values.Set("grant_type", "refresh_token")
+	values.Set("client_id", c.ClientID)
+	values.Set("client_secret", c.ClientSecret.Get())
+	values.Set("refresh_token", refreshToken)
+	return c.requestToken(ctx, values)
+}
+
+func (c *ClientOAuth) requestToken(
+	ctx context.Context,
+	values url.Values,
+) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.AuthURL+"/oauth/token",
+		strings.NewReader(values.Encode()),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to build the request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to request a token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read the response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("the received status code is not 200: %d; body: '%s'", resp.StatusCode, body)
+	}
+
+	var reply TokenReply
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return fmt.Errorf("unable to un-JSON-ize the response: %w: '%s'", err, body)
+	}
+	if reply.AccessToken == "" {
+		return fmt.Errorf("received an empty access token")
+	}
+
+	c.setTokens(ctx, reply.AccessToken, reply.RefreshToken)
+	return nil
+}
+
+type noBodyT struct{}
+
+var noBody noBodyT
+
+// request performs a request to the official Kick API. If the access token
+// is expired, it refreshes the token and retries the request once.
+func request[REPLY any, REQUEST any](
+	ctx context.Context,
+	c *ClientOAuth,
+	httpMethod string,
+	path string,
+	query url.Values,
+	request REQUEST,
+) (*REPLY, error) {
+	accessToken := xsync.DoR1(ctx, &c.tokenLocker, c.accessToken.Get)
+	reply, err := doRequest[REPLY](ctx, c, accessToken, httpMethod, path, query, request)
+	if _, ok := err.(ErrUnauthorized); !ok {
+		return reply, err
+	}
+
+	logger.Debugf(ctx, "the access token is rejected, refreshing it: %v", err)
+	if err := c.refreshAccessTokenIfRejected(ctx, accessToken); err != nil {
+		return nil, fmt.Errorf("unable to refresh the access token: %w", err)
+	}
+	accessToken = xsync.DoR1(ctx, &c.tokenLocker, c.accessToken.Get)
+	return doRequest[REPLY](ctx, c, accessToken, httpMethod, path, query, request)
+}
+
+func doRequest[REPLY any, REQUEST any](
+	ctx context.Context,
+	c *ClientOAuth,
+	accessToken string,
+	httpMethod string,
+	path string,
+	query url.Values,
+	request REQUEST,
+) (_ret *REPLY, _err error) {
+	logger.Debugf(ctx, "Request: %s %s: %#+v, %#+v", httpMethod, path, query, request)
+	defer func() {
+		logger.Debugf(ctx, "Reply: %#+v %v", _ret, _err)
+	}()
+
+	dstURL := c.APIURL + path
+	if len(query) != 0 {
+		dstURL += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if _, ok := any(request).(noBodyT); !ok {
+		b, err := json.Marshal(request)
+		if err != nil {
+			return nil, fmt.Errorf("unable to JSON-ize the request %#+v: %w", request, err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, httpMethod, dstURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build the request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make request %s %s: %w", httpMethod, dstURL, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the response body: %w", err)
+	}
+	logger.Tracef(ctx, "response body: <%s>", respBody)
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return nil, ErrUnauthorized{Body: string(respBody)}
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return nil, fmt.Errorf("the received status code is not 2xx: %d; body: '%s'", resp.StatusCode, respBody)
+	}
+
+	var result REPLY
+	if len(respBody) == 0 {
+		return &result, nil
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("unable to un-JSON-ize the response: %w: '%s'", err, respBody)
+	}
+	return &result, nil
+}
+
+type Reply[T any] struct {
+	Data    T      `json:"data"`
+	Message string `json:"message"`
+}
+
+type User struct {
+	UserID         uint64 `json:"user_id"`
+	Name           string `json:"name"`
+	Email          string `json:"email"`
+	ProfilePicture string `json:"profile_picture"`
+}
+
+func (c *ClientOAuth) GetCurrentUser(
+	ctx context.Context,
+) (*User, error) {
+	reply, err := request[Reply[[]User]](ctx, c, http.MethodGet, "/public/v1/users", nil, noBody)
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Data) != 1 {
+		return nil, fmt.Errorf("expected 1 user, but received %d", len(reply.Data))
+	}
+	return &reply.Data[0], nil
+}
+
+type Category struct {
+	ID        uint64 `json:"id"`
+	Name      string `json:"name"`
+	Thumbnail string `json:"thumbnail"`
+}
+
+func (c *ClientOAuth) SearchCategories(
+	ctx context.Context,
+	query string,
+) ([]Category, error) {
+	reply, err := request[Reply[[]Category]](
+		ctx, c, http.MethodGet, "/public/v1/categories",
+		url.Values{"q": []string{query}},
+		noBody,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return reply.Data, nil
+}
+
+// ChannelUpdate is a request to change the settings of the channel.
+// Unset fields are left unchanged.
+type ChannelUpdate struct {
+	CategoryID  *uint64  `json:"category_id,omitempty"`
+	StreamTitle *string  `json:"stream_title,omitempty"`
+	CustomTags  []string `json:"custom_tags,omitempty"`
+	Language    *string  `json:"language,omitempty"`
+	IsMature    *bool    `json:"is_mature,omitempty"`
+}
+
+func (c *ClientOAuth) UpdateChannel(
+	ctx context.Context,
+	update ChannelUpdate,
+) error {
+	_, err := request[struct{}](ctx, c, http.MethodPatch, "/public/v1/channels", nil, update)
+	return err
+}
+
+type ChatMessageSendRequest struct {
+	BroadcasterUserID uint64 `json:"broadcaster_user_id"`
+	Content           string `json:"content"`
+	Type              string `json:"type"`
+}
+
+type ChatMessageSendReply struct {
+	IsSent    bool   `json:"is_sent"`
+	MessageID string `json:"message_id"`
+}
+
+func (c *ClientOAuth) SendChatMessage(
+	ctx context.Context,
+	broadcasterUserID uint64,
+	content string,
+) (*ChatMessageSendReply, error) {
+	reply, err := request[Reply[ChatMessageSendReply]](
+		ctx, c, http.MethodPost, "/public/v1/chat", nil,
+		ChatMessageSendRequest{
+			BroadcasterUserID: broadcasterUserID,
+			Content:           content,
+			Type:              "user",
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !reply.Data.IsSent {
+		return nil, fmt.Errorf("the message was not sent: '%s'", reply.Message)
+	}
+	return &reply.Data, nil
+}
+
+func (c *ClientOAuth) DeleteChatMessage(
+	ctx context.Context,
+	messageID string,
+) error {
+	_, err := request[struct{}](
+		ctx, c, http.MethodDelete,
+		"/public/v1/chat/"+url.PathEscape(messageID),
+		nil, noBody,
+	)
+	return err
+}
+
+type BanRequest struct {
+	BroadcasterUserID uint64 `json:"broadcaster_user_id"`
+	UserID            uint64 `json:"user_id"`
+
+	// Duration is the timeout duration in minutes; zero means a permanent ban.
+	Duration uint64 `json:"duration,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+func (c *ClientOAuth) BanUser(
+	ctx context.Context,
+	req BanRequest,
+) error {
+	_, err := request[struct{}](ctx, c, http.MethodPost, "/public/v1/moderation/bans", nil, req)
+	return err
+}
@@ -0,0 +1,7 @@
+package kick
+
+type ErrOAuthNotConfigured struct{}
+
+func (ErrOAuthNotConfigured) Error() string {
+	return "Kick OAuth is not configured: 'clientid' or/and 'clientsecret' is/are not set; go to https://kick.com/settings/developer and create an app if it not created, yet"
+}
@@ -3,13 +3,19 @@ package kick
 import (
 	"context"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
 	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/hashicorp/go-multierror"
 	"github.com/xaionaro-go/kickcom"
 	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/xsync"
 )
 
 type Client interface {
@@ -27,10 +33,14 @@ type LivestreamInfoClient interface {
 type Kick struct {
 	CloseCtx    context.Context
 	CloseFn     context.CancelFunc
+	Config      Config
 	Channel     *kickcom.ChannelV1
 	Client      Client
+	ClientOAuth *ClientOAuth
 	ChatHandler *ChatHandler
 	SaveCfgFn   func(Config) error
+
+	tokenLocker xsync.Mutex
 }
 
 var _ streamcontrol.StreamController[StreamProfile] = (*Kick)(nil)
@@ -63,6 +73,7 @@ func New(
 				time.Sleep(time.Second)
 				continue
 			}
+			break
 		}
 		if err != nil {
 			return nil, err
@@ -76,11 +87,18 @@ func New(
 	k := &Kick{
 		CloseCtx:  ctx,
 		CloseFn:   closeFn,
+		Config:    cfg,
 		Client:    client,
 		Channel:   channel,
 		SaveCfgFn: saveCfgFn,
 	}
 
+	if cfg.Config.IsOAuthConfigured() {
+		k.ClientOAuth = k.newClientOAuth(ctx)
+	} else {
+		logger.Warnf(ctx, "OAuth is not configured for Kick, the channel could be only read but not controlled")
+	}
+
 	chatHandler, err := k.newChatHandler(ctx, channel.ID)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize a chat handler: %w", err)
@@ -90,29 +108,79 @@ func New(
 	return k, nil
 }
 
+func (k *Kick) newClientOAuth(ctx context.Context) *ClientOAuth {
+	c := NewClientOAuth(
+		k.Config.Config.ClientID,
+		k.Config.Config.ClientSecret.Get(),
+		k.Config.Config.UserAccessToken.Get(),
+		k.Config.Config.RefreshToken.Get(),
+	)
+	c.OnTokensUpdated = func(accessToken, refreshToken string) {
+		logger.Debugf(ctx, "saving the new tokens")
+		k.Config.Config.UserAccessToken.Set(accessToken)
+		k.Config.Config.RefreshToken.Set(refreshToken)
+		if k.SaveCfgFn == nil {
+			return
+		}
+		err := k.SaveCfgFn(k.Config)
+		errmon.ObserveErrorCtx(ctx, err)
+	}
+	return c
+}
+
+func (k *Kick) prepare(ctx context.Context) error {
+	return k.getTokenIfNeeded(ctx)
+}
+
 func (k *Kick) Close() error {
 	k.CloseFn()
 	return nil
 }
-func (k *Kick) SetTitle(ctx context.Context, title string) error {
-	logger.Warnf(ctx, "not implemented yet")
-	return nil
+
+func (k *Kick) SetTitle(
+	ctx context.Context,
+	title string,
+) (_err error) {
+	logger.Debugf(ctx, "SetTitle(ctx, '%s')", title)
+	defer func() { logger.Debugf(ctx, "/SetTitle(ctx, '%s'): %v", title, _err) }()
+
+	if err := k.prepare(ctx); err != nil {
+		return err
+	}
+	return k.ClientOAuth.UpdateChannel(ctx, ChannelUpdate{
+		StreamTitle: &title,
+	})
 }
-func (k *Kick) SetDescription(ctx context.Context, description string) error {
-	logger.Warnf(ctx, "not implemented yet")
+
+func (k *Kick) SetDescription(
+	ctx context.Context,
+	description string,
+) error {
+	// Kick streams has no description:
 	return nil
 }
-func (k *Kick) InsertAdsCuePoint(ctx context.Context, ts time.Time, duration time.Duration) error {
-	logger.Warnf(ctx, "not implemented yet")
+
+func (k *Kick) InsertAdsCuePoint(
+	ctx context.Context,
+	ts time.Time,
+	duration time.Duration,
+) error {
+	// Kick has no API for ads cues.
+	// So nothing to do here:
 	return nil
 }
+
 func (k *Kick) Flush(ctx context.Context) error {
 	return nil
 }
-func (k *Kick) EndStream(ctx context.Context) error {
-	logger.Warnf(ctx, "not implemented yet")
+
+func (k *Kick) EndStream(
+	ctx context.Context,
+) error {
+	// Kick ends a stream automatically when the ingest stops, nothing to do:
 	return nil
 }
+
 func (k *Kick) GetStreamStatus(ctx context.Context) (*streamcontrol.StreamStatus, error) {
 	logger.Debugf(ctx, "GetStreamStatus")
 	defer logger.Debugf(ctx, "/GetStreamStatus")
@@ -139,6 +207,7 @@ func (k *Kick) GetStreamStatus(ctx context.Context) (*streamcontrol.StreamStatus
 		CustomData:   nil,
 	}, nil
 }
+
 func (k *Kick) GetChatMessagesChan(
 	ctx context.Context,
 ) (<-chan streamcontrol.ChatMessage, error) {
@@ -167,37 +236,195 @@ func (k *Kick) GetChatMessagesChan(
 
 	return outCh, nil
 }
-func (k *Kick) SendChatMessage(ctx context.Context, message string) error {
-	logger.Warnf(ctx, "not implemented yet")
-	return nil
+
+func (k *Kick) SendChatMessage(
+	ctx context.Context,
+	message string,
+) (_err error) {
+	logger.Debugf(ctx, "SendChatMessage(ctx, '%s')", message)
+	defer func() { logger.Debugf(ctx, "/SendChatMessage(ctx, '%s'): %v", message, _err) }()
+
+	if err := k.prepare(ctx); err != nil {
+		return err
+	}
+	_, err := k.ClientOAuth.SendChatMessage(ctx, k.Channel.UserID, message)
+	return err
 }
-func (k *Kick) RemoveChatMessage(ctx context.Context, messageID streamcontrol.ChatMessageID) error {
-	logger.Warnf(ctx, "not implemented yet")
-	return nil
+
+func (k *Kick) RemoveChatMessage(
+	ctx context.Context,
+	messageID streamcontrol.ChatMessageID,
+) (_err error) {
+	logger.Debugf(ctx, "RemoveChatMessage(ctx, '%s')", messageID)
+	defer func() { logger.Debugf(ctx, "/RemoveChatMessage(ctx, '%s'): %v", messageID, _err) }()
+
+	if err := k.prepare(ctx); err != nil {
+		return err
+	}
+	return k.ClientOAuth.DeleteChatMessage(ctx, string(messageID))
 }
+
 func (k *Kick) BanUser(
 	ctx context.Context,
 	userID streamcontrol.ChatUserID,
 	reason string,
 	deadline time.Time,
+) (_err error) {
+	logger.Debugf(ctx, "BanUser(ctx, '%s', '%s', %v)", userID, reason, deadline)
+	defer func() { logger.Debugf(ctx, "/BanUser(ctx, '%s', '%s', %v): %v", userID, reason, deadline, _err) }()
+
+	if err := k.prepare(ctx); err != nil {
+		return err
+	}
+
+	userIDNum, err := strconv.ParseUint(string(userID), 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse user ID '%s': %w", userID, err)
+	}
+
+	var durationMinutes uint64
+	if !deadline.IsZero() {
+		duration := time.Until(deadline)
+		if duration <= 0 {
+			return fmt.Errorf("the deadline %v is in the past", deadline)
+		}
+		durationMinutes = uint64(math.Ceil(duration.Minutes()))
+	}
+
+	return k.ClientOAuth.BanUser(ctx, BanRequest{
+		BroadcasterUserID: k.Channel.UserID,
+		UserID:            userIDNum,
+		Duration:          durationMinutes,
+		Reason:            reason,
+	})
+}
+
+func (k *Kick) AddModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
 ) error {
-	logger.Warnf(ctx, "not implemented yet")
-	return nil
+	return fmt.Errorf("not supported by the Kick API")
+}
+
+func (k *Kick) RemoveModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+) error {
+	return fmt.Errorf("not supported by the Kick API")
+}
+
+func (k *Kick) ApplyProfile(
+	ctx context.Context,
+	profile StreamProfile,
+	customArgs ...any,
+) (_err error) {
+	logger.Debugf(ctx, "ApplyProfile")
+	defer func() { logger.Debugf(ctx, "/ApplyProfile: %v", _err) }()
+
+	if err := k.prepare(ctx); err != nil {
+		return err
+	}
+
+	if profile.CategoryName != nil {
+		if profile.CategoryID != nil {
+			logger.Warnf(
+				ctx,
+				"both category name and ID are set; these are contradicting stream profile settings; prioritizing the name",
+			)
+		}
+		categoryID, err := k.getCategoryID(ctx, *profile.CategoryName)
+		if err == nil {
+			profile.CategoryID = &categoryID
+			profile.CategoryName = nil
+			saveProfile(ctx, profile, customArgs...)
+		} else {
+			logger.Errorf(ctx, "unable to get the category ID: %v", err)
+		}
+	}
+
+	update := ChannelUpdate{
+		CategoryID: profile.CategoryID,
+		Language:   profile.Language,
+		IsMature:   profile.IsMature,
+	}
+	for _, tag := range profile.Tags {
+		if tag == "" {
+			continue
+		}
+		update.CustomTags = append(update.CustomTags, tag)
+	}
+
+	if update.CategoryID == nil && update.Language == nil && update.IsMature == nil && update.CustomTags == nil {
+		logger.Debugf(ctx, "no parameters, so skipping")
+		return nil
+	}
+	return k.ClientOAuth.UpdateChannel(ctx, update)
 }
-func (k *Kick) ApplyProfile(ctx context.Context, profile StreamProfile, customArgs ...any) error {
-	logger.Warnf(ctx, "not implemented yet")
-	return nil
 
+type SaveProfileHandler interface {
+	SaveProfile(context.Context, StreamProfile) error
 }
+
+func saveProfile(ctx context.Context, profile StreamProfile, customArgs ...any) {
+	for _, arg := range customArgs {
+		saver, ok := arg.(SaveProfileHandler)
+		if !ok {
+			continue
+		}
+		if err := saver.SaveProfile(ctx, profile); err != nil {
+			logger.Errorf(ctx, "unable to save profile: %v: %#+v", err, profile)
+		}
+	}
+}
+
+func (k *Kick) getCategoryID(
+	ctx context.Context,
+	categoryName string,
+) (uint64, error) {
+	logger.Debugf(ctx, "getCategoryID")
+	defer logger.Debugf(ctx, "/getCategoryID")
+
+	categories, err := k.ClientOAuth.SearchCategories(ctx, categoryName)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"unable to query the category info (of name '%s'): %w",
+			categoryName,
+			err,
+		)
+	}
+
+	for _, cat := range categories {
+		if strings.EqualFold(cat.Name, categoryName) {
+			return cat.ID, nil
+		}
+	}
+	return 0, fmt.Errorf("category '%s' not found among %d search results", categoryName, len(categories))
+}
+
 func (k *Kick) StartStream(
 	ctx context.Context,
 	title string,
 	description string,
 	profile StreamProfile,
 	customArgs ...any,
-) error {
-	logger.Warnf(ctx, "not implemented yet")
-	return nil
+) (_err error) {
+	logger.Debugf(ctx, "StartStream")
+	defer func() { logger.Debugf(ctx, "/StartStream: %v", _err) }()
+
+	var result error
+	if err := k.SetTitle(ctx, title); err != nil {
+		result = multierror.Append(result, fmt.Errorf("unable to set title: %w", err))
+	}
+	if err := k.SetDescription(ctx, description); err != nil {
+		result = multierror.Append(result, fmt.Errorf("unable to set description: %w", err))
+	}
+	if err := k.ApplyProfile(ctx, profile, customArgs...); err != nil {
+		result = multierror.Append(
+			result,
+			fmt.Errorf("unable to apply the stream-specific profile: %w", err),
+		)
+	}
+	return multierror.Append(result).ErrorOrNil()
 }
 
 func (k *Kick) IsCapable(
@@ -205,12 +432,11 @@ func (k *Kick) IsCapable(
 	cap streamcontrol.Capability,
 ) bool {
 	switch cap {
-	case streamcontrol.CapabilitySendChatMessage:
-		return false
-	case streamcontrol.CapabilityDeleteChatMessage:
-		return false
-	case streamcontrol.CapabilityBanUser:
-		return false
+	case streamcontrol.CapabilitySendChatMessage,
+		streamcontrol.CapabilityDeleteChatMessage,
+		streamcontrol.CapabilityBanUser,
+		streamcontrol.CapabilityTimeoutUser:
+		return k.ClientOAuth != nil
 	}
 	return false
 }
@@ -0,0 +1,222 @@
+package kick
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/kickcom"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+)
+
+type kickAPIMock struct {
+	locker       sync.Mutex
+	accessToken  string
+	refreshToken string
+	refreshCount int
+	requests     map[string][]map[string]any
+}
+
+func newKickAPIMock() *kickAPIMock {
+	return &kickAPIMock{
+		accessToken:  "access-token-1",
+		refreshToken: "refresh-token-1",
+		requests:     map[string][]map[string]any{},
+	}
+}
+
+func (m *kickAPIMock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.locker.Lock()
+	defer m.locker.Unlock()
+
+	if r.URL.Path == "/oauth/token" {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// as Kick does, a refresh token may be used only once:
+		if r.PostForm.Get("grant_type") != "refresh_token" || r.PostForm.Get("refresh_token") != m.refreshToken {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.refreshCount++
+		m.accessToken = fmt.Sprintf("access-token-%d", m.refreshCount+1)
+		m.refreshToken = fmt.Sprintf("refresh-token-%d", m.refreshCount+1)
+		json.NewEncoder(w).Encode(TokenReply{
+			AccessToken:  m.accessToken,
+			RefreshToken: m.refreshToken,
+		})
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+m.accessToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	key := r.Method + " " + r.URL.Path
+	var body map[string]any
+	if b, _ := io.ReadAll(r.Body); len(b) != 0 {
+		json.Unmarshal(b, &body)
+	}
+	m.requests[key] = append(m.requests[key], body)
+
+	switch key {
+	case "GET /public/v1/categories":
+		json.NewEncoder(w).Encode(Reply[[]Category]{Data: []Category{
+			{ID: 1, Name: "Just Chatting Extra"},
+			{ID: 15, Name: r.URL.Query().Get("q")},
+		}})
+	case "POST /public/v1/chat":
+		json.NewEncoder(w).Encode(Reply[ChatMessageSendReply]{Data: ChatMessageSendReply{
+			IsSent:    true,
+			MessageID: "message-id",
+		}})
+	case "PATCH /public/v1/channels", "DELETE /public/v1/chat/message-id":
+		w.WriteHeader(http.StatusNoContent)
+	case "POST /public/v1/moderation/bans":
+		w.Write([]byte(`{"data":{},"message":"OK"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (m *kickAPIMock) getRequests(key string) []map[string]any {
+	m.locker.Lock()
+	defer m.locker.Unlock()
+	return m.requests[key]
+}
+
+func newTestKick(t *testing.T, apiMock *kickAPIMock) (*Kick, *[]Config) {
+	srv := httptest.NewServer(apiMock)
+	t.Cleanup(srv.Close)
+
+	var savedConfigs []Config
+	k := &Kick{
+		CloseFn: func() {},
+		Channel: &kickcom.ChannelV1{
+			ID:     2,
+			UserID: 3,
+			Slug:   "test-channel",
+		},
+		SaveCfgFn: func(cfg Config) error {
+			savedConfigs = append(savedConfigs, cfg)
+			return nil
+		},
+	}
+	k.Config.Config.ClientID = "client-id"
+	k.Config.Config.ClientSecret.Set("client-secret")
+	k.Config.Config.UserAccessToken.Set("access-token-1")
+	k.Config.Config.RefreshToken.Set("refresh-token-1")
+	k.ClientOAuth = k.newClientOAuth(context.Background())
+	k.ClientOAuth.APIURL = srv.URL
+	k.ClientOAuth.AuthURL = srv.URL
+	return k, &savedConfigs
+}
+
+func TestKickStreamControl(t *testing.T) {
+	ctx := context.Background()
+	apiMock := newKickAPIMock()
+	k, _ := newTestKick(t, apiMock)
+
+	for _, cap := range []streamcontrol.Capability{
+		streamcontrol.CapabilitySendChatMessage,
+		streamcontrol.CapabilityDeleteChatMessage,
+		streamcontrol.CapabilityBanUser,
+		streamcontrol.CapabilityTimeoutUser,
+	} {
+		require.True(t, k.IsCapable(ctx, cap))
+	}
+	require.False(t, k.IsCapable(ctx, streamcontrol.CapabilityManageModerators))
+
+	require.NoError(t, k.StartStream(ctx, "some title", "some description", StreamProfile{
+		CategoryName: ptr("Some Game"),
+		Tags:         []string{"tag0", "", "tag1"},
+		Language:     ptr("English"),
+		IsMature:     ptr(true),
+	}))
+	channelUpdates := apiMock.getRequests("PATCH /public/v1/channels")
+	require.Len(t, channelUpdates, 2)
+	require.Equal(t, map[string]any{"stream_title": "some title"}, channelUpdates[0])
+	require.Equal(t, map[string]any{
+		"category_id": float64(15),
+		"custom_tags": []any{"tag0", "tag1"},
+		"language":    "English",
+		"is_mature":   true,
+	}, channelUpdates[1])
+
+	require.NoError(t, k.SendChatMessage(ctx, "hello"))
+	require.Equal(t, []map[string]any{{
+		"broadcaster_user_id": float64(3),
+		"content":             "hello",
+		"type":                "user",
+	}}, apiMock.getRequests("POST /public/v1/chat"))
+
+	require.NoError(t, k.RemoveChatMessage(ctx, "message-id"))
+	require.Len(t, apiMock.getRequests("DELETE /public/v1/chat/message-id"), 1)
+
+	require.NoError(t, k.BanUser(ctx, "4", "spam", time.Now().Add(10*time.Minute)))
+	require.NoError(t, k.BanUser(ctx, "5", "", time.Time{}))
+	require.Equal(t, []map[string]any{{
+		"broadcaster_user_id": float64(3),
+		"user_id":             float64(4),
+		"duration":            float64(10),
+		"reason":              "spam",
+	}, {
+		"broadcaster_user_id": float64(3),
+		"user_id":             float64(5),
+	}}, apiMock.getRequests("POST /public/v1/moderation/bans"))
+
+	require.Error(t, k.BanUser(ctx, "not-a-number", "", time.Time{}))
+	require.Error(t, k.BanUser(ctx, "6", "", time.Now().Add(-time.Minute)))
+	require.Len(t, apiMock.getRequests("POST /public/v1/moderation/bans"), 2)
+}
+
+func TestKickTokenRefresh(t *testing.T) {
+	ctx := context.Background()
+	apiMock := newKickAPIMock()
+	k, savedConfigs := newTestKick(t, apiMock)
+
+	apiMock.accessToken = "expired"
+	require.NoError(t, k.SetTitle(ctx, "title"))
+	require.Len(t, apiMock.getRequests("PATCH /public/v1/channels"), 1)
+
+	require.Len(t, *savedConfigs, 1)
+	savedCfg := (*savedConfigs)[0]
+	require.Equal(t, "access-token-2", savedCfg.Config.UserAccessToken.Get())
+	require.Equal(t, "refresh-token-2", savedCfg.Config.RefreshToken.Get())
+}
+
+func TestKickConcurrentTokenRefresh(t *testing.T) {
+	ctx := context.Background()
+	apiMock := newKickAPIMock()
+	k, _ := newTestKick(t, apiMock)
+
+	apiMock.accessToken = "expired"
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, k.SetTitle(ctx, "title"))
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 1, apiMock.refreshCount)
+	require.Len(t, apiMock.getRequests("PATCH /public/v1/channels"), 10)
+}
+
+func TestKickWithoutOAuth(t *testing.T) {
+	ctx := context.Background()
+	k := &Kick{}
+	require.False(t, k.IsCapable(ctx, streamcontrol.CapabilitySendChatMessage))
+	require.ErrorAs(t, k.SetTitle(ctx, "title"), &ErrOAuthNotConfigured{})
+}
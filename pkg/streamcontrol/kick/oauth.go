@@ -0,0 +1,171 @@
+package kick
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/experimental/errmon"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/hashicorp/go-multierror"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/oauthhandler"
+	"github.com/xaionaro-go/xsync"
+)
+
+func authRedirectURI(listenPort uint16) string {
+	return fmt.Sprintf("http://localhost:%d/", listenPort)
+}
+
+func (k *Kick) getTokenIfNeeded(
+	ctx context.Context,
+) error {
+	if k.ClientOAuth == nil {
+		return ErrOAuthNotConfigured{}
+	}
+	if k.ClientOAuth.HasAccessToken(ctx) {
+		return nil
+	}
+
+	logger.Infof(ctx, "getting a new token")
+	return k.getNewToken(ctx)
+}
+
+func (k *Kick) getNewToken(
+	ctx context.Context,
+) (_err error) {
+	logger.Debugf(ctx, "getNewToken")
+	defer func() { logger.Debugf(ctx, "/getNewToken: %v", _err) }()
+
+	return xsync.DoR1(ctx, &k.tokenLocker, func() error {
+		if k.ClientOAuth.HasAccessToken(ctx) {
+			return nil
+		}
+		return k.getNewTokenByUser(ctx)
+	})
+}
+
+func (k *Kick) getNewTokenByUser(
+	ctx context.Context,
+) (_err error) {
+	logger.Debugf(ctx, "getNewTokenByUser")
+	defer func() { logger.Debugf(ctx, "/getNewTokenByUser: %v", _err) }()
+
+	oauthHandler := k.Config.Config.CustomOAuthHandler
+	if oauthHandler == nil {
+		oauthHandler = oauthhandler.OAuth2HandlerViaCLI
+	}
+
+	getPortsFn := k.Config.Config.GetOAuthListenPorts
+	if getPortsFn == nil {
+		return fmt.Errorf("the function GetOAuthListenPorts is not set")
+	}
+
+	pkce, err := NewPKCE()
+	if err != nil {
+		return err
+	}
+	state, err := newRandomString(16)
+	if err != nil {
+		return fmt.Errorf("unable to generate the OAuth state: %w", err)
+	}
+
+	ctx, ctxCancelFunc := context.WithCancel(ctx)
+	cancelFunc := func() {
+		logger.Debugf(ctx, "cancelling the context")
+		ctxCancelFunc()
+	}
+
+	var errWg sync.WaitGroup
+	var resultErr error
+	errCh := make(chan error)
+	errWg.Add(1)
+	observability.Go(ctx, func() {
+		defer errWg.Done()
+		for err := range errCh {
+			errmon.ObserveErrorCtx(ctx, err)
+			resultErr = multierror.Append(resultErr, err)
+		}
+	})
+
+	alreadyListening := map[uint16]struct{}{}
+	var wg sync.WaitGroup
+	// set by the handler goroutines and read after the context is done:
+	var success atomic.Bool
+
+	startHandlerForPort := func(listenPort uint16) {
+		if _, ok := alreadyListening[listenPort]; ok {
+			return
+		}
+		alreadyListening[listenPort] = struct{}{}
+
+		logger.Debugf(ctx, "starting the oauth handler at port %d", listenPort)
+		wg.Add(1)
+		observability.Go(ctx, func() {
+			defer logger.Debugf(ctx, "ended the oauth handler at port %d", listenPort)
+			defer wg.Done()
+			redirectURI := authRedirectURI(listenPort)
+			arg := oauthhandler.OAuthHandlerArgument{
+				AuthURL:    k.ClientOAuth.GetAuthorizationURL(redirectURI, state, pkce),
+				ListenPort: listenPort,
+				ExchangeFn: func(code string) (_err error) {
+					logger.Debugf(ctx, "ExchangeFn()")
+					defer func() { logger.Debugf(ctx, "/ExchangeFn(): %v", _err) }()
+					if code == "" {
+						return fmt.Errorf("code is empty")
+					}
+					return k.ClientOAuth.ExchangeCode(ctx, code, redirectURI, pkce)
+				},
+			}
+
+			err := oauthHandler(ctx, arg)
+			if err != nil {
+				errCh <- fmt.Errorf("unable to get or exchange the oauth code to a token: %w", err)
+				return
+			}
+			success.Store(true)
+			cancelFunc()
+		})
+	}
+
+	for _, listenPort := range getPortsFn() {
+		startHandlerForPort(listenPort)
+	}
+
+	wg.Add(1)
+	observability.Go(ctx, func() {
+		defer wg.Done()
+		t := time.NewTicker(time.Second)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+			ports := getPortsFn()
+			logger.Tracef(ctx, "oauth listener ports: %#+v", ports)
+
+			for _, listenPort := range ports {
+				startHandlerForPort(listenPort)
+			}
+		}
+	})
+
+	observability.Go(ctx, func() {
+		wg.Wait()
+		close(errCh)
+	})
+	<-ctx.Done()
+	logger.Debugf(ctx, "did successfully took a new token? -- %v", success.Load())
+	if !success.Load() {
+		errWg.Wait()
+		if resultErr == nil {
+			resultErr = ctx.Err()
+		}
+		return resultErr
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/xaionaro-go/streamctl/pkg/oauthhandler"
+	"github.com/xaionaro-go/streamctl/pkg/secret"
 	streamctl "github.com/xaionaro-go/streamctl/pkg/streamcontrol"
 )
 
@@ -12,7 +13,13 @@ const ID = streamctl.PlatformName("kick")
 type OAuthHandler func(context.Context, oauthhandler.OAuthHandlerArgument) error
 
 type PlatformSpecificConfig struct {
-	Channel string
+	Channel             string
+	ClientID            string
+	ClientSecret        secret.String
+	UserAccessToken     secret.String
+	RefreshToken        secret.String
+	CustomOAuthHandler  OAuthHandler    `yaml:"-"`
+	GetOAuthListenPorts func() []uint16 `yaml:"-"`
 }
 
 type Config = streamctl.PlatformConfig[PlatformSpecificConfig, StreamProfile]
@@ -25,6 +32,18 @@ func (cfg PlatformSpecificConfig) IsInitialized() bool {
 	return cfg.Channel != ""
 }
 
+// IsOAuthConfigured returns true if the credentials necessary to
+// control the channel (and not only to read it) are set.
+func (cfg PlatformSpecificConfig) IsOAuthConfigured() bool {
+	return cfg.ClientID != "" && cfg.ClientSecret.Get() != ""
+}
+
 type StreamProfile struct {
 	streamctl.StreamProfileBase `yaml:",omitempty,inline,alias"`
+
+	CategoryName *string
+	CategoryID   *uint64
+	Tags         []string
+	Language     *string
+	IsMature     *bool
 }
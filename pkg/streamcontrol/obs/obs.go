@@ -288,6 +288,18 @@ func (obs *OBS) BanUser(
 ) error {
 	return fmt.Errorf("not implemented, yet")
 }
+func (obs *OBS) AddModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+) error {
+	return fmt.Errorf("not implemented, yet")
+}
+func (obs *OBS) RemoveModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+) error {
+	return fmt.Errorf("not implemented, yet")
+}
 
 func (obs *OBS) IsCapable(
 	ctx context.Context,
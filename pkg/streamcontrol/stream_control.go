@@ -115,6 +115,27 @@ type ChatMessage struct {
 	Username  string
 	MessageID ChatMessageID
 	Message   string
+
+	// Roles are the roles of the author in the channel.
+	Roles []ChatUserRole
+
+	// Badges are the badges of the author as they are shown on the platform.
+	Badges []ChatBadge
+
+	// Color is the color of the author's name (e.g. "#1E90FF"); empty
+	// if not set.
+	Color string
+
+	// Fragments is Message split to the text and the emotes; empty if
+	// the platform does not provide (or the message has no) emotes.
+	Fragments []ChatMessageFragment
+
+	// ReplyToMessageID is the ID of the message this message replies to;
+	// empty if it is not a reply.
+	ReplyToMessageID ChatMessageID
+
+	// Paid is set if the message is paid (a Super Chat, a cheer, etc).
+	Paid *ChatMessagePaid
 }
 
 type StreamControllerCommons interface {
@@ -131,6 +152,8 @@ type StreamControllerCommons interface {
 	SendChatMessage(ctx context.Context, message string) error
 	RemoveChatMessage(ctx context.Context, messageID ChatMessageID) error
 	BanUser(ctx context.Context, userID ChatUserID, reason string, deadline time.Time) error
+	AddModerator(ctx context.Context, userID ChatUserID) error
+	RemoveModerator(ctx context.Context, userID ChatUserID) error
 
 	IsCapable(context.Context, Capability) bool
 }
@@ -243,6 +266,12 @@ func (c *abstractStreamController) RemoveChatMessage(ctx context.Context, messag
 func (c *abstractStreamController) BanUser(ctx context.Context, userID ChatUserID, reason string, deadline time.Time) error {
 	return c.StreamController.BanUser(ctx, userID, reason, deadline)
 }
+func (c *abstractStreamController) AddModerator(ctx context.Context, userID ChatUserID) error {
+	return c.StreamController.AddModerator(ctx, userID)
+}
+func (c *abstractStreamController) RemoveModerator(ctx context.Context, userID ChatUserID) error {
+	return c.StreamController.RemoveModerator(ctx, userID)
+}
 func (c *abstractStreamController) IsCapable(ctx context.Context, cap Capability) bool {
 	return c.StreamController.IsCapable(ctx, cap)
 }
@@ -0,0 +1,211 @@
+package twitch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/nicklaw5/helix/v2"
+)
+
+const (
+	commercialLengthStep = 30 * time.Second
+	commercialLengthMax  = 180 * time.Second
+
+	// adsCuePointMaxDelay is how far in the future a cue point may be
+	// to still be considered "now" (to tolerate clock skews and delays
+	// between scheduling and executing a request).
+	adsCuePointMaxDelay = 5 * time.Second
+)
+
+// commercialLength converts the requested duration of an ad break
+// to the closest length supported by Twitch.
+func commercialLength(duration time.Duration) helix.AdLengthEnum {
+	steps := (duration + commercialLengthStep/2) / commercialLengthStep
+	if steps < 1 {
+		steps = 1
+	}
+	length := steps * commercialLengthStep
+	if length > commercialLengthMax {
+		length = commercialLengthMax
+	}
+	return helix.AdLengthEnum(length / time.Second)
+}
+
+func helixError(resp helix.ResponseCommon) error {
+	if resp.StatusCode < 300 {
+		return nil
+	}
+	return fmt.Errorf("%d %s: %s", resp.StatusCode, resp.Error, resp.ErrorMessage)
+}
+
+// startCommercial starts an ad break on the channel right away.
+func (t *Twitch) startCommercial(
+	ctx context.Context,
+	duration time.Duration,
+) (_err error) {
+	logger.Debugf(ctx, "startCommercial(ctx, %v)", duration)
+	defer func() { logger.Debugf(ctx, "/startCommercial(ctx, %v): %v", duration, _err) }()
+
+	if err := t.prepare(ctx); err != nil {
+		return fmt.Errorf("unable to prepare the client: %w", err)
+	}
+
+	length := commercialLength(duration)
+	if applied := time.Duration(length) * time.Second; applied != duration {
+		logger.Warnf(ctx, "Twitch does not support a commercial of %v, starting a commercial of %v instead", duration, applied)
+	}
+
+	resp, err := t.client.StartCommercial(&helix.StartCommercialParams{
+		BroadcasterID: t.broadcasterID,
+		Length:        length,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start a commercial: %w", err)
+	}
+	if err := helixError(resp.ResponseCommon); err != nil {
+		return fmt.Errorf("unable to start a commercial: %w", err)
+	}
+	for _, ad := range resp.Data.AdDetails {
+		logger.Infof(ctx, "started a commercial of %ds (retry after %ds): %s", ad.Length, ad.RetryAfter, ad.Message)
+	}
+	return nil
+}
+
+// CreateStreamMarker marks the current moment of the live stream
+// in its VOD.
+func (t *Twitch) CreateStreamMarker(
+	ctx context.Context,
+	description string,
+) (_ret *StreamMarker, _err error) {
+	logger.Debugf(ctx, "CreateStreamMarker(ctx, '%s')", description)
+	defer func() { logger.Debugf(ctx, "/CreateStreamMarker(ctx, '%s'): %v %v", description, _ret, _err) }()
+
+	if err := t.prepare(ctx); err != nil {
+		return nil, fmt.Errorf("unable to prepare the client: %w", err)
+	}
+
+	resp, err := t.client.CreateStreamMarker(&helix.CreateStreamMarkerParams{
+		UserID:      t.broadcasterID,
+		Description: truncateStringByByteLength(description, 140),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a stream marker: %w", err)
+	}
+	if err := helixError(resp.ResponseCommon); err != nil {
+		return nil, fmt.Errorf("unable to create a stream marker: %w", err)
+	}
+	if len(resp.Data.CreateStreamMarkers) != 1 {
+		return nil, fmt.Errorf("expected 1 stream marker, but received %d", len(resp.Data.CreateStreamMarkers))
+	}
+	marker := resp.Data.CreateStreamMarkers[0]
+	return &StreamMarker{
+		ID:          marker.ID,
+		CreatedAt:   marker.CreatedAt.Time,
+		Description: marker.Description,
+		Position:    time.Duration(marker.PositionSeconds) * time.Second,
+	}, nil
+}
+
+// GetAdSchedule returns the ad schedule of the channel.
+func (t *Twitch) GetAdSchedule(
+	ctx context.Context,
+) (_ret *AdSchedule, _err error) {
+	logger.Debugf(ctx, "GetAdSchedule(ctx)")
+	defer func() { logger.Debugf(ctx, "/GetAdSchedule(ctx): %v %v", _ret, _err) }()
+
+	if err := t.prepare(ctx); err != nil {
+		return nil, fmt.Errorf("unable to prepare the client: %w", err)
+	}
+
+	body, err := t.helixRequest(
+		ctx,
+		http.MethodGet,
+		"/channels/ads",
+		url.Values{"broadcaster_id": {t.broadcasterID}},
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the ad schedule: %w", err)
+	}
+
+	return parseAdSchedule(body)
+}
+
+type adScheduleResponse struct {
+	Data []struct {
+		NextAdAt        jsonTime `json:"next_ad_at"`
+		LastAdAt        jsonTime `json:"last_ad_at"`
+		Duration        jsonUint `json:"duration"`
+		PrerollFreeTime jsonUint `json:"preroll_free_time"`
+		SnoozeCount     jsonUint `json:"snooze_count"`
+		SnoozeRefreshAt jsonTime `json:"snooze_refresh_at"`
+	} `json:"data"`
+}
+
+func parseAdSchedule(body []byte) (*AdSchedule, error) {
+	var resp adScheduleResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unable to parse the ad schedule: %w", err)
+	}
+	if len(resp.Data) != 1 {
+		return nil, fmt.Errorf("expected 1 ad schedule, but received %d", len(resp.Data))
+	}
+	s := resp.Data[0]
+	return &AdSchedule{
+		NextAdAt:        time.Time(s.NextAdAt),
+		LastAdAt:        time.Time(s.LastAdAt),
+		Duration:        time.Duration(s.Duration) * time.Second,
+		PrerollFreeTime: time.Duration(s.PrerollFreeTime) * time.Second,
+		SnoozeCount:     uint(s.SnoozeCount),
+		SnoozeRefreshAt: time.Time(s.SnoozeRefreshAt),
+	}, nil
+}
+
+// jsonTime is a timestamp, which Twitch sends either as an RFC3339 string
+// or as a UNIX time (in a string or in a number); an empty value or
+// zero means no timestamp.
+type jsonTime time.Time
+
+func (t *jsonTime) UnmarshalJSON(b []byte) error {
+	s := string(bytes.Trim(b, `"`))
+	switch s {
+	case "", "null", "0":
+		*t = jsonTime{}
+		return nil
+	}
+	if unix, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*t = jsonTime(time.Unix(unix, 0))
+		return nil
+	}
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return fmt.Errorf("unable to parse timestamp '%s': %w", s, err)
+	}
+	*t = jsonTime(ts)
+	return nil
+}
+
+// jsonUint is a number, which Twitch sends either as is or in a string.
+type jsonUint uint64
+
+func (v *jsonUint) UnmarshalJSON(b []byte) error {
+	s := string(bytes.Trim(b, `"`))
+	switch s {
+	case "", "null":
+		*v = 0
+		return nil
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse number '%s': %w", s, err)
+	}
+	*v = jsonUint(n)
+	return nil
+}
@@ -0,0 +1,49 @@
+package twitch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nicklaw5/helix/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommercialLength(t *testing.T) {
+	for duration, expected := range map[time.Duration]helix.AdLengthEnum{
+		0:                 helix.AdLen30,
+		10 * time.Second:  helix.AdLen30,
+		50 * time.Second:  helix.AdLen60,
+		time.Minute:       helix.AdLen60,
+		100 * time.Second: helix.AdLen90,
+		time.Hour:         helix.AdLen180,
+	} {
+		require.Equal(t, expected, commercialLength(duration), duration.String())
+	}
+}
+
+func TestInsertAdsCuePointInFuture(t *testing.T) {
+	err := (&Twitch{}).InsertAdsCuePoint(context.Background(), time.Now().Add(time.Minute), time.Minute)
+	require.Error(t, err)
+}
+
+func TestParseAdSchedule(t *testing.T) {
+	s, err := parseAdSchedule([]byte(`{"data":[{
+		"next_ad_at": "2023-08-01T23:08:18+00:00",
+		"last_ad_at": 1690930098,
+		"duration": "60",
+		"preroll_free_time": 90,
+		"snooze_count": "1",
+		"snooze_refresh_at": ""
+	}]}`))
+	require.NoError(t, err)
+	require.Equal(t, int64(1690931298), s.NextAdAt.Unix())
+	require.Equal(t, int64(1690930098), s.LastAdAt.Unix())
+	require.Equal(t, time.Minute, s.Duration)
+	require.Equal(t, 90*time.Second, s.PrerollFreeTime)
+	require.Equal(t, uint(1), s.SnoozeCount)
+	require.True(t, s.SnoozeRefreshAt.IsZero())
+
+	_, err = parseAdSchedule([]byte(`{"data":[]}`))
+	require.Error(t, err)
+}
@@ -3,6 +3,9 @@ package twitch
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/adeithe/go-twitch/irc"
 	"github.com/xaionaro-go/observability"
@@ -61,13 +64,7 @@ func newChatHandler(
 				return
 			case ev := <-h.messagesInChan:
 				select {
-				case h.messagesOutChan <- streamcontrol.ChatMessage{
-					CreatedAt: ev.CreatedAt,
-					UserID:    streamcontrol.ChatUserID(ev.Sender.Username),
-					Username:  ev.Sender.Username,
-					MessageID: streamcontrol.ChatMessageID(ev.ID),
-					Message:   ev.Text, // TODO: investigate if we need ev.IRCMessage.Text
-				}:
+				case h.messagesOutChan <- chatMessageFromIRC(ev):
 				default:
 				}
 			}
@@ -78,6 +75,124 @@ func newChatHandler(
 	return h, nil
 }
 
+func chatMessageFromIRC(ev irc.ChatMessage) streamcontrol.ChatMessage {
+	msg := streamcontrol.ChatMessage{
+		CreatedAt:        ev.CreatedAt,
+		UserID:           streamcontrol.ChatUserID(ev.Sender.Username),
+		Username:         ev.Sender.Username,
+		MessageID:        streamcontrol.ChatMessageID(ev.ID),
+		Message:          ev.Text, // TODO: investigate if we need ev.IRCMessage.Text
+		Color:            ev.Sender.Color,
+		ReplyToMessageID: streamcontrol.ChatMessageID(ev.IRCMessage.Tags["reply-parent-msg-id"]),
+	}
+
+	for _, role := range []struct {
+		Has  bool
+		Role streamcontrol.ChatUserRole
+	}{
+		{ev.Sender.IsBroadcaster, streamcontrol.ChatUserRoleBroadcaster},
+		{ev.Sender.IsModerator, streamcontrol.ChatUserRoleModerator},
+		{ev.Sender.IsVIP, streamcontrol.ChatUserRoleVIP},
+		{ev.Sender.IsSubscriber, streamcontrol.ChatUserRoleSubscriber},
+	} {
+		if role.Has {
+			msg.Roles = append(msg.Roles, role.Role)
+		}
+	}
+
+	for id, version := range ev.Sender.Badges {
+		msg.Badges = append(msg.Badges, streamcontrol.ChatBadge{
+			ID:      id,
+			Version: version,
+		})
+	}
+	sort.Slice(msg.Badges, func(i, j int) bool {
+		return msg.Badges[i].ID < msg.Badges[j].ID
+	})
+
+	msg.Fragments = parseEmotes(ev.Text, ev.IRCMessage.Tags["emotes"])
+
+	if ev.IsCheer {
+		bits, err := strconv.ParseUint(ev.IRCMessage.Tags["bits"], 10, 64)
+		if err == nil && bits > 0 {
+			msg.Paid = &streamcontrol.ChatMessagePaid{
+				Amount:   float64(bits),
+				Currency: "bits",
+			}
+		}
+	}
+	return msg
+}
+
+type emoteSpan struct {
+	EmoteID string
+	Start   int
+	End     int
+}
+
+// parseEmotes splits the text into fragments using the value of
+// the IRC tag "emotes" (e.g. "25:0-4,12-16/1902:6-10", where the positions
+// are in runes and inclusive). Returns nil if there are no emotes.
+func parseEmotes(text string, emotesTag string) []streamcontrol.ChatMessageFragment {
+	if emotesTag == "" {
+		return nil
+	}
+	runes := []rune(text)
+
+	var spans []emoteSpan
+	for _, emote := range strings.Split(emotesTag, "/") {
+		emoteID, positions, ok := strings.Cut(emote, ":")
+		if !ok {
+			continue
+		}
+		for _, position := range strings.Split(positions, ",") {
+			startStr, endStr, ok := strings.Cut(position, "-")
+			if !ok {
+				continue
+			}
+			start, err0 := strconv.Atoi(startStr)
+			end, err1 := strconv.Atoi(endStr)
+			if err0 != nil || err1 != nil || start < 0 || end < start || end >= len(runes) {
+				continue
+			}
+			spans = append(spans, emoteSpan{EmoteID: emoteID, Start: start, End: end})
+		}
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].Start < spans[j].Start
+	})
+
+	var result []streamcontrol.ChatMessageFragment
+	pos := 0
+	for _, span := range spans {
+		if span.Start < pos {
+			continue
+		}
+		if span.Start > pos {
+			result = append(result, streamcontrol.ChatMessageFragment{
+				Type: streamcontrol.ChatMessageFragmentTypeText,
+				Text: string(runes[pos:span.Start]),
+			})
+		}
+		result = append(result, streamcontrol.ChatMessageFragment{
+			Type:    streamcontrol.ChatMessageFragmentTypeEmote,
+			Text:    string(runes[span.Start : span.End+1]),
+			EmoteID: span.EmoteID,
+		})
+		pos = span.End + 1
+	}
+	if pos < len(runes) {
+		result = append(result, streamcontrol.ChatMessageFragment{
+			Type: streamcontrol.ChatMessageFragmentTypeText,
+			Text: string(runes[pos:]),
+		})
+	}
+	return result
+}
+
 func (h *ChatHandler) onShardMessage(shard int, msg irc.ChatMessage) {
 	h.messagesInChan <- msg
 }
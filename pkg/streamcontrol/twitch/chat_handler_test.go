@@ -86,3 +86,65 @@ func TestChatHandler(t *testing.T) {
 	require.Equal(t, 1, closeCount)
 	wg.Wait()
 }
+
+func TestChatMessageFromIRC(t *testing.T) {
+	createdAt := time.Now()
+	msg := chatMessageFromIRC(irc.ChatMessage{
+		IRCMessage: irc.Message{
+			Tags: map[string]string{
+				"emotes":              "25:0-4,12-16/1902:6-10",
+				"bits":                "100",
+				"reply-parent-msg-id": "parent-id",
+			},
+		},
+		Sender: irc.ChatSender{
+			Username:     "someone",
+			Color:        "#1E90FF",
+			Badges:       map[string]string{"subscriber": "12", "moderator": "1"},
+			IsModerator:  true,
+			IsSubscriber: true,
+		},
+		ID:        "message-id",
+		Text:      "Kappa Keepo Kappa cheer100",
+		IsCheer:   true,
+		CreatedAt: createdAt,
+	})
+	require.Equal(t, streamcontrol.ChatMessage{
+		CreatedAt: createdAt,
+		UserID:    "someone",
+		Username:  "someone",
+		MessageID: "message-id",
+		Message:   "Kappa Keepo Kappa cheer100",
+		Roles: []streamcontrol.ChatUserRole{
+			streamcontrol.ChatUserRoleModerator,
+			streamcontrol.ChatUserRoleSubscriber,
+		},
+		Badges: []streamcontrol.ChatBadge{
+			{ID: "moderator", Version: "1"},
+			{ID: "subscriber", Version: "12"},
+		},
+		Color: "#1E90FF",
+		Fragments: []streamcontrol.ChatMessageFragment{
+			{Type: streamcontrol.ChatMessageFragmentTypeEmote, Text: "Kappa", EmoteID: "25"},
+			{Type: streamcontrol.ChatMessageFragmentTypeText, Text: " "},
+			{Type: streamcontrol.ChatMessageFragmentTypeEmote, Text: "Keepo", EmoteID: "1902"},
+			{Type: streamcontrol.ChatMessageFragmentTypeText, Text: " "},
+			{Type: streamcontrol.ChatMessageFragmentTypeEmote, Text: "Kappa", EmoteID: "25"},
+			{Type: streamcontrol.ChatMessageFragmentTypeText, Text: " cheer100"},
+		},
+		ReplyToMessageID: "parent-id",
+		Paid: &streamcontrol.ChatMessagePaid{
+			Amount:   100,
+			Currency: "bits",
+		},
+	}, msg)
+}
+
+func TestParseEmotes(t *testing.T) {
+	require.Nil(t, parseEmotes("no emotes", ""))
+	require.Nil(t, parseEmotes("short", "25:0-100"))
+	require.Equal(t, []streamcontrol.ChatMessageFragment{
+		{Type: streamcontrol.ChatMessageFragmentTypeText, Text: "привет "},
+		{Type: streamcontrol.ChatMessageFragmentTypeEmote, Text: "Kappa", EmoteID: "25"},
+	}, parseEmotes("привет Kappa", "25:7-11"))
+}
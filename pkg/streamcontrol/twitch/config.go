@@ -14,6 +14,8 @@ type Config = twitch.Config
 type StreamProfile = twitch.StreamProfile
 type PlatformSpecificConfig = twitch.PlatformSpecificConfig
 type OAuthHandler = twitch.OAuthHandler
+type StreamMarker = twitch.StreamMarker
+type AdSchedule = twitch.AdSchedule
 
 func init() {
 	streamctl.RegisterPlatform[PlatformSpecificConfig, StreamProfile](ID)
@@ -0,0 +1,483 @@
+package twitch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/gorilla/websocket"
+	"github.com/hashicorp/go-multierror"
+	"github.com/nicklaw5/helix/v2"
+	"github.com/xaionaro-go/observability"
+)
+
+const (
+	eventSubURL = "wss://eventsub.wss.twitch.tv/ws"
+
+	// eventSubKeepaliveSlack is how much longer than the keepalive timeout
+	// we wait for a message before considering the connection dead.
+	eventSubKeepaliveSlack = 10 * time.Second
+
+	// eventSubDefaultKeepalive is used if Twitch did not tell the keepalive
+	// timeout.
+	eventSubDefaultKeepalive = 10 * time.Second
+
+	eventSubRetryInterval = 5 * time.Second
+
+	// eventSubDedupSize is how many last message IDs are remembered to
+	// drop the messages Twitch sends more than once.
+	eventSubDedupSize = 100
+)
+
+// EventSubNotification is an event received from Twitch EventSub.
+type EventSubNotification struct {
+	MessageID string
+	CreatedAt time.Time
+
+	// Type is the type of the subscription, e.g. "channel.follow".
+	Type string
+
+	// Event is a pointer to the helix.EventSub*Event structure
+	// corresponding to Type (see eventSubSubscriptionTypes).
+	Event any
+}
+
+type eventSubSubscriptionType struct {
+	Type      string
+	Version   string
+	Condition func(broadcasterID string) map[string]string
+	NewEvent  func() any
+}
+
+func conditionBroadcaster(broadcasterID string) map[string]string {
+	return map[string]string{"broadcaster_user_id": broadcasterID}
+}
+
+// eventSubSubscriptionTypes are the events we subscribe to; each of them
+// requires the corresponding scope to be granted.
+var eventSubSubscriptionTypes = []eventSubSubscriptionType{
+	{
+		Type:    helix.EventSubTypeChannelFollow,
+		Version: "2",
+		Condition: func(broadcasterID string) map[string]string {
+			return map[string]string{
+				"broadcaster_user_id": broadcasterID,
+				"moderator_user_id":   broadcasterID,
+			}
+		},
+		NewEvent: func() any { return &helix.EventSubChannelFollowEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelSubscription,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelSubscribeEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelSubscriptionGift,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelSubscriptionGiftEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelSubscriptionMessage,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelSubscriptionMessageEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelCheer,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelCheerEvent{} },
+	},
+	{
+		Type:    helix.EventSubTypeChannelRaid,
+		Version: "1",
+		Condition: func(broadcasterID string) map[string]string {
+			return map[string]string{"to_broadcaster_user_id": broadcasterID}
+		},
+		NewEvent: func() any { return &helix.EventSubChannelRaidEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelPointsCustomRewardRedemptionAdd,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelPointsCustomRewardRedemptionEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelPollBegin,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelPollBeginEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelPollEnd,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelPollEndEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelPredictionBegin,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelPredictionBeginEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelPredictionLock,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelPredictionLockEvent{} },
+	},
+	{
+		Type:      helix.EventSubTypeChannelPredictionEnd,
+		Version:   "1",
+		Condition: conditionBroadcaster,
+		NewEvent:  func() any { return &helix.EventSubChannelPredictionEndEvent{} },
+	},
+}
+
+// EventSubSubscriptionRequest is the body of the request to create
+// an EventSub subscription.
+type EventSubSubscriptionRequest struct {
+	Type      string            `json:"type"`
+	Version   string            `json:"version"`
+	Condition map[string]string `json:"condition"`
+	Transport struct {
+		Method    string `json:"method"`
+		SessionID string `json:"session_id"`
+	} `json:"transport"`
+}
+
+// EventSubSubscriber creates EventSub subscriptions.
+type EventSubSubscriber interface {
+	CreateEventSubSubscription(context.Context, EventSubSubscriptionRequest) error
+
+	// ReauthorizeEventSub is called if none of the subscriptions could
+	// be created (e.g. the token was revoked or lacks all the scopes),
+	// so that a new token could be requested before the next attempt.
+	ReauthorizeEventSub(context.Context) error
+}
+
+// errEventSubNoSubscriptions is returned if none of the subscriptions
+// could be created.
+var errEventSubNoSubscriptions = errors.New("unable to create any EventSub subscription")
+
+type eventSubMessage struct {
+	Metadata struct {
+		MessageID        string    `json:"message_id"`
+		MessageType      string    `json:"message_type"`
+		MessageTimestamp time.Time `json:"message_timestamp"`
+		SubscriptionType string    `json:"subscription_type"`
+	} `json:"metadata"`
+	Payload struct {
+		Session *struct {
+			ID                      string `json:"id"`
+			Status                  string `json:"status"`
+			KeepaliveTimeoutSeconds *int   `json:"keepalive_timeout_seconds"`
+			ReconnectURL            string `json:"reconnect_url"`
+		} `json:"session"`
+		Subscription *struct {
+			ID     string `json:"id"`
+			Type   string `json:"type"`
+			Status string `json:"status"`
+		} `json:"subscription"`
+		Event json.RawMessage `json:"event"`
+	} `json:"payload"`
+}
+
+// EventSubHandler receives the events of a channel through
+// an EventSub WebSocket session.
+type EventSubHandler struct {
+	url           string
+	subscriber    EventSubSubscriber
+	broadcasterID string
+	cancelFunc    context.CancelFunc
+	waitGroup     sync.WaitGroup
+	eventsOutChan chan EventSubNotification
+
+	recentMessageIDs     map[string]struct{}
+	recentMessageIDsList []string
+}
+
+func NewEventSubHandler(
+	ctx context.Context,
+	subscriber EventSubSubscriber,
+	broadcasterID string,
+) (*EventSubHandler, error) {
+	return newEventSubHandler(ctx, eventSubURL, subscriber, broadcasterID)
+}
+
+func newEventSubHandler(
+	ctx context.Context,
+	url string,
+	subscriber EventSubSubscriber,
+	broadcasterID string,
+) (*EventSubHandler, error) {
+	if broadcasterID == "" {
+		return nil, fmt.Errorf("the broadcaster ID is not set")
+	}
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	h := &EventSubHandler{
+		url:              url,
+		subscriber:       subscriber,
+		broadcasterID:    broadcasterID,
+		cancelFunc:       cancelFn,
+		eventsOutChan:    make(chan EventSubNotification, 100),
+		recentMessageIDs: map[string]struct{}{},
+	}
+
+	h.waitGroup.Add(1)
+	observability.Go(ctx, func() {
+		defer h.waitGroup.Done()
+		defer close(h.eventsOutChan)
+		for {
+			err := h.serve(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+			logger.Errorf(ctx, "the EventSub session ended: %v; reconnecting in %v", err, eventSubRetryInterval)
+			if errors.Is(err, errEventSubNoSubscriptions) {
+				if err := h.subscriber.ReauthorizeEventSub(ctx); err != nil {
+					logger.Errorf(ctx, "unable to re-authorize: %v", err)
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(eventSubRetryInterval):
+			}
+		}
+	})
+	return h, nil
+}
+
+// serve runs an EventSub session (following the reconnect requests
+// from Twitch) until an error.
+func (h *EventSubHandler) serve(ctx context.Context) error {
+	conn, keepalive, sessionID, err := h.connect(ctx, h.url)
+	if err != nil {
+		return err
+	}
+	defer func() { conn.Close() }()
+
+	if err := h.subscribe(ctx, sessionID); err != nil {
+		return err
+	}
+
+	for {
+		reconnectURL, err := h.readMessages(ctx, conn.Conn, keepalive)
+		if err != nil {
+			return err
+		}
+
+		// the subscriptions are moved to the new session, and the old
+		// connection should be closed only after the new one is welcomed:
+		logger.Debugf(ctx, "Twitch asked to reconnect the EventSub session to '%s'", reconnectURL)
+		newConn, newKeepalive, _, err := h.connect(ctx, reconnectURL)
+		if err != nil {
+			return fmt.Errorf("unable to reconnect: %w", err)
+		}
+		conn.Close()
+		conn, keepalive = newConn, newKeepalive
+	}
+}
+
+// eventSubConn is a connection of an EventSub session, which is also
+// closed on the cancellation of the session context.
+type eventSubConn struct {
+	*websocket.Conn
+	stopCloseOnCancel func() bool
+}
+
+func newEventSubConn(
+	ctx context.Context,
+	conn *websocket.Conn,
+) *eventSubConn {
+	return &eventSubConn{
+		Conn: conn,
+		// to interrupt reading on cancellation:
+		stopCloseOnCancel: context.AfterFunc(ctx, func() { conn.Close() }),
+	}
+}
+
+// Close closes the connection and releases the cancellation hook, so that
+// replaced connections do not pile up until the session context ends.
+func (c *eventSubConn) Close() error {
+	c.stopCloseOnCancel()
+	return c.Conn.Close()
+}
+
+// connect opens a connection and waits for the welcome message.
+func (h *EventSubHandler) connect(
+	ctx context.Context,
+	url string,
+) (_conn *eventSubConn, _keepalive time.Duration, _sessionID string, _err error) {
+	logger.Debugf(ctx, "connect(ctx, '%s')", url)
+	defer func() { logger.Debugf(ctx, "/connect(ctx, '%s'): %v %v", url, _sessionID, _err) }()
+
+	wsConn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("unable to connect to '%s': %w", url, err)
+	}
+	conn := newEventSubConn(ctx, wsConn)
+
+	conn.SetReadDeadline(time.Now().Add(eventSubDefaultKeepalive + eventSubKeepaliveSlack))
+	msg, err := readEventSubMessage(conn.Conn)
+	if err != nil {
+		conn.Close()
+		return nil, 0, "", fmt.Errorf("unable to receive the welcome message: %w", err)
+	}
+	if msg.Metadata.MessageType != "session_welcome" || msg.Payload.Session == nil {
+		conn.Close()
+		return nil, 0, "", fmt.Errorf("expected the welcome message, but received '%s'", msg.Metadata.MessageType)
+	}
+
+	keepalive := eventSubDefaultKeepalive
+	if v := msg.Payload.Session.KeepaliveTimeoutSeconds; v != nil && *v > 0 {
+		keepalive = time.Duration(*v) * time.Second
+	}
+	return conn, keepalive, msg.Payload.Session.ID, nil
+}
+
+// subscribe creates the subscriptions of the session; it fails only if
+// none of them could be created.
+func (h *EventSubHandler) subscribe(
+	ctx context.Context,
+	sessionID string,
+) error {
+	var result *multierror.Error
+	for _, subType := range eventSubSubscriptionTypes {
+		req := EventSubSubscriptionRequest{
+			Type:      subType.Type,
+			Version:   subType.Version,
+			Condition: subType.Condition(h.broadcasterID),
+		}
+		req.Transport.Method = "websocket"
+		req.Transport.SessionID = sessionID
+
+		// a failure of a single subscription (e.g. a scope was not granted)
+		// should not prevent receiving the other events:
+		if err := h.subscriber.CreateEventSubSubscription(ctx, req); err != nil {
+			logger.Errorf(ctx, "unable to subscribe to '%s': %v", subType.Type, err)
+			result = multierror.Append(result, fmt.Errorf("'%s': %w", subType.Type, err))
+		}
+	}
+	if result != nil && len(result.Errors) == len(eventSubSubscriptionTypes) {
+		return fmt.Errorf("%w (re-authorization is required?): %w", errEventSubNoSubscriptions, result)
+	}
+	return nil
+}
+
+// readMessages handles the messages of the connection until an error
+// or a reconnect request (then the URL to reconnect to is returned).
+func (h *EventSubHandler) readMessages(
+	ctx context.Context,
+	conn *websocket.Conn,
+	keepalive time.Duration,
+) (string, error) {
+	for {
+		conn.SetReadDeadline(time.Now().Add(keepalive + eventSubKeepaliveSlack))
+		msg, err := readEventSubMessage(conn)
+		if err != nil {
+			return "", err
+		}
+		logger.Tracef(ctx, "received an EventSub message: %s %s", msg.Metadata.MessageType, msg.Metadata.SubscriptionType)
+
+		switch msg.Metadata.MessageType {
+		case "session_keepalive":
+		case "session_reconnect":
+			if msg.Payload.Session == nil || msg.Payload.Session.ReconnectURL == "" {
+				return "", fmt.Errorf("received a reconnect message without the URL")
+			}
+			return msg.Payload.Session.ReconnectURL, nil
+		case "revocation":
+			if sub := msg.Payload.Subscription; sub != nil {
+				logger.Errorf(ctx, "Twitch revoked the EventSub subscription to '%s': %s", sub.Type, sub.Status)
+			}
+		case "notification":
+			h.onNotification(ctx, msg)
+		default:
+			logger.Debugf(ctx, "unexpected EventSub message type '%s'", msg.Metadata.MessageType)
+		}
+	}
+}
+
+func (h *EventSubHandler) onNotification(
+	ctx context.Context,
+	msg *eventSubMessage,
+) {
+	if h.isDuplicate(msg.Metadata.MessageID) {
+		logger.Debugf(ctx, "EventSub message '%s' was already received", msg.Metadata.MessageID)
+		return
+	}
+
+	var ev any
+	for _, subType := range eventSubSubscriptionTypes {
+		if subType.Type == msg.Metadata.SubscriptionType {
+			ev = subType.NewEvent()
+			break
+		}
+	}
+	if ev == nil {
+		logger.Debugf(ctx, "unexpected EventSub notification type '%s'", msg.Metadata.SubscriptionType)
+		return
+	}
+	if err := json.Unmarshal(msg.Payload.Event, ev); err != nil {
+		logger.Errorf(ctx, "unable to parse the EventSub notification '%s': %v", msg.Metadata.SubscriptionType, err)
+		return
+	}
+
+	select {
+	case h.eventsOutChan <- EventSubNotification{
+		MessageID: msg.Metadata.MessageID,
+		CreatedAt: msg.Metadata.MessageTimestamp,
+		Type:      msg.Metadata.SubscriptionType,
+		Event:     ev,
+	}:
+	default:
+		logger.Errorf(ctx, "the EventSub events queue is full, dropping '%s'", msg.Metadata.SubscriptionType)
+	}
+}
+
+func (h *EventSubHandler) isDuplicate(messageID string) bool {
+	if _, ok := h.recentMessageIDs[messageID]; ok {
+		return true
+	}
+	h.recentMessageIDs[messageID] = struct{}{}
+	h.recentMessageIDsList = append(h.recentMessageIDsList, messageID)
+	if len(h.recentMessageIDsList) > eventSubDedupSize {
+		delete(h.recentMessageIDs, h.recentMessageIDsList[0])
+		h.recentMessageIDsList = h.recentMessageIDsList[1:]
+	}
+	return false
+}
+
+func readEventSubMessage(conn *websocket.Conn) (*eventSubMessage, error) {
+	_, b, err := conn.ReadMessage()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read a message: %w", err)
+	}
+	var msg eventSubMessage
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return nil, fmt.Errorf("unable to parse message '%s': %w", b, err)
+	}
+	return &msg, nil
+}
+
+func (h *EventSubHandler) Close() error {
+	h.cancelFunc()
+	h.waitGroup.Wait()
+	return nil
+}
+
+func (h *EventSubHandler) EventsChan() <-chan EventSubNotification {
+	return h.eventsOutChan
+}
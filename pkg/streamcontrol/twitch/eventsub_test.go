@@ -0,0 +1,200 @@
+package twitch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/nicklaw5/helix/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type eventSubSubscriberMock struct {
+	locker       sync.Mutex
+	requests     []EventSubSubscriptionRequest
+	failAll      bool
+	reauthorized chan struct{}
+}
+
+var _ EventSubSubscriber = (*eventSubSubscriberMock)(nil)
+
+func (s *eventSubSubscriberMock) CreateEventSubSubscription(
+	_ context.Context,
+	req EventSubSubscriptionRequest,
+) error {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.requests = append(s.requests, req)
+	if s.failAll {
+		return fmt.Errorf("401 Unauthorized")
+	}
+	if req.Type == helix.EventSubTypeChannelCheer {
+		return fmt.Errorf("missing scope 'bits:read'")
+	}
+	return nil
+}
+
+func (s *eventSubSubscriberMock) ReauthorizeEventSub(context.Context) error {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.failAll = false
+	if s.reauthorized != nil {
+		close(s.reauthorized)
+		s.reauthorized = nil
+	}
+	return nil
+}
+
+func (s *eventSubSubscriberMock) Requests() []EventSubSubscriptionRequest {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	return append([]EventSubSubscriptionRequest{}, s.requests...)
+}
+
+func eventSubTestMessage(
+	messageID string,
+	messageType string,
+	subscriptionType string,
+	payload string,
+) string {
+	return fmt.Sprintf(`{"metadata":{"message_id":%q,"message_type":%q,"message_timestamp":"2024-01-02T03:04:05.123456789Z","subscription_type":%q,"subscription_version":"1"},"payload":%s}`,
+		messageID, messageType, subscriptionType, payload,
+	)
+}
+
+func TestEventSubHandler(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	const broadcasterID = "12345"
+
+	var (
+		upgrader       websocket.Upgrader
+		oldConnClosed  = make(chan struct{})
+		sessionsServed = make(chan string, 10)
+		reconnectURL   string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		sessionsServed <- r.URL.Path
+
+		send := func(msg string) {
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+		}
+
+		switch r.URL.Path {
+		case "/ws":
+			send(eventSubTestMessage("m0", "session_welcome", "", `{"session":{"id":"session-1","status":"connected","keepalive_timeout_seconds":10}}`))
+			send(eventSubTestMessage("m1", "session_keepalive", "", `{}`))
+			follow := `{"subscription":{"type":"channel.follow"},"event":{"user_id":"42","user_login":"someone","user_name":"SomeOne","followed_at":"2024-01-02T03:04:05Z"}}`
+			send(eventSubTestMessage("m2", "notification", "channel.follow", follow))
+			send(eventSubTestMessage("m2", "notification", "channel.follow", follow)) // a duplicate
+			send(eventSubTestMessage("m3", "session_reconnect", "", fmt.Sprintf(`{"session":{"id":"session-1","status":"reconnecting","reconnect_url":%q}}`, reconnectURL)))
+			// the old connection should be closed after the new one is welcomed:
+			_, _, err := conn.ReadMessage()
+			require.Error(t, err)
+			close(oldConnClosed)
+		case "/reconnect":
+			send(eventSubTestMessage("m4", "session_welcome", "", `{"session":{"id":"session-1","status":"connected","keepalive_timeout_seconds":10}}`))
+			<-oldConnClosed
+			send(eventSubTestMessage("m5", "notification", "channel.raid", `{"event":{"from_broadcaster_user_id":"7","from_broadcaster_user_login":"raider","from_broadcaster_user_name":"Raider","to_broadcaster_user_id":"12345","viewers":9}}`))
+			send(eventSubTestMessage("m6", "notification", "channel.unknown", `{"event":{}}`))
+			_, _, _ = conn.ReadMessage()
+		}
+	}))
+	defer srv.Close()
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http")
+	reconnectURL = wsURL + "/reconnect"
+
+	subscriber := &eventSubSubscriberMock{}
+	h, err := newEventSubHandler(ctx, wsURL+"/ws", subscriber, broadcasterID)
+	require.NoError(t, err)
+
+	nextEvent := func() EventSubNotification {
+		select {
+		case ev := <-h.EventsChan():
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+			return EventSubNotification{}
+		}
+	}
+
+	ev := nextEvent()
+	require.Equal(t, "m2", ev.MessageID)
+	require.Equal(t, helix.EventSubTypeChannelFollow, ev.Type)
+	follow, ok := ev.Event.(*helix.EventSubChannelFollowEvent)
+	require.True(t, ok, "%T", ev.Event)
+	require.Equal(t, "42", follow.UserID)
+	require.Equal(t, "SomeOne", follow.UserName)
+
+	ev = nextEvent()
+	require.Equal(t, "m5", ev.MessageID)
+	raid, ok := ev.Event.(*helix.EventSubChannelRaidEvent)
+	require.True(t, ok, "%T", ev.Event)
+	require.Equal(t, 9, raid.Viewers)
+
+	// subscribed once (the subscriptions survive a reconnect), and
+	// a failed subscription did not prevent the others:
+	requests := subscriber.Requests()
+	require.Len(t, requests, len(eventSubSubscriptionTypes))
+	for _, req := range requests {
+		require.Equal(t, "websocket", req.Transport.Method)
+		require.Equal(t, "session-1", req.Transport.SessionID)
+		if req.Type == helix.EventSubTypeChannelRaid {
+			require.Equal(t, map[string]string{"to_broadcaster_user_id": broadcasterID}, req.Condition)
+		} else {
+			require.Equal(t, broadcasterID, req.Condition["broadcaster_user_id"])
+		}
+	}
+	require.Equal(t, "/ws", <-sessionsServed)
+	require.Equal(t, "/reconnect", <-sessionsServed)
+
+	require.NoError(t, h.Close())
+	_, ok = <-h.EventsChan()
+	require.False(t, ok)
+}
+
+func TestEventSubHandlerNoSubscriptions(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	var upgrader websocket.Upgrader
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		msg := eventSubTestMessage("m0", "session_welcome", "", `{"session":{"id":"session-1","status":"connected","keepalive_timeout_seconds":10}}`)
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer srv.Close()
+
+	reauthorized := make(chan struct{})
+	subscriber := &eventSubSubscriberMock{
+		failAll:      true,
+		reauthorized: reauthorized,
+	}
+	h, err := newEventSubHandler(ctx, "ws"+strings.TrimPrefix(srv.URL, "http"), subscriber, "12345")
+	require.NoError(t, err)
+	defer h.Close()
+
+	select {
+	case <-reauthorized:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the failure of all the subscriptions did not lead to a re-authorization")
+	}
+	require.Len(t, subscriber.Requests(), len(eventSubSubscriptionTypes))
+}
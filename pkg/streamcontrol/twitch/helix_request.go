@@ -0,0 +1,75 @@
+package twitch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/nicklaw5/helix/v2"
+)
+
+// helixRequest sends a request to a Helix endpoint, which is not
+// supported (or supported incorrectly) by the helix client, and returns
+// the body of the response.
+//
+// The request is authorized by the user access token, so it is expected
+// that prepare was called before.
+func (t *Twitch) helixRequest(
+	ctx context.Context,
+	method string,
+	path string,
+	query url.Values,
+	reqBody any,
+) (_ret []byte, _err error) {
+	logger.Tracef(ctx, "helixRequest(ctx, '%s', '%s', %v)", method, path, query)
+	defer func() { logger.Tracef(ctx, "/helixRequest(ctx, '%s', '%s', %v): %v", method, path, query, _err) }()
+
+	token := t.client.GetUserAccessToken()
+	if token == "" {
+		return nil, fmt.Errorf("a user access token is required (auth type 'user')")
+	}
+
+	reqURL := helix.DefaultAPIBaseURL + path
+	if len(query) != 0 {
+		reqURL += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if reqBody != nil {
+		b, err := json.Marshal(reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize the request: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a request: %w", err)
+	}
+	req.Header.Set("Client-Id", t.clientID)
+	req.Header.Set("Authorization", "Bearer "+token)
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to send the request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("received %s: %s", resp.Status, respBody)
+	}
+	return respBody, nil
+}
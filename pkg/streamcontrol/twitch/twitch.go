@@ -3,6 +3,7 @@ package twitch
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -120,11 +121,8 @@ func New(
 				"updating the token too often, most likely it won't help, so asking to re-authenticate",
 			)
 			t.prepareLocker.Do(ctx, func() {
-				t.client.SetAppAccessToken("")
-				t.client.SetUserAccessToken("")
-				t.client.SetRefreshToken("")
 				prevTokenUpdate = time.Time{}
-				err := t.getNewToken(ctx)
+				err := t.reauthorizeNoLock(ctx)
 				errmon.ObserveErrorCtx(ctx, err)
 			})
 			return
@@ -155,6 +153,14 @@ func getUserID(
 	return resp.Data.Users[0].ID, nil
 }
 
+// reauthorizeNoLock drops the current tokens and requests new ones.
+func (t *Twitch) reauthorizeNoLock(ctx context.Context) error {
+	t.client.SetAppAccessToken("")
+	t.client.SetUserAccessToken("")
+	t.client.SetRefreshToken("")
+	return t.getNewToken(ctx)
+}
+
 func (t *Twitch) prepare(ctx context.Context) error {
 	logger.Tracef(ctx, "prepare")
 	defer logger.Tracef(ctx, "/prepare")
@@ -377,14 +383,21 @@ func (t *Twitch) SetDescription(
 	return nil
 }
 
+// InsertAdsCuePoint starts a commercial of (approximately) the given
+// duration. Twitch allows only to start a commercial right away, so
+// a cue point in the future is rejected instead of being silently
+// moved to now.
 func (t *Twitch) InsertAdsCuePoint(
 	ctx context.Context,
 	ts time.Time,
 	duration time.Duration,
 ) error {
-	// Unfortunately, we do not support sending ads cues.
-	// So nothing to do here:
-	return nil
+	if !ts.IsZero() {
+		if delay := time.Until(ts); delay > adsCuePointMaxDelay {
+			return fmt.Errorf("Twitch can start a commercial only right away, but the cue point is %v in the future", delay.Round(time.Second))
+		}
+	}
+	return t.startCommercial(ctx, duration)
 }
 
 func (t *Twitch) Flush(
@@ -576,6 +589,15 @@ func (t *Twitch) getNewClientCode(
 							"channel:manage:broadcast",
 							"moderator:manage:chat_messages",
 							"moderator:manage:banned_users",
+							"channel:edit:commercial",
+							"channel:read:ads",
+							"moderator:read:followers",
+							"channel:read:subscriptions",
+							"bits:read",
+							"channel:read:redemptions",
+							"channel:read:polls",
+							"channel:read:predictions",
+							"channel:manage:moderators",
 						},
 					},
 					t.clientID,
@@ -848,6 +870,62 @@ func (t *Twitch) GetChatMessagesChan(
 	return outCh, nil
 }
 
+var _ EventSubSubscriber = (*Twitch)(nil)
+
+func (t *Twitch) CreateEventSubSubscription(
+	ctx context.Context,
+	req EventSubSubscriptionRequest,
+) error {
+	_, err := t.helixRequest(ctx, http.MethodPost, "/eventsub/subscriptions", nil, req)
+	return err
+}
+
+// ReauthorizeEventSub asks to re-authenticate, because the current token
+// does not allow to subscribe to any EventSub event.
+func (t *Twitch) ReauthorizeEventSub(
+	ctx context.Context,
+) (_err error) {
+	logger.Debugf(ctx, "ReauthorizeEventSub")
+	defer func() { logger.Debugf(ctx, "/ReauthorizeEventSub: %v", _err) }()
+
+	return xsync.DoR1(ctx, &t.prepareLocker, func() error {
+		return t.reauthorizeNoLock(ctx)
+	})
+}
+
+// GetEventSubChan starts an EventSub session and returns the channel
+// with the received events. The session ends (and the channel is closed)
+// when the context is cancelled or the controller is closed.
+func (t *Twitch) GetEventSubChan(
+	ctx context.Context,
+) (<-chan EventSubNotification, error) {
+	logger.Debugf(ctx, "GetEventSubChan")
+	defer logger.Debugf(ctx, "/GetEventSubChan")
+
+	if t.config.Config.AuthType != "user" {
+		return nil, fmt.Errorf("EventSub requires auth type 'user', but it is '%s'", t.config.Config.AuthType)
+	}
+	if err := t.prepare(ctx); err != nil {
+		return nil, fmt.Errorf("unable to prepare the client: %w", err)
+	}
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	h, err := NewEventSubHandler(ctx, t, t.broadcasterID)
+	if err != nil {
+		cancelFn()
+		return nil, fmt.Errorf("unable to initialize an EventSub handler: %w", err)
+	}
+	observability.Go(ctx, func() {
+		defer cancelFn()
+		select {
+		case <-ctx.Done():
+		case <-t.closeCtx.Done():
+		}
+		h.Close()
+	})
+	return h.EventsChan(), nil
+}
+
 func (t *Twitch) SendChatMessage(ctx context.Context, message string) (_ret error) {
 	logger.Debugf(ctx, "SendChatMessage(ctx, '%s')", message)
 	defer func() { logger.Debugf(ctx, "/SendChatMessage(ctx, '%s'): %v", message, _ret) }()
@@ -904,6 +982,54 @@ func (t *Twitch) BanUser(
 	return err
 }
 
+func (t *Twitch) AddModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+) (_err error) {
+	logger.Debugf(ctx, "AddModerator(ctx, '%s')", userID)
+	defer func() { logger.Debugf(ctx, "/AddModerator(ctx, '%s'): %v", userID, _err) }()
+
+	if err := t.prepare(ctx); err != nil {
+		return fmt.Errorf("unable to prepare the client: %w", err)
+	}
+
+	resp, err := t.client.AddChannelModerator(&helix.AddChannelModeratorParams{
+		BroadcasterID: t.broadcasterID,
+		UserID:        string(userID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add moderator '%s': %w", userID, err)
+	}
+	if err := helixError(resp.ResponseCommon); err != nil {
+		return fmt.Errorf("unable to add moderator '%s': %w", userID, err)
+	}
+	return nil
+}
+
+func (t *Twitch) RemoveModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+) (_err error) {
+	logger.Debugf(ctx, "RemoveModerator(ctx, '%s')", userID)
+	defer func() { logger.Debugf(ctx, "/RemoveModerator(ctx, '%s'): %v", userID, _err) }()
+
+	if err := t.prepare(ctx); err != nil {
+		return fmt.Errorf("unable to prepare the client: %w", err)
+	}
+
+	resp, err := t.client.RemoveChannelModerator(&helix.RemoveChannelModeratorParams{
+		BroadcasterID: t.broadcasterID,
+		UserID:        string(userID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove moderator '%s': %w", userID, err)
+	}
+	if err := helixError(resp.ResponseCommon); err != nil {
+		return fmt.Errorf("unable to remove moderator '%s': %w", userID, err)
+	}
+	return nil
+}
+
 func (t *Twitch) IsCapable(
 	ctx context.Context,
 	cap streamcontrol.Capability,
@@ -915,6 +1041,10 @@ func (t *Twitch) IsCapable(
 		return true
 	case streamcontrol.CapabilityBanUser:
 		return true
+	case streamcontrol.CapabilityTimeoutUser:
+		return true
+	case streamcontrol.CapabilityManageModerators:
+		return true
 	}
 	return false
 }
@@ -0,0 +1,39 @@
+package twitch
+
+import (
+	"time"
+)
+
+// StreamMarker is a marker in the VOD of a live stream.
+type StreamMarker struct {
+	ID          string
+	CreatedAt   time.Time
+	Description string
+
+	// Position is the offset of the marker from the beginning of the stream.
+	Position time.Duration
+}
+
+// AdSchedule is the ad schedule of a channel (and the state of
+// snoozing the ads).
+type AdSchedule struct {
+	// NextAdAt is when the next scheduled ad break starts; zero if
+	// there is no ad scheduled (e.g. the channel is offline).
+	NextAdAt time.Time
+
+	// LastAdAt is when the last ad break started; zero if there was none.
+	LastAdAt time.Time
+
+	// Duration is the duration of the next ad break.
+	Duration time.Duration
+
+	// PrerollFreeTime is how long the viewers are not shown prerolls
+	// (thanks to the last ad break).
+	PrerollFreeTime time.Duration
+
+	// SnoozeCount is how many times the next ad break could be snoozed.
+	SnoozeCount uint
+
+	// SnoozeRefreshAt is when a new snooze becomes available.
+	SnoozeRefreshAt time.Time
+}
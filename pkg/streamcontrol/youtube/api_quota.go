@@ -0,0 +1,168 @@
+package youtube
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// apiQuotaSaveInterval limits how often the spent quota is saved
+	// to the config (the chat alone spends units every few seconds);
+	// on a crash up to this interval of spending may be forgotten.
+	apiQuotaSaveInterval = time.Minute
+)
+
+// apiQuota accounts the API quota units spent by all the calls to
+// the YouTube API. Like the quota of YouTube itself, it is reset at
+// midnight Pacific Time.
+//
+// The limit is applied only to reading the chat (which would otherwise
+// spend the whole quota), so that the rest of the quota is left
+// to managing the streams.
+type apiQuota struct {
+	locker      sync.Mutex
+	limit       uint64
+	used        uint64
+	resetAt     time.Time
+	lastSavedAt time.Time
+
+	// saveFunc is called to persist the spent units; it should not
+	// block, because it may be called while the controller is locked.
+	saveFunc func()
+}
+
+func newAPIQuota(
+	limit uint64,
+	usage APIQuotaUsage,
+	saveFunc func(),
+) *apiQuota {
+	if limit == 0 {
+		limit = DefaultChatAPIQuotaPerDay
+	}
+	return &apiQuota{
+		limit:    limit,
+		used:     usage.Used,
+		resetAt:  usage.ResetAt,
+		saveFunc: saveFunc,
+	}
+}
+
+var quotaResetLocation = func() *time.Location {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		return time.FixedZone("PST", -8*60*60)
+	}
+	return loc
+}()
+
+func nextQuotaResetAt(now time.Time) time.Time {
+	now = now.In(quotaResetLocation)
+	return time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, quotaResetLocation)
+}
+
+func (q *apiQuota) resetIfNeeded(now time.Time) {
+	if now.Before(q.resetAt) {
+		return
+	}
+	q.used = 0
+	q.resetAt = nextQuotaResetAt(now)
+}
+
+// Usage returns the units spent until the next reset.
+func (q *apiQuota) Usage(now time.Time) APIQuotaUsage {
+	q.locker.Lock()
+	defer q.locker.Unlock()
+	q.resetIfNeeded(now)
+	return APIQuotaUsage{
+		Used:    q.used,
+		ResetAt: q.resetAt,
+	}
+}
+
+// IsAvailable returns true if the units fit into the limit.
+func (q *apiQuota) IsAvailable(now time.Time, units uint64) bool {
+	q.locker.Lock()
+	defer q.locker.Unlock()
+	q.resetIfNeeded(now)
+	return q.used+units <= q.limit
+}
+
+// Charge accounts the units of a call, regardless of the limit.
+func (q *apiQuota) Charge(now time.Time, units uint64) {
+	q.locker.Lock()
+	q.resetIfNeeded(now)
+	q.used += units
+	needSave := q.needSave(now, false)
+	q.locker.Unlock()
+	if needSave {
+		q.saveFunc()
+	}
+}
+
+// Exhaust marks the quota as fully spent until the next reset (for example,
+// if YouTube reported that the quota of the project is exceeded).
+func (q *apiQuota) Exhaust(now time.Time) {
+	q.locker.Lock()
+	q.resetIfNeeded(now)
+	q.used = max(q.used, q.limit)
+	needSave := q.needSave(now, true)
+	q.locker.Unlock()
+	if needSave {
+		q.saveFunc()
+	}
+}
+
+func (q *apiQuota) needSave(now time.Time, force bool) bool {
+	if q.saveFunc == nil {
+		return false
+	}
+	if !force && now.Sub(q.lastSavedAt) < apiQuotaSaveInterval {
+		return false
+	}
+	q.lastSavedAt = now
+	return true
+}
+
+// apiCallCost returns the amount of the quota units spent by a request
+// to the YouTube Data API, see
+// https://developers.google.com/youtube/v3/determine_quota_cost
+func apiCallCost(req *http.Request) uint64 {
+	path := req.URL.Path
+	for _, prefix := range []string{"/upload/youtube/v3/", "/youtube/v3/"} {
+		if idx := strings.Index(path, prefix); idx >= 0 {
+			path = path[idx+len(prefix):]
+			break
+		}
+	}
+	path = strings.Trim(path, "/")
+
+	if req.Method != http.MethodGet {
+		// all the modifying calls (insert, update, delete, transition,
+		// bind, set, etc) cost 50 units:
+		return 50
+	}
+	switch path {
+	case "liveChat/messages":
+		return chatAPIListCost
+	case "search":
+		return 100
+	default:
+		return 1
+	}
+}
+
+// apiQuotaTransport charges each request to the API to the quota.
+type apiQuotaTransport struct {
+	Base  http.RoundTripper
+	Quota *apiQuota
+}
+
+var _ http.RoundTripper = (*apiQuotaTransport)(nil)
+
+func (t *apiQuotaTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// YouTube charges the quota even for the failed calls:
+	t.Quota.Charge(time.Now(), apiCallCost(req))
+	return t.Base.RoundTrip(req)
+}
@@ -0,0 +1,61 @@
+package youtube
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPICallCost(t *testing.T) {
+	for _, tc := range []struct {
+		method   string
+		url      string
+		expected uint64
+	}{
+		{http.MethodGet, "https://youtube.googleapis.com/youtube/v3/liveBroadcasts?part=id", 1},
+		{http.MethodGet, "https://youtube.googleapis.com/youtube/v3/liveChat/messages?liveChatId=x", chatAPIListCost},
+		{http.MethodGet, "https://youtube.googleapis.com/youtube/v3/search?q=x", 100},
+		{http.MethodPut, "https://youtube.googleapis.com/youtube/v3/videos?part=snippet", 50},
+		{http.MethodPost, "https://youtube.googleapis.com/youtube/v3/liveChat/bans?part=snippet", 50},
+		{http.MethodDelete, "https://youtube.googleapis.com/youtube/v3/liveChat/messages?id=x", 50},
+		{http.MethodPost, "https://youtube.googleapis.com/upload/youtube/v3/thumbnails/set?videoId=x", 50},
+	} {
+		req := httptest.NewRequest(tc.method, tc.url, nil)
+		require.Equal(t, tc.expected, apiCallCost(req), "%s %s", tc.method, tc.url)
+	}
+}
+
+func TestAPIQuotaPersistence(t *testing.T) {
+	now := time.Date(2024, 3, 4, 12, 0, 0, 0, quotaResetLocation)
+	resetAt := nextQuotaResetAt(now)
+
+	var saves atomic.Uint64
+	q := newAPIQuota(100, APIQuotaUsage{Used: 90, ResetAt: resetAt}, func() { saves.Add(1) })
+
+	// the units spent before the restart are not forgotten:
+	require.True(t, q.IsAvailable(now, 10))
+	require.False(t, q.IsAvailable(now, 11))
+
+	q.Charge(now, 1)
+	require.Equal(t, uint64(1), saves.Load())
+	// the saves are rate-limited:
+	q.Charge(now.Add(time.Second), 1)
+	require.Equal(t, uint64(1), saves.Load())
+	q.Charge(now.Add(apiQuotaSaveInterval), 1)
+	require.Equal(t, uint64(2), saves.Load())
+	require.Equal(t, APIQuotaUsage{Used: 93, ResetAt: resetAt}, q.Usage(now))
+
+	// but exhausting is saved right away:
+	q.Exhaust(now.Add(apiQuotaSaveInterval + time.Second))
+	require.Equal(t, uint64(3), saves.Load())
+	require.Equal(t, uint64(100), q.Usage(now).Used)
+
+	// an outdated usage is reset:
+	q = newAPIQuota(100, APIQuotaUsage{Used: 100, ResetAt: resetAt}, nil)
+	require.True(t, q.IsAvailable(resetAt, 100))
+	require.Equal(t, APIQuotaUsage{Used: 0, ResetAt: nextQuotaResetAt(resetAt)}, q.Usage(resetAt))
+}
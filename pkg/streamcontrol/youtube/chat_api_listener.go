@@ -0,0 +1,244 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+const (
+	// chatAPIListCost is the amount of the quota units spent
+	// by a liveChatMessages.list call.
+	chatAPIListCost = 5
+
+	chatAPIDefaultPollingInterval = 5 * time.Second
+	chatAPIRetryInterval          = 5 * time.Second
+	chatAPIMaxConsecutiveErrors   = 5
+)
+
+var ErrChatAPIQuotaExceeded = errors.New("the quota for reading the chat through the API is exceeded")
+
+// ChatAPIListener reads the chat through the official API
+// (liveChatMessages.list).
+type ChatAPIListener struct {
+	videoID         string
+	liveChatID      string
+	service         *youtube.Service
+	quota           *apiQuota
+	wg              sync.WaitGroup
+	cancelFunc      context.CancelFunc
+	messagesOutChan chan streamcontrol.ChatMessage
+	err             error
+}
+
+var _ chatListener = (*ChatAPIListener)(nil)
+
+func NewChatAPIListener(
+	ctx context.Context,
+	service *youtube.Service,
+	quota *apiQuota,
+	videoID string,
+	liveChatID string,
+) (*ChatAPIListener, error) {
+	if liveChatID == "" {
+		return nil, fmt.Errorf("live chat ID is empty")
+	}
+	if !quota.IsAvailable(time.Now(), chatAPIListCost) {
+		return nil, ErrChatAPIQuotaExceeded
+	}
+
+	ctx, cancelFunc := context.WithCancel(ctx)
+	l := &ChatAPIListener{
+		videoID:         videoID,
+		liveChatID:      liveChatID,
+		service:         service,
+		quota:           quota,
+		cancelFunc:      cancelFunc,
+		messagesOutChan: make(chan streamcontrol.ChatMessage, 100),
+	}
+	l.wg.Add(1)
+	observability.Go(ctx, func() {
+		defer l.wg.Done()
+		defer func() {
+			logger.Debugf(ctx, "the listener loop is finished")
+			close(l.messagesOutChan)
+		}()
+		err := l.listenLoop(ctx)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			logger.Errorf(ctx, "the listener loop returned an error: %v", err)
+			l.err = err
+		}
+	})
+	return l, nil
+}
+
+func (l *ChatAPIListener) listenLoop(ctx context.Context) (_err error) {
+	logger.Debugf(ctx, "listenLoop")
+	defer func() { logger.Debugf(ctx, "/listenLoop: %v", _err) }()
+
+	pageToken := ""
+	consecutiveErrors := 0
+	for {
+		// the call itself is charged by the transport of the service
+		// (see apiQuotaTransport):
+		if !l.quota.IsAvailable(time.Now(), chatAPIListCost) {
+			return ErrChatAPIQuotaExceeded
+		}
+
+		call := l.service.LiveChatMessages.
+			List(l.liveChatID, []string{"snippet", "authorDetails"}).
+			Context(ctx)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		resp, err := call.Do()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			switch chatAPIErrorReason(err) {
+			case "liveChatEnded", "liveChatNotFound", "liveChatDisabled":
+				logger.Debugf(ctx, "the chat is not available anymore: %v", err)
+				return nil
+			case "quotaExceeded":
+				l.quota.Exhaust(time.Now())
+				return fmt.Errorf("%w: %w", ErrChatAPIQuotaExceeded, err)
+			}
+			consecutiveErrors++
+			if consecutiveErrors >= chatAPIMaxConsecutiveErrors {
+				return fmt.Errorf("unable to get the chat messages %d times in a row: %w", consecutiveErrors, err)
+			}
+			logger.Errorf(ctx, "unable to get the chat messages: %v; retrying in %v", err, chatAPIRetryInterval)
+			if err := sleep(ctx, chatAPIRetryInterval); err != nil {
+				return err
+			}
+			continue
+		}
+		consecutiveErrors = 0
+
+		for _, item := range resp.Items {
+			msg, ok := chatAPIMessage(item)
+			if !ok {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case l.messagesOutChan <- msg:
+			}
+		}
+
+		if resp.OfflineAt != "" {
+			logger.Debugf(ctx, "the broadcast went offline at %s", resp.OfflineAt)
+			return nil
+		}
+		pageToken = resp.NextPageToken
+
+		pollingInterval := time.Duration(resp.PollingIntervalMillis) * time.Millisecond
+		if pollingInterval <= 0 {
+			pollingInterval = chatAPIDefaultPollingInterval
+		}
+		if err := sleep(ctx, pollingInterval); err != nil {
+			return err
+		}
+	}
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	t := time.NewTimer(duration)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+func chatAPIErrorReason(err error) string {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return ""
+	}
+	for _, item := range apiErr.Errors {
+		if item.Reason != "" {
+			return item.Reason
+		}
+	}
+	if apiErr.Code == http.StatusNotFound {
+		return "liveChatNotFound"
+	}
+	return ""
+}
+
+func chatAPIMessage(item *youtube.LiveChatMessage) (streamcontrol.ChatMessage, bool) {
+	if item.Snippet == nil || !item.Snippet.HasDisplayContent || item.Snippet.DisplayMessage == "" {
+		return streamcontrol.ChatMessage{}, false
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, item.Snippet.PublishedAt)
+	if err != nil {
+		createdAt = time.Now()
+	}
+	msg := streamcontrol.ChatMessage{
+		CreatedAt: createdAt,
+		UserID:    streamcontrol.ChatUserID(item.Snippet.AuthorChannelId),
+		MessageID: streamcontrol.ChatMessageID(item.Id),
+		Message:   item.Snippet.DisplayMessage,
+	}
+	if author := item.AuthorDetails; author != nil {
+		msg.Username = author.DisplayName
+		if author.IsChatOwner {
+			msg.Roles = append(msg.Roles, streamcontrol.ChatUserRoleBroadcaster)
+		}
+		if author.IsChatModerator {
+			msg.Roles = append(msg.Roles, streamcontrol.ChatUserRoleModerator)
+			msg.Badges = append(msg.Badges, streamcontrol.ChatBadge{ID: "moderator"})
+		}
+		if author.IsChatSponsor {
+			msg.Roles = append(msg.Roles, streamcontrol.ChatUserRoleSubscriber)
+			msg.Badges = append(msg.Badges, streamcontrol.ChatBadge{ID: "member"})
+		}
+		if author.IsVerified {
+			msg.Badges = append(msg.Badges, streamcontrol.ChatBadge{ID: "verified"})
+		}
+	}
+	switch {
+	case item.Snippet.SuperChatDetails != nil:
+		details := item.Snippet.SuperChatDetails
+		msg.Paid = &streamcontrol.ChatMessagePaid{
+			Amount:      float64(details.AmountMicros) / 1_000_000,
+			Currency:    details.Currency,
+			Description: details.AmountDisplayString,
+		}
+	case item.Snippet.SuperStickerDetails != nil:
+		details := item.Snippet.SuperStickerDetails
+		msg.Paid = &streamcontrol.ChatMessagePaid{
+			Amount:      float64(details.AmountMicros) / 1_000_000,
+			Currency:    details.Currency,
+			Description: details.AmountDisplayString,
+		}
+	}
+	return msg, true
+}
+
+func (l *ChatAPIListener) Close() error {
+	l.cancelFunc()
+	return nil
+}
+
+func (l *ChatAPIListener) MessagesChan() <-chan streamcontrol.ChatMessage {
+	return l.messagesOutChan
+}
+
+func (l *ChatAPIListener) Err() error {
+	return l.err
+}
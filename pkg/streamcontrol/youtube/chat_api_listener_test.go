@@ -0,0 +1,150 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"google.golang.org/api/option"
+	"google.golang.org/api/youtube/v3"
+)
+
+func newTestChatAPIService(
+	t *testing.T,
+	quota *apiQuota,
+	handler http.HandlerFunc,
+) *youtube.Service {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	httpClient := srv.Client()
+	httpClient.Transport = &apiQuotaTransport{
+		Base:  httpClient.Transport,
+		Quota: quota,
+	}
+	service, err := youtube.NewService(
+		context.Background(),
+		option.WithEndpoint(srv.URL),
+		option.WithHTTPClient(httpClient),
+	)
+	require.NoError(t, err)
+	return service
+}
+
+func readChatMessages(
+	t *testing.T,
+	l chatListener,
+) []streamcontrol.ChatMessage {
+	var result []streamcontrol.ChatMessage
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case msg, ok := <-l.MessagesChan():
+			if !ok {
+				return result
+			}
+			result = append(result, msg)
+		case <-timeout:
+			t.Fatal("timeout")
+		}
+	}
+}
+
+func TestChatAPIListener(t *testing.T) {
+	var pageTokens []string
+	quota := newAPIQuota(100, APIQuotaUsage{}, nil)
+	service := newTestChatAPIService(t, quota, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "chat-1", r.URL.Query().Get("liveChatId"))
+		pageToken := r.URL.Query().Get("pageToken")
+		pageTokens = append(pageTokens, pageToken)
+		switch pageToken {
+		case "":
+			fmt.Fprint(w, `{"nextPageToken":"page-2","pollingIntervalMillis":1,"items":[
+				{"id":"msg-1","snippet":{"type":"textMessageEvent","authorChannelId":"UC1","hasDisplayContent":true,"displayMessage":"hello","publishedAt":"2024-01-02T03:04:05.123Z"},"authorDetails":{"channelId":"UC1","displayName":"@someone"}},
+				{"id":"msg-2","snippet":{"type":"messageDeletedEvent","hasDisplayContent":false}}
+			]}`)
+		case "page-2":
+			fmt.Fprint(w, `{"nextPageToken":"page-3","pollingIntervalMillis":1,"offlineAt":"2024-01-02T04:00:00Z","items":[
+				{"id":"msg-3","snippet":{"type":"superChatEvent","authorChannelId":"UC2","hasDisplayContent":true,"displayMessage":"$5.00 from Other: thanks","superChatDetails":{"amountMicros":"5000000","currency":"USD","amountDisplayString":"$5.00"}},"authorDetails":{"channelId":"UC2","displayName":"Other","isChatModerator":true,"isChatSponsor":true}}
+			]}`)
+		default:
+			t.Errorf("unexpected page token '%s'", pageToken)
+		}
+	})
+
+	l, err := NewChatAPIListener(context.Background(), service, quota, "video-1", "chat-1")
+	require.NoError(t, err)
+	defer l.Close()
+
+	msgs := readChatMessages(t, l)
+	require.NoError(t, l.Err())
+	require.Equal(t, []string{"", "page-2"}, pageTokens)
+	require.Len(t, msgs, 2)
+	require.Equal(t, streamcontrol.ChatMessage{
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.UTC),
+		UserID:    "UC1",
+		Username:  "@someone",
+		MessageID: "msg-1",
+		Message:   "hello",
+	}, msgs[0])
+	require.Equal(t, streamcontrol.ChatMessageID("msg-3"), msgs[1].MessageID)
+	require.Equal(t, []streamcontrol.ChatUserRole{
+		streamcontrol.ChatUserRoleModerator,
+		streamcontrol.ChatUserRoleSubscriber,
+	}, msgs[1].Roles)
+	require.Equal(t, &streamcontrol.ChatMessagePaid{
+		Amount:      5,
+		Currency:    "USD",
+		Description: "$5.00",
+	}, msgs[1].Paid)
+	require.Equal(t, uint64(2*chatAPIListCost), quota.used)
+}
+
+func TestChatAPIListenerQuota(t *testing.T) {
+	calls := 0
+	quota := newAPIQuota(3*chatAPIListCost, APIQuotaUsage{}, nil)
+	service := newTestChatAPIService(t, quota, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"nextPageToken":"next","pollingIntervalMillis":1}`)
+	})
+
+	l, err := NewChatAPIListener(context.Background(), service, quota, "video-1", "chat-1")
+	require.NoError(t, err)
+	defer l.Close()
+
+	require.Empty(t, readChatMessages(t, l))
+	require.ErrorIs(t, l.Err(), ErrChatAPIQuotaExceeded)
+	require.Equal(t, 3, calls)
+
+	_, err = NewChatAPIListener(context.Background(), service, quota, "video-1", "chat-1")
+	require.ErrorIs(t, err, ErrChatAPIQuotaExceeded)
+
+	// the quota is reset on the next day:
+	require.True(t, quota.IsAvailable(time.Now().Add(24*time.Hour), chatAPIListCost))
+}
+
+func TestChatAPIListenerQuotaExceededByYouTube(t *testing.T) {
+	quota := newAPIQuota(0, APIQuotaUsage{}, nil)
+	service := newTestChatAPIService(t, quota, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error":{"code":403,"message":"quota","errors":[{"reason":"quotaExceeded","domain":"youtube.quota"}]}}`)
+	})
+
+	l, err := NewChatAPIListener(context.Background(), service, quota, "video-1", "chat-1")
+	require.NoError(t, err)
+	defer l.Close()
+
+	require.Empty(t, readChatMessages(t, l))
+	require.ErrorIs(t, l.Err(), ErrChatAPIQuotaExceeded)
+	require.False(t, quota.IsAvailable(time.Now(), chatAPIListCost))
+}
+
+func TestNextQuotaResetAt(t *testing.T) {
+	now := time.Date(2024, 3, 4, 23, 59, 0, 0, quotaResetLocation)
+	require.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, quotaResetLocation), nextQuotaResetAt(now))
+	require.Equal(t, time.Date(2024, 3, 6, 0, 0, 0, 0, quotaResetLocation), nextQuotaResetAt(now.Add(time.Minute)))
+}
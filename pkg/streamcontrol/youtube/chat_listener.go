@@ -57,8 +57,11 @@ type ChatListener struct {
 	wg               sync.WaitGroup
 	cancelFunc       context.CancelFunc
 	messagesOutChan  chan streamcontrol.ChatMessage
+	err              error
 }
 
+var _ chatListener = (*ChatListener)(nil)
+
 func NewChatListener(
 	ctx context.Context,
 	videoID string,
@@ -92,23 +95,33 @@ func NewChatListener(
 		err := l.listenLoop(ctx)
 		if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, ytchat.ErrLiveStreamOver) {
 			logger.Errorf(ctx, "the listener loop returned an error: %v", err)
+			l.err = err
 		}
 	})
 	return l, nil
 }
 
-const chatFetchRetryInterval = time.Second
+const (
+	chatFetchRetryInterval        = time.Second
+	chatFetchMaxConsecutiveErrors = 30
+)
 
 func (l *ChatListener) listenLoop(ctx context.Context) (_err error) {
 	logger.Debugf(ctx, "listenLoop")
 	defer func() { logger.Debugf(ctx, "/listenLoop: %v", _err) }()
+	consecutiveErrors := 0
 	for {
 		msgs, newContinuation, err := ytchat.FetchContinuationChat(l.continuationCode, l.clientConfig)
 		switch err {
 		case nil:
+			consecutiveErrors = 0
 		case ytchat.ErrLiveStreamOver:
 			return err
 		default:
+			consecutiveErrors++
+			if consecutiveErrors >= chatFetchMaxConsecutiveErrors {
+				return fmt.Errorf("unable to get a continuation %d times in a row: %w", consecutiveErrors, err)
+			}
 			logger.Errorf(ctx, "unable to get a continuation: %v; retrying in %v", chatFetchRetryInterval, err)
 			time.Sleep(chatFetchRetryInterval)
 			continue
@@ -137,3 +150,7 @@ func (h *ChatListener) Close() error {
 func (h *ChatListener) MessagesChan() <-chan streamcontrol.ChatMessage {
 	return h.messagesOutChan
 }
+
+func (h *ChatListener) Err() error {
+	return h.err
+}
@@ -30,6 +30,18 @@ func GetConfig(
 	return streamcontrol.GetPlatformConfig[PlatformSpecificConfig, StreamProfile](ctx, cfg, ID)
 }
 
+type ChatSource = youtube.ChatSource
+
+const (
+	ChatSourceUndefined = youtube.ChatSourceUndefined
+	ChatSourceScraper   = youtube.ChatSourceScraper
+	ChatSourceAPI       = youtube.ChatSourceAPI
+)
+
+const DefaultChatAPIQuotaPerDay = youtube.DefaultChatAPIQuotaPerDay
+
+type APIQuotaUsage = youtube.APIQuotaUsage
+
 type TemplateTags = youtube.TemplateTags
 
 const (
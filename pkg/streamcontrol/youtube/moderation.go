@@ -0,0 +1,268 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/hashicorp/go-multierror"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/xsync"
+	"google.golang.org/api/youtube/v3"
+)
+
+var channelIDRegexp = regexp.MustCompile(`^UC[0-9A-Za-z_-]{22}$`)
+
+// currentLiveChatIDs returns the IDs of the chats of the current live broadcasts.
+func (yt *YouTube) currentLiveChatIDs(
+	ctx context.Context,
+) ([]string, error) {
+	liveChatIDs := xsync.DoR1(ctx, &yt.currentLiveBroadcastsLocker, func() []string {
+		var result []string
+		for _, broadcast := range yt.currentLiveBroadcasts {
+			if broadcast.Snippet == nil || broadcast.Snippet.LiveChatId == "" {
+				continue
+			}
+			result = append(result, broadcast.Snippet.LiveChatId)
+		}
+		return result
+	})
+	if len(liveChatIDs) == 0 {
+		return nil, fmt.Errorf("there are no live broadcasts with a chat")
+	}
+	return liveChatIDs, nil
+}
+
+// chatUserChannelID returns the ID of the channel of the chat user.
+//
+// The chat messages identify the users by their names (see ChatListener),
+// so if userID is not a channel ID, the user is looked up among the recent
+// messages of the chat. Display names are not unique on YouTube, so
+// if the name belongs to several channels, an error is returned and
+// the channel ID should be used instead.
+func (yt *YouTube) chatUserChannelID(
+	ctx context.Context,
+	liveChatID string,
+	userID streamcontrol.ChatUserID,
+) (string, error) {
+	if channelIDRegexp.MatchString(string(userID)) {
+		return string(userID), nil
+	}
+
+	resp, err := yt.YouTubeService.
+		LiveChatMessages.
+		List(liveChatID, []string{"authorDetails"}).
+		Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("unable to get the list of current chat messages of chat '%s': %w", liveChatID, err)
+	}
+
+	userName := strings.TrimPrefix(string(userID), "@")
+	var channelIDs []string
+	for _, item := range resp.Items {
+		if item.AuthorDetails == nil {
+			continue
+		}
+		if strings.TrimPrefix(item.AuthorDetails.DisplayName, "@") != userName {
+			continue
+		}
+		if slices.Contains(channelIDs, item.AuthorDetails.ChannelId) {
+			continue
+		}
+		channelIDs = append(channelIDs, item.AuthorDetails.ChannelId)
+	}
+	switch len(channelIDs) {
+	case 0:
+		return "", fmt.Errorf("user '%s' is not found among the recent messages of chat '%s'", userID, liveChatID)
+	case 1:
+		return channelIDs[0], nil
+	default:
+		return "", errAmbiguousChatUser(userID, liveChatID, channelIDs)
+	}
+}
+
+func errAmbiguousChatUser(
+	userID streamcontrol.ChatUserID,
+	liveChatID string,
+	channelIDs []string,
+) error {
+	return fmt.Errorf("user name '%s' is ambiguous in chat '%s' (channels: %s), please use the channel ID instead", userID, liveChatID, strings.Join(channelIDs, ", "))
+}
+
+// BanUser bans the user in the chats of the current live broadcasts;
+// if deadline is set, then the ban is temporary (a timeout).
+//
+// YouTube does not support a reason of a ban, so it is ignored.
+func (yt *YouTube) BanUser(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+	reason string,
+	deadline time.Time,
+) (_err error) {
+	logger.Debugf(ctx, "BanUser(ctx, '%s', '%s', %v)", userID, reason, deadline)
+	defer func() { logger.Debugf(ctx, "/BanUser(ctx, '%s', '%s', %v): %v", userID, reason, deadline, _err) }()
+
+	banType := "permanent"
+	var durationSeconds uint64
+	if !deadline.IsZero() {
+		duration := time.Until(deadline)
+		if duration <= 0 {
+			return fmt.Errorf("the deadline %v is in the past", deadline)
+		}
+		banType = "temporary"
+		durationSeconds = uint64(math.Ceil(duration.Seconds()))
+	}
+
+	liveChatIDs, err := yt.currentLiveChatIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+	for _, liveChatID := range liveChatIDs {
+		channelID, err := yt.chatUserChannelID(ctx, liveChatID, userID)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		_, err = yt.YouTubeService.LiveChatBans.Insert([]string{"snippet"}, &youtube.LiveChatBan{
+			Snippet: &youtube.LiveChatBanSnippet{
+				LiveChatId:         liveChatID,
+				Type:               banType,
+				BanDurationSeconds: durationSeconds,
+				BannedUserDetails: &youtube.ChannelProfileDetails{
+					ChannelId: channelID,
+				},
+			},
+		}).Context(ctx).Do()
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("unable to ban user '%s' in chat '%s': %w", userID, liveChatID, err))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+// AddModerator makes the user a moderator of the chats of the current
+// live broadcasts.
+func (yt *YouTube) AddModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+) (_err error) {
+	logger.Debugf(ctx, "AddModerator(ctx, '%s')", userID)
+	defer func() { logger.Debugf(ctx, "/AddModerator(ctx, '%s'): %v", userID, _err) }()
+
+	liveChatIDs, err := yt.currentLiveChatIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	var result *multierror.Error
+	for _, liveChatID := range liveChatIDs {
+		channelID, err := yt.chatUserChannelID(ctx, liveChatID, userID)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+		_, err = yt.YouTubeService.LiveChatModerators.Insert([]string{"snippet"}, &youtube.LiveChatModerator{
+			Snippet: &youtube.LiveChatModeratorSnippet{
+				LiveChatId: liveChatID,
+				ModeratorDetails: &youtube.ChannelProfileDetails{
+					ChannelId: channelID,
+				},
+			},
+		}).Context(ctx).Do()
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("unable to add moderator '%s' to chat '%s': %w", userID, liveChatID, err))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+// RemoveModerator removes the user from the moderators of the chats of
+// the current live broadcasts.
+func (yt *YouTube) RemoveModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+) (_err error) {
+	logger.Debugf(ctx, "RemoveModerator(ctx, '%s')", userID)
+	defer func() { logger.Debugf(ctx, "/RemoveModerator(ctx, '%s'): %v", userID, _err) }()
+
+	liveChatIDs, err := yt.currentLiveChatIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	var result *multierror.Error
+	for _, liveChatID := range liveChatIDs {
+		removed, err := yt.removeModeratorFromChat(ctx, liveChatID, userID)
+		count += removed
+		if err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	if err := result.ErrorOrNil(); err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("user '%s' is not a moderator", userID)
+	}
+	return nil
+}
+
+// removeModeratorFromChat removes the user from the moderators of the chat
+// and returns the amount of removed moderators.
+//
+// Similar to chatUserChannelID, if userID is a display name, which belongs
+// to moderators of several channels, nothing is removed and an error
+// is returned.
+func (yt *YouTube) removeModeratorFromChat(
+	ctx context.Context,
+	liveChatID string,
+	userID streamcontrol.ChatUserID,
+) (int, error) {
+	userName := strings.TrimPrefix(string(userID), "@")
+	var (
+		moderatorIDs []string
+		channelIDs   []string
+	)
+	err := yt.YouTubeService.LiveChatModerators.
+		List(liveChatID, []string{"snippet"}).
+		Pages(ctx, func(resp *youtube.LiveChatModeratorListResponse) error {
+			for _, item := range resp.Items {
+				if item.Snippet == nil || item.Snippet.ModeratorDetails == nil {
+					continue
+				}
+				details := item.Snippet.ModeratorDetails
+				if details.ChannelId != string(userID) &&
+					strings.TrimPrefix(details.DisplayName, "@") != userName {
+					continue
+				}
+				moderatorIDs = append(moderatorIDs, item.Id)
+				if !slices.Contains(channelIDs, details.ChannelId) {
+					channelIDs = append(channelIDs, details.ChannelId)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return 0, fmt.Errorf("unable to process the moderators of chat '%s': %w", liveChatID, err)
+	}
+	if len(channelIDs) > 1 {
+		return 0, errAmbiguousChatUser(userID, liveChatID, channelIDs)
+	}
+
+	count := 0
+	for _, moderatorID := range moderatorIDs {
+		if err := yt.YouTubeService.LiveChatModerators.Delete(moderatorID).Context(ctx).Do(); err != nil {
+			return count, fmt.Errorf("unable to remove moderator '%s' from chat '%s': %w", userID, liveChatID, err)
+		}
+		count++
+	}
+	return count, nil
+}
@@ -0,0 +1,85 @@
+package youtube
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatUserChannelID(t *testing.T) {
+	quota := newAPIQuota(0, APIQuotaUsage{}, nil)
+	yt := &YouTube{
+		apiQuota: quota,
+		YouTubeService: newTestChatAPIService(t, quota, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "chat-1", r.URL.Query().Get("liveChatId"))
+			fmt.Fprint(w, `{"items":[
+				{"id":"msg-1","authorDetails":{"channelId":"UC1","displayName":"@someone"}},
+				{"id":"msg-2","authorDetails":{"channelId":"UC1","displayName":"@someone"}},
+				{"id":"msg-3","authorDetails":{"channelId":"UC2","displayName":"@namesake"}},
+				{"id":"msg-4","authorDetails":{"channelId":"UC3","displayName":"namesake"}}
+			]}`)
+		}),
+	}
+	ctx := context.Background()
+
+	channelID, err := yt.chatUserChannelID(ctx, "chat-1", "someone")
+	require.NoError(t, err)
+	require.Equal(t, "UC1", channelID)
+	require.Equal(t, uint64(chatAPIListCost), quota.Usage(time.Now()).Used)
+
+	// no API call is needed for a channel ID:
+	channelID, err = yt.chatUserChannelID(ctx, "chat-1", "UCabcdefghijklmnopqrstuv")
+	require.NoError(t, err)
+	require.Equal(t, "UCabcdefghijklmnopqrstuv", channelID)
+	require.Equal(t, uint64(chatAPIListCost), quota.Usage(time.Now()).Used)
+
+	_, err = yt.chatUserChannelID(ctx, "chat-1", "@namesake")
+	require.ErrorContains(t, err, "ambiguous")
+
+	_, err = yt.chatUserChannelID(ctx, "chat-1", "nobody")
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestRemoveModeratorFromChat(t *testing.T) {
+	var deleted []string
+	quota := newAPIQuota(0, APIQuotaUsage{}, nil)
+	yt := &YouTube{
+		apiQuota: quota,
+		YouTubeService: newTestChatAPIService(t, quota, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				deleted = append(deleted, r.URL.Query().Get("id"))
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			require.Equal(t, "chat-1", r.URL.Query().Get("liveChatId"))
+			fmt.Fprint(w, `{"items":[
+				{"id":"mod-1","snippet":{"moderatorDetails":{"channelId":"UC1","displayName":"@someone"}}},
+				{"id":"mod-2","snippet":{"moderatorDetails":{"channelId":"UC2","displayName":"@namesake"}}},
+				{"id":"mod-3","snippet":{"moderatorDetails":{"channelId":"UC3","displayName":"namesake"}}}
+			]}`)
+		}),
+	}
+	ctx := context.Background()
+
+	_, err := yt.removeModeratorFromChat(ctx, "chat-1", "@namesake")
+	require.ErrorContains(t, err, "ambiguous")
+	require.Empty(t, deleted)
+
+	count, err := yt.removeModeratorFromChat(ctx, "chat-1", "UC2")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, []string{"mod-2"}, deleted)
+
+	count, err = yt.removeModeratorFromChat(ctx, "chat-1", "someone")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, []string{"mod-2", "mod-1"}, deleted)
+
+	count, err = yt.removeModeratorFromChat(ctx, "chat-1", "nobody")
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
@@ -3,6 +3,7 @@ package youtube
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/xaionaro-go/streamctl/pkg/oauthhandler"
 	"github.com/xaionaro-go/streamctl/pkg/secret"
@@ -20,6 +21,27 @@ type PlatformSpecificConfig struct {
 	Token               *secret.OAuth2Token
 	CustomOAuthHandler  OAuthHandler    `yaml:"-"`
 	GetOAuthListenPorts func() []uint16 `yaml:"-"`
+
+	// ChatSource is the preferred way to read the chat; the other one
+	// is used as a fallback.
+	ChatSource ChatSource
+
+	// ChatAPIQuotaPerDay is the amount of the API quota units (spent by
+	// all the API calls) per day, after which reading the chat through
+	// the API stops, so that the rest of the quota is left to managing
+	// the streams (zero means DefaultChatAPIQuotaPerDay).
+	ChatAPIQuotaPerDay uint64
+
+	// APIQuotaUsage is the API quota spent today; it is kept in the config
+	// to not forget it on restarts.
+	APIQuotaUsage APIQuotaUsage
+}
+
+// APIQuotaUsage is the amount of the API quota units spent until
+// the quota is reset by YouTube.
+type APIQuotaUsage struct {
+	Used    uint64
+	ResetAt time.Time
 }
 
 type Config = streamctl.PlatformConfig[PlatformSpecificConfig, StreamProfile]
@@ -32,6 +54,47 @@ func (cfg PlatformSpecificConfig) IsInitialized() bool {
 	return cfg.ClientID != "" && cfg.ClientSecret.Get() != ""
 }
 
+// DefaultChatAPIQuotaPerDay is a half of the default daily quota of
+// a Google Cloud project.
+const DefaultChatAPIQuotaPerDay = 5000
+
+type ChatSource string
+
+const (
+	// ChatSourceUndefined means ChatSourceScraper.
+	ChatSourceUndefined = ChatSource("")
+
+	// ChatSourceScraper reads the chat by scraping the watch page;
+	// it does not spend the API quota, but breaks whenever YouTube
+	// changes the markup.
+	ChatSourceScraper = ChatSource("scraper")
+
+	// ChatSourceAPI reads the chat through the official API
+	// (liveChatMessages.list); it spends the API quota.
+	ChatSourceAPI = ChatSource("api")
+)
+
+func (s *ChatSource) String() string {
+	if s == nil {
+		return "null"
+	}
+	return string(*s)
+}
+
+func (s *ChatSource) Parse(in string) error {
+	for _, candidate := range []ChatSource{
+		ChatSourceUndefined,
+		ChatSourceScraper,
+		ChatSourceAPI,
+	} {
+		if ChatSource(in) == candidate {
+			*s = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown/unexpected value: '%s'", in)
+}
+
 type TemplateTags string
 
 const (
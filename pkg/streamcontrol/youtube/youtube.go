@@ -51,6 +51,7 @@ type YouTube struct {
 	currentLiveBroadcasts       []*youtube.LiveBroadcast
 
 	messagesOutChan chan streamcontrol.ChatMessage
+	apiQuota        *apiQuota
 }
 
 var _ streamcontrol.StreamController[StreamProfile] = (*YouTube)(nil)
@@ -75,6 +76,11 @@ func New(
 
 		messagesOutChan: make(chan streamcontrol.ChatMessage, 100),
 	}
+	yt.apiQuota = newAPIQuota(
+		cfg.Config.ChatAPIQuotaPerDay,
+		cfg.Config.APIQuotaUsage,
+		func() { yt.saveAPIQuotaUsage(ctx) },
+	)
 
 	err := yt.init(ctx)
 	if err != nil {
@@ -143,6 +149,20 @@ func (yt *YouTube) checkTokenNoLock(ctx context.Context) (_err error) {
 	}
 }
 
+// saveAPIQuotaUsage persists the spent API quota. It is asynchronous,
+// because the quota is charged while the controller may be locked.
+func (yt *YouTube) saveAPIQuotaUsage(ctx context.Context) {
+	observability.Go(ctx, func() {
+		yt.locker.Do(ctx, func() {
+			yt.Config.Config.APIQuotaUsage = yt.apiQuota.Usage(time.Now())
+			err := yt.SaveConfigFunc(yt.Config)
+			if err != nil {
+				logger.Errorf(ctx, "unable to save the spent API quota: %v", err)
+			}
+		})
+	})
+}
+
 func (yt *YouTube) getNewToken(ctx context.Context) (_ret *oauth2.Token, _err error) {
 	logger.Debugf(ctx, "YouTube.getNewToken")
 	defer func() { logger.Debugf(ctx, "/YouTube.getNewToken: %v", _err) }()
@@ -197,7 +217,13 @@ func (yt *YouTube) initNoLock(ctx context.Context) (_err error) {
 		return fmt.Errorf("the token is invalid: %w", err)
 	}
 
-	youtubeService, err := youtube.NewService(ctx, option.WithTokenSource(tokenSource))
+	// every call is charged to the quota through the transport:
+	httpClient := oauth2.NewClient(ctx, tokenSource)
+	httpClient.Transport = &apiQuotaTransport{
+		Base:  httpClient.Transport,
+		Quota: yt.apiQuota,
+	}
+	youtubeService, err := youtube.NewService(ctx, option.WithHTTPClient(httpClient))
 	if err != nil {
 		yt.CancelFunc()
 		return err
@@ -1000,7 +1026,7 @@ func (yt *YouTube) StartStream(
 				}
 			}
 			yt.currentLiveBroadcasts = append(yt.currentLiveBroadcasts, newBroadcast)
-			err = yt.startChatListener(ctx, newBroadcast.Id)
+			err = yt.startChatListener(ctx, newBroadcast)
 			if err != nil {
 				logger.Errorf(ctx, "unable to start a chat listener for video '%s': %v", newBroadcast.Id, err)
 			}
@@ -1022,25 +1048,102 @@ func setProfile(broadcast *youtube.LiveBroadcast, profile StreamProfile) {
 	// Don't know how to set the tags :(
 }
 
+type chatListener interface {
+	MessagesChan() <-chan streamcontrol.ChatMessage
+
+	// Err returns the reason why the messages channel got closed
+	// (nil if the chat just ended).
+	Err() error
+
+	Close() error
+}
+
+const chatSourceSwitchDelay = 5 * time.Second
+
+// chatSources returns the ways to read the chat in the order of preference.
+func (yt *YouTube) chatSources() []ChatSource {
+	switch yt.Config.Config.ChatSource {
+	case ChatSourceAPI:
+		return []ChatSource{ChatSourceAPI, ChatSourceScraper}
+	default:
+		return []ChatSource{ChatSourceScraper, ChatSourceAPI}
+	}
+}
+
+func (yt *YouTube) newChatListener(
+	ctx context.Context,
+	source ChatSource,
+	broadcast *youtube.LiveBroadcast,
+) (chatListener, error) {
+	switch source {
+	case ChatSourceScraper:
+		return NewChatListener(ctx, broadcast.Id)
+	case ChatSourceAPI:
+		var liveChatID string
+		if broadcast.Snippet != nil {
+			liveChatID = broadcast.Snippet.LiveChatId
+		}
+		return NewChatAPIListener(ctx, yt.YouTubeService, yt.apiQuota, broadcast.Id, liveChatID)
+	default:
+		return nil, fmt.Errorf("unknown chat source '%s'", source)
+	}
+}
+
+// openChatListener starts reading the chat through the first source
+// (starting from sources[startIdx]) which works.
+func (yt *YouTube) openChatListener(
+	ctx context.Context,
+	sources []ChatSource,
+	startIdx int,
+	broadcast *youtube.LiveBroadcast,
+) (chatListener, int, error) {
+	var result *multierror.Error
+	for i := range sources {
+		idx := (startIdx + i) % len(sources)
+		l, err := yt.newChatListener(ctx, sources[idx], broadcast)
+		if err == nil {
+			logger.Debugf(ctx, "reading the chat of '%s' through '%s'", broadcast.Id, sources[idx])
+			return l, idx, nil
+		}
+		result = multierror.Append(result, fmt.Errorf("unable to start reading the chat through '%s': %w", sources[idx], err))
+	}
+	return nil, -1, result.ErrorOrNil()
+}
+
 func (yt *YouTube) startChatListener(
 	ctx context.Context,
-	videoID string,
+	broadcast *youtube.LiveBroadcast,
 ) (_err error) {
+	videoID := broadcast.Id
 	ctx = belt.WithField(ctx, "video_id", videoID)
 	ctx = xcontext.DetachDone(ctx)
 
 	logger.Debugf(ctx, "startChatListener(ctx, '%s')", videoID)
 	defer func() { logger.Debugf(ctx, "/startChatListener(ctx, '%s'): %v", videoID, _err) }()
 
-	chatListener, err := NewChatListener(ctx, videoID)
+	sources := yt.chatSources()
+	chatListener, sourceIdx, err := yt.openChatListener(ctx, sources, 0, broadcast)
 	if err != nil {
 		return fmt.Errorf("unable to initialize the chat listener instance: %w", err)
 	}
 
 	observability.Go(ctx, func() {
-		err := yt.processChatListener(ctx, chatListener)
-		if err != nil && !errors.Is(err, context.Canceled) {
-			logger.Errorf(ctx, "unable to process the chat listener for '%s': %v", videoID, err)
+		for {
+			err := yt.processChatListener(ctx, videoID, chatListener)
+			if err == nil || errors.Is(err, context.Canceled) {
+				return
+			}
+			logger.Warnf(ctx, "unable to read the chat of '%s' through '%s': %v; falling back to another source", videoID, sources[sourceIdx], err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(chatSourceSwitchDelay):
+			}
+			chatListener, sourceIdx, err = yt.openChatListener(ctx, sources, sourceIdx+1, broadcast)
+			if err != nil {
+				logger.Errorf(ctx, "unable to process the chat listener for '%s': %v", videoID, err)
+				return
+			}
 		}
 	})
 	return nil
@@ -1048,23 +1151,24 @@ func (yt *YouTube) startChatListener(
 
 func (yt *YouTube) processChatListener(
 	ctx context.Context,
-	chatListener *ChatListener,
+	videoID string,
+	chatListener chatListener,
 ) (_err error) {
 	defer func() {
 		err := chatListener.Close()
 		if err != nil {
-			logger.Errorf(ctx, "unable to close the chat listener for '%s': %v", chatListener.videoID, err)
+			logger.Errorf(ctx, "unable to close the chat listener for '%s': %v", videoID, err)
 		}
 	}()
 	defer func() {
-		logger.Debugf(ctx, "stopped listening for chat messages in '%s': %v", chatListener.videoID, _err)
+		logger.Debugf(ctx, "stopped listening for chat messages in '%s': %v", videoID, _err)
 	}()
 	inChan := chatListener.MessagesChan()
 	for {
 		msg, ok := <-inChan
 		if !ok {
 			logger.Debugf(ctx, "the input channel got closed")
-			return nil
+			return chatListener.Err()
 		}
 		select {
 		case <-ctx.Done():
@@ -1155,7 +1259,7 @@ func (yt *YouTube) GetStreamStatus(
 			if _, ok := ids[newBroadcast.Id]; ok {
 				continue
 			}
-			err = yt.startChatListener(ctx, newBroadcast.Id)
+			err = yt.startChatListener(ctx, newBroadcast)
 			if err != nil {
 				logger.Errorf(ctx, "unable to start a chat listener for video '%s': %v", newBroadcast.Id, err)
 			}
@@ -1444,13 +1548,19 @@ func (yt *YouTube) RemoveChatMessage(
 	ctx context.Context,
 	messageID streamcontrol.ChatMessageID,
 ) error {
-	// TODO: The `messageID` value below is not a message ID, unfortunately.
+	// TODO: If the chat is read by the scraper (see ChatListener), then
+	//       the `messageID` value below is not a message ID, unfortunately.
 	//       It just contains the author and the message as a temporary solution.
 	//       Find a way to extract the message ID.
 
 	words := strings.SplitN(string(messageID), "/", 2)
 	if len(words) != 2 {
-		return fmt.Errorf("internal error: cannot split '%s' to author and message", messageID)
+		// a message ID (see ChatAPIListener)
+		err := yt.YouTubeService.LiveChatMessages.Delete(string(messageID)).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("unable to remove the message '%s': %w", messageID, err)
+		}
+		return nil
 	}
 	authorName := words[0]
 	message := words[1]
@@ -1485,14 +1595,6 @@ func (yt *YouTube) RemoveChatMessage(
 
 	return nil
 }
-func (yt *YouTube) BanUser(
-	ctx context.Context,
-	userID streamcontrol.ChatUserID,
-	reason string,
-	deadline time.Time,
-) error {
-	return fmt.Errorf("not implemented, yet")
-}
 
 func (yt *YouTube) IsCapable(
 	ctx context.Context,
@@ -1504,7 +1606,11 @@ func (yt *YouTube) IsCapable(
 	case streamcontrol.CapabilityDeleteChatMessage:
 		return true
 	case streamcontrol.CapabilityBanUser:
-		return false
+		return true
+	case streamcontrol.CapabilityTimeoutUser:
+		return true
+	case streamcontrol.CapabilityManageModerators:
+		return true
 	}
 	return false
 }
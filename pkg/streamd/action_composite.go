@@ -0,0 +1,88 @@
+package streamd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/hashicorp/go-multierror"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/expression"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
+)
+
+func (d *StreamD) doActionSequence(
+	ctx context.Context,
+	a *action.Sequence,
+	exprCtx any,
+) error {
+	for idx, step := range a.Steps {
+		err := d.doAction(ctx, step.Action, exprCtx)
+		if err == nil {
+			continue
+		}
+		err = fmt.Errorf("step #%d (%s) failed: %w", idx, step.Action, err)
+		if step.OnError == action.ErrorPolicyContinue {
+			logger.Warnf(ctx, "%v; continuing", err)
+			continue
+		}
+		return err
+	}
+	return nil
+}
+
+func (d *StreamD) doActionParallel(
+	ctx context.Context,
+	a *action.Parallel,
+	exprCtx any,
+) error {
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+		mErr  *multierror.Error
+	)
+	for idx, step := range a.Steps {
+		wg.Add(1)
+		observability.Go(ctx, func() {
+			defer wg.Done()
+			err := d.doAction(ctx, step.Action, exprCtx)
+			if err == nil {
+				return
+			}
+			err = fmt.Errorf("step #%d (%s) failed: %w", idx, step.Action, err)
+			if step.OnError == action.ErrorPolicyContinue {
+				logger.Warnf(ctx, "%v; continuing", err)
+				return
+			}
+			cancelFn()
+			mutex.Lock()
+			defer mutex.Unlock()
+			mErr = multierror.Append(mErr, err)
+		})
+	}
+	wg.Wait()
+	return mErr.ErrorOrNil()
+}
+
+func (d *StreamD) doActionIf(
+	ctx context.Context,
+	a *action.If,
+	exprCtx any,
+) error {
+	cond, err := expression.Eval[bool](a.Condition, exprCtx)
+	if err != nil {
+		return fmt.Errorf("unable to Eval() the condition '%s': %w", a.Condition, err)
+	}
+	next := a.Else
+	if cond {
+		next = a.Then
+	}
+	if next == nil {
+		return nil
+	}
+	return d.doAction(ctx, next, exprCtx)
+}
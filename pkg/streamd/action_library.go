@@ -0,0 +1,108 @@
+package streamd
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/streamctl/pkg/expression"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
+	"github.com/xaionaro-go/xsync"
+)
+
+func (d *StreamD) applyProfileByName(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+	profileName streamcontrol.ProfileName,
+) error {
+	profile, err := xsync.DoR2(ctx, &d.ConfigLock, func() (streamcontrol.AbstractStreamProfile, error) {
+		platCfg := d.Config.Backends[platID]
+		if platCfg == nil {
+			return nil, fmt.Errorf("platform '%s' is not configured", platID)
+		}
+		profile, ok := platCfg.GetStreamProfile(profileName)
+		if !ok {
+			return nil, fmt.Errorf("profile '%s' is not found on platform '%s'", profileName, platID)
+		}
+		return profile, nil
+	})
+	if err != nil {
+		return err
+	}
+	return d.ApplyProfile(ctx, platID, profile)
+}
+
+func (d *StreamD) setStreamForwardEnabled(
+	ctx context.Context,
+	streamID api.StreamID,
+	destinationID api.DestinationID,
+	enabled bool,
+) error {
+	fwds, err := d.ListStreamForwards(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get the list of stream forwards: %w", err)
+	}
+	for _, fwd := range fwds {
+		if fwd.StreamID != streamID || fwd.DestinationID != destinationID {
+			continue
+		}
+		if fwd.Enabled == enabled {
+			logger.Debugf(ctx, "stream forward %s->%s already has enabled==%v", streamID, destinationID, enabled)
+			return nil
+		}
+		return d.UpdateStreamForward(ctx, streamID, destinationID, enabled, fwd.Quirks)
+	}
+	return fmt.Errorf("stream forward %s->%s is not found", streamID, destinationID)
+}
+
+func (d *StreamD) InsertAdsCuePoint(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+	ts time.Time,
+	duration time.Duration,
+) error {
+	return xsync.RDoR1(ctx, &d.ControllersLocker, func() error {
+		c, err := d.streamController(ctx, platID)
+		if err != nil {
+			return err
+		}
+
+		return c.InsertAdsCuePoint(d.ctxForController(ctx), ts, duration)
+	})
+}
+
+func (d *StreamD) doActionRunCommand(
+	ctx context.Context,
+	a *action.RunCommand,
+	exprCtx any,
+) error {
+	if a.Command == "" {
+		return fmt.Errorf("the command is not set")
+	}
+
+	args := make([]string, 0, len(a.Args))
+	for idx, argExpr := range a.Args {
+		arg, err := expression.Eval[string](argExpr, exprCtx)
+		if err != nil {
+			return fmt.Errorf("unable to Eval() argument #%d '%s': %w", idx, argExpr, err)
+		}
+		args = append(args, arg)
+	}
+
+	if a.Timeout > 0 {
+		var cancelFn context.CancelFunc
+		ctx, cancelFn = context.WithTimeout(ctx, a.Timeout)
+		defer cancelFn()
+	}
+
+	output, err := exec.CommandContext(ctx, a.Command, args...).CombinedOutput()
+	logger.Debugf(ctx, "the output of command '%s' %q: %s", a.Command, args, output)
+	if err != nil {
+		return fmt.Errorf("command '%s' %q failed: %w: %s", a.Command, args, err, output)
+	}
+	return nil
+}
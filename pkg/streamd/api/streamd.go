@@ -9,8 +9,10 @@ import (
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/obs-grpc-proxy/protobuf/go/obs_grpc"
 	"github.com/xaionaro-go/player/pkg/player"
+	"github.com/xaionaro-go/streamctl/pkg/expression"
 	p2ptypes "github.com/xaionaro-go/streamctl/pkg/p2p/types"
 	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	twitch "github.com/xaionaro-go/streamctl/pkg/streamcontrol/twitch/types"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/cache"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
@@ -156,6 +158,18 @@ type StreamD interface {
 		streamID StreamID,
 		destinationID DestinationID,
 	) error
+	StartRecording(
+		ctx context.Context,
+		streamID StreamID,
+		cfg RecordingConfig,
+	) error
+	StopRecording(
+		ctx context.Context,
+		streamID StreamID,
+	) error
+	ListRecordings(
+		ctx context.Context,
+	) ([]Recording, error)
 	WaitForStreamPublisher(
 		ctx context.Context,
 		streamID StreamID,
@@ -237,6 +251,9 @@ type StreamD interface {
 	SubscribeToStreamForwardsChanges(
 		ctx context.Context,
 	) (<-chan DiffStreamForwards, error)
+	SubscribeToStreamForwardStats(
+		ctx context.Context,
+	) (<-chan []StreamForward, error)
 	SubscribeToStreamPlayersChanges(
 		ctx context.Context,
 	) (<-chan DiffStreamPlayers, error)
@@ -245,6 +262,8 @@ type StreamD interface {
 		ctx context.Context,
 		triggerAt time.Time,
 		action Action,
+		missedDeadlinePolicy TimerMissedDeadlinePolicy,
+		schedule TimerSchedule,
 	) (TimerID, error)
 	RemoveTimer(ctx context.Context, timerID TimerID) error
 	ListTimers(ctx context.Context) ([]Timer, error)
@@ -265,6 +284,14 @@ type StreamD interface {
 	ListTriggerRules(
 		ctx context.Context,
 	) (TriggerRules, error)
+	ListTriggerRuleExecutions(
+		ctx context.Context,
+	) ([]TriggerRuleExecution, error)
+	TestTriggerRule(
+		ctx context.Context,
+		triggerRule *config.TriggerRule,
+		event event.Event,
+	) (*TriggerRuleTestResult, error)
 
 	SubmitEvent(
 		ctx context.Context,
@@ -291,6 +318,30 @@ type StreamD interface {
 		reason string,
 		deadline time.Time,
 	) error
+	AddModerator(
+		ctx context.Context,
+		platID streamcontrol.PlatformName,
+		userID streamcontrol.ChatUserID,
+	) error
+	RemoveModerator(
+		ctx context.Context,
+		platID streamcontrol.PlatformName,
+		userID streamcontrol.ChatUserID,
+	) error
+
+	InsertAdsCuePoint(
+		ctx context.Context,
+		platID streamcontrol.PlatformName,
+		ts time.Time,
+		duration time.Duration,
+	) error
+	TwitchCreateStreamMarker(
+		ctx context.Context,
+		description string,
+	) (*TwitchStreamMarker, error)
+	TwitchGetAdSchedule(
+		ctx context.Context,
+	) (*TwitchAdSchedule, error)
 
 	GetPeerIDs(ctx context.Context) ([]p2ptypes.PeerID, error)
 	DialPeerByID(
@@ -313,6 +364,9 @@ type BackendDataTwitch struct {
 	Cache cache.Twitch
 }
 
+type TwitchStreamMarker = twitch.StreamMarker
+type TwitchAdSchedule = twitch.AdSchedule
+
 type BackendDataKick struct {
 	Cache cache.Kick
 }
@@ -343,6 +397,7 @@ type StreamForward struct {
 	Quirks        StreamForwardingQuirks
 	NumBytesWrote uint64
 	NumBytesRead  uint64
+	Stats         StreamForwardStats
 }
 
 type IncomingStream struct {
@@ -355,26 +410,41 @@ type OBSInstanceID = streamtypes.OBSInstanceID
 
 type StreamForwardingQuirks = sstypes.ForwardingQuirks
 
-type RestartUntilYoutubeRecognizesStream = sstypes.RestartUntilYoutubeRecognizesStream
+type RecognizingPlatform = sstypes.RecognizingPlatform
+
+type RestartUntilPlatformRecognizesStream = sstypes.RestartUntilPlatformRecognizesStream
 
-type StartAfterYoutubeRecognizedStream = sstypes.StartAfterYoutubeRecognizedStream
+type StartAfterPlatformRecognizedStream = sstypes.StartAfterPlatformRecognizedStream
+
+type StreamForwardStats = sstypes.StreamForwardStats
+
+type RecordingConfig = sstypes.RecordingConfig
+type Recording = sstypes.Recording
 
 type DiffConfig struct{}
 type DiffDashboard struct{}
 type DiffStreams struct{}
 type DiffStreamServers struct{}
 type DiffStreamDestinations struct{}
-type DiffIncomingStreams struct{}
-type DiffStreamForwards struct{}
+type DiffIncomingStreams struct {
+	// Event is the event caused the change (if any), for example
+	// *event.IncomingStreamPublished or *event.IncomingStreamAuthFailed.
+	Event event.Event
+}
+type DiffStreamForwards struct {
+	// Event is the event caused the change (if any), for example
+	// *event.StreamForwardFailed, *event.StreamForwardSourceSwitched or
+	// *event.StreamForwardFallbackStopped.
+	Event event.Event
+}
 type DiffStreamPlayers struct{}
 
-type TimerID uint64
-
-type Timer struct {
-	ID        TimerID
-	TriggerAt time.Time
-	Action    Action
-}
+type TimerID = config.TimerID
+type Timer = config.Timer
+type Timers = config.Timers
+type TimerMissedDeadlinePolicy = config.TimerMissedDeadlinePolicy
+type TimerSchedule = config.TimerSchedule
+type TimerRelativeToStreamStart = config.TimerRelativeToStreamStart
 
 type Action = action.Action
 
@@ -382,6 +452,36 @@ type TriggerRuleID uint64
 type TriggerRule = config.TriggerRule
 type TriggerRules = config.TriggerRules
 
+// TriggerRuleExecution is a record about an action performed due to a trigger rule.
+type TriggerRuleExecution struct {
+	RuleID    TriggerRuleID
+	Event     event.Event
+	Action    Action
+	StartedAt time.Time
+	EndedAt   time.Time
+	Error     error
+}
+
+// TriggerRuleTestResult is the result of a dry run of a trigger rule.
+type TriggerRuleTestResult struct {
+	Matched bool
+	Actions []TriggerRuleTestAction
+}
+
+// TriggerRuleTestAction is an action that would be performed by a trigger
+// rule, together with the values of its expressions.
+type TriggerRuleTestAction struct {
+	Action      Action
+	Expressions []EvaluatedExpression
+}
+
+type EvaluatedExpression struct {
+	Field      string
+	Expression expression.Expression
+	Value      string
+	Error      error
+}
+
 type ChatMessage struct {
 	streamcontrol.ChatMessage
 	Platform streamcontrol.PlatformName
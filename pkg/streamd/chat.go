@@ -9,6 +9,7 @@ import (
 	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
 )
 
 func (d *StreamD) startListeningForChatMessages(
@@ -34,10 +35,24 @@ func (d *StreamD) startListeningForChatMessages(
 				if !ok {
 					return
 				}
+				d.metrics.observeChatMessage(platName)
 				d.publishEvent(ctx, api.ChatMessage{
 					ChatMessage: ev,
 					Platform:    platName,
 				})
+				if err := d.SubmitEvent(ctx, &event.ChatMessageReceived{
+					Platform:  &platName,
+					UserID:    &ev.UserID,
+					Username:  &ev.Username,
+					MessageID: &ev.MessageID,
+					Message:   &ev.Message,
+					Roles:     ev.Roles,
+					Badges:    ev.Badges,
+					IsPaid:    ptr(ev.Paid != nil),
+					Paid:      ev.Paid,
+				}); err != nil {
+					logger.Errorf(ctx, "unable to submit the ChatMessageReceived event: %v", err)
+				}
 			}
 		}
 	})
@@ -81,3 +96,39 @@ func (d *StreamD) BanUser(
 
 	return nil
 }
+
+func (d *StreamD) AddModerator(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+	userID streamcontrol.ChatUserID,
+) error {
+	ctrl, err := d.streamController(ctx, platID)
+	if err != nil {
+		return fmt.Errorf("unable to get stream controller '%s': %w", platID, err)
+	}
+
+	err = ctrl.AddModerator(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("unable to add moderator '%s' on '%s': %w", userID, platID, err)
+	}
+
+	return nil
+}
+
+func (d *StreamD) RemoveModerator(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+	userID streamcontrol.ChatUserID,
+) error {
+	ctrl, err := d.streamController(ctx, platID)
+	if err != nil {
+		return fmt.Errorf("unable to get stream controller '%s': %w", platID, err)
+	}
+
+	err = ctrl.RemoveModerator(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("unable to remove moderator '%s' on '%s': %w", userID, platID, err)
+	}
+
+	return nil
+}
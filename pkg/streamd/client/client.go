@@ -35,7 +35,6 @@ import (
 	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/goconv"
 	"github.com/xaionaro-go/streamctl/pkg/streampanel/consts"
 	sptypes "github.com/xaionaro-go/streamctl/pkg/streamplayer/types"
-	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
 	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
 	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
 	"github.com/xaionaro-go/xsync"
@@ -1485,45 +1484,7 @@ func (c *Client) ListStreamForwards(
 
 	var result []api.StreamForward
 	for _, forward := range reply.GetStreamForwards() {
-		item := api.StreamForward{
-			Enabled: forward.Config.Enabled,
-			StreamID: api.StreamID(
-				forward.Config.GetStreamID(),
-			),
-			DestinationID: api.DestinationID(
-				forward.Config.GetDestinationID(),
-			),
-			NumBytesWrote: uint64(
-				forward.Statistics.NumBytesWrote,
-			),
-			NumBytesRead: uint64(
-				forward.Statistics.NumBytesRead,
-			),
-		}
-		restartUntilYoutubeRecognizesStream := forward.GetConfig().
-			GetQuirks().
-			GetRestartUntilYoutubeRecognizesStream()
-		if restartUntilYoutubeRecognizesStream != nil {
-			item.Quirks = api.StreamForwardingQuirks{
-				RestartUntilYoutubeRecognizesStream: types.RestartUntilYoutubeRecognizesStream{
-					Enabled: restartUntilYoutubeRecognizesStream.Enabled,
-					StartTimeout: time.Duration(
-						float64(
-							time.Second,
-						) * restartUntilYoutubeRecognizesStream.StartTimeout,
-					),
-					StopStartDelay: time.Duration(
-						float64(
-							time.Second,
-						) * restartUntilYoutubeRecognizesStream.StopStartDelay,
-					),
-				},
-				StartAfterYoutubeRecognizedStream: types.StartAfterYoutubeRecognizedStream{
-					Enabled: forward.Config.Quirks.StartAfterYoutubeRecognizedStream.Enabled,
-				},
-			}
-		}
-		result = append(result, item)
+		result = append(result, goconv.StreamForwardGRPC2Go(forward))
 	}
 	return result, nil
 }
@@ -1549,16 +1510,7 @@ func (c *Client) AddStreamForward(
 					StreamID:      string(streamID),
 					DestinationID: string(destinationID),
 					Enabled:       enabled,
-					Quirks: &streamd_grpc.StreamForwardQuirks{
-						RestartUntilYoutubeRecognizesStream: &streamd_grpc.RestartUntilYoutubeRecognizesStream{
-							Enabled:        quirks.RestartUntilYoutubeRecognizesStream.Enabled,
-							StartTimeout:   quirks.RestartUntilYoutubeRecognizesStream.StartTimeout.Seconds(),
-							StopStartDelay: quirks.RestartUntilYoutubeRecognizesStream.StopStartDelay.Seconds(),
-						},
-						StartAfterYoutubeRecognizedStream: &streamd_grpc.StartAfterYoutubeRecognizedStream{
-							Enabled: quirks.StartAfterYoutubeRecognizedStream.Enabled,
-						},
-					},
+					Quirks:        goconv.StreamForwardQuirksGo2GRPC(quirks),
 				},
 			},
 		)
@@ -1587,16 +1539,7 @@ func (c *Client) UpdateStreamForward(
 					StreamID:      string(streamID),
 					DestinationID: string(destinationID),
 					Enabled:       enabled,
-					Quirks: &streamd_grpc.StreamForwardQuirks{
-						RestartUntilYoutubeRecognizesStream: &streamd_grpc.RestartUntilYoutubeRecognizesStream{
-							Enabled:        quirks.RestartUntilYoutubeRecognizesStream.Enabled,
-							StartTimeout:   quirks.RestartUntilYoutubeRecognizesStream.StartTimeout.Seconds(),
-							StopStartDelay: quirks.RestartUntilYoutubeRecognizesStream.StopStartDelay.Seconds(),
-						},
-						StartAfterYoutubeRecognizedStream: &streamd_grpc.StartAfterYoutubeRecognizedStream{
-							Enabled: quirks.StartAfterYoutubeRecognizedStream.Enabled,
-						},
-					},
+					Quirks:        goconv.StreamForwardQuirksGo2GRPC(quirks),
 				},
 			},
 		)
@@ -1629,6 +1572,76 @@ func (c *Client) RemoveStreamForward(
 	return err
 }
 
+func (c *Client) StartRecording(
+	ctx context.Context,
+	streamID api.StreamID,
+	cfg api.RecordingConfig,
+) error {
+	_, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.StartRecordingReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.StartRecording,
+			&streamd_grpc.StartRecordingRequest{
+				StreamID: string(streamID),
+				Config:   goconv.RecordingConfigGo2GRPC(cfg),
+			},
+		)
+	})
+	return err
+}
+
+func (c *Client) StopRecording(
+	ctx context.Context,
+	streamID api.StreamID,
+) error {
+	_, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.StopRecordingReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.StopRecording,
+			&streamd_grpc.StopRecordingRequest{
+				StreamID: string(streamID),
+			},
+		)
+	})
+	return err
+}
+
+func (c *Client) ListRecordings(
+	ctx context.Context,
+) ([]api.Recording, error) {
+	reply, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.ListRecordingsReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.ListRecordings,
+			&streamd_grpc.ListRecordingsRequest{},
+		)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to request to list the recordings: %w", err)
+	}
+
+	result := make([]api.Recording, 0, len(reply.GetRecordings()))
+	for _, recording := range reply.GetRecordings() {
+		result = append(result, goconv.RecordingGRPC2Go(recording))
+	}
+	return result, nil
+}
+
 func (c *Client) WaitForStreamPublisher(
 	ctx context.Context,
 	streamID api.StreamID,
@@ -2247,7 +2260,17 @@ func (c *Client) SubscribeToIncomingStreamsChanges(
 			ctx context.Context,
 			event *streamd_grpc.IncomingStreamsChange,
 		) api.DiffIncomingStreams {
-			return api.DiffIncomingStreams{}
+			if event.GetEvent() == nil {
+				return api.DiffIncomingStreams{}
+			}
+			ev, err := goconv.EventGRPC2Go(event.GetEvent())
+			if err != nil {
+				logger.Errorf(ctx, "unable to convert the event %v: %v", event.GetEvent(), err)
+				return api.DiffIncomingStreams{}
+			}
+			return api.DiffIncomingStreams{
+				Event: ev,
+			}
 		},
 	)
 }
@@ -2273,7 +2296,47 @@ func (c *Client) SubscribeToStreamForwardsChanges(
 			ctx context.Context,
 			event *streamd_grpc.StreamForwardsChange,
 		) api.DiffStreamForwards {
-			return api.DiffStreamForwards{}
+			if event.GetEvent() == nil {
+				return api.DiffStreamForwards{}
+			}
+			ev, err := goconv.EventGRPC2Go(event.GetEvent())
+			if err != nil {
+				logger.Errorf(ctx, "unable to convert the event %v: %v", event.GetEvent(), err)
+				return api.DiffStreamForwards{}
+			}
+			return api.DiffStreamForwards{
+				Event: ev,
+			}
+		},
+	)
+}
+
+func (c *Client) SubscribeToStreamForwardStats(
+	ctx context.Context,
+) (<-chan []api.StreamForward, error) {
+	return unwrapStreamDChan(
+		ctx,
+		c,
+		func(
+			ctx context.Context,
+			client streamd_grpc.StreamDClient,
+		) (streamd_grpc.StreamD_SubscribeToStreamForwardStatsClient, error) {
+			return callWrapper(
+				ctx,
+				c,
+				client.SubscribeToStreamForwardStats,
+				&streamd_grpc.SubscribeToStreamForwardStatsRequest{},
+			)
+		},
+		func(
+			ctx context.Context,
+			update *streamd_grpc.StreamForwardStatsUpdate,
+		) []api.StreamForward {
+			var result []api.StreamForward
+			for _, forward := range update.GetStreamForwards() {
+				result = append(result, goconv.StreamForwardGRPC2Go(forward))
+			}
+			return result
 		},
 	)
 }
@@ -2358,6 +2421,8 @@ func (c *Client) AddTimer(
 	ctx context.Context,
 	triggerAt time.Time,
 	action api.Action,
+	missedDeadlinePolicy api.TimerMissedDeadlinePolicy,
+	schedule api.TimerSchedule,
 ) (api.TimerID, error) {
 	actionGRPC, err := goconv.ActionGo2GRPC(action)
 	if err != nil {
@@ -2366,6 +2431,13 @@ func (c *Client) AddTimer(
 			err,
 		)
 	}
+	missedDeadlinePolicyGRPC, err := goconv.TimerMissedDeadlinePolicyGo2GRPC(missedDeadlinePolicy)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"unable to convert the missed deadline policy: %w",
+			err,
+		)
+	}
 	reply, err := withStreamDClient(ctx, c, func(
 		ctx context.Context,
 		client streamd_grpc.StreamDClient,
@@ -2376,8 +2448,10 @@ func (c *Client) AddTimer(
 			c,
 			client.AddTimer,
 			&streamd_grpc.AddTimerRequest{
-				TriggerAtUnixNano: triggerAt.UnixNano(),
-				Action:            actionGRPC,
+				TriggerAtUnixNano:    goconv.UnixGo2GRPC(triggerAt),
+				Action:               actionGRPC,
+				MissedDeadlinePolicy: missedDeadlinePolicyGRPC,
+				Schedule:             goconv.TimerScheduleGo2GRPC(schedule),
 			},
 		)
 	})
@@ -2441,25 +2515,15 @@ func (c *Client) ListTimers(
 	timers := reply.GetTimers()
 	result := make([]api.Timer, 0, len(timers))
 	for _, timer := range timers {
-		triggerAtUnixNano := timer.GetTriggerAtUnixNano()
-		triggerAt := time.Unix(
-			triggerAtUnixNano/int64(time.Second),
-			triggerAtUnixNano%int64(time.Second),
-		)
-		action, err := goconv.ActionGRPC2Go(
-			timer.GetAction(),
-		)
+		timerGo, err := goconv.TimerGRPC2Go(timer)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to convert the action: %w",
+				"unable to convert the timer %d: %w",
+				timer.GetTimerID(),
 				err,
 			)
 		}
-		result = append(result, api.Timer{
-			ID:        api.TimerID(timer.GetTimerID()),
-			TriggerAt: triggerAt,
-			Action:    action,
-		})
+		result = append(result, timerGo)
 	}
 	return result, nil
 }
@@ -2576,6 +2640,71 @@ func (c *Client) ListTriggerRules(
 	return result, nil
 }
 
+func (c *Client) ListTriggerRuleExecutions(
+	ctx context.Context,
+) ([]api.TriggerRuleExecution, error) {
+	response, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.ListTriggerRuleExecutionsReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.ListTriggerRuleExecutions,
+			&streamd_grpc.ListTriggerRuleExecutionsRequest{},
+		)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the trigger rule executions: %w", err)
+	}
+
+	executions := response.GetExecutions()
+	result := make([]api.TriggerRuleExecution, 0, len(executions))
+	for _, executionGRPC := range executions {
+		execution, err := goconv.TriggerRuleExecutionGRPC2Go(executionGRPC)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert the trigger rule execution %#+v: %w", executionGRPC, err)
+		}
+		result = append(result, execution)
+	}
+	return result, nil
+}
+
+func (c *Client) TestTriggerRule(
+	ctx context.Context,
+	triggerRule *api.TriggerRule,
+	event event.Event,
+) (*api.TriggerRuleTestResult, error) {
+	triggerRuleGRPC, err := goconv.TriggerRuleGo2GRPC(triggerRule)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the trigger rule: %w", err)
+	}
+	eventGRPC, err := goconv.EventGo2GRPC(event)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the event: %w", err)
+	}
+	response, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.TestTriggerRuleReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.TestTriggerRule,
+			&streamd_grpc.TestTriggerRuleRequest{
+				Rule:  triggerRuleGRPC,
+				Event: eventGRPC,
+			},
+		)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to test the trigger rule: %w", err)
+	}
+	return goconv.TriggerRuleTestResultGRPC2Go(response)
+}
+
 func (c *Client) SubmitEvent(
 	ctx context.Context,
 	event event.Event,
@@ -2636,6 +2765,13 @@ func (c *Client) SubscribeToChatMessages(
 					Username:  event.GetUsername(),
 					MessageID: streamcontrol.ChatMessageID(event.GetMessageID()),
 					Message:   event.GetMessage(),
+
+					Roles:            goconv.ChatUserRolesGRPC2Go(event.GetRoles()),
+					Badges:           goconv.ChatBadgesGRPC2Go(event.GetBadges()),
+					Color:            event.GetColor(),
+					Fragments:        goconv.ChatMessageFragmentsGRPC2Go(event.GetFragments()),
+					ReplyToMessageID: streamcontrol.ChatMessageID(event.GetReplyToMessageID()),
+					Paid:             goconv.ChatMessagePaidGRPC2Go(event.GetPaid()),
 				},
 				Platform: streamcontrol.PlatformName(event.GetPlatID()),
 			}
@@ -2702,6 +2838,131 @@ func (c *Client) BanUser(
 	return nil
 }
 
+func (c *Client) AddModerator(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+	userID streamcontrol.ChatUserID,
+) error {
+	_, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.AddModeratorReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.AddModerator,
+			&streamd_grpc.AddModeratorRequest{
+				PlatID: string(platID),
+				UserID: string(userID),
+			},
+		)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add the moderator: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) RemoveModerator(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+	userID streamcontrol.ChatUserID,
+) error {
+	_, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.RemoveModeratorReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.RemoveModerator,
+			&streamd_grpc.RemoveModeratorRequest{
+				PlatID: string(platID),
+				UserID: string(userID),
+			},
+		)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove the moderator: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) InsertAdsCuePoint(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+	ts time.Time,
+	duration time.Duration,
+) error {
+	_, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.InsertAdsCuePointReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.InsertAdsCuePoint,
+			&streamd_grpc.InsertAdsCuePointRequest{
+				PlatID:       string(platID),
+				TsUnixNano:   ts.UnixNano(),
+				DurationNano: int64(duration),
+			},
+		)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert an ads cue point: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) TwitchCreateStreamMarker(
+	ctx context.Context,
+	description string,
+) (*api.TwitchStreamMarker, error) {
+	reply, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.TwitchCreateStreamMarkerReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.TwitchCreateStreamMarker,
+			&streamd_grpc.TwitchCreateStreamMarkerRequest{
+				Description: description,
+			},
+		)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a stream marker on Twitch: %w", err)
+	}
+	return ptr(goconv.TwitchStreamMarkerGRPC2Go(reply.GetMarker())), nil
+}
+
+func (c *Client) TwitchGetAdSchedule(
+	ctx context.Context,
+) (*api.TwitchAdSchedule, error) {
+	reply, err := withStreamDClient(ctx, c, func(
+		ctx context.Context,
+		client streamd_grpc.StreamDClient,
+		conn io.Closer,
+	) (*streamd_grpc.TwitchGetAdScheduleReply, error) {
+		return callWrapper(
+			ctx,
+			c,
+			client.TwitchGetAdSchedule,
+			&streamd_grpc.TwitchGetAdScheduleRequest{},
+		)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the ad schedule from Twitch: %w", err)
+	}
+	return ptr(goconv.TwitchAdScheduleGRPC2Go(reply.GetSchedule())), nil
+}
+
 func (c *Client) SendChatMessage(
 	ctx context.Context,
 	platID streamcontrol.PlatformName,
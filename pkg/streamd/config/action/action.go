@@ -3,10 +3,13 @@ package action
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/xaionaro-go/streamctl/pkg/expression"
 	"github.com/xaionaro-go/streamctl/pkg/serializable"
 	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/consts"
+	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
 )
 
 func init() {
@@ -15,6 +18,17 @@ func init() {
 	serializable.RegisterType[OBSWindowCaptureSetSource]()
 	serializable.RegisterType[StartStream]()
 	serializable.RegisterType[EndStream]()
+	serializable.RegisterType[OBSSetCurrentScene]()
+	serializable.RegisterType[SendChatMessage]()
+	serializable.RegisterType[SetTitle]()
+	serializable.RegisterType[SetDescription]()
+	serializable.RegisterType[ApplyProfile]()
+	serializable.RegisterType[EnableStreamForward]()
+	serializable.RegisterType[DisableStreamForward]()
+	serializable.RegisterType[SetVariable]()
+	serializable.RegisterType[InsertAdsCuePoint]()
+	serializable.RegisterType[RunCommand]()
+	serializable.RegisterType[TwitchCreateStreamMarker]()
 }
 
 type Action interface {
@@ -58,6 +72,23 @@ func (a *OBSWindowCaptureSetSource) String() string {
 	return string(tryJSON(*a))
 }
 
+// OBSSetCurrentScene switches the current program scene in OBS; the scene
+// name is the result of the expression.
+type OBSSetCurrentScene struct {
+	SceneNameExpression ValueExpression `yaml:"scene_name_expression" json:"scene_name_expression"`
+}
+
+var _ Action = (*OBSSetCurrentScene)(nil)
+
+func (*OBSSetCurrentScene) isAction() {}
+
+func (a *OBSSetCurrentScene) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
 type Noop struct{}
 
 var _ Action = (*Noop)(nil)
@@ -108,6 +139,173 @@ func (a *EndStream) String() string {
 	return string(tryJSON(*a))
 }
 
+type SendChatMessage struct {
+	PlatID            streamcontrol.PlatformName `yaml:"platform"           json:"platform"`
+	MessageExpression ValueExpression            `yaml:"message_expression" json:"message_expression"`
+}
+
+var _ Action = (*SendChatMessage)(nil)
+
+func (*SendChatMessage) isAction() {}
+
+func (a *SendChatMessage) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+type SetTitle struct {
+	PlatID          streamcontrol.PlatformName `yaml:"platform"         json:"platform"`
+	TitleExpression ValueExpression            `yaml:"title_expression" json:"title_expression"`
+}
+
+var _ Action = (*SetTitle)(nil)
+
+func (*SetTitle) isAction() {}
+
+func (a *SetTitle) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+type SetDescription struct {
+	PlatID                streamcontrol.PlatformName `yaml:"platform"               json:"platform"`
+	DescriptionExpression ValueExpression            `yaml:"description_expression" json:"description_expression"`
+}
+
+var _ Action = (*SetDescription)(nil)
+
+func (*SetDescription) isAction() {}
+
+func (a *SetDescription) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+// ApplyProfile applies a stream profile defined in the platform config;
+// the profile name is the result of the expression.
+type ApplyProfile struct {
+	PlatID                streamcontrol.PlatformName `yaml:"platform"                json:"platform"`
+	ProfileNameExpression ValueExpression            `yaml:"profile_name_expression" json:"profile_name_expression"`
+}
+
+var _ Action = (*ApplyProfile)(nil)
+
+func (*ApplyProfile) isAction() {}
+
+func (a *ApplyProfile) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+type EnableStreamForward struct {
+	StreamID      streamtypes.StreamID      `yaml:"stream_id"      json:"stream_id"`
+	DestinationID streamtypes.DestinationID `yaml:"destination_id" json:"destination_id"`
+}
+
+var _ Action = (*EnableStreamForward)(nil)
+
+func (*EnableStreamForward) isAction() {}
+
+func (a *EnableStreamForward) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+type DisableStreamForward struct {
+	StreamID      streamtypes.StreamID      `yaml:"stream_id"      json:"stream_id"`
+	DestinationID streamtypes.DestinationID `yaml:"destination_id" json:"destination_id"`
+}
+
+var _ Action = (*DisableStreamForward)(nil)
+
+func (*DisableStreamForward) isAction() {}
+
+func (a *DisableStreamForward) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+type SetVariable struct {
+	Key             consts.VarKey   `yaml:"key"              json:"key"`
+	ValueExpression ValueExpression `yaml:"value_expression" json:"value_expression"`
+}
+
+var _ Action = (*SetVariable)(nil)
+
+func (*SetVariable) isAction() {}
+
+func (a *SetVariable) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+// InsertAdsCuePoint asks the platform to show ads right now.
+type InsertAdsCuePoint struct {
+	PlatID   streamcontrol.PlatformName `yaml:"platform" json:"platform"`
+	Duration time.Duration              `yaml:"duration" json:"duration"`
+}
+
+var _ Action = (*InsertAdsCuePoint)(nil)
+
+func (*InsertAdsCuePoint) isAction() {}
+
+func (a *InsertAdsCuePoint) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+// TwitchCreateStreamMarker marks the current moment of the Twitch live
+// stream in its VOD; the description is the result of the expression.
+type TwitchCreateStreamMarker struct {
+	DescriptionExpression ValueExpression `yaml:"description_expression,omitempty" json:"description_expression,omitempty"`
+}
+
+var _ Action = (*TwitchCreateStreamMarker)(nil)
+
+func (*TwitchCreateStreamMarker) isAction() {}
+
+func (a *TwitchCreateStreamMarker) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+// RunCommand executes a program (without a shell). Each argument is
+// evaluated separately, so the event data cannot inject extra arguments.
+type RunCommand struct {
+	Command string            `yaml:"command"           json:"command"`
+	Args    []ValueExpression `yaml:"args,omitempty"    json:"args,omitempty"`
+	Timeout time.Duration     `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+var _ Action = (*RunCommand)(nil)
+
+func (*RunCommand) isAction() {}
+
+func (a *RunCommand) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
 func tryJSON(value any) []byte {
 	b, _ := json.Marshal(value)
 	return b
@@ -0,0 +1,36 @@
+package action
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/serializable"
+)
+
+func TestActionsSerialization(t *testing.T) {
+	for _, a := range []Action{
+		&OBSSetCurrentScene{SceneNameExpression: "BRB"},
+		&SendChatMessage{PlatID: "twitch", MessageExpression: `welcome, {{ .user }}!`},
+		&SetTitle{PlatID: "youtube", TitleExpression: `playing {{ .game }}`},
+		&SetDescription{PlatID: "youtube", DescriptionExpression: "some description"},
+		&ApplyProfile{PlatID: "twitch", ProfileNameExpression: "gaming"},
+		&EnableStreamForward{StreamID: "live", DestinationID: "twitch"},
+		&DisableStreamForward{StreamID: "live", DestinationID: "youtube"},
+		&SetVariable{Key: "last_follower", ValueExpression: `{{ .user }}`},
+		&InsertAdsCuePoint{PlatID: "twitch", Duration: time.Minute},
+		&RunCommand{Command: "/usr/bin/notify-send", Args: []ValueExpression{"new follower", `{{ .user }}`}, Timeout: time.Second},
+	} {
+		t.Run(a.String(), func(t *testing.T) {
+			b, err := yaml.Marshal(serializable.Serializable[Action]{Value: a})
+			require.NoError(t, err)
+
+			var cmp serializable.Serializable[Action]
+			err = yaml.Unmarshal(b, &cmp)
+			require.NoError(t, err, string(b))
+
+			require.Equal(t, a, cmp.Value)
+		})
+	}
+}
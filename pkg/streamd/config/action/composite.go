@@ -0,0 +1,181 @@
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/xaionaro-go/streamctl/pkg/serializable"
+)
+
+func init() {
+	serializable.RegisterType[Sequence]()
+	serializable.RegisterType[Parallel]()
+	serializable.RegisterType[Delay]()
+	serializable.RegisterType[If]()
+}
+
+type ErrorPolicy string
+
+const (
+	ErrorPolicyUndefined = ErrorPolicy("")
+	ErrorPolicyAbort     = ErrorPolicy("abort")
+	ErrorPolicyContinue  = ErrorPolicy("continue")
+)
+
+// Step is an action of a Sequence or of a Parallel.
+type Step struct {
+	Action Action `yaml:"action" json:"action"`
+
+	// OnError defines what to do if the action failed; an empty
+	// value means ErrorPolicyAbort.
+	OnError ErrorPolicy `yaml:"on_error,omitempty" json:"on_error,omitempty"`
+}
+
+var _ yaml.BytesMarshaler = (*Step)(nil)
+var _ yaml.BytesUnmarshaler = (*Step)(nil)
+
+func (s *Step) UnmarshalYAML(b []byte) error {
+	if s == nil {
+		return fmt.Errorf("nil Step")
+	}
+
+	intermediate := serializableStep{}
+	err := yaml.Unmarshal(b, &intermediate)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal the Step: %w: %s", err, b)
+	}
+
+	*s = Step{
+		Action:  intermediate.Action.Value,
+		OnError: intermediate.OnError,
+	}
+	if s.Action == nil {
+		return fmt.Errorf("s.Action == nil")
+	}
+	return nil
+}
+
+func (s Step) MarshalYAML() ([]byte, error) {
+	return yaml.Marshal(serializableStep{
+		Action:  serializable.Serializable[Action]{Value: s.Action},
+		OnError: s.OnError,
+	})
+}
+
+type serializableStep struct {
+	Action  serializable.Serializable[Action] `yaml:"action"             json:"action"`
+	OnError ErrorPolicy                       `yaml:"on_error,omitempty" json:"on_error,omitempty"`
+}
+
+// Sequence executes the steps one by one.
+type Sequence struct {
+	Steps []Step `yaml:"steps" json:"steps"`
+}
+
+var _ Action = (*Sequence)(nil)
+
+func (*Sequence) isAction() {}
+
+func (a *Sequence) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+// Parallel executes the steps concurrently and waits for all of them
+// to finish. A failed step with ErrorPolicyAbort cancels the rest of
+// the steps.
+type Parallel struct {
+	Steps []Step `yaml:"steps" json:"steps"`
+}
+
+var _ Action = (*Parallel)(nil)
+
+func (*Parallel) isAction() {}
+
+func (a *Parallel) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+// Delay just waits for the specified duration.
+type Delay struct {
+	Duration time.Duration `yaml:"duration" json:"duration"`
+}
+
+var _ Action = (*Delay)(nil)
+
+func (*Delay) isAction() {}
+
+func (a *Delay) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+// If executes Then if the Condition evaluates to "true", and Else otherwise.
+type If struct {
+	Condition ValueExpression `yaml:"condition"      json:"condition"`
+	Then      Action          `yaml:"then,omitempty" json:"then,omitempty"`
+	Else      Action          `yaml:"else,omitempty" json:"else,omitempty"`
+}
+
+var _ Action = (*If)(nil)
+var _ yaml.BytesMarshaler = (*If)(nil)
+var _ yaml.BytesUnmarshaler = (*If)(nil)
+
+func (*If) isAction() {}
+
+func (a *If) String() string {
+	if a == nil {
+		return "null"
+	}
+	return string(tryJSON(*a))
+}
+
+func (a *If) UnmarshalYAML(b []byte) error {
+	if a == nil {
+		return fmt.Errorf("nil If")
+	}
+
+	intermediate := serializableIf{}
+	err := yaml.Unmarshal(b, &intermediate)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal the If: %w: %s", err, b)
+	}
+
+	*a = If{
+		Condition: intermediate.Condition,
+	}
+	if intermediate.Then != nil {
+		a.Then = intermediate.Then.Value
+	}
+	if intermediate.Else != nil {
+		a.Else = intermediate.Else.Value
+	}
+	return nil
+}
+
+func (a If) MarshalYAML() ([]byte, error) {
+	intermediate := serializableIf{
+		Condition: a.Condition,
+	}
+	if a.Then != nil {
+		intermediate.Then = &serializable.Serializable[Action]{Value: a.Then}
+	}
+	if a.Else != nil {
+		intermediate.Else = &serializable.Serializable[Action]{Value: a.Else}
+	}
+	return yaml.Marshal(intermediate)
+}
+
+type serializableIf struct {
+	Condition ValueExpression                    `yaml:"condition"      json:"condition"`
+	Then      *serializable.Serializable[Action] `yaml:"then,omitempty" json:"then,omitempty"`
+	Else      *serializable.Serializable[Action] `yaml:"else,omitempty" json:"else,omitempty"`
+}
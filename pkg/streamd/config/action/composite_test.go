@@ -0,0 +1,44 @@
+package action
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/serializable"
+)
+
+func TestCompositeActions(t *testing.T) {
+	itemName := "BRB"
+	a := &Sequence{
+		Steps: []Step{
+			{Action: &OBSItemShowHide{ItemName: &itemName, ValueExpression: "true"}},
+			{Action: &Delay{Duration: 5 * time.Second}},
+			{
+				Action: &Parallel{
+					Steps: []Step{
+						{Action: &EndStream{PlatID: "twitch"}, OnError: ErrorPolicyContinue},
+						{Action: &EndStream{PlatID: "youtube"}},
+					},
+				},
+				OnError: ErrorPolicyContinue,
+			},
+			{
+				Action: &If{
+					Condition: `{{ eq .platform "twitch" }}`,
+					Then:      &Noop{},
+				},
+			},
+		},
+	}
+
+	b, err := yaml.Marshal(serializable.Serializable[Action]{Value: a})
+	require.NoError(t, err)
+
+	var cmp serializable.Serializable[Action]
+	err = yaml.Unmarshal(b, &cmp)
+	require.NoError(t, err, string(b))
+
+	require.Equal(t, a, cmp.Value)
+}
@@ -29,6 +29,8 @@ type config struct {
 	Dashboard       DashboardConfig     `yaml:"monitor"` // TODO: rename to `dashboard`
 	TriggerRules    TriggerRules        `yaml:"trigger_rules"`
 	P2PNetwork      P2PNetwork          `yaml:"p2p_network"`
+	Timers          Timers              `yaml:"timers"`
+	NextTimerID     TimerID             `yaml:"next_timer_id"`
 }
 
 type Config config
@@ -3,12 +3,35 @@ package event
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/xaionaro-go/streamctl/pkg/serializable"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
 )
 
 func init() {
 	serializable.RegisterType[WindowFocusChange]()
+	serializable.RegisterType[ChatMessageReceived]()
+	serializable.RegisterType[StreamStarted]()
+	serializable.RegisterType[StreamEnded]()
+	serializable.RegisterType[ViewerCountChanged]()
+	serializable.RegisterType[IncomingStreamPublished]()
+	serializable.RegisterType[IncomingStreamUnpublished]()
+	serializable.RegisterType[IncomingStreamAuthFailed]()
+	serializable.RegisterType[StreamForwardStarted]()
+	serializable.RegisterType[StreamForwardFailed]()
+	serializable.RegisterType[StreamForwardSourceSwitched]()
+	serializable.RegisterType[StreamForwardFallbackStopped]()
+	serializable.RegisterType[TwitchFollow]()
+	serializable.RegisterType[TwitchSubscription]()
+	serializable.RegisterType[TwitchSubscriptionGift]()
+	serializable.RegisterType[TwitchCheer]()
+	serializable.RegisterType[TwitchRaid]()
+	serializable.RegisterType[TwitchChannelPointsRedemption]()
+	serializable.RegisterType[TwitchPoll]()
+	serializable.RegisterType[TwitchPrediction]()
 }
 
 type Event interface {
@@ -67,6 +90,460 @@ func (ev *WindowFocusChange) String() string {
 	return string(tryJSON(*ev))
 }
 
+type ChatMessageReceived struct {
+	Platform  *streamcontrol.PlatformName  `yaml:"platform,omitempty"   json:"platform,omitempty"`
+	UserID    *streamcontrol.ChatUserID    `yaml:"user_id,omitempty"    json:"user_id,omitempty"`
+	Username  *string                      `yaml:"username,omitempty"   json:"username,omitempty"`
+	MessageID *streamcontrol.ChatMessageID `yaml:"message_id,omitempty" json:"message_id,omitempty"`
+	Message   *string                      `yaml:"message,omitempty"    json:"message,omitempty"`
+
+	// Roles are the roles of the author. In a trigger rule it matches
+	// if the author has all of the roles (e.g. "roles: [moderator]").
+	Roles []streamcontrol.ChatUserRole `yaml:"roles,omitempty" json:"roles,omitempty"`
+
+	// Badges are the badges of the author. In a trigger rule it matches
+	// if the author has all of the badges (an empty version matches any).
+	Badges []streamcontrol.ChatBadge `yaml:"badges,omitempty" json:"badges,omitempty"`
+
+	// IsPaid is true if the message is paid (see Paid).
+	IsPaid *bool                          `yaml:"is_paid,omitempty" json:"is_paid,omitempty"`
+	Paid   *streamcontrol.ChatMessagePaid `yaml:"paid,omitempty"    json:"paid,omitempty"`
+
+	// MessageContains, MessageRegex, PaidCurrency and PaidAmountMin are
+	// used only for matching (in a trigger rule) and are never set in
+	// the submitted events.
+	MessageContains *string  `yaml:"message_contains,omitempty" json:"message_contains,omitempty"`
+	MessageRegex    *string  `yaml:"message_regex,omitempty"    json:"message_regex,omitempty"`
+	PaidCurrency    *string  `yaml:"paid_currency,omitempty"    json:"paid_currency,omitempty"`
+	PaidAmountMin   *float64 `yaml:"paid_amount_min,omitempty"  json:"paid_amount_min,omitempty"`
+}
+
+func (ev *ChatMessageReceived) Get() Event { return ev }
+
+func (ev *ChatMessageReceived) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*ChatMessageReceived)
+	if !ok {
+		return false
+	}
+
+	if !fieldMatch(ev.Platform, cmp.Platform) {
+		return false
+	}
+	if !fieldMatch(ev.UserID, cmp.UserID) {
+		return false
+	}
+	if !fieldMatch(ev.Username, cmp.Username) {
+		return false
+	}
+	if !fieldMatch(ev.MessageID, cmp.MessageID) {
+		return false
+	}
+	if !fieldMatch(ev.Message, cmp.Message) {
+		return false
+	}
+	if !messageContainsMatch(ev.MessageContains, cmp.Message) || !messageContainsMatch(cmp.MessageContains, ev.Message) {
+		return false
+	}
+	if !messageRegexMatch(ev.MessageRegex, cmp.Message) || !messageRegexMatch(cmp.MessageRegex, ev.Message) {
+		return false
+	}
+	if !subsetMatch(ev.Roles, cmp.Roles, roleMatch) {
+		return false
+	}
+	if !subsetMatch(ev.Badges, cmp.Badges, badgeMatch) {
+		return false
+	}
+	if !fieldMatch(ev.IsPaid, cmp.IsPaid) {
+		return false
+	}
+	if !paidMatch(ev, cmp.Paid) || !paidMatch(cmp, ev.Paid) {
+		return false
+	}
+
+	return true
+}
+
+// subsetMatch returns true if either of the slices contains all
+// the items of the other one (thus an empty slice matches anything).
+func subsetMatch[T any](s1, s2 []T, itemMatch func(T, T) bool) bool {
+	return containsAll(s1, s2, itemMatch) || containsAll(s2, s1, itemMatch)
+}
+
+func containsAll[T any](s, items []T, itemMatch func(T, T) bool) bool {
+	for _, item := range items {
+		if !slices.ContainsFunc(s, func(candidate T) bool { return itemMatch(candidate, item) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func roleMatch(r1, r2 streamcontrol.ChatUserRole) bool {
+	return r1 == r2
+}
+
+func badgeMatch(b1, b2 streamcontrol.ChatBadge) bool {
+	if b1.ID != b2.ID {
+		return false
+	}
+	return b1.Version == "" || b2.Version == "" || b1.Version == b2.Version
+}
+
+func paidMatch(query *ChatMessageReceived, paid *streamcontrol.ChatMessagePaid) bool {
+	if query.PaidCurrency == nil && query.PaidAmountMin == nil {
+		return true
+	}
+	if paid == nil {
+		return false
+	}
+	if query.PaidCurrency != nil && !strings.EqualFold(*query.PaidCurrency, paid.Currency) {
+		return false
+	}
+	if query.PaidAmountMin != nil && paid.Amount < *query.PaidAmountMin {
+		return false
+	}
+	return true
+}
+
+func messageContainsMatch(substr *string, message *string) bool {
+	if substr == nil || message == nil {
+		return true
+	}
+	return strings.Contains(*message, *substr)
+}
+
+func messageRegexMatch(regex *string, message *string) bool {
+	if regex == nil || message == nil {
+		return true
+	}
+	r, err := CompileRegex(*regex)
+	if err != nil {
+		return false
+	}
+	return r.MatchString(*message)
+}
+
+func (ev *ChatMessageReceived) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type StreamStarted struct {
+	Platform *streamcontrol.PlatformName `yaml:"platform,omitempty" json:"platform,omitempty"`
+}
+
+func (ev *StreamStarted) Get() Event { return ev }
+
+func (ev *StreamStarted) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*StreamStarted)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.Platform, cmp.Platform)
+}
+
+func (ev *StreamStarted) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type StreamEnded struct {
+	Platform *streamcontrol.PlatformName `yaml:"platform,omitempty" json:"platform,omitempty"`
+}
+
+func (ev *StreamEnded) Get() Event { return ev }
+
+func (ev *StreamEnded) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*StreamEnded)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.Platform, cmp.Platform)
+}
+
+func (ev *StreamEnded) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type ViewerCountChanged struct {
+	Platform             *streamcontrol.PlatformName `yaml:"platform,omitempty"               json:"platform,omitempty"`
+	ViewersCount         *uint64                     `yaml:"viewers_count,omitempty"          json:"viewers_count,omitempty"`
+	PreviousViewersCount *uint64                     `yaml:"previous_viewers_count,omitempty" json:"previous_viewers_count,omitempty"`
+
+	// The fields below are used only for matching (in a trigger rule)
+	// and are never set in the submitted events.
+
+	// ViewersCountMin and ViewersCountMax define the range (inclusive)
+	// the new viewers count should be in.
+	ViewersCountMin *uint64 `yaml:"viewers_count_min,omitempty" json:"viewers_count_min,omitempty"`
+	ViewersCountMax *uint64 `yaml:"viewers_count_max,omitempty" json:"viewers_count_max,omitempty"`
+
+	// CrossedAbove matches if the viewers count became greater or equal
+	// to the value, while previously it was less.
+	CrossedAbove *uint64 `yaml:"crossed_above,omitempty" json:"crossed_above,omitempty"`
+
+	// CrossedBelow matches if the viewers count became less than
+	// the value, while previously it was greater or equal.
+	CrossedBelow *uint64 `yaml:"crossed_below,omitempty" json:"crossed_below,omitempty"`
+}
+
+func (ev *ViewerCountChanged) Get() Event { return ev }
+
+func (ev *ViewerCountChanged) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*ViewerCountChanged)
+	if !ok {
+		return false
+	}
+
+	if !fieldMatch(ev.Platform, cmp.Platform) {
+		return false
+	}
+	if !fieldMatch(ev.ViewersCount, cmp.ViewersCount) {
+		return false
+	}
+	if !fieldMatch(ev.PreviousViewersCount, cmp.PreviousViewersCount) {
+		return false
+	}
+	if !viewerCountQueryMatch(ev, cmp) || !viewerCountQueryMatch(cmp, ev) {
+		return false
+	}
+
+	return true
+}
+
+func viewerCountQueryMatch(query, ev *ViewerCountChanged) bool {
+	if ev.ViewersCount == nil {
+		return true
+	}
+	cur := *ev.ViewersCount
+	var prev uint64
+	if ev.PreviousViewersCount != nil {
+		prev = *ev.PreviousViewersCount
+	}
+
+	if query.ViewersCountMin != nil && cur < *query.ViewersCountMin {
+		return false
+	}
+	if query.ViewersCountMax != nil && cur > *query.ViewersCountMax {
+		return false
+	}
+	if query.CrossedAbove != nil && !(prev < *query.CrossedAbove && cur >= *query.CrossedAbove) {
+		return false
+	}
+	if query.CrossedBelow != nil && !(prev >= *query.CrossedBelow && cur < *query.CrossedBelow) {
+		return false
+	}
+	return true
+}
+
+func (ev *ViewerCountChanged) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type IncomingStreamPublished struct {
+	StreamID *streamtypes.StreamID `yaml:"stream_id,omitempty" json:"stream_id,omitempty"`
+}
+
+func (ev *IncomingStreamPublished) Get() Event { return ev }
+
+func (ev *IncomingStreamPublished) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*IncomingStreamPublished)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.StreamID, cmp.StreamID)
+}
+
+func (ev *IncomingStreamPublished) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type IncomingStreamUnpublished struct {
+	StreamID *streamtypes.StreamID `yaml:"stream_id,omitempty" json:"stream_id,omitempty"`
+}
+
+func (ev *IncomingStreamUnpublished) Get() Event { return ev }
+
+func (ev *IncomingStreamUnpublished) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*IncomingStreamUnpublished)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.StreamID, cmp.StreamID)
+}
+
+func (ev *IncomingStreamUnpublished) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type IncomingStreamAuthFailed struct {
+	StreamID *streamtypes.StreamID `yaml:"stream_id,omitempty" json:"stream_id,omitempty"`
+
+	// Action is either "publish" or "read".
+	Action *string `yaml:"action,omitempty" json:"action,omitempty"`
+
+	RemoteAddr *string `yaml:"remote_addr,omitempty" json:"remote_addr,omitempty"`
+}
+
+func (ev *IncomingStreamAuthFailed) Get() Event { return ev }
+
+func (ev *IncomingStreamAuthFailed) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*IncomingStreamAuthFailed)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.StreamID, cmp.StreamID) &&
+		fieldMatch(ev.Action, cmp.Action) &&
+		fieldMatch(ev.RemoteAddr, cmp.RemoteAddr)
+}
+
+func (ev *IncomingStreamAuthFailed) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type StreamForwardStarted struct {
+	StreamID      *streamtypes.StreamID      `yaml:"stream_id,omitempty"      json:"stream_id,omitempty"`
+	DestinationID *streamtypes.DestinationID `yaml:"destination_id,omitempty" json:"destination_id,omitempty"`
+}
+
+func (ev *StreamForwardStarted) Get() Event { return ev }
+
+func (ev *StreamForwardStarted) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*StreamForwardStarted)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.StreamID, cmp.StreamID) &&
+		fieldMatch(ev.DestinationID, cmp.DestinationID)
+}
+
+func (ev *StreamForwardStarted) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type StreamForwardFailed struct {
+	StreamID      *streamtypes.StreamID      `yaml:"stream_id,omitempty"      json:"stream_id,omitempty"`
+	DestinationID *streamtypes.DestinationID `yaml:"destination_id,omitempty" json:"destination_id,omitempty"`
+
+	// Error is the description of the failure; it is not used for matching
+	// (use ErrorContains for that).
+	Error *string `yaml:"error,omitempty" json:"error,omitempty"`
+
+	// ErrorContains is used only in queries: the event matches only if
+	// the error contains the substring.
+	ErrorContains *string `yaml:"error_contains,omitempty" json:"error_contains,omitempty"`
+}
+
+func (ev *StreamForwardFailed) Get() Event { return ev }
+
+func (ev *StreamForwardFailed) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*StreamForwardFailed)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.StreamID, cmp.StreamID) &&
+		fieldMatch(ev.DestinationID, cmp.DestinationID) &&
+		messageContainsMatch(ev.ErrorContains, cmp.Error) &&
+		messageContainsMatch(cmp.ErrorContains, ev.Error)
+}
+
+func (ev *StreamForwardFailed) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type StreamForwardSourceSwitched struct {
+	StreamID      *streamtypes.StreamID      `yaml:"stream_id,omitempty"      json:"stream_id,omitempty"`
+	DestinationID *streamtypes.DestinationID `yaml:"destination_id,omitempty" json:"destination_id,omitempty"`
+
+	// Source is the stream ID or the file that is forwarded now.
+	Source     *string `yaml:"source,omitempty"      json:"source,omitempty"`
+	IsFallback *bool   `yaml:"is_fallback,omitempty" json:"is_fallback,omitempty"`
+}
+
+func (ev *StreamForwardSourceSwitched) Get() Event { return ev }
+
+func (ev *StreamForwardSourceSwitched) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*StreamForwardSourceSwitched)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.StreamID, cmp.StreamID) &&
+		fieldMatch(ev.DestinationID, cmp.DestinationID) &&
+		fieldMatch(ev.Source, cmp.Source) &&
+		fieldMatch(ev.IsFallback, cmp.IsFallback)
+}
+
+func (ev *StreamForwardSourceSwitched) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+type StreamForwardFallbackStopped struct {
+	StreamID      *streamtypes.StreamID      `yaml:"stream_id,omitempty"      json:"stream_id,omitempty"`
+	DestinationID *streamtypes.DestinationID `yaml:"destination_id,omitempty" json:"destination_id,omitempty"`
+
+	// Fallback is the stream ID or the file that was forwarded.
+	Fallback *string `yaml:"fallback,omitempty" json:"fallback,omitempty"`
+
+	// Reason is why the fallback stopped; it is not used for matching.
+	Reason *string `yaml:"reason,omitempty" json:"reason,omitempty"`
+}
+
+func (ev *StreamForwardFallbackStopped) Get() Event { return ev }
+
+func (ev *StreamForwardFallbackStopped) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*StreamForwardFallbackStopped)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.StreamID, cmp.StreamID) &&
+		fieldMatch(ev.DestinationID, cmp.DestinationID) &&
+		fieldMatch(ev.Fallback, cmp.Fallback)
+}
+
+func (ev *StreamForwardFallbackStopped) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
 func tryJSON(value any) []byte {
 	b, _ := json.Marshal(value)
 	return b
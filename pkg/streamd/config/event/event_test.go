@@ -0,0 +1,259 @@
+package event
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
+)
+
+func ptr[T any](in T) *T {
+	return &in
+}
+
+func TestChatMessageReceivedMatch(t *testing.T) {
+	ev := &ChatMessageReceived{
+		Platform:  ptr(streamcontrol.PlatformName("twitch")),
+		UserID:    ptr(streamcontrol.ChatUserID("123")),
+		Username:  ptr("someone"),
+		MessageID: ptr(streamcontrol.ChatMessageID("456")),
+		Message:   ptr("hey, !brb for 5 mins"),
+	}
+
+	for _, q := range []*ChatMessageReceived{
+		{},
+		{Platform: ptr(streamcontrol.PlatformName("twitch"))},
+		{Username: ptr("someone"), MessageContains: ptr("!brb")},
+		{MessageRegex: ptr(`!brb\b`)},
+		{Message: ptr("hey, !brb for 5 mins")},
+	} {
+		require.True(t, q.Match(ev), q.String())
+	}
+
+	for _, q := range []*ChatMessageReceived{
+		{Platform: ptr(streamcontrol.PlatformName("youtube"))},
+		{UserID: ptr(streamcontrol.ChatUserID("1"))},
+		{MessageContains: ptr("!lurk")},
+		{MessageRegex: ptr(`^!brb`)},
+		{MessageRegex: ptr(`(`)},
+	} {
+		require.False(t, q.Match(ev), q.String())
+	}
+
+	require.False(t, (&ChatMessageReceived{}).Match(&WindowFocusChange{}))
+}
+
+func TestChatMessageReceivedMatchAuthor(t *testing.T) {
+	ev := &ChatMessageReceived{
+		Message: ptr("!brb"),
+		Roles: []streamcontrol.ChatUserRole{
+			streamcontrol.ChatUserRoleModerator,
+			streamcontrol.ChatUserRoleSubscriber,
+		},
+		Badges: []streamcontrol.ChatBadge{
+			{ID: "moderator", Version: "1"},
+			{ID: "subscriber", Version: "12"},
+		},
+		IsPaid: ptr(true),
+		Paid:   &streamcontrol.ChatMessagePaid{Amount: 500, Currency: "bits"},
+	}
+
+	for _, q := range []*ChatMessageReceived{
+		{Roles: []streamcontrol.ChatUserRole{streamcontrol.ChatUserRoleModerator}, Message: ptr("!brb")},
+		{Badges: []streamcontrol.ChatBadge{{ID: "subscriber"}}},
+		{Badges: []streamcontrol.ChatBadge{{ID: "subscriber", Version: "12"}}},
+		{IsPaid: ptr(true)},
+		{PaidCurrency: ptr("BITS"), PaidAmountMin: ptr(100.0)},
+	} {
+		require.True(t, q.Match(ev), q.String())
+	}
+
+	for _, q := range []*ChatMessageReceived{
+		{Roles: []streamcontrol.ChatUserRole{streamcontrol.ChatUserRoleBroadcaster}},
+		{Roles: []streamcontrol.ChatUserRole{streamcontrol.ChatUserRoleModerator, streamcontrol.ChatUserRoleVIP}},
+		{Badges: []streamcontrol.ChatBadge{{ID: "subscriber", Version: "6"}}},
+		{IsPaid: ptr(false)},
+		{PaidCurrency: ptr("USD")},
+		{PaidAmountMin: ptr(1000.0)},
+	} {
+		require.False(t, q.Match(ev), q.String())
+	}
+
+	require.False(t, (&ChatMessageReceived{PaidAmountMin: ptr(1.0)}).Match(&ChatMessageReceived{IsPaid: ptr(false)}))
+}
+
+func TestChatMessageReceivedRolesYAML(t *testing.T) {
+	var q ChatMessageReceived
+	require.NoError(t, yaml.Unmarshal([]byte("roles: [moderator]\nmessage_contains: '!brb'\n"), &q))
+	require.Equal(t, []streamcontrol.ChatUserRole{streamcontrol.ChatUserRoleModerator}, q.Roles)
+
+	b, err := yaml.Marshal(q)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "- moderator")
+
+	require.Error(t, yaml.Unmarshal([]byte("roles: [king]\n"), &q))
+}
+
+func TestViewerCountChangedMatch(t *testing.T) {
+	ev := &ViewerCountChanged{
+		Platform:             ptr(streamcontrol.PlatformName("youtube")),
+		ViewersCount:         ptr(uint64(105)),
+		PreviousViewersCount: ptr(uint64(98)),
+	}
+
+	for _, q := range []*ViewerCountChanged{
+		{},
+		{Platform: ptr(streamcontrol.PlatformName("youtube"))},
+		{ViewersCountMin: ptr(uint64(100))},
+		{ViewersCountMin: ptr(uint64(100)), ViewersCountMax: ptr(uint64(105))},
+		{CrossedAbove: ptr(uint64(100))},
+	} {
+		require.True(t, q.Match(ev), q.String())
+	}
+
+	for _, q := range []*ViewerCountChanged{
+		{Platform: ptr(streamcontrol.PlatformName("twitch"))},
+		{ViewersCountMax: ptr(uint64(100))},
+		{CrossedAbove: ptr(uint64(50))},
+		{CrossedBelow: ptr(uint64(100))},
+	} {
+		require.False(t, q.Match(ev), q.String())
+	}
+
+	require.True(t, (&ViewerCountChanged{CrossedBelow: ptr(uint64(100))}).Match(&ViewerCountChanged{
+		ViewersCount:         ptr(uint64(99)),
+		PreviousViewersCount: ptr(uint64(100)),
+	}))
+}
+
+func TestStreamForwardFailedMatch(t *testing.T) {
+	ev := &StreamForwardFailed{
+		StreamID:      ptr(streamtypes.StreamID("obs")),
+		DestinationID: ptr(streamtypes.DestinationID("twitch")),
+		Error:         ptr("unable to open the output: connection refused"),
+	}
+
+	for _, q := range []*StreamForwardFailed{
+		{},
+		{StreamID: ptr(streamtypes.StreamID("obs"))},
+		{DestinationID: ptr(streamtypes.DestinationID("twitch"))},
+		{ErrorContains: ptr("connection refused")},
+	} {
+		require.True(t, q.Match(ev), q.String())
+	}
+
+	for _, q := range []*StreamForwardFailed{
+		{StreamID: ptr(streamtypes.StreamID("camera"))},
+		{DestinationID: ptr(streamtypes.DestinationID("youtube"))},
+		{ErrorContains: ptr("timeout")},
+	} {
+		require.False(t, q.Match(ev), q.String())
+	}
+
+	require.False(t, (&StreamForwardFailed{}).Match(&StreamForwardStarted{}))
+	require.False(t, (&IncomingStreamPublished{}).Match(&IncomingStreamUnpublished{}))
+	require.True(t, (&IncomingStreamPublished{StreamID: ptr(streamtypes.StreamID("obs"))}).Match(&IncomingStreamPublished{
+		StreamID: ptr(streamtypes.StreamID("obs")),
+	}))
+}
+
+func TestStreamForwardSourceSwitchedMatch(t *testing.T) {
+	ev := &StreamForwardSourceSwitched{
+		StreamID:      ptr(streamtypes.StreamID("obs")),
+		DestinationID: ptr(streamtypes.DestinationID("twitch")),
+		Source:        ptr("backup"),
+		IsFallback:    ptr(true),
+	}
+
+	require.True(t, (&StreamForwardSourceSwitched{}).Match(ev))
+	require.True(t, (&StreamForwardSourceSwitched{IsFallback: ptr(true)}).Match(ev))
+	require.False(t, (&StreamForwardSourceSwitched{IsFallback: ptr(false)}).Match(ev))
+	require.False(t, (&StreamForwardSourceSwitched{Source: ptr("slate.mp4")}).Match(ev))
+	require.False(t, (&StreamForwardSourceSwitched{}).Match(&StreamForwardStarted{}))
+}
+
+func TestTwitchCheerMatch(t *testing.T) {
+	ev := &TwitchCheer{
+		UserID:      ptr(streamcontrol.ChatUserID("123")),
+		Username:    ptr("someone"),
+		IsAnonymous: ptr(false),
+		Bits:        ptr(uint64(500)),
+		Message:     ptr("Cheer500 gg"),
+	}
+
+	require.True(t, (&TwitchCheer{}).Match(ev))
+	require.True(t, (&TwitchCheer{BitsMin: ptr(uint64(100))}).Match(ev))
+	require.True(t, (&TwitchCheer{BitsMin: ptr(uint64(500))}).Match(ev))
+	require.False(t, (&TwitchCheer{BitsMin: ptr(uint64(1000))}).Match(ev))
+	require.False(t, (&TwitchCheer{IsAnonymous: ptr(true)}).Match(ev))
+	require.False(t, (&TwitchCheer{}).Match(&TwitchRaid{}))
+}
+
+func TestTwitchRaidMatch(t *testing.T) {
+	ev := &TwitchRaid{
+		FromUserID:   ptr(streamcontrol.ChatUserID("7")),
+		FromUsername: ptr("raider"),
+		Viewers:      ptr(uint64(9)),
+	}
+
+	require.True(t, (&TwitchRaid{}).Match(ev))
+	require.True(t, (&TwitchRaid{FromUsername: ptr("raider"), ViewersMin: ptr(uint64(5))}).Match(ev))
+	require.False(t, (&TwitchRaid{ViewersMin: ptr(uint64(10))}).Match(ev))
+	require.False(t, (&TwitchRaid{FromUsername: ptr("someone")}).Match(ev))
+}
+
+func TestCompileRegex(t *testing.T) {
+	r0, err := CompileRegex(`^!brb$`)
+	require.NoError(t, err)
+	r1, err := CompileRegex(`^!brb$`)
+	require.NoError(t, err)
+	require.Same(t, r0, r1)
+
+	_, err = CompileRegex(`(`)
+	require.Error(t, err)
+	_, err = CompileRegex(`(`)
+	require.Error(t, err)
+}
+
+func TestRegexLRU(t *testing.T) {
+	c := newRegexLRU(2)
+	r0, err := c.Compile(`a`)
+	require.NoError(t, err)
+	_, err = c.Compile(`b`)
+	require.NoError(t, err)
+
+	// "a" becomes the most recently used, so "b" is evicted:
+	r0Cached, err := c.Compile(`a`)
+	require.NoError(t, err)
+	require.Same(t, r0, r0Cached)
+	_, err = c.Compile(`c`)
+	require.NoError(t, err)
+	require.Equal(t, 2, c.Len())
+
+	r0Cached, err = c.Compile(`a`)
+	require.NoError(t, err)
+	require.Same(t, r0, r0Cached)
+	for i := 0; i < 10; i++ {
+		_, err = c.Compile(fmt.Sprintf("x%d", i))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, c.Len())
+}
+
+func TestStreamForwardFallbackStoppedMatch(t *testing.T) {
+	ev := &StreamForwardFallbackStopped{
+		StreamID:      ptr(streamtypes.StreamID("obs")),
+		DestinationID: ptr(streamtypes.DestinationID("twitch")),
+		Fallback:      ptr("slate.ts"),
+		Reason:        ptr("the fallback has reached its maximal duration"),
+	}
+
+	require.True(t, (&StreamForwardFallbackStopped{}).Match(ev))
+	require.True(t, (&StreamForwardFallbackStopped{Fallback: ptr("slate.ts")}).Match(ev))
+	require.True(t, (&StreamForwardFallbackStopped{Reason: ptr("unrelated")}).Match(ev))
+	require.False(t, (&StreamForwardFallbackStopped{Fallback: ptr("backup")}).Match(ev))
+	require.False(t, (&StreamForwardFallbackStopped{}).Match(&StreamForwardSourceSwitched{}))
+}
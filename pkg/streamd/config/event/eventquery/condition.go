@@ -0,0 +1,75 @@
+package eventquery
+
+import (
+	"context"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/consts"
+)
+
+// StateProvider provides the current state for the conditions, which
+// do not depend on the event itself.
+type StateProvider interface {
+	// GetLastStreamStatus returns the last known stream status, without
+	// querying the platform (the conditions are checked on every event).
+	GetLastStreamStatus(
+		ctx context.Context,
+		platID streamcontrol.PlatformName,
+	) (*streamcontrol.StreamStatus, error)
+	GetVariable(ctx context.Context, key consts.VarKey) ([]byte, error)
+}
+
+// StreamIsActive matches (any event) if a stream is active on the platform.
+type StreamIsActive struct {
+	PlatID streamcontrol.PlatformName `yaml:"platform" json:"platform"`
+}
+
+func (q *StreamIsActive) Match(ctx context.Context, _ event.Event, state StateProvider) bool {
+	if state == nil {
+		logger.Errorf(ctx, "the state is not provided, cannot check if the stream is active on '%s'", q.PlatID)
+		return false
+	}
+	status, err := state.GetLastStreamStatus(ctx, q.PlatID)
+	if err != nil {
+		logger.Errorf(ctx, "unable to get the stream status of '%s': %v", q.PlatID, err)
+		return false
+	}
+	return status.IsActive
+}
+func (q *StreamIsActive) Get() EventQuery { return q }
+
+func (q *StreamIsActive) String() string {
+	if q == nil {
+		return "null"
+	}
+	return string(tryJSON(*q))
+}
+
+// VariableEquals matches (any event) if the variable has the value.
+type VariableEquals struct {
+	Key   consts.VarKey `yaml:"key"   json:"key"`
+	Value string        `yaml:"value" json:"value"`
+}
+
+func (q *VariableEquals) Match(ctx context.Context, _ event.Event, state StateProvider) bool {
+	if state == nil {
+		logger.Errorf(ctx, "the state is not provided, cannot get variable '%s'", q.Key)
+		return false
+	}
+	value, err := state.GetVariable(ctx, q.Key)
+	if err != nil {
+		logger.Debugf(ctx, "unable to get variable '%s': %v", q.Key, err)
+		return false
+	}
+	return string(value) == q.Value
+}
+func (q *VariableEquals) Get() EventQuery { return q }
+
+func (q *VariableEquals) String() string {
+	if q == nil {
+		return "null"
+	}
+	return string(tryJSON(*q))
+}
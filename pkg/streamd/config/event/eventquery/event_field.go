@@ -0,0 +1,113 @@
+package eventquery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+)
+
+// EventField matches a field of the event. The field is addressed by
+// its name in the serialized event (for example "window_title" or
+// "viewers_count"); nested fields are separated by dots.
+type EventField struct {
+	Field string `yaml:"field" json:"field"`
+
+	// Regex if set, then the field value (as a string) should match it.
+	Regex *string `yaml:"regex,omitempty" json:"regex,omitempty"`
+
+	// Min and Max if set, then the field value should be a number
+	// within the range (inclusive).
+	Min *float64 `yaml:"min,omitempty" json:"min,omitempty"`
+	Max *float64 `yaml:"max,omitempty" json:"max,omitempty"`
+}
+
+func (q *EventField) Match(ctx context.Context, ev event.Event, _ StateProvider) bool {
+	value, ok := eventFieldValue(ev, q.Field)
+	if !ok {
+		return false
+	}
+
+	if q.Regex != nil {
+		r, err := event.CompileRegex(*q.Regex)
+		if err != nil {
+			logger.Errorf(ctx, "unable to compile regex '%s': %v", *q.Regex, err)
+			return false
+		}
+		if !r.MatchString(fmt.Sprint(value)) {
+			return false
+		}
+	}
+
+	if q.Min != nil || q.Max != nil {
+		number, ok := toFloat64(value)
+		if !ok {
+			return false
+		}
+		if q.Min != nil && number < *q.Min {
+			return false
+		}
+		if q.Max != nil && number > *q.Max {
+			return false
+		}
+	}
+
+	return true
+}
+func (q *EventField) Get() EventQuery { return q }
+
+func (q *EventField) String() string {
+	if q == nil {
+		return "null"
+	}
+	return string(tryJSON(*q))
+}
+
+func eventFieldValue(ev event.Event, field string) (any, bool) {
+	if ev == nil || field == "" {
+		return nil, false
+	}
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return nil, false
+	}
+	var cur any
+	if err := json.Unmarshal(b, &cur); err != nil {
+		return nil, false
+	}
+	for _, name := range strings.Split(field, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		cur, ok = m[name]
+		if !ok || cur == nil {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func toFloat64(value any) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case string:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, false
+		}
+		return number, true
+	default:
+		return 0, false
+	}
+}
+
+func tryJSON(value any) []byte {
+	b, _ := json.Marshal(value)
+	return b
+}
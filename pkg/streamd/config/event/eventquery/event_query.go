@@ -1,6 +1,7 @@
 package eventquery
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/goccy/go-yaml"
@@ -17,11 +18,17 @@ type serializableInterface interface {
 func init() {
 	//serializable.RegisterType[EventType[event.WindowFocusChange]]()
 	serializable.RegisterType[Event]()
+	serializable.RegisterType[And]()
+	serializable.RegisterType[Or]()
+	serializable.RegisterType[Not]()
+	serializable.RegisterType[EventField]()
+	serializable.RegisterType[StreamIsActive]()
+	serializable.RegisterType[VariableEquals]()
 }
 
 type EventQuery interface {
 	fmt.Stringer
-	Match(event.Event) bool
+	Match(ctx context.Context, ev event.Event, state StateProvider) bool
 	Get() EventQuery
 }
 
@@ -29,7 +36,10 @@ type Event struct{ event.Event }
 
 var _ serializableInterface = (*Event)(nil)
 
-func (ev *Event) Match(cmp event.Event) bool {
+func (ev *Event) Match(_ context.Context, cmp event.Event, _ StateProvider) bool {
+	if ev.Event == nil {
+		return false
+	}
 	return ev.Event.Match(cmp)
 }
 func (ev *Event) Get() EventQuery { return ev }
@@ -67,7 +77,7 @@ func (ev *Event) String() string {
 
 type EventType[T event.Event] struct{}
 
-func (*EventType[T]) Match(ev event.Event) bool {
+func (*EventType[T]) Match(_ context.Context, ev event.Event, _ StateProvider) bool {
 	_, ok := ev.(T)
 	return ok
 }
@@ -0,0 +1,90 @@
+package eventquery
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/consts"
+)
+
+type dummyState struct {
+	activePlatforms map[streamcontrol.PlatformName]bool
+	variables       map[consts.VarKey]string
+}
+
+var _ StateProvider = (*dummyState)(nil)
+
+func (s *dummyState) GetLastStreamStatus(
+	_ context.Context,
+	platID streamcontrol.PlatformName,
+) (*streamcontrol.StreamStatus, error) {
+	return &streamcontrol.StreamStatus{IsActive: s.activePlatforms[platID]}, nil
+}
+
+func (s *dummyState) GetVariable(_ context.Context, key consts.VarKey) ([]byte, error) {
+	v, ok := s.variables[key]
+	if !ok {
+		return nil, fmt.Errorf("no variable '%s'", key)
+	}
+	return []byte(v), nil
+}
+
+func ptr[T any](in T) *T {
+	return &in
+}
+
+func TestEventQueryMatch(t *testing.T) {
+	ctx := context.Background()
+	state := &dummyState{
+		activePlatforms: map[streamcontrol.PlatformName]bool{"youtube": true},
+		variables:       map[consts.VarKey]string{"mode": "live"},
+	}
+	ev := &event.WindowFocusChange{
+		WindowTitle: ptr("OBS 30.0.0 - Profile: main"),
+		ProcessID:   ptr(uint64(1234)),
+	}
+
+	for _, q := range []EventQuery{
+		&And{},
+		&Event{Event: &event.WindowFocusChange{}},
+		&EventField{Field: "window_title", Regex: ptr("^OBS ")},
+		&EventField{Field: "process_id", Min: ptr(1000.0), Max: ptr(2000.0)},
+		&StreamIsActive{PlatID: "youtube"},
+		&VariableEquals{Key: "mode", Value: "live"},
+		&Not{Query: &StreamIsActive{PlatID: "twitch"}},
+		&Or{Queries: []EventQuery{
+			&StreamIsActive{PlatID: "twitch"},
+			&EventField{Field: "window_title", Regex: ptr("Profile")},
+		}},
+		&And{Queries: []EventQuery{
+			&Event{Event: &event.WindowFocusChange{}},
+			&StreamIsActive{PlatID: "youtube"},
+			&Not{Query: &VariableEquals{Key: "mode", Value: "brb"}},
+		}},
+	} {
+		require.True(t, q.Match(ctx, ev, state), q.String())
+	}
+
+	for _, q := range []EventQuery{
+		&Or{},
+		&Event{Event: &event.StreamStarted{}},
+		&EventField{Field: "window_title", Regex: ptr("^Firefox")},
+		&EventField{Field: "process_id", Max: ptr(1000.0)},
+		&EventField{Field: "window_title", Min: ptr(0.0)},
+		&EventField{Field: "host"},
+		&StreamIsActive{PlatID: "twitch"},
+		&VariableEquals{Key: "mode", Value: "brb"},
+		&VariableEquals{Key: "unknown", Value: ""},
+		&Not{Query: &And{}},
+		&And{Queries: []EventQuery{
+			&Event{Event: &event.WindowFocusChange{}},
+			&StreamIsActive{PlatID: "twitch"},
+		}},
+	} {
+		require.False(t, q.Match(ctx, ev, state), q.String())
+	}
+}
@@ -0,0 +1,189 @@
+package eventquery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/xaionaro-go/streamctl/pkg/serializable"
+	"github.com/xaionaro-go/streamctl/pkg/serializable/registry"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+)
+
+// And matches if all the queries match (an empty And matches everything).
+type And struct {
+	Queries []EventQuery `yaml:"queries" json:"queries"`
+}
+
+var _ serializableInterface = (*And)(nil)
+
+func (q *And) Match(ctx context.Context, ev event.Event, state StateProvider) bool {
+	for _, sub := range q.Queries {
+		if sub == nil {
+			continue
+		}
+		if !sub.Match(ctx, ev, state) {
+			return false
+		}
+	}
+	return true
+}
+func (q *And) Get() EventQuery { return q }
+
+func (q *And) String() string {
+	if q == nil {
+		return "null"
+	}
+	return joinQueries(q.Queries, " && ")
+}
+
+func (q And) MarshalYAML() ([]byte, error) {
+	b, err := yaml.Marshal(serializableQueries{Queries: toSerializableQueries(q.Queries)})
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize eventquery.And (%#+v): %w", q, err)
+	}
+	return b, nil
+}
+
+func (q *And) UnmarshalYAML(b []byte) error {
+	var intermediate serializableQueries
+	if err := yaml.Unmarshal(b, &intermediate); err != nil {
+		return fmt.Errorf("unable to unserialize eventquery.And: %w", err)
+	}
+	q.Queries = fromSerializableQueries(intermediate.Queries)
+	return nil
+}
+
+// Or matches if any of the queries matches (an empty Or matches nothing).
+type Or struct {
+	Queries []EventQuery `yaml:"queries" json:"queries"`
+}
+
+var _ serializableInterface = (*Or)(nil)
+
+func (q *Or) Match(ctx context.Context, ev event.Event, state StateProvider) bool {
+	for _, sub := range q.Queries {
+		if sub == nil {
+			continue
+		}
+		if sub.Match(ctx, ev, state) {
+			return true
+		}
+	}
+	return false
+}
+func (q *Or) Get() EventQuery { return q }
+
+func (q *Or) String() string {
+	if q == nil {
+		return "null"
+	}
+	return joinQueries(q.Queries, " || ")
+}
+
+func (q Or) MarshalYAML() ([]byte, error) {
+	b, err := yaml.Marshal(serializableQueries{Queries: toSerializableQueries(q.Queries)})
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize eventquery.Or (%#+v): %w", q, err)
+	}
+	return b, nil
+}
+
+func (q *Or) UnmarshalYAML(b []byte) error {
+	var intermediate serializableQueries
+	if err := yaml.Unmarshal(b, &intermediate); err != nil {
+		return fmt.Errorf("unable to unserialize eventquery.Or: %w", err)
+	}
+	q.Queries = fromSerializableQueries(intermediate.Queries)
+	return nil
+}
+
+// Not matches if the query does not match.
+type Not struct {
+	Query EventQuery `yaml:"query" json:"query"`
+}
+
+var _ serializableInterface = (*Not)(nil)
+
+func (q *Not) Match(ctx context.Context, ev event.Event, state StateProvider) bool {
+	if q.Query == nil {
+		return false
+	}
+	return !q.Query.Match(ctx, ev, state)
+}
+func (q *Not) Get() EventQuery { return q }
+
+func (q *Not) String() string {
+	if q == nil {
+		return "null"
+	}
+	return "!" + queryString(q.Query)
+}
+
+func (q Not) MarshalYAML() ([]byte, error) {
+	b, err := yaml.Marshal(serializableNot{Query: serializable.SerializableNested[EventQuery]{Value: q.Query}})
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize eventquery.Not (%#+v): %w", q, err)
+	}
+	return b, nil
+}
+
+func (q *Not) UnmarshalYAML(b []byte) error {
+	var intermediate serializableNot
+	if err := yaml.Unmarshal(b, &intermediate); err != nil {
+		return fmt.Errorf("unable to unserialize eventquery.Not: %w", err)
+	}
+	q.Query = intermediate.Query.Value
+	return nil
+}
+
+type serializableQueries struct {
+	Queries []serializable.SerializableNested[EventQuery] `yaml:"queries"`
+}
+
+type serializableNot struct {
+	Query serializable.SerializableNested[EventQuery] `yaml:"query"`
+}
+
+func toSerializableQueries(
+	queries []EventQuery,
+) []serializable.SerializableNested[EventQuery] {
+	result := make([]serializable.SerializableNested[EventQuery], 0, len(queries))
+	for _, q := range queries {
+		if q == nil {
+			continue
+		}
+		result = append(result, serializable.SerializableNested[EventQuery]{Value: q})
+	}
+	return result
+}
+
+func fromSerializableQueries(
+	queries []serializable.SerializableNested[EventQuery],
+) []EventQuery {
+	result := make([]EventQuery, 0, len(queries))
+	for _, q := range queries {
+		result = append(result, q.Value)
+	}
+	return result
+}
+
+func queryString(q EventQuery) string {
+	if q == nil {
+		return "null"
+	}
+	switch q.(type) {
+	case *And, *Or, *Not, *Event:
+		return q.String()
+	}
+	return fmt.Sprintf("%s:%s", registry.ToTypeName(q), q.String())
+}
+
+func joinQueries(queries []EventQuery, sep string) string {
+	var parts []string
+	for _, q := range queries {
+		parts = append(parts, queryString(q))
+	}
+	return "(" + strings.Join(parts, sep) + ")"
+}
@@ -7,3 +7,13 @@ func fieldMatch[T comparable](f1 *T, f2 *T) bool {
 
 	return *f1 == *f2
 }
+
+// minMatch returns false only if both the minimum and the value are set,
+// and the value is less than the minimum.
+func minMatch(min *uint64, value *uint64) bool {
+	if min == nil || value == nil {
+		return true
+	}
+
+	return *value >= *min
+}
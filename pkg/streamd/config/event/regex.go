@@ -0,0 +1,78 @@
+package event
+
+import (
+	"container/list"
+	"regexp"
+	"sync"
+)
+
+// regexCacheSize is how many compiled regexes are kept. Regexes come not
+// only from the config, but also from the events and queries received
+// through the API, so the cache has to be bounded.
+const regexCacheSize = 1024
+
+type compiledRegex struct {
+	Expr   string
+	Regexp *regexp.Regexp
+	Err    error
+}
+
+// regexLRU is a cache of compiled regexes, which evicts the least
+// recently used ones.
+type regexLRU struct {
+	locker  sync.Mutex
+	maxSize int
+	list    *list.List
+	items   map[string]*list.Element
+}
+
+func newRegexLRU(maxSize int) *regexLRU {
+	return &regexLRU{
+		maxSize: maxSize,
+		list:    list.New(),
+		items:   map[string]*list.Element{},
+	}
+}
+
+func (c *regexLRU) Compile(expr string) (*regexp.Regexp, error) {
+	c.locker.Lock()
+	if item, ok := c.items[expr]; ok {
+		c.list.MoveToFront(item)
+		r := item.Value.(compiledRegex)
+		c.locker.Unlock()
+		return r.Regexp, r.Err
+	}
+	c.locker.Unlock()
+
+	// compiling without the lock, so that a heavy regex would not block
+	// the others; a concurrent compilation of the same regex is harmless:
+	r, err := regexp.Compile(expr)
+
+	c.locker.Lock()
+	defer c.locker.Unlock()
+	if item, ok := c.items[expr]; ok {
+		c.list.MoveToFront(item)
+		return r, err
+	}
+	c.items[expr] = c.list.PushFront(compiledRegex{Expr: expr, Regexp: r, Err: err})
+	for c.list.Len() > c.maxSize {
+		oldest := c.list.Back()
+		c.list.Remove(oldest)
+		delete(c.items, oldest.Value.(compiledRegex).Expr)
+	}
+	return r, err
+}
+
+func (c *regexLRU) Len() int {
+	c.locker.Lock()
+	defer c.locker.Unlock()
+	return c.list.Len()
+}
+
+var regexCache = newRegexLRU(regexCacheSize)
+
+// CompileRegex is regexp.Compile, but the recently used regexes are
+// not compiled again.
+func CompileRegex(expr string) (*regexp.Regexp, error) {
+	return regexCache.Compile(expr)
+}
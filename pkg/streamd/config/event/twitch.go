@@ -0,0 +1,281 @@
+package event
+
+import (
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+)
+
+// TwitchFollow is a new follower of the Twitch channel.
+type TwitchFollow struct {
+	UserID   *streamcontrol.ChatUserID `yaml:"user_id,omitempty"  json:"user_id,omitempty"`
+	Username *string                   `yaml:"username,omitempty" json:"username,omitempty"`
+}
+
+func (ev *TwitchFollow) Get() Event { return ev }
+
+func (ev *TwitchFollow) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*TwitchFollow)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.UserID, cmp.UserID) &&
+		fieldMatch(ev.Username, cmp.Username)
+}
+
+func (ev *TwitchFollow) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+// TwitchSubscription is a new subscription (or a resubscription shared
+// in the chat) to the Twitch channel.
+type TwitchSubscription struct {
+	UserID   *streamcontrol.ChatUserID `yaml:"user_id,omitempty"  json:"user_id,omitempty"`
+	Username *string                   `yaml:"username,omitempty" json:"username,omitempty"`
+
+	// Tier is "1000", "2000" or "3000".
+	Tier   *string `yaml:"tier,omitempty"    json:"tier,omitempty"`
+	IsGift *bool   `yaml:"is_gift,omitempty" json:"is_gift,omitempty"`
+
+	// CumulativeMonths and Message are set only for resubscriptions.
+	CumulativeMonths *uint64 `yaml:"cumulative_months,omitempty" json:"cumulative_months,omitempty"`
+	Message          *string `yaml:"message,omitempty"           json:"message,omitempty"`
+}
+
+func (ev *TwitchSubscription) Get() Event { return ev }
+
+func (ev *TwitchSubscription) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*TwitchSubscription)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.UserID, cmp.UserID) &&
+		fieldMatch(ev.Username, cmp.Username) &&
+		fieldMatch(ev.Tier, cmp.Tier) &&
+		fieldMatch(ev.IsGift, cmp.IsGift) &&
+		fieldMatch(ev.CumulativeMonths, cmp.CumulativeMonths) &&
+		fieldMatch(ev.Message, cmp.Message)
+}
+
+func (ev *TwitchSubscription) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+// TwitchSubscriptionGift is a gift of subscriptions to the viewers of
+// the Twitch channel.
+type TwitchSubscriptionGift struct {
+	// UserID and Username are not set if the gift is anonymous.
+	UserID      *streamcontrol.ChatUserID `yaml:"user_id,omitempty"      json:"user_id,omitempty"`
+	Username    *string                   `yaml:"username,omitempty"     json:"username,omitempty"`
+	Tier        *string                   `yaml:"tier,omitempty"         json:"tier,omitempty"`
+	Total       *uint64                   `yaml:"total,omitempty"        json:"total,omitempty"`
+	IsAnonymous *bool                     `yaml:"is_anonymous,omitempty" json:"is_anonymous,omitempty"`
+
+	// TotalMin is used only in queries: the event matches only if
+	// at least this amount of subscriptions is gifted.
+	TotalMin *uint64 `yaml:"total_min,omitempty" json:"total_min,omitempty"`
+}
+
+func (ev *TwitchSubscriptionGift) Get() Event { return ev }
+
+func (ev *TwitchSubscriptionGift) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*TwitchSubscriptionGift)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.UserID, cmp.UserID) &&
+		fieldMatch(ev.Username, cmp.Username) &&
+		fieldMatch(ev.Tier, cmp.Tier) &&
+		fieldMatch(ev.Total, cmp.Total) &&
+		fieldMatch(ev.IsAnonymous, cmp.IsAnonymous) &&
+		minMatch(ev.TotalMin, cmp.Total) &&
+		minMatch(cmp.TotalMin, ev.Total)
+}
+
+func (ev *TwitchSubscriptionGift) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+// TwitchCheer is bits cheered in the Twitch channel.
+type TwitchCheer struct {
+	// UserID and Username are not set if the cheer is anonymous.
+	UserID      *streamcontrol.ChatUserID `yaml:"user_id,omitempty"      json:"user_id,omitempty"`
+	Username    *string                   `yaml:"username,omitempty"     json:"username,omitempty"`
+	IsAnonymous *bool                     `yaml:"is_anonymous,omitempty" json:"is_anonymous,omitempty"`
+	Bits        *uint64                   `yaml:"bits,omitempty"         json:"bits,omitempty"`
+	Message     *string                   `yaml:"message,omitempty"      json:"message,omitempty"`
+
+	// BitsMin is used only in queries: the event matches only if
+	// at least this amount of bits is cheered.
+	BitsMin *uint64 `yaml:"bits_min,omitempty" json:"bits_min,omitempty"`
+}
+
+func (ev *TwitchCheer) Get() Event { return ev }
+
+func (ev *TwitchCheer) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*TwitchCheer)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.UserID, cmp.UserID) &&
+		fieldMatch(ev.Username, cmp.Username) &&
+		fieldMatch(ev.IsAnonymous, cmp.IsAnonymous) &&
+		fieldMatch(ev.Bits, cmp.Bits) &&
+		fieldMatch(ev.Message, cmp.Message) &&
+		minMatch(ev.BitsMin, cmp.Bits) &&
+		minMatch(cmp.BitsMin, ev.Bits)
+}
+
+func (ev *TwitchCheer) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+// TwitchRaid is a raid of the Twitch channel by another channel.
+type TwitchRaid struct {
+	FromUserID   *streamcontrol.ChatUserID `yaml:"from_user_id,omitempty"  json:"from_user_id,omitempty"`
+	FromUsername *string                   `yaml:"from_username,omitempty" json:"from_username,omitempty"`
+	Viewers      *uint64                   `yaml:"viewers,omitempty"       json:"viewers,omitempty"`
+
+	// ViewersMin is used only in queries: the event matches only if
+	// the raid brought at least this amount of viewers.
+	ViewersMin *uint64 `yaml:"viewers_min,omitempty" json:"viewers_min,omitempty"`
+}
+
+func (ev *TwitchRaid) Get() Event { return ev }
+
+func (ev *TwitchRaid) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*TwitchRaid)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.FromUserID, cmp.FromUserID) &&
+		fieldMatch(ev.FromUsername, cmp.FromUsername) &&
+		fieldMatch(ev.Viewers, cmp.Viewers) &&
+		minMatch(ev.ViewersMin, cmp.Viewers) &&
+		minMatch(cmp.ViewersMin, ev.Viewers)
+}
+
+func (ev *TwitchRaid) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+// TwitchChannelPointsRedemption is a redemption of a custom channel
+// points reward of the Twitch channel.
+type TwitchChannelPointsRedemption struct {
+	UserID      *streamcontrol.ChatUserID `yaml:"user_id,omitempty"      json:"user_id,omitempty"`
+	Username    *string                   `yaml:"username,omitempty"     json:"username,omitempty"`
+	RewardID    *string                   `yaml:"reward_id,omitempty"    json:"reward_id,omitempty"`
+	RewardTitle *string                   `yaml:"reward_title,omitempty" json:"reward_title,omitempty"`
+	RewardCost  *uint64                   `yaml:"reward_cost,omitempty"  json:"reward_cost,omitempty"`
+	UserInput   *string                   `yaml:"user_input,omitempty"   json:"user_input,omitempty"`
+}
+
+func (ev *TwitchChannelPointsRedemption) Get() Event { return ev }
+
+func (ev *TwitchChannelPointsRedemption) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*TwitchChannelPointsRedemption)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.UserID, cmp.UserID) &&
+		fieldMatch(ev.Username, cmp.Username) &&
+		fieldMatch(ev.RewardID, cmp.RewardID) &&
+		fieldMatch(ev.RewardTitle, cmp.RewardTitle) &&
+		fieldMatch(ev.RewardCost, cmp.RewardCost) &&
+		fieldMatch(ev.UserInput, cmp.UserInput)
+}
+
+func (ev *TwitchChannelPointsRedemption) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+// TwitchPoll is a poll in the Twitch channel that began or ended.
+type TwitchPoll struct {
+	PollID *string `yaml:"poll_id,omitempty" json:"poll_id,omitempty"`
+	Title  *string `yaml:"title,omitempty"   json:"title,omitempty"`
+
+	// Stage is either "begin" or "end".
+	Stage *string `yaml:"stage,omitempty" json:"stage,omitempty"`
+
+	// WinningChoice is the title of the choice with the most votes; it is
+	// set only at the end of the poll.
+	WinningChoice *string `yaml:"winning_choice,omitempty" json:"winning_choice,omitempty"`
+}
+
+func (ev *TwitchPoll) Get() Event { return ev }
+
+func (ev *TwitchPoll) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*TwitchPoll)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.PollID, cmp.PollID) &&
+		fieldMatch(ev.Title, cmp.Title) &&
+		fieldMatch(ev.Stage, cmp.Stage) &&
+		fieldMatch(ev.WinningChoice, cmp.WinningChoice)
+}
+
+func (ev *TwitchPoll) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
+
+// TwitchPrediction is a prediction in the Twitch channel that began,
+// was locked or ended.
+type TwitchPrediction struct {
+	PredictionID *string `yaml:"prediction_id,omitempty" json:"prediction_id,omitempty"`
+	Title        *string `yaml:"title,omitempty"         json:"title,omitempty"`
+
+	// Stage is "begin", "lock" or "end".
+	Stage *string `yaml:"stage,omitempty" json:"stage,omitempty"`
+
+	// WinningOutcome is the title of the winning outcome; it is set only
+	// at the end of the prediction (if it was not canceled).
+	WinningOutcome *string `yaml:"winning_outcome,omitempty" json:"winning_outcome,omitempty"`
+}
+
+func (ev *TwitchPrediction) Get() Event { return ev }
+
+func (ev *TwitchPrediction) Match(cmpIface Event) bool {
+	cmp, ok := cmpIface.(*TwitchPrediction)
+	if !ok {
+		return false
+	}
+
+	return fieldMatch(ev.PredictionID, cmp.PredictionID) &&
+		fieldMatch(ev.Title, cmp.Title) &&
+		fieldMatch(ev.Stage, cmp.Stage) &&
+		fieldMatch(ev.WinningOutcome, cmp.WinningOutcome)
+}
+
+func (ev *TwitchPrediction) String() string {
+	if ev == nil {
+		return "null"
+	}
+	return string(tryJSON(*ev))
+}
@@ -0,0 +1,232 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/xaionaro-go/streamctl/pkg/cron"
+	"github.com/xaionaro-go/streamctl/pkg/serializable"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
+)
+
+type TimerID uint64
+
+type Timers []*Timer
+
+type TimerMissedDeadlinePolicyType string
+
+const (
+	TimerMissedDeadlinePolicyTypeUndefined          = TimerMissedDeadlinePolicyType("")
+	TimerMissedDeadlinePolicyTypeFireImmediately    = TimerMissedDeadlinePolicyType("fire_immediately")
+	TimerMissedDeadlinePolicyTypeSkip               = TimerMissedDeadlinePolicyType("skip")
+	TimerMissedDeadlinePolicyTypeFireIfLateLessThan = TimerMissedDeadlinePolicyType("fire_if_late_less_than")
+)
+
+// TimerMissedDeadlinePolicy defines what to do with a timer which deadline
+// was missed (for example because streamd was not running at that moment).
+type TimerMissedDeadlinePolicy struct {
+	Type TimerMissedDeadlinePolicyType `yaml:"type,omitempty"         json:"type,omitempty"`
+
+	// MaxLateness is used only by TimerMissedDeadlinePolicyTypeFireIfLateLessThan.
+	MaxLateness time.Duration `yaml:"max_lateness,omitempty" json:"max_lateness,omitempty"`
+}
+
+// ShouldFire returns true if a timer that is late by the given duration
+// should still be fired.
+func (p TimerMissedDeadlinePolicy) ShouldFire(lateness time.Duration) bool {
+	if lateness <= 0 {
+		return true
+	}
+	switch p.Type {
+	case TimerMissedDeadlinePolicyTypeUndefined, TimerMissedDeadlinePolicyTypeFireImmediately:
+		return true
+	case TimerMissedDeadlinePolicyTypeSkip:
+		return false
+	case TimerMissedDeadlinePolicyTypeFireIfLateLessThan:
+		return lateness < p.MaxLateness
+	default:
+		return true
+	}
+}
+
+// TimerRelativeToStreamStart makes a timer to be armed every time
+// a stream is started.
+type TimerRelativeToStreamStart struct {
+	// PlatID is the platform to watch the stream starts on;
+	// an empty value means any platform.
+	PlatID streamcontrol.PlatformName `yaml:"platform,omitempty" json:"platform,omitempty"`
+	Delay  time.Duration              `yaml:"delay,omitempty"    json:"delay,omitempty"`
+}
+
+// TimerSchedule defines how a timer repeats. A zero value means
+// a one-shot timer.
+type TimerSchedule struct {
+	// CronExpression is a standard 5-field cron expression, see package cron.
+	CronExpression string `yaml:"cron,omitempty" json:"cron,omitempty"`
+
+	// Interval is the period between triggers.
+	Interval time.Duration `yaml:"interval,omitempty" json:"interval,omitempty"`
+
+	// EndAt is the moment after which the timer will not trigger anymore
+	// (and will be removed); a zero value means no end date.
+	EndAt time.Time `yaml:"end_at,omitempty" json:"end_at,omitempty"`
+
+	// RelativeToStreamStart if set, then the timer is armed (to trigger
+	// after the specified delay) on each stream start and disarmed on
+	// each stream end. Can be combined with Interval, but not with
+	// CronExpression.
+	RelativeToStreamStart *TimerRelativeToStreamStart `yaml:"relative_to_stream_start,omitempty" json:"relative_to_stream_start,omitempty"`
+}
+
+func (s TimerSchedule) IsRecurring() bool {
+	return s.CronExpression != "" || s.Interval != 0 || s.RelativeToStreamStart != nil
+}
+
+func (s TimerSchedule) Validate() error {
+	if s.Interval < 0 {
+		return fmt.Errorf("the interval is negative: %v", s.Interval)
+	}
+	if s.CronExpression != "" {
+		if s.Interval != 0 {
+			return fmt.Errorf("a cron expression and an interval cannot be used together")
+		}
+		if s.RelativeToStreamStart != nil {
+			return fmt.Errorf("a cron expression cannot be relative to a stream start")
+		}
+		expr, err := cron.Parse(s.CronExpression)
+		if err != nil {
+			return fmt.Errorf("unable to parse the cron expression '%s': %w", s.CronExpression, err)
+		}
+		if expr.Next(time.Now()).IsZero() {
+			return fmt.Errorf("the cron expression '%s' never matches", s.CronExpression)
+		}
+	}
+	if s.RelativeToStreamStart != nil && s.RelativeToStreamStart.Delay < 0 {
+		return fmt.Errorf("the delay is negative: %v", s.RelativeToStreamStart.Delay)
+	}
+	return nil
+}
+
+// First returns the moment of the first trigger of a timer added at "now"
+// and requested to trigger at "triggerAt" (which might be zero
+// for recurring timers).
+//
+// Returns a zero value for a timer relative to a stream start (it is
+// armed only when a stream starts).
+func (s TimerSchedule) First(triggerAt, now time.Time) (time.Time, error) {
+	var result time.Time
+	switch {
+	case s.RelativeToStreamStart != nil:
+		return time.Time{}, nil
+	case !triggerAt.IsZero():
+		result = triggerAt
+	case s.CronExpression != "":
+		expr, err := cron.Parse(s.CronExpression)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unable to parse the cron expression '%s': %w", s.CronExpression, err)
+		}
+		result = expr.Next(now)
+		if result.IsZero() {
+			return time.Time{}, fmt.Errorf("the cron expression '%s' never matches", s.CronExpression)
+		}
+	case s.Interval != 0:
+		result = now.Add(s.Interval)
+	default:
+		return time.Time{}, fmt.Errorf("the trigger time is not set")
+	}
+	if !s.EndAt.IsZero() && result.After(s.EndAt) {
+		return time.Time{}, fmt.Errorf("the first trigger (%v) is after the end date (%v)", result, s.EndAt)
+	}
+	return result, nil
+}
+
+// Next returns the moment of the trigger that follows the trigger
+// planned at "prev", which is after "now". A zero value is returned if
+// there are no more triggers (for example the timer is one-shot,
+// or the end date was reached).
+func (s TimerSchedule) Next(prev, now time.Time) (time.Time, error) {
+	var result time.Time
+	switch {
+	case s.CronExpression != "":
+		expr, err := cron.Parse(s.CronExpression)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unable to parse the cron expression '%s': %w", s.CronExpression, err)
+		}
+		after := prev
+		if now.After(after) {
+			after = now
+		}
+		result = expr.Next(after)
+	case s.Interval != 0:
+		result = prev.Add(s.Interval)
+		if result.Before(now) {
+			skipCount := now.Sub(result)/s.Interval + 1
+			result = result.Add(skipCount * s.Interval)
+		}
+	default:
+		return time.Time{}, nil
+	}
+	if !s.EndAt.IsZero() && result.After(s.EndAt) {
+		return time.Time{}, nil
+	}
+	return result, nil
+}
+
+type Timer struct {
+	ID TimerID `yaml:"id" json:"id"`
+
+	// TriggerAt is the moment of the next trigger of the timer. It is zero
+	// for a timer relative to a stream start, which is not armed.
+	TriggerAt            time.Time                 `yaml:"trigger_at"                       json:"trigger_at"`
+	Action               action.Action             `yaml:"action"                           json:"action"`
+	MissedDeadlinePolicy TimerMissedDeadlinePolicy `yaml:"missed_deadline_policy,omitempty" json:"missed_deadline_policy,omitempty"`
+	Schedule             TimerSchedule             `yaml:"schedule,omitempty"               json:"schedule,omitempty"`
+}
+
+var _ yaml.BytesMarshaler = (*Timer)(nil)
+var _ yaml.BytesUnmarshaler = (*Timer)(nil)
+
+func (t *Timer) UnmarshalYAML(b []byte) (_err error) {
+	if t == nil {
+		return fmt.Errorf("nil Timer")
+	}
+
+	intermediate := serializableTimer{}
+	err := yaml.Unmarshal(b, &intermediate)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal the Timer: %w: %s", err, b)
+	}
+
+	*t = Timer{
+		ID:                   intermediate.ID,
+		TriggerAt:            intermediate.TriggerAt,
+		Action:               intermediate.Action.Value,
+		MissedDeadlinePolicy: intermediate.MissedDeadlinePolicy,
+		Schedule:             intermediate.Schedule,
+	}
+	if t.Action == nil {
+		return fmt.Errorf("t.Action == nil")
+	}
+	return nil
+}
+
+func (t Timer) MarshalYAML() (b []byte, _err error) {
+	return yaml.Marshal(serializableTimer{
+		ID:                   t.ID,
+		TriggerAt:            t.TriggerAt,
+		Action:               serializable.Serializable[action.Action]{Value: t.Action},
+		MissedDeadlinePolicy: t.MissedDeadlinePolicy,
+		Schedule:             t.Schedule,
+	})
+}
+
+type serializableTimer struct {
+	ID                   TimerID                                  `yaml:"id"                               json:"id"`
+	TriggerAt            time.Time                                `yaml:"trigger_at"                       json:"trigger_at"`
+	Action               serializable.Serializable[action.Action] `yaml:"action"                           json:"action"`
+	MissedDeadlinePolicy TimerMissedDeadlinePolicy                `yaml:"missed_deadline_policy,omitempty" json:"missed_deadline_policy,omitempty"`
+	Schedule             TimerSchedule                            `yaml:"schedule,omitempty"               json:"schedule,omitempty"`
+}
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
+)
+
+func TestTimer(t *testing.T) {
+	timer := Timer{
+		ID:        3,
+		TriggerAt: time.Unix(1700000000, 0).UTC(),
+		Action: &action.EndStream{
+			PlatID: "twitch",
+		},
+		MissedDeadlinePolicy: TimerMissedDeadlinePolicy{
+			Type:        TimerMissedDeadlinePolicyTypeFireIfLateLessThan,
+			MaxLateness: time.Minute,
+		},
+		Schedule: TimerSchedule{
+			Interval: time.Hour,
+			EndAt:    time.Unix(1800000000, 0).UTC(),
+			RelativeToStreamStart: &TimerRelativeToStreamStart{
+				PlatID: "youtube",
+				Delay:  30 * time.Minute,
+			},
+		},
+	}
+
+	b, err := yaml.Marshal(timer)
+	require.NoError(t, err)
+
+	var cmp Timer
+	err = yaml.Unmarshal(b, &cmp)
+	require.NoError(t, err)
+
+	require.Equal(t, timer, cmp)
+}
+
+func TestTimerMissedDeadlinePolicy(t *testing.T) {
+	for _, policy := range []TimerMissedDeadlinePolicy{
+		{},
+		{Type: TimerMissedDeadlinePolicyTypeFireImmediately},
+		{Type: TimerMissedDeadlinePolicyTypeSkip},
+		{Type: TimerMissedDeadlinePolicyTypeFireIfLateLessThan, MaxLateness: time.Minute},
+	} {
+		require.True(t, policy.ShouldFire(-time.Second), policy)
+	}
+
+	require.True(t, TimerMissedDeadlinePolicy{}.ShouldFire(time.Hour))
+	require.True(t, TimerMissedDeadlinePolicy{Type: TimerMissedDeadlinePolicyTypeFireImmediately}.ShouldFire(time.Hour))
+	require.False(t, TimerMissedDeadlinePolicy{Type: TimerMissedDeadlinePolicyTypeSkip}.ShouldFire(time.Second))
+
+	fireIfLate := TimerMissedDeadlinePolicy{Type: TimerMissedDeadlinePolicyTypeFireIfLateLessThan, MaxLateness: time.Minute}
+	require.True(t, fireIfLate.ShouldFire(30*time.Second))
+	require.False(t, fireIfLate.ShouldFire(2*time.Minute))
+}
+
+func TestTimerSchedule(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	t.Run("one-shot", func(t *testing.T) {
+		s := TimerSchedule{}
+		require.NoError(t, s.Validate())
+		require.False(t, s.IsRecurring())
+		_, err := s.First(time.Time{}, now)
+		require.Error(t, err)
+		next, err := s.Next(now, now)
+		require.NoError(t, err)
+		require.True(t, next.IsZero())
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		s := TimerSchedule{
+			Interval: time.Hour,
+			EndAt:    now.Add(3 * time.Hour),
+		}
+		require.NoError(t, s.Validate())
+		first, err := s.First(time.Time{}, now)
+		require.NoError(t, err)
+		require.Equal(t, now.Add(time.Hour), first)
+
+		next, err := s.Next(first, first)
+		require.NoError(t, err)
+		require.Equal(t, now.Add(2*time.Hour), next)
+
+		// missed triggers are not repeated
+		next, err = s.Next(first, first.Add(90*time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, now.Add(3*time.Hour), next)
+
+		next, err = s.Next(now.Add(3*time.Hour), now.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.True(t, next.IsZero())
+	})
+
+	t.Run("cron", func(t *testing.T) {
+		s := TimerSchedule{
+			CronExpression: "0 23 * * *",
+		}
+		require.NoError(t, s.Validate())
+		first, err := s.First(time.Time{}, now)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC), first)
+
+		next, err := s.Next(first, first)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2024, 1, 2, 23, 0, 0, 0, time.UTC), next)
+	})
+
+	t.Run("relative_to_stream_start", func(t *testing.T) {
+		s := TimerSchedule{
+			RelativeToStreamStart: &TimerRelativeToStreamStart{
+				Delay: 30 * time.Minute,
+			},
+		}
+		require.NoError(t, s.Validate())
+		first, err := s.First(now, now)
+		require.NoError(t, err)
+		require.True(t, first.IsZero())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		require.Error(t, TimerSchedule{CronExpression: "abc"}.Validate())
+		require.Error(t, TimerSchedule{CronExpression: "* * * * *", Interval: time.Hour}.Validate())
+		require.Error(t, TimerSchedule{CronExpression: "* * * * *", RelativeToStreamStart: &TimerRelativeToStreamStart{}}.Validate())
+		require.Error(t, TimerSchedule{Interval: -time.Hour}.Validate())
+	})
+
+	t.Run("cron_never_matches", func(t *testing.T) {
+		s := TimerSchedule{CronExpression: "0 0 31 2 *"}
+		require.Error(t, s.Validate())
+		_, err := s.First(time.Time{}, time.Now())
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event/eventquery"
+)
+
+func TestTriggerRule(t *testing.T) {
+	regex := "^OBS"
+	minViewers := 100.0
+	rule := TriggerRule{
+		Description: "a",
+		EventQuery: &eventquery.And{
+			Queries: []eventquery.EventQuery{
+				&eventquery.Event{Event: &event.WindowFocusChange{}},
+				&eventquery.EventField{
+					Field: "window_title",
+					Regex: &regex,
+				},
+				&eventquery.Or{
+					Queries: []eventquery.EventQuery{
+						&eventquery.StreamIsActive{PlatID: "youtube"},
+						&eventquery.EventField{
+							Field: "viewers_count",
+							Min:   &minViewers,
+						},
+					},
+				},
+				&eventquery.Not{
+					Query: &eventquery.VariableEquals{
+						Key:   "mode",
+						Value: "brb",
+					},
+				},
+			},
+		},
+		Action: &action.EndStream{
+			PlatID: "twitch",
+		},
+	}
+
+	b, err := yaml.Marshal(rule)
+	require.NoError(t, err)
+
+	var cmp TriggerRule
+	err = yaml.Unmarshal(b, &cmp)
+	require.NoError(t, err, string(b))
+
+	require.Equal(t, rule, cmp)
+}
@@ -11,10 +11,12 @@ import (
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/streamctl/pkg/expression"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
 	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
+	"github.com/xaionaro-go/xcontext"
 	"github.com/xaionaro-go/xsync"
 )
 
@@ -45,10 +47,22 @@ func (d *StreamD) submitEvent(
 	logger.Tracef(ctx, "submitEvent(ctx, %s)", spew.Sdump(ev))
 	defer logger.Tracef(ctx, "/submitEvent(ctx, %#v)", spew.Sdump(ev))
 	exprCtx := objToMap(ev)
-	for _, rule := range d.Config.TriggerRules {
-		if rule.EventQuery.Match(ev) {
+	for idx, rule := range d.Config.TriggerRules {
+		if rule.EventQuery.Match(ctx, ev, d) {
+			ruleID := api.TriggerRuleID(idx)
+			ctx := xcontext.DetachDone(ctx)
 			observability.Go(ctx, func() {
+				startedAt := time.Now()
 				err := d.doAction(ctx, rule.Action, exprCtx)
+				d.metrics.observeTriggerRuleExecution(ruleID, err)
+				d.triggerRuleHistory.add(ctx, api.TriggerRuleExecution{
+					RuleID:    ruleID,
+					Event:     ev,
+					Action:    rule.Action,
+					StartedAt: startedAt,
+					EndedAt:   time.Now(),
+					Error:     err,
+				})
 				if err != nil {
 					logger.Errorf(ctx, "unable to perform action %s: %v", rule.Action, err)
 				}
@@ -85,6 +99,83 @@ func (d *StreamD) doAction(
 			},
 			value,
 		)
+	case *action.OBSWindowCaptureSetSource:
+		value, err := expression.Eval[string](a.ValueExpression, exprCtx)
+		if err != nil {
+			return fmt.Errorf("unable to Eval() the expression '%s': %w", a.ValueExpression, err)
+		}
+		return d.OBSWindowCaptureSetSource(
+			ctx,
+			SceneElementIdentifier{
+				Name: a.ItemName,
+				UUID: a.ItemUUID,
+			},
+			value,
+		)
+	case *action.OBSSetCurrentScene:
+		sceneName, err := expression.Eval[string](a.SceneNameExpression, exprCtx)
+		if err != nil {
+			return fmt.Errorf("unable to Eval() the expression '%s': %w", a.SceneNameExpression, err)
+		}
+		return d.OBSSetCurrentScene(ctx, sceneName)
+	case *action.SendChatMessage:
+		message, err := expression.Eval[string](a.MessageExpression, exprCtx)
+		if err != nil {
+			return fmt.Errorf("unable to Eval() the expression '%s': %w", a.MessageExpression, err)
+		}
+		return d.SendChatMessage(ctx, a.PlatID, message)
+	case *action.SetTitle:
+		title, err := expression.Eval[string](a.TitleExpression, exprCtx)
+		if err != nil {
+			return fmt.Errorf("unable to Eval() the expression '%s': %w", a.TitleExpression, err)
+		}
+		return d.SetTitle(ctx, a.PlatID, title)
+	case *action.SetDescription:
+		description, err := expression.Eval[string](a.DescriptionExpression, exprCtx)
+		if err != nil {
+			return fmt.Errorf("unable to Eval() the expression '%s': %w", a.DescriptionExpression, err)
+		}
+		return d.SetDescription(ctx, a.PlatID, description)
+	case *action.ApplyProfile:
+		profileName, err := expression.Eval[string](a.ProfileNameExpression, exprCtx)
+		if err != nil {
+			return fmt.Errorf("unable to Eval() the expression '%s': %w", a.ProfileNameExpression, err)
+		}
+		return d.applyProfileByName(ctx, a.PlatID, streamcontrol.ProfileName(profileName))
+	case *action.EnableStreamForward:
+		return d.setStreamForwardEnabled(ctx, a.StreamID, a.DestinationID, true)
+	case *action.DisableStreamForward:
+		return d.setStreamForwardEnabled(ctx, a.StreamID, a.DestinationID, false)
+	case *action.SetVariable:
+		value, err := expression.Eval[string](a.ValueExpression, exprCtx)
+		if err != nil {
+			return fmt.Errorf("unable to Eval() the expression '%s': %w", a.ValueExpression, err)
+		}
+		return d.SetVariable(ctx, a.Key, []byte(value))
+	case *action.InsertAdsCuePoint:
+		return d.InsertAdsCuePoint(ctx, a.PlatID, time.Now(), a.Duration)
+	case *action.RunCommand:
+		return d.doActionRunCommand(ctx, a, exprCtx)
+	case *action.TwitchCreateStreamMarker:
+		description, err := expression.Eval[string](a.DescriptionExpression, exprCtx)
+		if err != nil {
+			return fmt.Errorf("unable to Eval() the expression '%s': %w", a.DescriptionExpression, err)
+		}
+		_, err = d.TwitchCreateStreamMarker(ctx, description)
+		return err
+	case *action.Sequence:
+		return d.doActionSequence(ctx, a, exprCtx)
+	case *action.Parallel:
+		return d.doActionParallel(ctx, a, exprCtx)
+	case *action.Delay:
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(a.Duration):
+			return nil
+		}
+	case *action.If:
+		return d.doActionIf(ctx, a, exprCtx)
 	default:
 		return fmt.Errorf("unknown action type: %T", a)
 	}
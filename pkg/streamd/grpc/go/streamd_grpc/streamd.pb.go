@@ -92,6 +92,8 @@ const (
 	Capability_SendChatMessage     Capability = 1
 	Capability_DeleteChatMessage   Capability = 2
 	Capability_BanUser             Capability = 3
+	Capability_TimeoutUser         Capability = 4
+	Capability_ManageModerators    Capability = 5
 )
 
 // Enum value maps for Capability.
@@ -101,12 +103,16 @@ var (
 		1: "SendChatMessage",
 		2: "DeleteChatMessage",
 		3: "BanUser",
+		4: "TimeoutUser",
+		5: "ManageModerators",
 	}
 	Capability_value = map[string]int32{
 		"capabilityUndefined": 0,
 		"SendChatMessage":     1,
 		"DeleteChatMessage":   2,
 		"BanUser":             3,
+		"TimeoutUser":         4,
+		"ManageModerators":    5,
 	}
 )
 
@@ -186,6 +192,9 @@ const (
 	StreamServerType_Undefined StreamServerType = 0
 	StreamServerType_RTSP      StreamServerType = 1
 	StreamServerType_RTMP      StreamServerType = 2
+	StreamServerType_SRT       StreamServerType = 3
+	StreamServerType_HLS       StreamServerType = 4
+	StreamServerType_WebRTC    StreamServerType = 5
 )
 
 // Enum value maps for StreamServerType.
@@ -194,11 +203,17 @@ var (
 		0: "Undefined",
 		1: "RTSP",
 		2: "RTMP",
+		3: "SRT",
+		4: "HLS",
+		5: "WebRTC",
 	}
 	StreamServerType_value = map[string]int32{
 		"Undefined": 0,
 		"RTSP":      1,
 		"RTMP":      2,
+		"SRT":       3,
+		"HLS":       4,
+		"WebRTC":    5,
 	}
 )
 
@@ -278,22 +293,174 @@ func (PlayerType) EnumDescriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{4}
 }
 
+type ActionErrorPolicy int32
+
+const (
+	ActionErrorPolicy_ActionErrorPolicyAbort    ActionErrorPolicy = 0
+	ActionErrorPolicy_ActionErrorPolicyContinue ActionErrorPolicy = 1
+)
+
+// Enum value maps for ActionErrorPolicy.
+var (
+	ActionErrorPolicy_name = map[int32]string{
+		0: "ActionErrorPolicyAbort",
+		1: "ActionErrorPolicyContinue",
+	}
+	ActionErrorPolicy_value = map[string]int32{
+		"ActionErrorPolicyAbort":    0,
+		"ActionErrorPolicyContinue": 1,
+	}
+)
+
+func (x ActionErrorPolicy) Enum() *ActionErrorPolicy {
+	p := new(ActionErrorPolicy)
+	*p = x
+	return p
+}
+
+func (x ActionErrorPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionErrorPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_streamd_proto_enumTypes[5].Descriptor()
+}
+
+func (ActionErrorPolicy) Type() protoreflect.EnumType {
+	return &file_streamd_proto_enumTypes[5]
+}
+
+func (x ActionErrorPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionErrorPolicy.Descriptor instead.
+func (ActionErrorPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{5}
+}
+
+type TimerMissedDeadlinePolicyType int32
+
+const (
+	TimerMissedDeadlinePolicyType_TimerMissedDeadlineFireImmediately    TimerMissedDeadlinePolicyType = 0
+	TimerMissedDeadlinePolicyType_TimerMissedDeadlineSkip               TimerMissedDeadlinePolicyType = 1
+	TimerMissedDeadlinePolicyType_TimerMissedDeadlineFireIfLateLessThan TimerMissedDeadlinePolicyType = 2
+)
+
+// Enum value maps for TimerMissedDeadlinePolicyType.
+var (
+	TimerMissedDeadlinePolicyType_name = map[int32]string{
+		0: "TimerMissedDeadlineFireImmediately",
+		1: "TimerMissedDeadlineSkip",
+		2: "TimerMissedDeadlineFireIfLateLessThan",
+	}
+	TimerMissedDeadlinePolicyType_value = map[string]int32{
+		"TimerMissedDeadlineFireImmediately":    0,
+		"TimerMissedDeadlineSkip":               1,
+		"TimerMissedDeadlineFireIfLateLessThan": 2,
+	}
+)
+
+func (x TimerMissedDeadlinePolicyType) Enum() *TimerMissedDeadlinePolicyType {
+	p := new(TimerMissedDeadlinePolicyType)
+	*p = x
+	return p
+}
+
+func (x TimerMissedDeadlinePolicyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimerMissedDeadlinePolicyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_streamd_proto_enumTypes[6].Descriptor()
+}
+
+func (TimerMissedDeadlinePolicyType) Type() protoreflect.EnumType {
+	return &file_streamd_proto_enumTypes[6]
+}
+
+func (x TimerMissedDeadlinePolicyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimerMissedDeadlinePolicyType.Descriptor instead.
+func (TimerMissedDeadlinePolicyType) EnumDescriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{6}
+}
+
 type EventType int32
 
 const (
-	EventType_eventWindowFocusChange EventType = 0
-	EventType_eventOBSSceneChange    EventType = 1
+	EventType_eventWindowFocusChange             EventType = 0
+	EventType_eventOBSSceneChange                EventType = 1
+	EventType_eventChatMessageReceived           EventType = 2
+	EventType_eventStreamStarted                 EventType = 3
+	EventType_eventStreamEnded                   EventType = 4
+	EventType_eventViewerCountChanged            EventType = 5
+	EventType_eventIncomingStreamPublished       EventType = 6
+	EventType_eventIncomingStreamUnpublished     EventType = 7
+	EventType_eventStreamForwardStarted          EventType = 8
+	EventType_eventStreamForwardFailed           EventType = 9
+	EventType_eventIncomingStreamAuthFailed      EventType = 10
+	EventType_eventStreamForwardSourceSwitched   EventType = 11
+	EventType_eventTwitchFollow                  EventType = 12
+	EventType_eventTwitchSubscription            EventType = 13
+	EventType_eventTwitchSubscriptionGift        EventType = 14
+	EventType_eventTwitchCheer                   EventType = 15
+	EventType_eventTwitchRaid                    EventType = 16
+	EventType_eventTwitchChannelPointsRedemption EventType = 17
+	EventType_eventTwitchPoll                    EventType = 18
+	EventType_eventTwitchPrediction              EventType = 19
+	EventType_eventStreamForwardFallbackStopped  EventType = 20
 )
 
 // Enum value maps for EventType.
 var (
 	EventType_name = map[int32]string{
-		0: "eventWindowFocusChange",
-		1: "eventOBSSceneChange",
+		0:  "eventWindowFocusChange",
+		1:  "eventOBSSceneChange",
+		2:  "eventChatMessageReceived",
+		3:  "eventStreamStarted",
+		4:  "eventStreamEnded",
+		5:  "eventViewerCountChanged",
+		6:  "eventIncomingStreamPublished",
+		7:  "eventIncomingStreamUnpublished",
+		8:  "eventStreamForwardStarted",
+		9:  "eventStreamForwardFailed",
+		10: "eventIncomingStreamAuthFailed",
+		11: "eventStreamForwardSourceSwitched",
+		12: "eventTwitchFollow",
+		13: "eventTwitchSubscription",
+		14: "eventTwitchSubscriptionGift",
+		15: "eventTwitchCheer",
+		16: "eventTwitchRaid",
+		17: "eventTwitchChannelPointsRedemption",
+		18: "eventTwitchPoll",
+		19: "eventTwitchPrediction",
+		20: "eventStreamForwardFallbackStopped",
 	}
 	EventType_value = map[string]int32{
-		"eventWindowFocusChange": 0,
-		"eventOBSSceneChange":    1,
+		"eventWindowFocusChange":             0,
+		"eventOBSSceneChange":                1,
+		"eventChatMessageReceived":           2,
+		"eventStreamStarted":                 3,
+		"eventStreamEnded":                   4,
+		"eventViewerCountChanged":            5,
+		"eventIncomingStreamPublished":       6,
+		"eventIncomingStreamUnpublished":     7,
+		"eventStreamForwardStarted":          8,
+		"eventStreamForwardFailed":           9,
+		"eventIncomingStreamAuthFailed":      10,
+		"eventStreamForwardSourceSwitched":   11,
+		"eventTwitchFollow":                  12,
+		"eventTwitchSubscription":            13,
+		"eventTwitchSubscriptionGift":        14,
+		"eventTwitchCheer":                   15,
+		"eventTwitchRaid":                    16,
+		"eventTwitchChannelPointsRedemption": 17,
+		"eventTwitchPoll":                    18,
+		"eventTwitchPrediction":              19,
+		"eventStreamForwardFallbackStopped":  20,
 	}
 )
 
@@ -308,11 +475,11 @@ func (x EventType) String() string {
 }
 
 func (EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_streamd_proto_enumTypes[5].Descriptor()
+	return file_streamd_proto_enumTypes[7].Descriptor()
 }
 
 func (EventType) Type() protoreflect.EnumType {
-	return &file_streamd_proto_enumTypes[5]
+	return &file_streamd_proto_enumTypes[7]
 }
 
 func (x EventType) Number() protoreflect.EnumNumber {
@@ -321,7 +488,111 @@ func (x EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventType.Descriptor instead.
 func (EventType) EnumDescriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{5}
+	return file_streamd_proto_rawDescGZIP(), []int{7}
+}
+
+type ChatUserRole int32
+
+const (
+	ChatUserRole_chatUserRoleUndefined ChatUserRole = 0
+	ChatUserRole_Broadcaster           ChatUserRole = 1
+	ChatUserRole_Moderator             ChatUserRole = 2
+	ChatUserRole_VIP                   ChatUserRole = 3
+	ChatUserRole_Subscriber            ChatUserRole = 4
+)
+
+// Enum value maps for ChatUserRole.
+var (
+	ChatUserRole_name = map[int32]string{
+		0: "chatUserRoleUndefined",
+		1: "Broadcaster",
+		2: "Moderator",
+		3: "VIP",
+		4: "Subscriber",
+	}
+	ChatUserRole_value = map[string]int32{
+		"chatUserRoleUndefined": 0,
+		"Broadcaster":           1,
+		"Moderator":             2,
+		"VIP":                   3,
+		"Subscriber":            4,
+	}
+)
+
+func (x ChatUserRole) Enum() *ChatUserRole {
+	p := new(ChatUserRole)
+	*p = x
+	return p
+}
+
+func (x ChatUserRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatUserRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_streamd_proto_enumTypes[8].Descriptor()
+}
+
+func (ChatUserRole) Type() protoreflect.EnumType {
+	return &file_streamd_proto_enumTypes[8]
+}
+
+func (x ChatUserRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatUserRole.Descriptor instead.
+func (ChatUserRole) EnumDescriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{8}
+}
+
+type ChatMessageFragmentType int32
+
+const (
+	ChatMessageFragmentType_chatMessageFragmentTypeUndefined ChatMessageFragmentType = 0
+	ChatMessageFragmentType_Text                             ChatMessageFragmentType = 1
+	ChatMessageFragmentType_Emote                            ChatMessageFragmentType = 2
+)
+
+// Enum value maps for ChatMessageFragmentType.
+var (
+	ChatMessageFragmentType_name = map[int32]string{
+		0: "chatMessageFragmentTypeUndefined",
+		1: "Text",
+		2: "Emote",
+	}
+	ChatMessageFragmentType_value = map[string]int32{
+		"chatMessageFragmentTypeUndefined": 0,
+		"Text":                             1,
+		"Emote":                            2,
+	}
+)
+
+func (x ChatMessageFragmentType) Enum() *ChatMessageFragmentType {
+	p := new(ChatMessageFragmentType)
+	*p = x
+	return p
+}
+
+func (x ChatMessageFragmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatMessageFragmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_streamd_proto_enumTypes[9].Descriptor()
+}
+
+func (ChatMessageFragmentType) Type() protoreflect.EnumType {
+	return &file_streamd_proto_enumTypes[9]
+}
+
+func (x ChatMessageFragmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatMessageFragmentType.Descriptor instead.
+func (ChatMessageFragmentType) EnumDescriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{9}
 }
 
 type PingRequest struct {
@@ -2950,14 +3221,21 @@ type StreamServer struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerType       StreamServerType `protobuf:"varint,1,opt,name=serverType,proto3,enum=streamd.StreamServerType" json:"serverType,omitempty"`
-	ListenAddr       string           `protobuf:"bytes,2,opt,name=listenAddr,proto3" json:"listenAddr,omitempty"`
-	IsTLS            bool             `protobuf:"varint,3,opt,name=IsTLS,proto3" json:"IsTLS,omitempty"`
-	WriteQueueSize   uint64           `protobuf:"varint,4,opt,name=WriteQueueSize,proto3" json:"WriteQueueSize,omitempty"`
-	WriteTimeoutNano uint64           `protobuf:"varint,5,opt,name=WriteTimeoutNano,proto3" json:"WriteTimeoutNano,omitempty"`
-	ReadTimeoutNano  uint64           `protobuf:"varint,6,opt,name=ReadTimeoutNano,proto3" json:"ReadTimeoutNano,omitempty"`
-	ServerCert       *TLSCertificate  `protobuf:"bytes,7,opt,name=ServerCert,proto3,oneof" json:"ServerCert,omitempty"`
-	ServerKey        *PrivateKey      `protobuf:"bytes,8,opt,name=ServerKey,proto3,oneof" json:"ServerKey,omitempty"`
+	ServerType             StreamServerType `protobuf:"varint,1,opt,name=serverType,proto3,enum=streamd.StreamServerType" json:"serverType,omitempty"`
+	ListenAddr             string           `protobuf:"bytes,2,opt,name=listenAddr,proto3" json:"listenAddr,omitempty"`
+	IsTLS                  bool             `protobuf:"varint,3,opt,name=IsTLS,proto3" json:"IsTLS,omitempty"`
+	WriteQueueSize         uint64           `protobuf:"varint,4,opt,name=WriteQueueSize,proto3" json:"WriteQueueSize,omitempty"`
+	WriteTimeoutNano       uint64           `protobuf:"varint,5,opt,name=WriteTimeoutNano,proto3" json:"WriteTimeoutNano,omitempty"`
+	ReadTimeoutNano        uint64           `protobuf:"varint,6,opt,name=ReadTimeoutNano,proto3" json:"ReadTimeoutNano,omitempty"`
+	ServerCert             *TLSCertificate  `protobuf:"bytes,7,opt,name=ServerCert,proto3,oneof" json:"ServerCert,omitempty"`
+	ServerKey              *PrivateKey      `protobuf:"bytes,8,opt,name=ServerKey,proto3,oneof" json:"ServerKey,omitempty"`
+	SRTPassphrase          string           `protobuf:"bytes,9,opt,name=SRTPassphrase,proto3" json:"SRTPassphrase,omitempty"`
+	HLSSegmentDurationNano uint64           `protobuf:"varint,11,opt,name=HLSSegmentDurationNano,proto3" json:"HLSSegmentDurationNano,omitempty"`
+	HLSSegmentCount        uint64           `protobuf:"varint,12,opt,name=HLSSegmentCount,proto3" json:"HLSSegmentCount,omitempty"`
+	HLSTempDirectory       string           `protobuf:"bytes,13,opt,name=HLSTempDirectory,proto3" json:"HLSTempDirectory,omitempty"`
+	WebRTCICEUDPListenAddr string           `protobuf:"bytes,14,opt,name=WebRTCICEUDPListenAddr,proto3" json:"WebRTCICEUDPListenAddr,omitempty"`
+	WebRTCICETCPListenAddr string           `protobuf:"bytes,15,opt,name=WebRTCICETCPListenAddr,proto3" json:"WebRTCICETCPListenAddr,omitempty"`
+	WebRTCAdditionalHosts  []string         `protobuf:"bytes,16,rep,name=WebRTCAdditionalHosts,proto3" json:"WebRTCAdditionalHosts,omitempty"`
 }
 
 func (x *StreamServer) Reset() {
@@ -3048,6 +3326,55 @@ func (x *StreamServer) GetServerKey() *PrivateKey {
 	return nil
 }
 
+func (x *StreamServer) GetSRTPassphrase() string {
+	if x != nil {
+		return x.SRTPassphrase
+	}
+	return ""
+}
+
+func (x *StreamServer) GetHLSSegmentDurationNano() uint64 {
+	if x != nil {
+		return x.HLSSegmentDurationNano
+	}
+	return 0
+}
+
+func (x *StreamServer) GetHLSSegmentCount() uint64 {
+	if x != nil {
+		return x.HLSSegmentCount
+	}
+	return 0
+}
+
+func (x *StreamServer) GetHLSTempDirectory() string {
+	if x != nil {
+		return x.HLSTempDirectory
+	}
+	return ""
+}
+
+func (x *StreamServer) GetWebRTCICEUDPListenAddr() string {
+	if x != nil {
+		return x.WebRTCICEUDPListenAddr
+	}
+	return ""
+}
+
+func (x *StreamServer) GetWebRTCICETCPListenAddr() string {
+	if x != nil {
+		return x.WebRTCICETCPListenAddr
+	}
+	return ""
+}
+
+func (x *StreamServer) GetWebRTCAdditionalHosts() []string {
+	if x != nil {
+		return x.WebRTCAdditionalHosts
+	}
+	return nil
+}
+
 type StreamServerStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4118,18 +4445,20 @@ func (x *ListIncomingStreamsReply) GetIncomingStreams() []*IncomingStream {
 	return nil
 }
 
-type RestartUntilYoutubeRecognizesStream struct {
+type RestartUntilPlatformRecognizesStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled        bool    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	StartTimeout   float64 `protobuf:"fixed64,2,opt,name=startTimeout,proto3" json:"startTimeout,omitempty"`
-	StopStartDelay float64 `protobuf:"fixed64,3,opt,name=stopStartDelay,proto3" json:"stopStartDelay,omitempty"`
+	Enabled          bool    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	StartTimeout     float64 `protobuf:"fixed64,2,opt,name=startTimeout,proto3" json:"startTimeout,omitempty"`
+	StopStartDelay   float64 `protobuf:"fixed64,3,opt,name=stopStartDelay,proto3" json:"stopStartDelay,omitempty"`
+	PlatformID       string  `protobuf:"bytes,4,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	ByDestinationURL bool    `protobuf:"varint,5,opt,name=byDestinationURL,proto3" json:"byDestinationURL,omitempty"`
 }
 
-func (x *RestartUntilYoutubeRecognizesStream) Reset() {
-	*x = RestartUntilYoutubeRecognizesStream{}
+func (x *RestartUntilPlatformRecognizesStream) Reset() {
+	*x = RestartUntilPlatformRecognizesStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4137,13 +4466,13 @@ func (x *RestartUntilYoutubeRecognizesStream) Reset() {
 	}
 }
 
-func (x *RestartUntilYoutubeRecognizesStream) String() string {
+func (x *RestartUntilPlatformRecognizesStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestartUntilYoutubeRecognizesStream) ProtoMessage() {}
+func (*RestartUntilPlatformRecognizesStream) ProtoMessage() {}
 
-func (x *RestartUntilYoutubeRecognizesStream) ProtoReflect() protoreflect.Message {
+func (x *RestartUntilPlatformRecognizesStream) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4155,42 +4484,60 @@ func (x *RestartUntilYoutubeRecognizesStream) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestartUntilYoutubeRecognizesStream.ProtoReflect.Descriptor instead.
-func (*RestartUntilYoutubeRecognizesStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestartUntilPlatformRecognizesStream.ProtoReflect.Descriptor instead.
+func (*RestartUntilPlatformRecognizesStream) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *RestartUntilYoutubeRecognizesStream) GetEnabled() bool {
+func (x *RestartUntilPlatformRecognizesStream) GetEnabled() bool {
 	if x != nil {
 		return x.Enabled
 	}
 	return false
 }
 
-func (x *RestartUntilYoutubeRecognizesStream) GetStartTimeout() float64 {
+func (x *RestartUntilPlatformRecognizesStream) GetStartTimeout() float64 {
 	if x != nil {
 		return x.StartTimeout
 	}
 	return 0
 }
 
-func (x *RestartUntilYoutubeRecognizesStream) GetStopStartDelay() float64 {
+func (x *RestartUntilPlatformRecognizesStream) GetStopStartDelay() float64 {
 	if x != nil {
 		return x.StopStartDelay
 	}
 	return 0
 }
 
-type StartAfterYoutubeRecognizedStream struct {
+func (x *RestartUntilPlatformRecognizesStream) GetPlatformID() string {
+	if x != nil {
+		return x.PlatformID
+	}
+	return ""
+}
+
+func (x *RestartUntilPlatformRecognizesStream) GetByDestinationURL() bool {
+	if x != nil {
+		return x.ByDestinationURL
+	}
+	return false
+}
+
+type StartAfterPlatformRecognizedStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enabled          bool    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	PlatformID       string  `protobuf:"bytes,2,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	ByDestinationURL bool    `protobuf:"varint,3,opt,name=byDestinationURL,proto3" json:"byDestinationURL,omitempty"`
+	CheckInterval    float64 `protobuf:"fixed64,4,opt,name=checkInterval,proto3" json:"checkInterval,omitempty"`
+	Timeout          float64 `protobuf:"fixed64,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *StartAfterYoutubeRecognizedStream) Reset() {
-	*x = StartAfterYoutubeRecognizedStream{}
+func (x *StartAfterPlatformRecognizedStream) Reset() {
+	*x = StartAfterPlatformRecognizedStream{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4198,13 +4545,13 @@ func (x *StartAfterYoutubeRecognizedStream) Reset() {
 	}
 }
 
-func (x *StartAfterYoutubeRecognizedStream) String() string {
+func (x *StartAfterPlatformRecognizedStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartAfterYoutubeRecognizedStream) ProtoMessage() {}
+func (*StartAfterPlatformRecognizedStream) ProtoMessage() {}
 
-func (x *StartAfterYoutubeRecognizedStream) ProtoReflect() protoreflect.Message {
+func (x *StartAfterPlatformRecognizedStream) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4216,25 +4563,53 @@ func (x *StartAfterYoutubeRecognizedStream) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartAfterYoutubeRecognizedStream.ProtoReflect.Descriptor instead.
-func (*StartAfterYoutubeRecognizedStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartAfterPlatformRecognizedStream.ProtoReflect.Descriptor instead.
+func (*StartAfterPlatformRecognizedStream) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *StartAfterYoutubeRecognizedStream) GetEnabled() bool {
+func (x *StartAfterPlatformRecognizedStream) GetEnabled() bool {
 	if x != nil {
 		return x.Enabled
 	}
 	return false
 }
 
+func (x *StartAfterPlatformRecognizedStream) GetPlatformID() string {
+	if x != nil {
+		return x.PlatformID
+	}
+	return ""
+}
+
+func (x *StartAfterPlatformRecognizedStream) GetByDestinationURL() bool {
+	if x != nil {
+		return x.ByDestinationURL
+	}
+	return false
+}
+
+func (x *StartAfterPlatformRecognizedStream) GetCheckInterval() float64 {
+	if x != nil {
+		return x.CheckInterval
+	}
+	return 0
+}
+
+func (x *StartAfterPlatformRecognizedStream) GetTimeout() float64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
 type StreamForwardQuirks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RestartUntilYoutubeRecognizesStream *RestartUntilYoutubeRecognizesStream `protobuf:"bytes,1,opt,name=restartUntilYoutubeRecognizesStream,proto3" json:"restartUntilYoutubeRecognizesStream,omitempty"`
-	StartAfterYoutubeRecognizedStream   *StartAfterYoutubeRecognizedStream   `protobuf:"bytes,2,opt,name=startAfterYoutubeRecognizedStream,proto3" json:"startAfterYoutubeRecognizedStream,omitempty"`
+	RestartUntilPlatformRecognizesStream *RestartUntilPlatformRecognizesStream `protobuf:"bytes,1,opt,name=restartUntilPlatformRecognizesStream,proto3" json:"restartUntilPlatformRecognizesStream,omitempty"`
+	StartAfterPlatformRecognizedStream   *StartAfterPlatformRecognizedStream   `protobuf:"bytes,2,opt,name=startAfterPlatformRecognizedStream,proto3" json:"startAfterPlatformRecognizedStream,omitempty"`
 }
 
 func (x *StreamForwardQuirks) Reset() {
@@ -4269,16 +4644,16 @@ func (*StreamForwardQuirks) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *StreamForwardQuirks) GetRestartUntilYoutubeRecognizesStream() *RestartUntilYoutubeRecognizesStream {
+func (x *StreamForwardQuirks) GetRestartUntilPlatformRecognizesStream() *RestartUntilPlatformRecognizesStream {
 	if x != nil {
-		return x.RestartUntilYoutubeRecognizesStream
+		return x.RestartUntilPlatformRecognizesStream
 	}
 	return nil
 }
 
-func (x *StreamForwardQuirks) GetStartAfterYoutubeRecognizedStream() *StartAfterYoutubeRecognizedStream {
+func (x *StreamForwardQuirks) GetStartAfterPlatformRecognizedStream() *StartAfterPlatformRecognizedStream {
 	if x != nil {
-		return x.StartAfterYoutubeRecognizedStream
+		return x.StartAfterPlatformRecognizedStream
 	}
 	return nil
 }
@@ -4359,8 +4734,19 @@ type StreamForwardStatistics struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumBytesWrote int64 `protobuf:"varint,1,opt,name=numBytesWrote,proto3" json:"numBytesWrote,omitempty"`
-	NumBytesRead  int64 `protobuf:"varint,2,opt,name=numBytesRead,proto3" json:"numBytesRead,omitempty"`
+	NumBytesWrote        int64   `protobuf:"varint,1,opt,name=numBytesWrote,proto3" json:"numBytesWrote,omitempty"`
+	NumBytesRead         int64   `protobuf:"varint,2,opt,name=numBytesRead,proto3" json:"numBytesRead,omitempty"`
+	InputBitrate         uint64  `protobuf:"varint,3,opt,name=inputBitrate,proto3" json:"inputBitrate,omitempty"`
+	OutputBitrate        uint64  `protobuf:"varint,4,opt,name=outputBitrate,proto3" json:"outputBitrate,omitempty"`
+	FrameRate            float64 `protobuf:"fixed64,5,opt,name=frameRate,proto3" json:"frameRate,omitempty"`
+	KeyframeIntervalNano int64   `protobuf:"varint,6,opt,name=keyframeIntervalNano,proto3" json:"keyframeIntervalNano,omitempty"`
+	DroppedPackets       uint64  `protobuf:"varint,7,opt,name=droppedPackets,proto3" json:"droppedPackets,omitempty"`
+	LatePackets          uint64  `protobuf:"varint,8,opt,name=latePackets,proto3" json:"latePackets,omitempty"`
+	Reconnects           uint64  `protobuf:"varint,9,opt,name=reconnects,proto3" json:"reconnects,omitempty"`
+	LastError            string  `protobuf:"bytes,10,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	LastErrorAtUnixNano  int64   `protobuf:"varint,11,opt,name=lastErrorAtUnixNano,proto3" json:"lastErrorAtUnixNano,omitempty"`
+	UptimeNano           int64   `protobuf:"varint,12,opt,name=uptimeNano,proto3" json:"uptimeNano,omitempty"`
+	HasFrameStats        bool    `protobuf:"varint,13,opt,name=hasFrameStats,proto3" json:"hasFrameStats,omitempty"`
 }
 
 func (x *StreamForwardStatistics) Reset() {
@@ -4409,6 +4795,83 @@ func (x *StreamForwardStatistics) GetNumBytesRead() int64 {
 	return 0
 }
 
+func (x *StreamForwardStatistics) GetInputBitrate() uint64 {
+	if x != nil {
+		return x.InputBitrate
+	}
+	return 0
+}
+
+func (x *StreamForwardStatistics) GetOutputBitrate() uint64 {
+	if x != nil {
+		return x.OutputBitrate
+	}
+	return 0
+}
+
+func (x *StreamForwardStatistics) GetFrameRate() float64 {
+	if x != nil {
+		return x.FrameRate
+	}
+	return 0
+}
+
+func (x *StreamForwardStatistics) GetKeyframeIntervalNano() int64 {
+	if x != nil {
+		return x.KeyframeIntervalNano
+	}
+	return 0
+}
+
+func (x *StreamForwardStatistics) GetDroppedPackets() uint64 {
+	if x != nil {
+		return x.DroppedPackets
+	}
+	return 0
+}
+
+func (x *StreamForwardStatistics) GetLatePackets() uint64 {
+	if x != nil {
+		return x.LatePackets
+	}
+	return 0
+}
+
+func (x *StreamForwardStatistics) GetReconnects() uint64 {
+	if x != nil {
+		return x.Reconnects
+	}
+	return 0
+}
+
+func (x *StreamForwardStatistics) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *StreamForwardStatistics) GetLastErrorAtUnixNano() int64 {
+	if x != nil {
+		return x.LastErrorAtUnixNano
+	}
+	return 0
+}
+
+func (x *StreamForwardStatistics) GetUptimeNano() int64 {
+	if x != nil {
+		return x.UptimeNano
+	}
+	return 0
+}
+
+func (x *StreamForwardStatistics) GetHasFrameStats() bool {
+	if x != nil {
+		return x.HasFrameStats
+	}
+	return false
+}
+
 type StreamForwardWithStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4804,16 +5267,20 @@ func (*RemoveStreamForwardReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{94}
 }
 
-type WaitForStreamPublisherRequest struct {
+type StreamRecordingConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID *string `protobuf:"bytes,1,opt,name=streamID,proto3,oneof" json:"streamID,omitempty"`
+	Directory           string `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	Format              string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	SegmentDurationNano int64  `protobuf:"varint,3,opt,name=segmentDurationNano,proto3" json:"segmentDurationNano,omitempty"`
+	MaxDiskUsage        uint64 `protobuf:"varint,4,opt,name=maxDiskUsage,proto3" json:"maxDiskUsage,omitempty"`
+	RetentionNano       int64  `protobuf:"varint,5,opt,name=retentionNano,proto3" json:"retentionNano,omitempty"`
 }
 
-func (x *WaitForStreamPublisherRequest) Reset() {
-	*x = WaitForStreamPublisherRequest{}
+func (x *StreamRecordingConfig) Reset() {
+	*x = StreamRecordingConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4821,13 +5288,13 @@ func (x *WaitForStreamPublisherRequest) Reset() {
 	}
 }
 
-func (x *WaitForStreamPublisherRequest) String() string {
+func (x *StreamRecordingConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitForStreamPublisherRequest) ProtoMessage() {}
+func (*StreamRecordingConfig) ProtoMessage() {}
 
-func (x *WaitForStreamPublisherRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamRecordingConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4839,86 +5306,76 @@ func (x *WaitForStreamPublisherRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitForStreamPublisherRequest.ProtoReflect.Descriptor instead.
-func (*WaitForStreamPublisherRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamRecordingConfig.ProtoReflect.Descriptor instead.
+func (*StreamRecordingConfig) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *WaitForStreamPublisherRequest) GetStreamID() string {
-	if x != nil && x.StreamID != nil {
-		return *x.StreamID
+func (x *StreamRecordingConfig) GetDirectory() string {
+	if x != nil {
+		return x.Directory
 	}
 	return ""
 }
 
-type StreamPublisher struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *StreamPublisher) Reset() {
-	*x = StreamPublisher{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[96]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StreamRecordingConfig) GetFormat() string {
+	if x != nil {
+		return x.Format
 	}
+	return ""
 }
 
-func (x *StreamPublisher) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StreamRecordingConfig) GetSegmentDurationNano() int64 {
+	if x != nil {
+		return x.SegmentDurationNano
+	}
+	return 0
 }
 
-func (*StreamPublisher) ProtoMessage() {}
-
-func (x *StreamPublisher) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[96]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StreamRecordingConfig) GetMaxDiskUsage() uint64 {
+	if x != nil {
+		return x.MaxDiskUsage
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StreamPublisher.ProtoReflect.Descriptor instead.
-func (*StreamPublisher) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{96}
+func (x *StreamRecordingConfig) GetRetentionNano() int64 {
+	if x != nil {
+		return x.RetentionNano
+	}
+	return 0
 }
 
-type StreamPlaybackConfig struct {
+type StreamRecording struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JitterBufDurationSecs float64 `protobuf:"fixed64,1,opt,name=JitterBufDurationSecs,proto3" json:"JitterBufDurationSecs,omitempty"`
-	CatchupMaxSpeedFactor float64 `protobuf:"fixed64,2,opt,name=CatchupMaxSpeedFactor,proto3" json:"CatchupMaxSpeedFactor,omitempty"`
-	MaxCatchupAtLagSecs   float64 `protobuf:"fixed64,3,opt,name=MaxCatchupAtLagSecs,proto3" json:"MaxCatchupAtLagSecs,omitempty"`
-	StartTimeoutSecs      float64 `protobuf:"fixed64,4,opt,name=StartTimeoutSecs,proto3" json:"StartTimeoutSecs,omitempty"`
-	ReadTimeoutSecs       float64 `protobuf:"fixed64,5,opt,name=ReadTimeoutSecs,proto3" json:"ReadTimeoutSecs,omitempty"`
-	OverriddenURL         string  `protobuf:"bytes,6,opt,name=overriddenURL,proto3" json:"overriddenURL,omitempty"`
+	StreamID           string `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Path               string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size               uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	StartedAtUnixNano  int64  `protobuf:"varint,4,opt,name=startedAtUnixNano,proto3" json:"startedAtUnixNano,omitempty"`
+	ModifiedAtUnixNano int64  `protobuf:"varint,5,opt,name=modifiedAtUnixNano,proto3" json:"modifiedAtUnixNano,omitempty"`
+	IsActive           bool   `protobuf:"varint,6,opt,name=isActive,proto3" json:"isActive,omitempty"`
 }
 
-func (x *StreamPlaybackConfig) Reset() {
-	*x = StreamPlaybackConfig{}
+func (x *StreamRecording) Reset() {
+	*x = StreamRecording{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[97]
+		mi := &file_streamd_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamPlaybackConfig) String() string {
+func (x *StreamRecording) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlaybackConfig) ProtoMessage() {}
+func (*StreamRecording) ProtoMessage() {}
 
-func (x *StreamPlaybackConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[97]
+func (x *StreamRecording) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4929,81 +5386,79 @@ func (x *StreamPlaybackConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlaybackConfig.ProtoReflect.Descriptor instead.
-func (*StreamPlaybackConfig) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use StreamRecording.ProtoReflect.Descriptor instead.
+func (*StreamRecording) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *StreamPlaybackConfig) GetJitterBufDurationSecs() float64 {
+func (x *StreamRecording) GetStreamID() string {
 	if x != nil {
-		return x.JitterBufDurationSecs
+		return x.StreamID
 	}
-	return 0
+	return ""
 }
 
-func (x *StreamPlaybackConfig) GetCatchupMaxSpeedFactor() float64 {
+func (x *StreamRecording) GetPath() string {
 	if x != nil {
-		return x.CatchupMaxSpeedFactor
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *StreamPlaybackConfig) GetMaxCatchupAtLagSecs() float64 {
+func (x *StreamRecording) GetSize() uint64 {
 	if x != nil {
-		return x.MaxCatchupAtLagSecs
+		return x.Size
 	}
 	return 0
 }
 
-func (x *StreamPlaybackConfig) GetStartTimeoutSecs() float64 {
+func (x *StreamRecording) GetStartedAtUnixNano() int64 {
 	if x != nil {
-		return x.StartTimeoutSecs
+		return x.StartedAtUnixNano
 	}
 	return 0
 }
 
-func (x *StreamPlaybackConfig) GetReadTimeoutSecs() float64 {
+func (x *StreamRecording) GetModifiedAtUnixNano() int64 {
 	if x != nil {
-		return x.ReadTimeoutSecs
+		return x.ModifiedAtUnixNano
 	}
 	return 0
 }
 
-func (x *StreamPlaybackConfig) GetOverriddenURL() string {
+func (x *StreamRecording) GetIsActive() bool {
 	if x != nil {
-		return x.OverriddenURL
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-type StreamPlayerConfig struct {
+type StartRecordingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID             string                `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	PlayerType           PlayerType            `protobuf:"varint,2,opt,name=playerType,proto3,enum=streamd.PlayerType" json:"playerType,omitempty"`
-	Disabled             bool                  `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	StreamPlaybackConfig *StreamPlaybackConfig `protobuf:"bytes,4,opt,name=streamPlaybackConfig,proto3" json:"streamPlaybackConfig,omitempty"`
+	StreamID string                 `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Config   *StreamRecordingConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *StreamPlayerConfig) Reset() {
-	*x = StreamPlayerConfig{}
+func (x *StartRecordingRequest) Reset() {
+	*x = StartRecordingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[98]
+		mi := &file_streamd_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamPlayerConfig) String() string {
+func (x *StartRecordingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerConfig) ProtoMessage() {}
+func (*StartRecordingRequest) ProtoMessage() {}
 
-func (x *StreamPlayerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[98]
+func (x *StartRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5014,49 +5469,73 @@ func (x *StreamPlayerConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerConfig.ProtoReflect.Descriptor instead.
-func (*StreamPlayerConfig) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use StartRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StartRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *StreamPlayerConfig) GetStreamID() string {
+func (x *StartRecordingRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerConfig) GetPlayerType() PlayerType {
+func (x *StartRecordingRequest) GetConfig() *StreamRecordingConfig {
 	if x != nil {
-		return x.PlayerType
+		return x.Config
 	}
-	return PlayerType_PlayerTypeAuto
+	return nil
 }
 
-func (x *StreamPlayerConfig) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
+type StartRecordingReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartRecordingReply) Reset() {
+	*x = StartRecordingReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *StreamPlayerConfig) GetStreamPlaybackConfig() *StreamPlaybackConfig {
-	if x != nil {
-		return x.StreamPlaybackConfig
+func (x *StartRecordingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartRecordingReply) ProtoMessage() {}
+
+func (x *StartRecordingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type AddStreamPlayerRequest struct {
+// Deprecated: Use StartRecordingReply.ProtoReflect.Descriptor instead.
+func (*StartRecordingReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{98}
+}
+
+type StopRecordingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *StreamPlayerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	StreamID string `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
 }
 
-func (x *AddStreamPlayerRequest) Reset() {
-	*x = AddStreamPlayerRequest{}
+func (x *StopRecordingRequest) Reset() {
+	*x = StopRecordingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5064,13 +5543,13 @@ func (x *AddStreamPlayerRequest) Reset() {
 	}
 }
 
-func (x *AddStreamPlayerRequest) String() string {
+func (x *StopRecordingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddStreamPlayerRequest) ProtoMessage() {}
+func (*StopRecordingRequest) ProtoMessage() {}
 
-func (x *AddStreamPlayerRequest) ProtoReflect() protoreflect.Message {
+func (x *StopRecordingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5082,26 +5561,26 @@ func (x *AddStreamPlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddStreamPlayerRequest.ProtoReflect.Descriptor instead.
-func (*AddStreamPlayerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopRecordingRequest.ProtoReflect.Descriptor instead.
+func (*StopRecordingRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *AddStreamPlayerRequest) GetConfig() *StreamPlayerConfig {
+func (x *StopRecordingRequest) GetStreamID() string {
 	if x != nil {
-		return x.Config
+		return x.StreamID
 	}
-	return nil
+	return ""
 }
 
-type AddStreamPlayerReply struct {
+type StopRecordingReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AddStreamPlayerReply) Reset() {
-	*x = AddStreamPlayerReply{}
+func (x *StopRecordingReply) Reset() {
+	*x = StopRecordingReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5109,13 +5588,13 @@ func (x *AddStreamPlayerReply) Reset() {
 	}
 }
 
-func (x *AddStreamPlayerReply) String() string {
+func (x *StopRecordingReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddStreamPlayerReply) ProtoMessage() {}
+func (*StopRecordingReply) ProtoMessage() {}
 
-func (x *AddStreamPlayerReply) ProtoReflect() protoreflect.Message {
+func (x *StopRecordingReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5127,21 +5606,19 @@ func (x *AddStreamPlayerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddStreamPlayerReply.ProtoReflect.Descriptor instead.
-func (*AddStreamPlayerReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopRecordingReply.ProtoReflect.Descriptor instead.
+func (*StopRecordingReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{100}
 }
 
-type RemoveStreamPlayerRequest struct {
+type ListRecordingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StreamID string `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
 }
 
-func (x *RemoveStreamPlayerRequest) Reset() {
-	*x = RemoveStreamPlayerRequest{}
+func (x *ListRecordingsRequest) Reset() {
+	*x = ListRecordingsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5149,13 +5626,13 @@ func (x *RemoveStreamPlayerRequest) Reset() {
 	}
 }
 
-func (x *RemoveStreamPlayerRequest) String() string {
+func (x *ListRecordingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveStreamPlayerRequest) ProtoMessage() {}
+func (*ListRecordingsRequest) ProtoMessage() {}
 
-func (x *RemoveStreamPlayerRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRecordingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5167,26 +5644,21 @@ func (x *RemoveStreamPlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveStreamPlayerRequest.ProtoReflect.Descriptor instead.
-func (*RemoveStreamPlayerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRecordingsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecordingsRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *RemoveStreamPlayerRequest) GetStreamID() string {
-	if x != nil {
-		return x.StreamID
-	}
-	return ""
-}
-
-type RemoveStreamPlayerReply struct {
+type ListRecordingsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Recordings []*StreamRecording `protobuf:"bytes,1,rep,name=recordings,proto3" json:"recordings,omitempty"`
 }
 
-func (x *RemoveStreamPlayerReply) Reset() {
-	*x = RemoveStreamPlayerReply{}
+func (x *ListRecordingsReply) Reset() {
+	*x = ListRecordingsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5194,13 +5666,13 @@ func (x *RemoveStreamPlayerReply) Reset() {
 	}
 }
 
-func (x *RemoveStreamPlayerReply) String() string {
+func (x *ListRecordingsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveStreamPlayerReply) ProtoMessage() {}
+func (*ListRecordingsReply) ProtoMessage() {}
 
-func (x *RemoveStreamPlayerReply) ProtoReflect() protoreflect.Message {
+func (x *ListRecordingsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5212,21 +5684,28 @@ func (x *RemoveStreamPlayerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveStreamPlayerReply.ProtoReflect.Descriptor instead.
-func (*RemoveStreamPlayerReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRecordingsReply.ProtoReflect.Descriptor instead.
+func (*ListRecordingsReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{102}
 }
 
-type UpdateStreamPlayerRequest struct {
+func (x *ListRecordingsReply) GetRecordings() []*StreamRecording {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
+}
+
+type WaitForStreamPublisherRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *StreamPlayerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	StreamID *string `protobuf:"bytes,1,opt,name=streamID,proto3,oneof" json:"streamID,omitempty"`
 }
 
-func (x *UpdateStreamPlayerRequest) Reset() {
-	*x = UpdateStreamPlayerRequest{}
+func (x *WaitForStreamPublisherRequest) Reset() {
+	*x = WaitForStreamPublisherRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5234,13 +5713,13 @@ func (x *UpdateStreamPlayerRequest) Reset() {
 	}
 }
 
-func (x *UpdateStreamPlayerRequest) String() string {
+func (x *WaitForStreamPublisherRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStreamPlayerRequest) ProtoMessage() {}
+func (*WaitForStreamPublisherRequest) ProtoMessage() {}
 
-func (x *UpdateStreamPlayerRequest) ProtoReflect() protoreflect.Message {
+func (x *WaitForStreamPublisherRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5252,26 +5731,26 @@ func (x *UpdateStreamPlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStreamPlayerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateStreamPlayerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WaitForStreamPublisherRequest.ProtoReflect.Descriptor instead.
+func (*WaitForStreamPublisherRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *UpdateStreamPlayerRequest) GetConfig() *StreamPlayerConfig {
-	if x != nil {
-		return x.Config
+func (x *WaitForStreamPublisherRequest) GetStreamID() string {
+	if x != nil && x.StreamID != nil {
+		return *x.StreamID
 	}
-	return nil
+	return ""
 }
 
-type UpdateStreamPlayerReply struct {
+type StreamPublisher struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateStreamPlayerReply) Reset() {
-	*x = UpdateStreamPlayerReply{}
+func (x *StreamPublisher) Reset() {
+	*x = StreamPublisher{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5279,13 +5758,13 @@ func (x *UpdateStreamPlayerReply) Reset() {
 	}
 }
 
-func (x *UpdateStreamPlayerReply) String() string {
+func (x *StreamPublisher) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateStreamPlayerReply) ProtoMessage() {}
+func (*StreamPublisher) ProtoMessage() {}
 
-func (x *UpdateStreamPlayerReply) ProtoReflect() protoreflect.Message {
+func (x *StreamPublisher) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5297,19 +5776,26 @@ func (x *UpdateStreamPlayerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateStreamPlayerReply.ProtoReflect.Descriptor instead.
-func (*UpdateStreamPlayerReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPublisher.ProtoReflect.Descriptor instead.
+func (*StreamPublisher) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{104}
 }
 
-type ListStreamPlayersRequest struct {
+type StreamPlaybackConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	JitterBufDurationSecs float64 `protobuf:"fixed64,1,opt,name=JitterBufDurationSecs,proto3" json:"JitterBufDurationSecs,omitempty"`
+	CatchupMaxSpeedFactor float64 `protobuf:"fixed64,2,opt,name=CatchupMaxSpeedFactor,proto3" json:"CatchupMaxSpeedFactor,omitempty"`
+	MaxCatchupAtLagSecs   float64 `protobuf:"fixed64,3,opt,name=MaxCatchupAtLagSecs,proto3" json:"MaxCatchupAtLagSecs,omitempty"`
+	StartTimeoutSecs      float64 `protobuf:"fixed64,4,opt,name=StartTimeoutSecs,proto3" json:"StartTimeoutSecs,omitempty"`
+	ReadTimeoutSecs       float64 `protobuf:"fixed64,5,opt,name=ReadTimeoutSecs,proto3" json:"ReadTimeoutSecs,omitempty"`
+	OverriddenURL         string  `protobuf:"bytes,6,opt,name=overriddenURL,proto3" json:"overriddenURL,omitempty"`
 }
 
-func (x *ListStreamPlayersRequest) Reset() {
-	*x = ListStreamPlayersRequest{}
+func (x *StreamPlaybackConfig) Reset() {
+	*x = StreamPlaybackConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5317,13 +5803,13 @@ func (x *ListStreamPlayersRequest) Reset() {
 	}
 }
 
-func (x *ListStreamPlayersRequest) String() string {
+func (x *StreamPlaybackConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStreamPlayersRequest) ProtoMessage() {}
+func (*StreamPlaybackConfig) ProtoMessage() {}
 
-func (x *ListStreamPlayersRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlaybackConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5335,21 +5821,66 @@ func (x *ListStreamPlayersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStreamPlayersRequest.ProtoReflect.Descriptor instead.
-func (*ListStreamPlayersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlaybackConfig.ProtoReflect.Descriptor instead.
+func (*StreamPlaybackConfig) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{105}
 }
 
-type ListStreamPlayersReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *StreamPlaybackConfig) GetJitterBufDurationSecs() float64 {
+	if x != nil {
+		return x.JitterBufDurationSecs
+	}
+	return 0
+}
 
-	Players []*StreamPlayerConfig `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+func (x *StreamPlaybackConfig) GetCatchupMaxSpeedFactor() float64 {
+	if x != nil {
+		return x.CatchupMaxSpeedFactor
+	}
+	return 0
 }
 
-func (x *ListStreamPlayersReply) Reset() {
-	*x = ListStreamPlayersReply{}
+func (x *StreamPlaybackConfig) GetMaxCatchupAtLagSecs() float64 {
+	if x != nil {
+		return x.MaxCatchupAtLagSecs
+	}
+	return 0
+}
+
+func (x *StreamPlaybackConfig) GetStartTimeoutSecs() float64 {
+	if x != nil {
+		return x.StartTimeoutSecs
+	}
+	return 0
+}
+
+func (x *StreamPlaybackConfig) GetReadTimeoutSecs() float64 {
+	if x != nil {
+		return x.ReadTimeoutSecs
+	}
+	return 0
+}
+
+func (x *StreamPlaybackConfig) GetOverriddenURL() string {
+	if x != nil {
+		return x.OverriddenURL
+	}
+	return ""
+}
+
+type StreamPlayerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID             string                `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	PlayerType           PlayerType            `protobuf:"varint,2,opt,name=playerType,proto3,enum=streamd.PlayerType" json:"playerType,omitempty"`
+	Disabled             bool                  `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	StreamPlaybackConfig *StreamPlaybackConfig `protobuf:"bytes,4,opt,name=streamPlaybackConfig,proto3" json:"streamPlaybackConfig,omitempty"`
+}
+
+func (x *StreamPlayerConfig) Reset() {
+	*x = StreamPlayerConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5357,13 +5888,13 @@ func (x *ListStreamPlayersReply) Reset() {
 	}
 }
 
-func (x *ListStreamPlayersReply) String() string {
+func (x *StreamPlayerConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStreamPlayersReply) ProtoMessage() {}
+func (*StreamPlayerConfig) ProtoMessage() {}
 
-func (x *ListStreamPlayersReply) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5375,28 +5906,49 @@ func (x *ListStreamPlayersReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStreamPlayersReply.ProtoReflect.Descriptor instead.
-func (*ListStreamPlayersReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerConfig.ProtoReflect.Descriptor instead.
+func (*StreamPlayerConfig) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *ListStreamPlayersReply) GetPlayers() []*StreamPlayerConfig {
+func (x *StreamPlayerConfig) GetStreamID() string {
 	if x != nil {
-		return x.Players
+		return x.StreamID
+	}
+	return ""
+}
+
+func (x *StreamPlayerConfig) GetPlayerType() PlayerType {
+	if x != nil {
+		return x.PlayerType
+	}
+	return PlayerType_PlayerTypeAuto
+}
+
+func (x *StreamPlayerConfig) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *StreamPlayerConfig) GetStreamPlaybackConfig() *StreamPlaybackConfig {
+	if x != nil {
+		return x.StreamPlaybackConfig
 	}
 	return nil
 }
 
-type GetStreamPlayerRequest struct {
+type AddStreamPlayerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Config *StreamPlayerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *GetStreamPlayerRequest) Reset() {
-	*x = GetStreamPlayerRequest{}
+func (x *AddStreamPlayerRequest) Reset() {
+	*x = AddStreamPlayerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5404,13 +5956,13 @@ func (x *GetStreamPlayerRequest) Reset() {
 	}
 }
 
-func (x *GetStreamPlayerRequest) String() string {
+func (x *AddStreamPlayerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamPlayerRequest) ProtoMessage() {}
+func (*AddStreamPlayerRequest) ProtoMessage() {}
 
-func (x *GetStreamPlayerRequest) ProtoReflect() protoreflect.Message {
+func (x *AddStreamPlayerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5422,28 +5974,26 @@ func (x *GetStreamPlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamPlayerRequest.ProtoReflect.Descriptor instead.
-func (*GetStreamPlayerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddStreamPlayerRequest.ProtoReflect.Descriptor instead.
+func (*AddStreamPlayerRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetStreamPlayerRequest) GetStreamID() string {
+func (x *AddStreamPlayerRequest) GetConfig() *StreamPlayerConfig {
 	if x != nil {
-		return x.StreamID
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-type GetStreamPlayerReply struct {
+type AddStreamPlayerReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Config *StreamPlayerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *GetStreamPlayerReply) Reset() {
-	*x = GetStreamPlayerReply{}
+func (x *AddStreamPlayerReply) Reset() {
+	*x = AddStreamPlayerReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5451,13 +6001,13 @@ func (x *GetStreamPlayerReply) Reset() {
 	}
 }
 
-func (x *GetStreamPlayerReply) String() string {
+func (x *AddStreamPlayerReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamPlayerReply) ProtoMessage() {}
+func (*AddStreamPlayerReply) ProtoMessage() {}
 
-func (x *GetStreamPlayerReply) ProtoReflect() protoreflect.Message {
+func (x *AddStreamPlayerReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5469,29 +6019,21 @@ func (x *GetStreamPlayerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamPlayerReply.ProtoReflect.Descriptor instead.
-func (*GetStreamPlayerReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddStreamPlayerReply.ProtoReflect.Descriptor instead.
+func (*AddStreamPlayerReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetStreamPlayerReply) GetConfig() *StreamPlayerConfig {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-type StreamPlayerOpenRequest struct {
+type RemoveStreamPlayerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                   `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.OpenRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	StreamID string `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
 }
 
-func (x *StreamPlayerOpenRequest) Reset() {
-	*x = StreamPlayerOpenRequest{}
+func (x *RemoveStreamPlayerRequest) Reset() {
+	*x = RemoveStreamPlayerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5499,13 +6041,13 @@ func (x *StreamPlayerOpenRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerOpenRequest) String() string {
+func (x *RemoveStreamPlayerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerOpenRequest) ProtoMessage() {}
+func (*RemoveStreamPlayerRequest) ProtoMessage() {}
 
-func (x *StreamPlayerOpenRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveStreamPlayerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5517,35 +6059,26 @@ func (x *StreamPlayerOpenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerOpenRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerOpenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveStreamPlayerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStreamPlayerRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *StreamPlayerOpenRequest) GetStreamID() string {
+func (x *RemoveStreamPlayerRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerOpenRequest) GetRequest() *player_grpc.OpenRequest {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-type StreamPlayerOpenReply struct {
+type RemoveStreamPlayerReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Reply *player_grpc.OpenReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamPlayerOpenReply) Reset() {
-	*x = StreamPlayerOpenReply{}
+func (x *RemoveStreamPlayerReply) Reset() {
+	*x = RemoveStreamPlayerReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5553,13 +6086,13 @@ func (x *StreamPlayerOpenReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerOpenReply) String() string {
+func (x *RemoveStreamPlayerReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerOpenReply) ProtoMessage() {}
+func (*RemoveStreamPlayerReply) ProtoMessage() {}
 
-func (x *StreamPlayerOpenReply) ProtoReflect() protoreflect.Message {
+func (x *RemoveStreamPlayerReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5571,29 +6104,21 @@ func (x *StreamPlayerOpenReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerOpenReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerOpenReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveStreamPlayerReply.ProtoReflect.Descriptor instead.
+func (*RemoveStreamPlayerReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *StreamPlayerOpenReply) GetReply() *player_grpc.OpenReply {
-	if x != nil {
-		return x.Reply
-	}
-	return nil
-}
-
-type StreamPlayerProcessTitleRequest struct {
+type UpdateStreamPlayerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                           `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.ProcessTitleRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Config *StreamPlayerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *StreamPlayerProcessTitleRequest) Reset() {
-	*x = StreamPlayerProcessTitleRequest{}
+func (x *UpdateStreamPlayerRequest) Reset() {
+	*x = UpdateStreamPlayerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5601,13 +6126,13 @@ func (x *StreamPlayerProcessTitleRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerProcessTitleRequest) String() string {
+func (x *UpdateStreamPlayerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerProcessTitleRequest) ProtoMessage() {}
+func (*UpdateStreamPlayerRequest) ProtoMessage() {}
 
-func (x *StreamPlayerProcessTitleRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateStreamPlayerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5619,35 +6144,26 @@ func (x *StreamPlayerProcessTitleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerProcessTitleRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerProcessTitleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateStreamPlayerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStreamPlayerRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *StreamPlayerProcessTitleRequest) GetStreamID() string {
-	if x != nil {
-		return x.StreamID
-	}
-	return ""
-}
-
-func (x *StreamPlayerProcessTitleRequest) GetRequest() *player_grpc.ProcessTitleRequest {
+func (x *UpdateStreamPlayerRequest) GetConfig() *StreamPlayerConfig {
 	if x != nil {
-		return x.Request
+		return x.Config
 	}
 	return nil
 }
 
-type StreamPlayerProcessTitleReply struct {
+type UpdateStreamPlayerReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Reply *player_grpc.ProcessTitleReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamPlayerProcessTitleReply) Reset() {
-	*x = StreamPlayerProcessTitleReply{}
+func (x *UpdateStreamPlayerReply) Reset() {
+	*x = UpdateStreamPlayerReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5655,13 +6171,13 @@ func (x *StreamPlayerProcessTitleReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerProcessTitleReply) String() string {
+func (x *UpdateStreamPlayerReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerProcessTitleReply) ProtoMessage() {}
+func (*UpdateStreamPlayerReply) ProtoMessage() {}
 
-func (x *StreamPlayerProcessTitleReply) ProtoReflect() protoreflect.Message {
+func (x *UpdateStreamPlayerReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5673,29 +6189,19 @@ func (x *StreamPlayerProcessTitleReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerProcessTitleReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerProcessTitleReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateStreamPlayerReply.ProtoReflect.Descriptor instead.
+func (*UpdateStreamPlayerReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *StreamPlayerProcessTitleReply) GetReply() *player_grpc.ProcessTitleReply {
-	if x != nil {
-		return x.Reply
-	}
-	return nil
-}
-
-type StreamPlayerGetLinkRequest struct {
+type ListStreamPlayersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StreamID string                      `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.GetLinkRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *StreamPlayerGetLinkRequest) Reset() {
-	*x = StreamPlayerGetLinkRequest{}
+func (x *ListStreamPlayersRequest) Reset() {
+	*x = ListStreamPlayersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5703,13 +6209,13 @@ func (x *StreamPlayerGetLinkRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerGetLinkRequest) String() string {
+func (x *ListStreamPlayersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerGetLinkRequest) ProtoMessage() {}
+func (*ListStreamPlayersRequest) ProtoMessage() {}
 
-func (x *StreamPlayerGetLinkRequest) ProtoReflect() protoreflect.Message {
+func (x *ListStreamPlayersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5721,35 +6227,21 @@ func (x *StreamPlayerGetLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerGetLinkRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerGetLinkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListStreamPlayersRequest.ProtoReflect.Descriptor instead.
+func (*ListStreamPlayersRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *StreamPlayerGetLinkRequest) GetStreamID() string {
-	if x != nil {
-		return x.StreamID
-	}
-	return ""
-}
-
-func (x *StreamPlayerGetLinkRequest) GetRequest() *player_grpc.GetLinkRequest {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-type StreamPlayerGetLinkReply struct {
+type ListStreamPlayersReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reply *player_grpc.GetLinkReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Players []*StreamPlayerConfig `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
 }
 
-func (x *StreamPlayerGetLinkReply) Reset() {
-	*x = StreamPlayerGetLinkReply{}
+func (x *ListStreamPlayersReply) Reset() {
+	*x = ListStreamPlayersReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5757,13 +6249,13 @@ func (x *StreamPlayerGetLinkReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerGetLinkReply) String() string {
+func (x *ListStreamPlayersReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerGetLinkReply) ProtoMessage() {}
+func (*ListStreamPlayersReply) ProtoMessage() {}
 
-func (x *StreamPlayerGetLinkReply) ProtoReflect() protoreflect.Message {
+func (x *ListStreamPlayersReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5775,29 +6267,28 @@ func (x *StreamPlayerGetLinkReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerGetLinkReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerGetLinkReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListStreamPlayersReply.ProtoReflect.Descriptor instead.
+func (*ListStreamPlayersReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *StreamPlayerGetLinkReply) GetReply() *player_grpc.GetLinkReply {
+func (x *ListStreamPlayersReply) GetPlayers() []*StreamPlayerConfig {
 	if x != nil {
-		return x.Reply
+		return x.Players
 	}
 	return nil
 }
 
-type StreamPlayerEndChanRequest struct {
+type GetStreamPlayerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                      `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.EndChanRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	StreamID string `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
 }
 
-func (x *StreamPlayerEndChanRequest) Reset() {
-	*x = StreamPlayerEndChanRequest{}
+func (x *GetStreamPlayerRequest) Reset() {
+	*x = GetStreamPlayerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5805,13 +6296,13 @@ func (x *StreamPlayerEndChanRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerEndChanRequest) String() string {
+func (x *GetStreamPlayerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerEndChanRequest) ProtoMessage() {}
+func (*GetStreamPlayerRequest) ProtoMessage() {}
 
-func (x *StreamPlayerEndChanRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStreamPlayerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5823,35 +6314,28 @@ func (x *StreamPlayerEndChanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerEndChanRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerEndChanRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamPlayerRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamPlayerRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *StreamPlayerEndChanRequest) GetStreamID() string {
+func (x *GetStreamPlayerRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerEndChanRequest) GetRequest() *player_grpc.EndChanRequest {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-type StreamPlayerEndChanReply struct {
+type GetStreamPlayerReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reply *player_grpc.EndChanReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Config *StreamPlayerConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *StreamPlayerEndChanReply) Reset() {
-	*x = StreamPlayerEndChanReply{}
+func (x *GetStreamPlayerReply) Reset() {
+	*x = GetStreamPlayerReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[116]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5859,13 +6343,13 @@ func (x *StreamPlayerEndChanReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerEndChanReply) String() string {
+func (x *GetStreamPlayerReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerEndChanReply) ProtoMessage() {}
+func (*GetStreamPlayerReply) ProtoMessage() {}
 
-func (x *StreamPlayerEndChanReply) ProtoReflect() protoreflect.Message {
+func (x *GetStreamPlayerReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[116]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5877,29 +6361,29 @@ func (x *StreamPlayerEndChanReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerEndChanReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerEndChanReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStreamPlayerReply.ProtoReflect.Descriptor instead.
+func (*GetStreamPlayerReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *StreamPlayerEndChanReply) GetReply() *player_grpc.EndChanReply {
+func (x *GetStreamPlayerReply) GetConfig() *StreamPlayerConfig {
 	if x != nil {
-		return x.Reply
+		return x.Config
 	}
 	return nil
 }
 
-type StreamPlayerIsEndedRequest struct {
+type StreamPlayerOpenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                      `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.IsEndedRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	StreamID string                   `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.OpenRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *StreamPlayerIsEndedRequest) Reset() {
-	*x = StreamPlayerIsEndedRequest{}
+func (x *StreamPlayerOpenRequest) Reset() {
+	*x = StreamPlayerOpenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5907,13 +6391,13 @@ func (x *StreamPlayerIsEndedRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerIsEndedRequest) String() string {
+func (x *StreamPlayerOpenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerIsEndedRequest) ProtoMessage() {}
+func (*StreamPlayerOpenRequest) ProtoMessage() {}
 
-func (x *StreamPlayerIsEndedRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerOpenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5925,35 +6409,35 @@ func (x *StreamPlayerIsEndedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerIsEndedRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerIsEndedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerOpenRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerOpenRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *StreamPlayerIsEndedRequest) GetStreamID() string {
+func (x *StreamPlayerOpenRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerIsEndedRequest) GetRequest() *player_grpc.IsEndedRequest {
+func (x *StreamPlayerOpenRequest) GetRequest() *player_grpc.OpenRequest {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type StreamPlayerIsEndedReply struct {
+type StreamPlayerOpenReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reply *player_grpc.IsEndedReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Reply *player_grpc.OpenReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamPlayerIsEndedReply) Reset() {
-	*x = StreamPlayerIsEndedReply{}
+func (x *StreamPlayerOpenReply) Reset() {
+	*x = StreamPlayerOpenReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5961,13 +6445,13 @@ func (x *StreamPlayerIsEndedReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerIsEndedReply) String() string {
+func (x *StreamPlayerOpenReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerIsEndedReply) ProtoMessage() {}
+func (*StreamPlayerOpenReply) ProtoMessage() {}
 
-func (x *StreamPlayerIsEndedReply) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerOpenReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5979,29 +6463,29 @@ func (x *StreamPlayerIsEndedReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerIsEndedReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerIsEndedReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerOpenReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerOpenReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *StreamPlayerIsEndedReply) GetReply() *player_grpc.IsEndedReply {
+func (x *StreamPlayerOpenReply) GetReply() *player_grpc.OpenReply {
 	if x != nil {
 		return x.Reply
 	}
 	return nil
 }
 
-type StreamPlayerGetPositionRequest struct {
+type StreamPlayerProcessTitleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                          `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.GetPositionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	StreamID string                           `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.ProcessTitleRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *StreamPlayerGetPositionRequest) Reset() {
-	*x = StreamPlayerGetPositionRequest{}
+func (x *StreamPlayerProcessTitleRequest) Reset() {
+	*x = StreamPlayerProcessTitleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6009,13 +6493,13 @@ func (x *StreamPlayerGetPositionRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerGetPositionRequest) String() string {
+func (x *StreamPlayerProcessTitleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerGetPositionRequest) ProtoMessage() {}
+func (*StreamPlayerProcessTitleRequest) ProtoMessage() {}
 
-func (x *StreamPlayerGetPositionRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerProcessTitleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6027,35 +6511,35 @@ func (x *StreamPlayerGetPositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerGetPositionRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerGetPositionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerProcessTitleRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerProcessTitleRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *StreamPlayerGetPositionRequest) GetStreamID() string {
+func (x *StreamPlayerProcessTitleRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerGetPositionRequest) GetRequest() *player_grpc.GetPositionRequest {
+func (x *StreamPlayerProcessTitleRequest) GetRequest() *player_grpc.ProcessTitleRequest {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type StreamPlayerGetPositionReply struct {
+type StreamPlayerProcessTitleReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reply *player_grpc.GetPositionReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Reply *player_grpc.ProcessTitleReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamPlayerGetPositionReply) Reset() {
-	*x = StreamPlayerGetPositionReply{}
+func (x *StreamPlayerProcessTitleReply) Reset() {
+	*x = StreamPlayerProcessTitleReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6063,13 +6547,13 @@ func (x *StreamPlayerGetPositionReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerGetPositionReply) String() string {
+func (x *StreamPlayerProcessTitleReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerGetPositionReply) ProtoMessage() {}
+func (*StreamPlayerProcessTitleReply) ProtoMessage() {}
 
-func (x *StreamPlayerGetPositionReply) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerProcessTitleReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6081,29 +6565,29 @@ func (x *StreamPlayerGetPositionReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerGetPositionReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerGetPositionReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerProcessTitleReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerProcessTitleReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *StreamPlayerGetPositionReply) GetReply() *player_grpc.GetPositionReply {
+func (x *StreamPlayerProcessTitleReply) GetReply() *player_grpc.ProcessTitleReply {
 	if x != nil {
 		return x.Reply
 	}
 	return nil
 }
 
-type StreamPlayerGetLengthRequest struct {
+type StreamPlayerGetLinkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                        `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.GetLengthRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	StreamID string                      `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.GetLinkRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *StreamPlayerGetLengthRequest) Reset() {
-	*x = StreamPlayerGetLengthRequest{}
+func (x *StreamPlayerGetLinkRequest) Reset() {
+	*x = StreamPlayerGetLinkRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6111,13 +6595,13 @@ func (x *StreamPlayerGetLengthRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerGetLengthRequest) String() string {
+func (x *StreamPlayerGetLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerGetLengthRequest) ProtoMessage() {}
+func (*StreamPlayerGetLinkRequest) ProtoMessage() {}
 
-func (x *StreamPlayerGetLengthRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerGetLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6129,35 +6613,35 @@ func (x *StreamPlayerGetLengthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerGetLengthRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerGetLengthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerGetLinkRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerGetLinkRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *StreamPlayerGetLengthRequest) GetStreamID() string {
+func (x *StreamPlayerGetLinkRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerGetLengthRequest) GetRequest() *player_grpc.GetLengthRequest {
+func (x *StreamPlayerGetLinkRequest) GetRequest() *player_grpc.GetLinkRequest {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type StreamPlayerGetLengthReply struct {
+type StreamPlayerGetLinkReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reply *player_grpc.GetLengthReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Reply *player_grpc.GetLinkReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamPlayerGetLengthReply) Reset() {
-	*x = StreamPlayerGetLengthReply{}
+func (x *StreamPlayerGetLinkReply) Reset() {
+	*x = StreamPlayerGetLinkReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6165,13 +6649,13 @@ func (x *StreamPlayerGetLengthReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerGetLengthReply) String() string {
+func (x *StreamPlayerGetLinkReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerGetLengthReply) ProtoMessage() {}
+func (*StreamPlayerGetLinkReply) ProtoMessage() {}
 
-func (x *StreamPlayerGetLengthReply) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerGetLinkReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6183,29 +6667,29 @@ func (x *StreamPlayerGetLengthReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerGetLengthReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerGetLengthReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerGetLinkReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerGetLinkReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *StreamPlayerGetLengthReply) GetReply() *player_grpc.GetLengthReply {
+func (x *StreamPlayerGetLinkReply) GetReply() *player_grpc.GetLinkReply {
 	if x != nil {
 		return x.Reply
 	}
 	return nil
 }
 
-type StreamPlayerSetSpeedRequest struct {
+type StreamPlayerEndChanRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                       `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.SetSpeedRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	StreamID string                      `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.EndChanRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *StreamPlayerSetSpeedRequest) Reset() {
-	*x = StreamPlayerSetSpeedRequest{}
+func (x *StreamPlayerEndChanRequest) Reset() {
+	*x = StreamPlayerEndChanRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[123]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6213,13 +6697,13 @@ func (x *StreamPlayerSetSpeedRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerSetSpeedRequest) String() string {
+func (x *StreamPlayerEndChanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerSetSpeedRequest) ProtoMessage() {}
+func (*StreamPlayerEndChanRequest) ProtoMessage() {}
 
-func (x *StreamPlayerSetSpeedRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerEndChanRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[123]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6231,35 +6715,35 @@ func (x *StreamPlayerSetSpeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerSetSpeedRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerSetSpeedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerEndChanRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerEndChanRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *StreamPlayerSetSpeedRequest) GetStreamID() string {
+func (x *StreamPlayerEndChanRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerSetSpeedRequest) GetRequest() *player_grpc.SetSpeedRequest {
+func (x *StreamPlayerEndChanRequest) GetRequest() *player_grpc.EndChanRequest {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type StreamPlayerSetSpeedReply struct {
+type StreamPlayerEndChanReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reply *player_grpc.SetSpeedReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Reply *player_grpc.EndChanReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamPlayerSetSpeedReply) Reset() {
-	*x = StreamPlayerSetSpeedReply{}
+func (x *StreamPlayerEndChanReply) Reset() {
+	*x = StreamPlayerEndChanReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6267,13 +6751,13 @@ func (x *StreamPlayerSetSpeedReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerSetSpeedReply) String() string {
+func (x *StreamPlayerEndChanReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerSetSpeedReply) ProtoMessage() {}
+func (*StreamPlayerEndChanReply) ProtoMessage() {}
 
-func (x *StreamPlayerSetSpeedReply) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerEndChanReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6285,29 +6769,29 @@ func (x *StreamPlayerSetSpeedReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerSetSpeedReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerSetSpeedReply) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use StreamPlayerEndChanReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerEndChanReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *StreamPlayerSetSpeedReply) GetReply() *player_grpc.SetSpeedReply {
+func (x *StreamPlayerEndChanReply) GetReply() *player_grpc.EndChanReply {
 	if x != nil {
 		return x.Reply
 	}
 	return nil
 }
 
-type StreamPlayerSetPauseRequest struct {
+type StreamPlayerIsEndedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                       `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.SetPauseRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	StreamID string                      `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.IsEndedRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *StreamPlayerSetPauseRequest) Reset() {
-	*x = StreamPlayerSetPauseRequest{}
+func (x *StreamPlayerIsEndedRequest) Reset() {
+	*x = StreamPlayerIsEndedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6315,13 +6799,13 @@ func (x *StreamPlayerSetPauseRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerSetPauseRequest) String() string {
+func (x *StreamPlayerIsEndedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerSetPauseRequest) ProtoMessage() {}
+func (*StreamPlayerIsEndedRequest) ProtoMessage() {}
 
-func (x *StreamPlayerSetPauseRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerIsEndedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6333,35 +6817,35 @@ func (x *StreamPlayerSetPauseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerSetPauseRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerSetPauseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerIsEndedRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerIsEndedRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *StreamPlayerSetPauseRequest) GetStreamID() string {
+func (x *StreamPlayerIsEndedRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerSetPauseRequest) GetRequest() *player_grpc.SetPauseRequest {
+func (x *StreamPlayerIsEndedRequest) GetRequest() *player_grpc.IsEndedRequest {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type StreamPlayerSetPauseReply struct {
+type StreamPlayerIsEndedReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reply *player_grpc.SetPauseReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Reply *player_grpc.IsEndedReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamPlayerSetPauseReply) Reset() {
-	*x = StreamPlayerSetPauseReply{}
+func (x *StreamPlayerIsEndedReply) Reset() {
+	*x = StreamPlayerIsEndedReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6369,13 +6853,13 @@ func (x *StreamPlayerSetPauseReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerSetPauseReply) String() string {
+func (x *StreamPlayerIsEndedReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerSetPauseReply) ProtoMessage() {}
+func (*StreamPlayerIsEndedReply) ProtoMessage() {}
 
-func (x *StreamPlayerSetPauseReply) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerIsEndedReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6387,29 +6871,29 @@ func (x *StreamPlayerSetPauseReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerSetPauseReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerSetPauseReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerIsEndedReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerIsEndedReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *StreamPlayerSetPauseReply) GetReply() *player_grpc.SetPauseReply {
+func (x *StreamPlayerIsEndedReply) GetReply() *player_grpc.IsEndedReply {
 	if x != nil {
 		return x.Reply
 	}
 	return nil
 }
 
-type StreamPlayerStopRequest struct {
+type StreamPlayerGetPositionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                   `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.StopRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	StreamID string                          `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.GetPositionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *StreamPlayerStopRequest) Reset() {
-	*x = StreamPlayerStopRequest{}
+func (x *StreamPlayerGetPositionRequest) Reset() {
+	*x = StreamPlayerGetPositionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[127]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6417,13 +6901,13 @@ func (x *StreamPlayerStopRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerStopRequest) String() string {
+func (x *StreamPlayerGetPositionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerStopRequest) ProtoMessage() {}
+func (*StreamPlayerGetPositionRequest) ProtoMessage() {}
 
-func (x *StreamPlayerStopRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerGetPositionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[127]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6435,35 +6919,35 @@ func (x *StreamPlayerStopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerStopRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerStopRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerGetPositionRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerGetPositionRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *StreamPlayerStopRequest) GetStreamID() string {
+func (x *StreamPlayerGetPositionRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerStopRequest) GetRequest() *player_grpc.StopRequest {
+func (x *StreamPlayerGetPositionRequest) GetRequest() *player_grpc.GetPositionRequest {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type StreamPlayerStopReply struct {
+type StreamPlayerGetPositionReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reply *player_grpc.StopReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Reply *player_grpc.GetPositionReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamPlayerStopReply) Reset() {
-	*x = StreamPlayerStopReply{}
+func (x *StreamPlayerGetPositionReply) Reset() {
+	*x = StreamPlayerGetPositionReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6471,13 +6955,13 @@ func (x *StreamPlayerStopReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerStopReply) String() string {
+func (x *StreamPlayerGetPositionReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerStopReply) ProtoMessage() {}
+func (*StreamPlayerGetPositionReply) ProtoMessage() {}
 
-func (x *StreamPlayerStopReply) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerGetPositionReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6489,29 +6973,29 @@ func (x *StreamPlayerStopReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerStopReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerStopReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerGetPositionReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerGetPositionReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *StreamPlayerStopReply) GetReply() *player_grpc.StopReply {
+func (x *StreamPlayerGetPositionReply) GetReply() *player_grpc.GetPositionReply {
 	if x != nil {
 		return x.Reply
 	}
 	return nil
 }
 
-type StreamPlayerCloseRequest struct {
+type StreamPlayerGetLengthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID string                    `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
-	Request  *player_grpc.CloseRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	StreamID string                        `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.GetLengthRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *StreamPlayerCloseRequest) Reset() {
-	*x = StreamPlayerCloseRequest{}
+func (x *StreamPlayerGetLengthRequest) Reset() {
+	*x = StreamPlayerGetLengthRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[129]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6519,13 +7003,13 @@ func (x *StreamPlayerCloseRequest) Reset() {
 	}
 }
 
-func (x *StreamPlayerCloseRequest) String() string {
+func (x *StreamPlayerGetLengthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerCloseRequest) ProtoMessage() {}
+func (*StreamPlayerGetLengthRequest) ProtoMessage() {}
 
-func (x *StreamPlayerCloseRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerGetLengthRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[129]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6537,35 +7021,35 @@ func (x *StreamPlayerCloseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerCloseRequest.ProtoReflect.Descriptor instead.
-func (*StreamPlayerCloseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerGetLengthRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerGetLengthRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *StreamPlayerCloseRequest) GetStreamID() string {
+func (x *StreamPlayerGetLengthRequest) GetStreamID() string {
 	if x != nil {
 		return x.StreamID
 	}
 	return ""
 }
 
-func (x *StreamPlayerCloseRequest) GetRequest() *player_grpc.CloseRequest {
+func (x *StreamPlayerGetLengthRequest) GetRequest() *player_grpc.GetLengthRequest {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type StreamPlayerCloseReply struct {
+type StreamPlayerGetLengthReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reply *player_grpc.CloseReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Reply *player_grpc.GetLengthReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamPlayerCloseReply) Reset() {
-	*x = StreamPlayerCloseReply{}
+func (x *StreamPlayerGetLengthReply) Reset() {
+	*x = StreamPlayerGetLengthReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6573,13 +7057,13 @@ func (x *StreamPlayerCloseReply) Reset() {
 	}
 }
 
-func (x *StreamPlayerCloseReply) String() string {
+func (x *StreamPlayerGetLengthReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayerCloseReply) ProtoMessage() {}
+func (*StreamPlayerGetLengthReply) ProtoMessage() {}
 
-func (x *StreamPlayerCloseReply) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerGetLengthReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6591,26 +7075,29 @@ func (x *StreamPlayerCloseReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayerCloseReply.ProtoReflect.Descriptor instead.
-func (*StreamPlayerCloseReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerGetLengthReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerGetLengthReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *StreamPlayerCloseReply) GetReply() *player_grpc.CloseReply {
+func (x *StreamPlayerGetLengthReply) GetReply() *player_grpc.GetLengthReply {
 	if x != nil {
 		return x.Reply
 	}
 	return nil
 }
 
-type SubscribeToConfigChangesRequest struct {
+type StreamPlayerSetSpeedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StreamID string                       `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.SetSpeedRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *SubscribeToConfigChangesRequest) Reset() {
-	*x = SubscribeToConfigChangesRequest{}
+func (x *StreamPlayerSetSpeedRequest) Reset() {
+	*x = StreamPlayerSetSpeedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6618,13 +7105,13 @@ func (x *SubscribeToConfigChangesRequest) Reset() {
 	}
 }
 
-func (x *SubscribeToConfigChangesRequest) String() string {
+func (x *StreamPlayerSetSpeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeToConfigChangesRequest) ProtoMessage() {}
+func (*StreamPlayerSetSpeedRequest) ProtoMessage() {}
 
-func (x *SubscribeToConfigChangesRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerSetSpeedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6636,19 +7123,35 @@ func (x *SubscribeToConfigChangesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeToConfigChangesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeToConfigChangesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerSetSpeedRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerSetSpeedRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{131}
 }
 
-type ConfigChange struct {
+func (x *StreamPlayerSetSpeedRequest) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+func (x *StreamPlayerSetSpeedRequest) GetRequest() *player_grpc.SetSpeedRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type StreamPlayerSetSpeedReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Reply *player_grpc.SetSpeedReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *ConfigChange) Reset() {
-	*x = ConfigChange{}
+func (x *StreamPlayerSetSpeedReply) Reset() {
+	*x = StreamPlayerSetSpeedReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[132]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6656,13 +7159,13 @@ func (x *ConfigChange) Reset() {
 	}
 }
 
-func (x *ConfigChange) String() string {
+func (x *StreamPlayerSetSpeedReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigChange) ProtoMessage() {}
+func (*StreamPlayerSetSpeedReply) ProtoMessage() {}
 
-func (x *ConfigChange) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerSetSpeedReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[132]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6674,19 +7177,29 @@ func (x *ConfigChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigChange.ProtoReflect.Descriptor instead.
-func (*ConfigChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerSetSpeedReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerSetSpeedReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{132}
 }
 
-type SubscribeToStreamsChangesRequest struct {
+func (x *StreamPlayerSetSpeedReply) GetReply() *player_grpc.SetSpeedReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+type StreamPlayerSetPauseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StreamID string                       `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.SetPauseRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *SubscribeToStreamsChangesRequest) Reset() {
-	*x = SubscribeToStreamsChangesRequest{}
+func (x *StreamPlayerSetPauseRequest) Reset() {
+	*x = StreamPlayerSetPauseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[133]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6694,13 +7207,13 @@ func (x *SubscribeToStreamsChangesRequest) Reset() {
 	}
 }
 
-func (x *SubscribeToStreamsChangesRequest) String() string {
+func (x *StreamPlayerSetPauseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeToStreamsChangesRequest) ProtoMessage() {}
+func (*StreamPlayerSetPauseRequest) ProtoMessage() {}
 
-func (x *SubscribeToStreamsChangesRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerSetPauseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[133]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6712,19 +7225,35 @@ func (x *SubscribeToStreamsChangesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeToStreamsChangesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeToStreamsChangesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerSetPauseRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerSetPauseRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{133}
 }
 
-type StreamsChange struct {
+func (x *StreamPlayerSetPauseRequest) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+func (x *StreamPlayerSetPauseRequest) GetRequest() *player_grpc.SetPauseRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type StreamPlayerSetPauseReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Reply *player_grpc.SetPauseReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamsChange) Reset() {
-	*x = StreamsChange{}
+func (x *StreamPlayerSetPauseReply) Reset() {
+	*x = StreamPlayerSetPauseReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[134]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6732,13 +7261,13 @@ func (x *StreamsChange) Reset() {
 	}
 }
 
-func (x *StreamsChange) String() string {
+func (x *StreamPlayerSetPauseReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamsChange) ProtoMessage() {}
+func (*StreamPlayerSetPauseReply) ProtoMessage() {}
 
-func (x *StreamsChange) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerSetPauseReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[134]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6750,19 +7279,29 @@ func (x *StreamsChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamsChange.ProtoReflect.Descriptor instead.
-func (*StreamsChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerSetPauseReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerSetPauseReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{134}
 }
 
-type SubscribeToStreamServersChangesRequest struct {
+func (x *StreamPlayerSetPauseReply) GetReply() *player_grpc.SetPauseReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+type StreamPlayerStopRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *SubscribeToStreamServersChangesRequest) Reset() {
-	*x = SubscribeToStreamServersChangesRequest{}
+	StreamID string                   `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.StopRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *StreamPlayerStopRequest) Reset() {
+	*x = StreamPlayerStopRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[135]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6770,13 +7309,13 @@ func (x *SubscribeToStreamServersChangesRequest) Reset() {
 	}
 }
 
-func (x *SubscribeToStreamServersChangesRequest) String() string {
+func (x *StreamPlayerStopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeToStreamServersChangesRequest) ProtoMessage() {}
+func (*StreamPlayerStopRequest) ProtoMessage() {}
 
-func (x *SubscribeToStreamServersChangesRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerStopRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[135]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6788,19 +7327,35 @@ func (x *SubscribeToStreamServersChangesRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeToStreamServersChangesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeToStreamServersChangesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerStopRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerStopRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{135}
 }
 
-type StreamServersChange struct {
+func (x *StreamPlayerStopRequest) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+func (x *StreamPlayerStopRequest) GetRequest() *player_grpc.StopRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type StreamPlayerStopReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Reply *player_grpc.StopReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamServersChange) Reset() {
-	*x = StreamServersChange{}
+func (x *StreamPlayerStopReply) Reset() {
+	*x = StreamPlayerStopReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[136]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6808,13 +7363,13 @@ func (x *StreamServersChange) Reset() {
 	}
 }
 
-func (x *StreamServersChange) String() string {
+func (x *StreamPlayerStopReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamServersChange) ProtoMessage() {}
+func (*StreamPlayerStopReply) ProtoMessage() {}
 
-func (x *StreamServersChange) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerStopReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[136]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6826,19 +7381,29 @@ func (x *StreamServersChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamServersChange.ProtoReflect.Descriptor instead.
-func (*StreamServersChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerStopReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerStopReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{136}
 }
 
-type SubscribeToStreamDestinationsChangesRequest struct {
+func (x *StreamPlayerStopReply) GetReply() *player_grpc.StopReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+type StreamPlayerCloseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StreamID string                    `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	Request  *player_grpc.CloseRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *SubscribeToStreamDestinationsChangesRequest) Reset() {
-	*x = SubscribeToStreamDestinationsChangesRequest{}
+func (x *StreamPlayerCloseRequest) Reset() {
+	*x = StreamPlayerCloseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[137]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6846,13 +7411,13 @@ func (x *SubscribeToStreamDestinationsChangesRequest) Reset() {
 	}
 }
 
-func (x *SubscribeToStreamDestinationsChangesRequest) String() string {
+func (x *StreamPlayerCloseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeToStreamDestinationsChangesRequest) ProtoMessage() {}
+func (*StreamPlayerCloseRequest) ProtoMessage() {}
 
-func (x *SubscribeToStreamDestinationsChangesRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerCloseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[137]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6864,19 +7429,35 @@ func (x *SubscribeToStreamDestinationsChangesRequest) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeToStreamDestinationsChangesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeToStreamDestinationsChangesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerCloseRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlayerCloseRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{137}
 }
 
-type StreamDestinationsChange struct {
+func (x *StreamPlayerCloseRequest) GetStreamID() string {
+	if x != nil {
+		return x.StreamID
+	}
+	return ""
+}
+
+func (x *StreamPlayerCloseRequest) GetRequest() *player_grpc.CloseRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type StreamPlayerCloseReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Reply *player_grpc.CloseReply `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 }
 
-func (x *StreamDestinationsChange) Reset() {
-	*x = StreamDestinationsChange{}
+func (x *StreamPlayerCloseReply) Reset() {
+	*x = StreamPlayerCloseReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[138]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6884,13 +7465,13 @@ func (x *StreamDestinationsChange) Reset() {
 	}
 }
 
-func (x *StreamDestinationsChange) String() string {
+func (x *StreamPlayerCloseReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamDestinationsChange) ProtoMessage() {}
+func (*StreamPlayerCloseReply) ProtoMessage() {}
 
-func (x *StreamDestinationsChange) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayerCloseReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[138]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6902,19 +7483,26 @@ func (x *StreamDestinationsChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamDestinationsChange.ProtoReflect.Descriptor instead.
-func (*StreamDestinationsChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayerCloseReply.ProtoReflect.Descriptor instead.
+func (*StreamPlayerCloseReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{138}
 }
 
-type SubscribeToIncomingStreamsChangesRequest struct {
+func (x *StreamPlayerCloseReply) GetReply() *player_grpc.CloseReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+type SubscribeToConfigChangesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SubscribeToIncomingStreamsChangesRequest) Reset() {
-	*x = SubscribeToIncomingStreamsChangesRequest{}
+func (x *SubscribeToConfigChangesRequest) Reset() {
+	*x = SubscribeToConfigChangesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[139]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6922,13 +7510,13 @@ func (x *SubscribeToIncomingStreamsChangesRequest) Reset() {
 	}
 }
 
-func (x *SubscribeToIncomingStreamsChangesRequest) String() string {
+func (x *SubscribeToConfigChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeToIncomingStreamsChangesRequest) ProtoMessage() {}
+func (*SubscribeToConfigChangesRequest) ProtoMessage() {}
 
-func (x *SubscribeToIncomingStreamsChangesRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeToConfigChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[139]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6940,19 +7528,19 @@ func (x *SubscribeToIncomingStreamsChangesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeToIncomingStreamsChangesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeToIncomingStreamsChangesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeToConfigChangesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToConfigChangesRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{139}
 }
 
-type IncomingStreamsChange struct {
+type ConfigChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *IncomingStreamsChange) Reset() {
-	*x = IncomingStreamsChange{}
+func (x *ConfigChange) Reset() {
+	*x = ConfigChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[140]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6960,13 +7548,13 @@ func (x *IncomingStreamsChange) Reset() {
 	}
 }
 
-func (x *IncomingStreamsChange) String() string {
+func (x *ConfigChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncomingStreamsChange) ProtoMessage() {}
+func (*ConfigChange) ProtoMessage() {}
 
-func (x *IncomingStreamsChange) ProtoReflect() protoreflect.Message {
+func (x *ConfigChange) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[140]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6978,19 +7566,19 @@ func (x *IncomingStreamsChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncomingStreamsChange.ProtoReflect.Descriptor instead.
-func (*IncomingStreamsChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfigChange.ProtoReflect.Descriptor instead.
+func (*ConfigChange) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{140}
 }
 
-type SubscribeToStreamForwardsChangesRequest struct {
+type SubscribeToStreamsChangesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SubscribeToStreamForwardsChangesRequest) Reset() {
-	*x = SubscribeToStreamForwardsChangesRequest{}
+func (x *SubscribeToStreamsChangesRequest) Reset() {
+	*x = SubscribeToStreamsChangesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[141]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6998,13 +7586,13 @@ func (x *SubscribeToStreamForwardsChangesRequest) Reset() {
 	}
 }
 
-func (x *SubscribeToStreamForwardsChangesRequest) String() string {
+func (x *SubscribeToStreamsChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeToStreamForwardsChangesRequest) ProtoMessage() {}
+func (*SubscribeToStreamsChangesRequest) ProtoMessage() {}
 
-func (x *SubscribeToStreamForwardsChangesRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeToStreamsChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[141]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7016,19 +7604,19 @@ func (x *SubscribeToStreamForwardsChangesRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeToStreamForwardsChangesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeToStreamForwardsChangesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeToStreamsChangesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToStreamsChangesRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{141}
 }
 
-type StreamForwardsChange struct {
+type StreamsChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StreamForwardsChange) Reset() {
-	*x = StreamForwardsChange{}
+func (x *StreamsChange) Reset() {
+	*x = StreamsChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[142]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7036,13 +7624,13 @@ func (x *StreamForwardsChange) Reset() {
 	}
 }
 
-func (x *StreamForwardsChange) String() string {
+func (x *StreamsChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamForwardsChange) ProtoMessage() {}
+func (*StreamsChange) ProtoMessage() {}
 
-func (x *StreamForwardsChange) ProtoReflect() protoreflect.Message {
+func (x *StreamsChange) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[142]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7054,19 +7642,19 @@ func (x *StreamForwardsChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamForwardsChange.ProtoReflect.Descriptor instead.
-func (*StreamForwardsChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamsChange.ProtoReflect.Descriptor instead.
+func (*StreamsChange) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{142}
 }
 
-type SubscribeToStreamPlayersChangesRequest struct {
+type SubscribeToStreamServersChangesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SubscribeToStreamPlayersChangesRequest) Reset() {
-	*x = SubscribeToStreamPlayersChangesRequest{}
+func (x *SubscribeToStreamServersChangesRequest) Reset() {
+	*x = SubscribeToStreamServersChangesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[143]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7074,13 +7662,13 @@ func (x *SubscribeToStreamPlayersChangesRequest) Reset() {
 	}
 }
 
-func (x *SubscribeToStreamPlayersChangesRequest) String() string {
+func (x *SubscribeToStreamServersChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeToStreamPlayersChangesRequest) ProtoMessage() {}
+func (*SubscribeToStreamServersChangesRequest) ProtoMessage() {}
 
-func (x *SubscribeToStreamPlayersChangesRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeToStreamServersChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[143]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7092,19 +7680,19 @@ func (x *SubscribeToStreamPlayersChangesRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeToStreamPlayersChangesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeToStreamPlayersChangesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeToStreamServersChangesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToStreamServersChangesRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{143}
 }
 
-type StreamPlayersChange struct {
+type StreamServersChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StreamPlayersChange) Reset() {
-	*x = StreamPlayersChange{}
+func (x *StreamServersChange) Reset() {
+	*x = StreamServersChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[144]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7112,13 +7700,13 @@ func (x *StreamPlayersChange) Reset() {
 	}
 }
 
-func (x *StreamPlayersChange) String() string {
+func (x *StreamServersChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamPlayersChange) ProtoMessage() {}
+func (*StreamServersChange) ProtoMessage() {}
 
-func (x *StreamPlayersChange) ProtoReflect() protoreflect.Message {
+func (x *StreamServersChange) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[144]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7130,19 +7718,19 @@ func (x *StreamPlayersChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamPlayersChange.ProtoReflect.Descriptor instead.
-func (*StreamPlayersChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamServersChange.ProtoReflect.Descriptor instead.
+func (*StreamServersChange) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{144}
 }
 
-type NoopRequest struct {
+type SubscribeToStreamDestinationsChangesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *NoopRequest) Reset() {
-	*x = NoopRequest{}
+func (x *SubscribeToStreamDestinationsChangesRequest) Reset() {
+	*x = SubscribeToStreamDestinationsChangesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[145]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7150,13 +7738,13 @@ func (x *NoopRequest) Reset() {
 	}
 }
 
-func (x *NoopRequest) String() string {
+func (x *SubscribeToStreamDestinationsChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NoopRequest) ProtoMessage() {}
+func (*SubscribeToStreamDestinationsChangesRequest) ProtoMessage() {}
 
-func (x *NoopRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeToStreamDestinationsChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[145]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7168,23 +7756,19 @@ func (x *NoopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NoopRequest.ProtoReflect.Descriptor instead.
-func (*NoopRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeToStreamDestinationsChangesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToStreamDestinationsChangesRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{145}
 }
 
-type OBSActionItemShowHide struct {
+type StreamDestinationsChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ItemName        *string `protobuf:"bytes,1,opt,name=itemName,proto3,oneof" json:"itemName,omitempty"`
-	ItemUUID        *string `protobuf:"bytes,2,opt,name=itemUUID,proto3,oneof" json:"itemUUID,omitempty"`
-	ValueExpression string  `protobuf:"bytes,3,opt,name=valueExpression,proto3" json:"valueExpression,omitempty"`
 }
 
-func (x *OBSActionItemShowHide) Reset() {
-	*x = OBSActionItemShowHide{}
+func (x *StreamDestinationsChange) Reset() {
+	*x = StreamDestinationsChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[146]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7192,13 +7776,13 @@ func (x *OBSActionItemShowHide) Reset() {
 	}
 }
 
-func (x *OBSActionItemShowHide) String() string {
+func (x *StreamDestinationsChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OBSActionItemShowHide) ProtoMessage() {}
+func (*StreamDestinationsChange) ProtoMessage() {}
 
-func (x *OBSActionItemShowHide) ProtoReflect() protoreflect.Message {
+func (x *StreamDestinationsChange) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[146]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7210,44 +7794,19 @@ func (x *OBSActionItemShowHide) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OBSActionItemShowHide.ProtoReflect.Descriptor instead.
-func (*OBSActionItemShowHide) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamDestinationsChange.ProtoReflect.Descriptor instead.
+func (*StreamDestinationsChange) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *OBSActionItemShowHide) GetItemName() string {
-	if x != nil && x.ItemName != nil {
-		return *x.ItemName
-	}
-	return ""
+type SubscribeToIncomingStreamsChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *OBSActionItemShowHide) GetItemUUID() string {
-	if x != nil && x.ItemUUID != nil {
-		return *x.ItemUUID
-	}
-	return ""
-}
-
-func (x *OBSActionItemShowHide) GetValueExpression() string {
-	if x != nil {
-		return x.ValueExpression
-	}
-	return ""
-}
-
-type OBSActionWindowCaptureSetSource struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ItemName        *string `protobuf:"bytes,1,opt,name=itemName,proto3,oneof" json:"itemName,omitempty"`
-	ItemUUID        *string `protobuf:"bytes,2,opt,name=itemUUID,proto3,oneof" json:"itemUUID,omitempty"`
-	ValueExpression string  `protobuf:"bytes,3,opt,name=valueExpression,proto3" json:"valueExpression,omitempty"`
-}
-
-func (x *OBSActionWindowCaptureSetSource) Reset() {
-	*x = OBSActionWindowCaptureSetSource{}
+func (x *SubscribeToIncomingStreamsChangesRequest) Reset() {
+	*x = SubscribeToIncomingStreamsChangesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[147]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7255,13 +7814,13 @@ func (x *OBSActionWindowCaptureSetSource) Reset() {
 	}
 }
 
-func (x *OBSActionWindowCaptureSetSource) String() string {
+func (x *SubscribeToIncomingStreamsChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OBSActionWindowCaptureSetSource) ProtoMessage() {}
+func (*SubscribeToIncomingStreamsChangesRequest) ProtoMessage() {}
 
-func (x *OBSActionWindowCaptureSetSource) ProtoReflect() protoreflect.Message {
+func (x *SubscribeToIncomingStreamsChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[147]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7273,46 +7832,21 @@ func (x *OBSActionWindowCaptureSetSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OBSActionWindowCaptureSetSource.ProtoReflect.Descriptor instead.
-func (*OBSActionWindowCaptureSetSource) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeToIncomingStreamsChangesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToIncomingStreamsChangesRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *OBSActionWindowCaptureSetSource) GetItemName() string {
-	if x != nil && x.ItemName != nil {
-		return *x.ItemName
-	}
-	return ""
-}
-
-func (x *OBSActionWindowCaptureSetSource) GetItemUUID() string {
-	if x != nil && x.ItemUUID != nil {
-		return *x.ItemUUID
-	}
-	return ""
-}
-
-func (x *OBSActionWindowCaptureSetSource) GetValueExpression() string {
-	if x != nil {
-		return x.ValueExpression
-	}
-	return ""
-}
-
-type OBSAction struct {
+type IncomingStreamsChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to OBSActionOneOf:
-	//
-	//	*OBSAction_ItemShowHide
-	//	*OBSAction_WindowCaptureSetSource
-	OBSActionOneOf isOBSAction_OBSActionOneOf `protobuf_oneof:"OBSActionOneOf"`
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *OBSAction) Reset() {
-	*x = OBSAction{}
+func (x *IncomingStreamsChange) Reset() {
+	*x = IncomingStreamsChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[148]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7320,13 +7854,13 @@ func (x *OBSAction) Reset() {
 	}
 }
 
-func (x *OBSAction) String() string {
+func (x *IncomingStreamsChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OBSAction) ProtoMessage() {}
+func (*IncomingStreamsChange) ProtoMessage() {}
 
-func (x *OBSAction) ProtoReflect() protoreflect.Message {
+func (x *IncomingStreamsChange) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[148]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7338,64 +7872,26 @@ func (x *OBSAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OBSAction.ProtoReflect.Descriptor instead.
-func (*OBSAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use IncomingStreamsChange.ProtoReflect.Descriptor instead.
+func (*IncomingStreamsChange) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{148}
 }
 
-func (m *OBSAction) GetOBSActionOneOf() isOBSAction_OBSActionOneOf {
-	if m != nil {
-		return m.OBSActionOneOf
-	}
-	return nil
-}
-
-func (x *OBSAction) GetItemShowHide() *OBSActionItemShowHide {
-	if x, ok := x.GetOBSActionOneOf().(*OBSAction_ItemShowHide); ok {
-		return x.ItemShowHide
-	}
-	return nil
-}
-
-func (x *OBSAction) GetWindowCaptureSetSource() *OBSActionWindowCaptureSetSource {
-	if x, ok := x.GetOBSActionOneOf().(*OBSAction_WindowCaptureSetSource); ok {
-		return x.WindowCaptureSetSource
+func (x *IncomingStreamsChange) GetEvent() *Event {
+	if x != nil {
+		return x.Event
 	}
 	return nil
 }
 
-type isOBSAction_OBSActionOneOf interface {
-	isOBSAction_OBSActionOneOf()
-}
-
-type OBSAction_ItemShowHide struct {
-	ItemShowHide *OBSActionItemShowHide `protobuf:"bytes,1,opt,name=itemShowHide,proto3,oneof"`
-}
-
-type OBSAction_WindowCaptureSetSource struct {
-	WindowCaptureSetSource *OBSActionWindowCaptureSetSource `protobuf:"bytes,2,opt,name=windowCaptureSetSource,proto3,oneof"`
-}
-
-func (*OBSAction_ItemShowHide) isOBSAction_OBSActionOneOf() {}
-
-func (*OBSAction_WindowCaptureSetSource) isOBSAction_OBSActionOneOf() {}
-
-type Action struct {
+type SubscribeToStreamForwardsChangesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to ActionOneof:
-	//
-	//	*Action_NoopRequest
-	//	*Action_StartStreamRequest
-	//	*Action_EndStreamRequest
-	//	*Action_ObsAction
-	ActionOneof isAction_ActionOneof `protobuf_oneof:"ActionOneof"`
 }
 
-func (x *Action) Reset() {
-	*x = Action{}
+func (x *SubscribeToStreamForwardsChangesRequest) Reset() {
+	*x = SubscribeToStreamForwardsChangesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[149]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7403,13 +7899,13 @@ func (x *Action) Reset() {
 	}
 }
 
-func (x *Action) String() string {
+func (x *SubscribeToStreamForwardsChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Action) ProtoMessage() {}
+func (*SubscribeToStreamForwardsChangesRequest) ProtoMessage() {}
 
-func (x *Action) ProtoReflect() protoreflect.Message {
+func (x *SubscribeToStreamForwardsChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[149]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7421,85 +7917,21 @@ func (x *Action) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Action.ProtoReflect.Descriptor instead.
-func (*Action) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeToStreamForwardsChangesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToStreamForwardsChangesRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{149}
 }
 
-func (m *Action) GetActionOneof() isAction_ActionOneof {
-	if m != nil {
-		return m.ActionOneof
-	}
-	return nil
-}
-
-func (x *Action) GetNoopRequest() *NoopRequest {
-	if x, ok := x.GetActionOneof().(*Action_NoopRequest); ok {
-		return x.NoopRequest
-	}
-	return nil
-}
-
-func (x *Action) GetStartStreamRequest() *StartStreamRequest {
-	if x, ok := x.GetActionOneof().(*Action_StartStreamRequest); ok {
-		return x.StartStreamRequest
-	}
-	return nil
-}
-
-func (x *Action) GetEndStreamRequest() *EndStreamRequest {
-	if x, ok := x.GetActionOneof().(*Action_EndStreamRequest); ok {
-		return x.EndStreamRequest
-	}
-	return nil
-}
-
-func (x *Action) GetObsAction() *OBSAction {
-	if x, ok := x.GetActionOneof().(*Action_ObsAction); ok {
-		return x.ObsAction
-	}
-	return nil
-}
-
-type isAction_ActionOneof interface {
-	isAction_ActionOneof()
-}
-
-type Action_NoopRequest struct {
-	NoopRequest *NoopRequest `protobuf:"bytes,1,opt,name=noopRequest,proto3,oneof"`
-}
-
-type Action_StartStreamRequest struct {
-	StartStreamRequest *StartStreamRequest `protobuf:"bytes,2,opt,name=startStreamRequest,proto3,oneof"`
-}
-
-type Action_EndStreamRequest struct {
-	EndStreamRequest *EndStreamRequest `protobuf:"bytes,3,opt,name=endStreamRequest,proto3,oneof"`
-}
-
-type Action_ObsAction struct {
-	ObsAction *OBSAction `protobuf:"bytes,4,opt,name=obsAction,proto3,oneof"`
-}
-
-func (*Action_NoopRequest) isAction_ActionOneof() {}
-
-func (*Action_StartStreamRequest) isAction_ActionOneof() {}
-
-func (*Action_EndStreamRequest) isAction_ActionOneof() {}
-
-func (*Action_ObsAction) isAction_ActionOneof() {}
-
-type AddTimerRequest struct {
+type StreamForwardsChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TriggerAtUnixNano int64   `protobuf:"varint,1,opt,name=triggerAtUnixNano,proto3" json:"triggerAtUnixNano,omitempty"`
-	Action            *Action `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *AddTimerRequest) Reset() {
-	*x = AddTimerRequest{}
+func (x *StreamForwardsChange) Reset() {
+	*x = StreamForwardsChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[150]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7507,13 +7939,13 @@ func (x *AddTimerRequest) Reset() {
 	}
 }
 
-func (x *AddTimerRequest) String() string {
+func (x *StreamForwardsChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTimerRequest) ProtoMessage() {}
+func (*StreamForwardsChange) ProtoMessage() {}
 
-func (x *AddTimerRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamForwardsChange) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[150]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7525,35 +7957,26 @@ func (x *AddTimerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTimerRequest.ProtoReflect.Descriptor instead.
-func (*AddTimerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamForwardsChange.ProtoReflect.Descriptor instead.
+func (*StreamForwardsChange) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *AddTimerRequest) GetTriggerAtUnixNano() int64 {
-	if x != nil {
-		return x.TriggerAtUnixNano
-	}
-	return 0
-}
-
-func (x *AddTimerRequest) GetAction() *Action {
+func (x *StreamForwardsChange) GetEvent() *Event {
 	if x != nil {
-		return x.Action
+		return x.Event
 	}
 	return nil
 }
 
-type AddTimerReply struct {
+type SubscribeToStreamForwardStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TimerID int64 `protobuf:"varint,1,opt,name=timerID,proto3" json:"timerID,omitempty"`
 }
 
-func (x *AddTimerReply) Reset() {
-	*x = AddTimerReply{}
+func (x *SubscribeToStreamForwardStatsRequest) Reset() {
+	*x = SubscribeToStreamForwardStatsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[151]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7561,13 +7984,13 @@ func (x *AddTimerReply) Reset() {
 	}
 }
 
-func (x *AddTimerReply) String() string {
+func (x *SubscribeToStreamForwardStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTimerReply) ProtoMessage() {}
+func (*SubscribeToStreamForwardStatsRequest) ProtoMessage() {}
 
-func (x *AddTimerReply) ProtoReflect() protoreflect.Message {
+func (x *SubscribeToStreamForwardStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[151]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7579,28 +8002,21 @@ func (x *AddTimerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTimerReply.ProtoReflect.Descriptor instead.
-func (*AddTimerReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeToStreamForwardStatsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToStreamForwardStatsRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *AddTimerReply) GetTimerID() int64 {
-	if x != nil {
-		return x.TimerID
-	}
-	return 0
-}
-
-type RemoveTimerRequest struct {
+type StreamForwardStatsUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TimerID int64 `protobuf:"varint,1,opt,name=timerID,proto3" json:"timerID,omitempty"`
+	StreamForwards []*StreamForwardWithStatistics `protobuf:"bytes,1,rep,name=streamForwards,proto3" json:"streamForwards,omitempty"`
 }
 
-func (x *RemoveTimerRequest) Reset() {
-	*x = RemoveTimerRequest{}
+func (x *StreamForwardStatsUpdate) Reset() {
+	*x = StreamForwardStatsUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[152]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7608,13 +8024,13 @@ func (x *RemoveTimerRequest) Reset() {
 	}
 }
 
-func (x *RemoveTimerRequest) String() string {
+func (x *StreamForwardStatsUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTimerRequest) ProtoMessage() {}
+func (*StreamForwardStatsUpdate) ProtoMessage() {}
 
-func (x *RemoveTimerRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamForwardStatsUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[152]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7626,26 +8042,26 @@ func (x *RemoveTimerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTimerRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTimerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamForwardStatsUpdate.ProtoReflect.Descriptor instead.
+func (*StreamForwardStatsUpdate) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *RemoveTimerRequest) GetTimerID() int64 {
+func (x *StreamForwardStatsUpdate) GetStreamForwards() []*StreamForwardWithStatistics {
 	if x != nil {
-		return x.TimerID
+		return x.StreamForwards
 	}
-	return 0
+	return nil
 }
 
-type RemoveTimerReply struct {
+type SubscribeToStreamPlayersChangesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RemoveTimerReply) Reset() {
-	*x = RemoveTimerReply{}
+func (x *SubscribeToStreamPlayersChangesRequest) Reset() {
+	*x = SubscribeToStreamPlayersChangesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[153]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7653,13 +8069,13 @@ func (x *RemoveTimerReply) Reset() {
 	}
 }
 
-func (x *RemoveTimerReply) String() string {
+func (x *SubscribeToStreamPlayersChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTimerReply) ProtoMessage() {}
+func (*SubscribeToStreamPlayersChangesRequest) ProtoMessage() {}
 
-func (x *RemoveTimerReply) ProtoReflect() protoreflect.Message {
+func (x *SubscribeToStreamPlayersChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[153]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7671,23 +8087,19 @@ func (x *RemoveTimerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTimerReply.ProtoReflect.Descriptor instead.
-func (*RemoveTimerReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeToStreamPlayersChangesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToStreamPlayersChangesRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{153}
 }
 
-type Timer struct {
+type StreamPlayersChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TimerID           int64   `protobuf:"varint,1,opt,name=timerID,proto3" json:"timerID,omitempty"`
-	TriggerAtUnixNano int64   `protobuf:"varint,2,opt,name=triggerAtUnixNano,proto3" json:"triggerAtUnixNano,omitempty"`
-	Action            *Action `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
 }
 
-func (x *Timer) Reset() {
-	*x = Timer{}
+func (x *StreamPlayersChange) Reset() {
+	*x = StreamPlayersChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[154]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7695,13 +8107,13 @@ func (x *Timer) Reset() {
 	}
 }
 
-func (x *Timer) String() string {
+func (x *StreamPlayersChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Timer) ProtoMessage() {}
+func (*StreamPlayersChange) ProtoMessage() {}
 
-func (x *Timer) ProtoReflect() protoreflect.Message {
+func (x *StreamPlayersChange) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[154]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7713,40 +8125,19 @@ func (x *Timer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Timer.ProtoReflect.Descriptor instead.
-func (*Timer) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamPlayersChange.ProtoReflect.Descriptor instead.
+func (*StreamPlayersChange) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *Timer) GetTimerID() int64 {
-	if x != nil {
-		return x.TimerID
-	}
-	return 0
-}
-
-func (x *Timer) GetTriggerAtUnixNano() int64 {
-	if x != nil {
-		return x.TriggerAtUnixNano
-	}
-	return 0
-}
-
-func (x *Timer) GetAction() *Action {
-	if x != nil {
-		return x.Action
-	}
-	return nil
-}
-
-type ListTimersRequest struct {
+type NoopRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListTimersRequest) Reset() {
-	*x = ListTimersRequest{}
+func (x *NoopRequest) Reset() {
+	*x = NoopRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[155]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7754,13 +8145,13 @@ func (x *ListTimersRequest) Reset() {
 	}
 }
 
-func (x *ListTimersRequest) String() string {
+func (x *NoopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTimersRequest) ProtoMessage() {}
+func (*NoopRequest) ProtoMessage() {}
 
-func (x *ListTimersRequest) ProtoReflect() protoreflect.Message {
+func (x *NoopRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[155]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7772,21 +8163,23 @@ func (x *ListTimersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTimersRequest.ProtoReflect.Descriptor instead.
-func (*ListTimersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NoopRequest.ProtoReflect.Descriptor instead.
+func (*NoopRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{155}
 }
 
-type ListTimersReply struct {
+type OBSActionItemShowHide struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timers []*Timer `protobuf:"bytes,1,rep,name=timers,proto3" json:"timers,omitempty"`
+	ItemName        *string `protobuf:"bytes,1,opt,name=itemName,proto3,oneof" json:"itemName,omitempty"`
+	ItemUUID        *string `protobuf:"bytes,2,opt,name=itemUUID,proto3,oneof" json:"itemUUID,omitempty"`
+	ValueExpression string  `protobuf:"bytes,3,opt,name=valueExpression,proto3" json:"valueExpression,omitempty"`
 }
 
-func (x *ListTimersReply) Reset() {
-	*x = ListTimersReply{}
+func (x *OBSActionItemShowHide) Reset() {
+	*x = OBSActionItemShowHide{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[156]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7794,13 +8187,13 @@ func (x *ListTimersReply) Reset() {
 	}
 }
 
-func (x *ListTimersReply) String() string {
+func (x *OBSActionItemShowHide) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTimersReply) ProtoMessage() {}
+func (*OBSActionItemShowHide) ProtoMessage() {}
 
-func (x *ListTimersReply) ProtoReflect() protoreflect.Message {
+func (x *OBSActionItemShowHide) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[156]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7812,28 +8205,44 @@ func (x *ListTimersReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTimersReply.ProtoReflect.Descriptor instead.
-func (*ListTimersReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use OBSActionItemShowHide.ProtoReflect.Descriptor instead.
+func (*OBSActionItemShowHide) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *ListTimersReply) GetTimers() []*Timer {
+func (x *OBSActionItemShowHide) GetItemName() string {
+	if x != nil && x.ItemName != nil {
+		return *x.ItemName
+	}
+	return ""
+}
+
+func (x *OBSActionItemShowHide) GetItemUUID() string {
+	if x != nil && x.ItemUUID != nil {
+		return *x.ItemUUID
+	}
+	return ""
+}
+
+func (x *OBSActionItemShowHide) GetValueExpression() string {
 	if x != nil {
-		return x.Timers
+		return x.ValueExpression
 	}
-	return nil
+	return ""
 }
 
-type EventQueryAnd struct {
+type OBSActionWindowCaptureSetSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Queries []*Event `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	ItemName        *string `protobuf:"bytes,1,opt,name=itemName,proto3,oneof" json:"itemName,omitempty"`
+	ItemUUID        *string `protobuf:"bytes,2,opt,name=itemUUID,proto3,oneof" json:"itemUUID,omitempty"`
+	ValueExpression string  `protobuf:"bytes,3,opt,name=valueExpression,proto3" json:"valueExpression,omitempty"`
 }
 
-func (x *EventQueryAnd) Reset() {
-	*x = EventQueryAnd{}
+func (x *OBSActionWindowCaptureSetSource) Reset() {
+	*x = OBSActionWindowCaptureSetSource{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[157]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7841,13 +8250,13 @@ func (x *EventQueryAnd) Reset() {
 	}
 }
 
-func (x *EventQueryAnd) String() string {
+func (x *OBSActionWindowCaptureSetSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventQueryAnd) ProtoMessage() {}
+func (*OBSActionWindowCaptureSetSource) ProtoMessage() {}
 
-func (x *EventQueryAnd) ProtoReflect() protoreflect.Message {
+func (x *OBSActionWindowCaptureSetSource) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[157]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7859,28 +8268,42 @@ func (x *EventQueryAnd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventQueryAnd.ProtoReflect.Descriptor instead.
-func (*EventQueryAnd) Descriptor() ([]byte, []int) {
+// Deprecated: Use OBSActionWindowCaptureSetSource.ProtoReflect.Descriptor instead.
+func (*OBSActionWindowCaptureSetSource) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *EventQueryAnd) GetQueries() []*Event {
+func (x *OBSActionWindowCaptureSetSource) GetItemName() string {
+	if x != nil && x.ItemName != nil {
+		return *x.ItemName
+	}
+	return ""
+}
+
+func (x *OBSActionWindowCaptureSetSource) GetItemUUID() string {
+	if x != nil && x.ItemUUID != nil {
+		return *x.ItemUUID
+	}
+	return ""
+}
+
+func (x *OBSActionWindowCaptureSetSource) GetValueExpression() string {
 	if x != nil {
-		return x.Queries
+		return x.ValueExpression
 	}
-	return nil
+	return ""
 }
 
-type EventQueryOr struct {
+type OBSActionSetCurrentScene struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Queries []*Event `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	SceneNameExpression string `protobuf:"bytes,1,opt,name=sceneNameExpression,proto3" json:"sceneNameExpression,omitempty"`
 }
 
-func (x *EventQueryOr) Reset() {
-	*x = EventQueryOr{}
+func (x *OBSActionSetCurrentScene) Reset() {
+	*x = OBSActionSetCurrentScene{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[158]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7888,13 +8311,13 @@ func (x *EventQueryOr) Reset() {
 	}
 }
 
-func (x *EventQueryOr) String() string {
+func (x *OBSActionSetCurrentScene) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventQueryOr) ProtoMessage() {}
+func (*OBSActionSetCurrentScene) ProtoMessage() {}
 
-func (x *EventQueryOr) ProtoReflect() protoreflect.Message {
+func (x *OBSActionSetCurrentScene) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[158]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7906,28 +8329,33 @@ func (x *EventQueryOr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventQueryOr.ProtoReflect.Descriptor instead.
-func (*EventQueryOr) Descriptor() ([]byte, []int) {
+// Deprecated: Use OBSActionSetCurrentScene.ProtoReflect.Descriptor instead.
+func (*OBSActionSetCurrentScene) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *EventQueryOr) GetQueries() []*Event {
+func (x *OBSActionSetCurrentScene) GetSceneNameExpression() string {
 	if x != nil {
-		return x.Queries
+		return x.SceneNameExpression
 	}
-	return nil
+	return ""
 }
 
-type EventQueryNot struct {
+type OBSAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query *Event `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Types that are assignable to OBSActionOneOf:
+	//
+	//	*OBSAction_ItemShowHide
+	//	*OBSAction_WindowCaptureSetSource
+	//	*OBSAction_SetCurrentScene
+	OBSActionOneOf isOBSAction_OBSActionOneOf `protobuf_oneof:"OBSActionOneOf"`
 }
 
-func (x *EventQueryNot) Reset() {
-	*x = EventQueryNot{}
+func (x *OBSAction) Reset() {
+	*x = OBSAction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[159]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7935,13 +8363,13 @@ func (x *EventQueryNot) Reset() {
 	}
 }
 
-func (x *EventQueryNot) String() string {
+func (x *OBSAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventQueryNot) ProtoMessage() {}
+func (*OBSAction) ProtoMessage() {}
 
-func (x *EventQueryNot) ProtoReflect() protoreflect.Message {
+func (x *OBSAction) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[159]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7953,28 +8381,72 @@ func (x *EventQueryNot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventQueryNot.ProtoReflect.Descriptor instead.
-func (*EventQueryNot) Descriptor() ([]byte, []int) {
+// Deprecated: Use OBSAction.ProtoReflect.Descriptor instead.
+func (*OBSAction) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *EventQueryNot) GetQuery() *Event {
-	if x != nil {
-		return x.Query
+func (m *OBSAction) GetOBSActionOneOf() isOBSAction_OBSActionOneOf {
+	if m != nil {
+		return m.OBSActionOneOf
 	}
 	return nil
 }
 
-type EventOBSSceneChange struct {
+func (x *OBSAction) GetItemShowHide() *OBSActionItemShowHide {
+	if x, ok := x.GetOBSActionOneOf().(*OBSAction_ItemShowHide); ok {
+		return x.ItemShowHide
+	}
+	return nil
+}
+
+func (x *OBSAction) GetWindowCaptureSetSource() *OBSActionWindowCaptureSetSource {
+	if x, ok := x.GetOBSActionOneOf().(*OBSAction_WindowCaptureSetSource); ok {
+		return x.WindowCaptureSetSource
+	}
+	return nil
+}
+
+func (x *OBSAction) GetSetCurrentScene() *OBSActionSetCurrentScene {
+	if x, ok := x.GetOBSActionOneOf().(*OBSAction_SetCurrentScene); ok {
+		return x.SetCurrentScene
+	}
+	return nil
+}
+
+type isOBSAction_OBSActionOneOf interface {
+	isOBSAction_OBSActionOneOf()
+}
+
+type OBSAction_ItemShowHide struct {
+	ItemShowHide *OBSActionItemShowHide `protobuf:"bytes,1,opt,name=itemShowHide,proto3,oneof"`
+}
+
+type OBSAction_WindowCaptureSetSource struct {
+	WindowCaptureSetSource *OBSActionWindowCaptureSetSource `protobuf:"bytes,2,opt,name=windowCaptureSetSource,proto3,oneof"`
+}
+
+type OBSAction_SetCurrentScene struct {
+	SetCurrentScene *OBSActionSetCurrentScene `protobuf:"bytes,3,opt,name=setCurrentScene,proto3,oneof"`
+}
+
+func (*OBSAction_ItemShowHide) isOBSAction_OBSActionOneOf() {}
+
+func (*OBSAction_WindowCaptureSetSource) isOBSAction_OBSActionOneOf() {}
+
+func (*OBSAction_SetCurrentScene) isOBSAction_OBSActionOneOf() {}
+
+type ActionStep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SceneName string `protobuf:"bytes,1,opt,name=sceneName,proto3" json:"sceneName,omitempty"`
+	Action  *Action           `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	OnError ActionErrorPolicy `protobuf:"varint,2,opt,name=onError,proto3,enum=streamd.ActionErrorPolicy" json:"onError,omitempty"`
 }
 
-func (x *EventOBSSceneChange) Reset() {
-	*x = EventOBSSceneChange{}
+func (x *ActionStep) Reset() {
+	*x = ActionStep{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[160]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7982,13 +8454,13 @@ func (x *EventOBSSceneChange) Reset() {
 	}
 }
 
-func (x *EventOBSSceneChange) String() string {
+func (x *ActionStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventOBSSceneChange) ProtoMessage() {}
+func (*ActionStep) ProtoMessage() {}
 
-func (x *EventOBSSceneChange) ProtoReflect() protoreflect.Message {
+func (x *ActionStep) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[160]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8000,34 +8472,35 @@ func (x *EventOBSSceneChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventOBSSceneChange.ProtoReflect.Descriptor instead.
-func (*EventOBSSceneChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionStep.ProtoReflect.Descriptor instead.
+func (*ActionStep) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *EventOBSSceneChange) GetSceneName() string {
+func (x *ActionStep) GetAction() *Action {
 	if x != nil {
-		return x.SceneName
+		return x.Action
 	}
-	return ""
+	return nil
 }
 
-type EventWindowFocusChange struct {
+func (x *ActionStep) GetOnError() ActionErrorPolicy {
+	if x != nil {
+		return x.OnError
+	}
+	return ActionErrorPolicy_ActionErrorPolicyAbort
+}
+
+type ActionSequence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Host        *string `protobuf:"bytes,1,opt,name=host,proto3,oneof" json:"host,omitempty"`
-	WindowID    *uint64 `protobuf:"varint,2,opt,name=windowID,proto3,oneof" json:"windowID,omitempty"`
-	WindowTitle *string `protobuf:"bytes,3,opt,name=windowTitle,proto3,oneof" json:"windowTitle,omitempty"`
-	ProcessID   *uint64 `protobuf:"varint,4,opt,name=processID,proto3,oneof" json:"processID,omitempty"`
-	ProcessName *string `protobuf:"bytes,5,opt,name=processName,proto3,oneof" json:"processName,omitempty"`
-	UserID      *uint64 `protobuf:"varint,6,opt,name=userID,proto3,oneof" json:"userID,omitempty"`
-	IsFocused   *bool   `protobuf:"varint,7,opt,name=isFocused,proto3,oneof" json:"isFocused,omitempty"`
+	Steps []*ActionStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
 }
 
-func (x *EventWindowFocusChange) Reset() {
-	*x = EventWindowFocusChange{}
+func (x *ActionSequence) Reset() {
+	*x = ActionSequence{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[161]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8035,13 +8508,13 @@ func (x *EventWindowFocusChange) Reset() {
 	}
 }
 
-func (x *EventWindowFocusChange) String() string {
+func (x *ActionSequence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventWindowFocusChange) ProtoMessage() {}
+func (*ActionSequence) ProtoMessage() {}
 
-func (x *EventWindowFocusChange) ProtoReflect() protoreflect.Message {
+func (x *ActionSequence) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[161]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8053,77 +8526,28 @@ func (x *EventWindowFocusChange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventWindowFocusChange.ProtoReflect.Descriptor instead.
-func (*EventWindowFocusChange) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionSequence.ProtoReflect.Descriptor instead.
+func (*ActionSequence) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *EventWindowFocusChange) GetHost() string {
-	if x != nil && x.Host != nil {
-		return *x.Host
-	}
-	return ""
-}
-
-func (x *EventWindowFocusChange) GetWindowID() uint64 {
-	if x != nil && x.WindowID != nil {
-		return *x.WindowID
-	}
-	return 0
-}
-
-func (x *EventWindowFocusChange) GetWindowTitle() string {
-	if x != nil && x.WindowTitle != nil {
-		return *x.WindowTitle
-	}
-	return ""
-}
-
-func (x *EventWindowFocusChange) GetProcessID() uint64 {
-	if x != nil && x.ProcessID != nil {
-		return *x.ProcessID
-	}
-	return 0
-}
-
-func (x *EventWindowFocusChange) GetProcessName() string {
-	if x != nil && x.ProcessName != nil {
-		return *x.ProcessName
-	}
-	return ""
-}
-
-func (x *EventWindowFocusChange) GetUserID() uint64 {
-	if x != nil && x.UserID != nil {
-		return *x.UserID
-	}
-	return 0
-}
-
-func (x *EventWindowFocusChange) GetIsFocused() bool {
-	if x != nil && x.IsFocused != nil {
-		return *x.IsFocused
+func (x *ActionSequence) GetSteps() []*ActionStep {
+	if x != nil {
+		return x.Steps
 	}
-	return false
+	return nil
 }
 
-type EventQuery struct {
+type ActionParallel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to EventQueryOneOf:
-	//
-	//	*EventQuery_And
-	//	*EventQuery_Or
-	//	*EventQuery_Not
-	//	*EventQuery_EventType
-	//	*EventQuery_Event
-	EventQueryOneOf isEventQuery_EventQueryOneOf `protobuf_oneof:"EventQueryOneOf"`
+	Steps []*ActionStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
 }
 
-func (x *EventQuery) Reset() {
-	*x = EventQuery{}
+func (x *ActionParallel) Reset() {
+	*x = ActionParallel{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[162]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8131,13 +8555,13 @@ func (x *EventQuery) Reset() {
 	}
 }
 
-func (x *EventQuery) String() string {
+func (x *ActionParallel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventQuery) ProtoMessage() {}
+func (*ActionParallel) ProtoMessage() {}
 
-func (x *EventQuery) ProtoReflect() protoreflect.Message {
+func (x *ActionParallel) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[162]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8149,101 +8573,28 @@ func (x *EventQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventQuery.ProtoReflect.Descriptor instead.
-func (*EventQuery) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionParallel.ProtoReflect.Descriptor instead.
+func (*ActionParallel) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{162}
 }
 
-func (m *EventQuery) GetEventQueryOneOf() isEventQuery_EventQueryOneOf {
-	if m != nil {
-		return m.EventQueryOneOf
-	}
-	return nil
-}
-
-func (x *EventQuery) GetAnd() *EventQueryAnd {
-	if x, ok := x.GetEventQueryOneOf().(*EventQuery_And); ok {
-		return x.And
-	}
-	return nil
-}
-
-func (x *EventQuery) GetOr() *EventQueryOr {
-	if x, ok := x.GetEventQueryOneOf().(*EventQuery_Or); ok {
-		return x.Or
-	}
-	return nil
-}
-
-func (x *EventQuery) GetNot() *EventQueryNot {
-	if x, ok := x.GetEventQueryOneOf().(*EventQuery_Not); ok {
-		return x.Not
-	}
-	return nil
-}
-
-func (x *EventQuery) GetEventType() EventType {
-	if x, ok := x.GetEventQueryOneOf().(*EventQuery_EventType); ok {
-		return x.EventType
-	}
-	return EventType_eventWindowFocusChange
-}
-
-func (x *EventQuery) GetEvent() *Event {
-	if x, ok := x.GetEventQueryOneOf().(*EventQuery_Event); ok {
-		return x.Event
+func (x *ActionParallel) GetSteps() []*ActionStep {
+	if x != nil {
+		return x.Steps
 	}
 	return nil
 }
 
-type isEventQuery_EventQueryOneOf interface {
-	isEventQuery_EventQueryOneOf()
-}
-
-type EventQuery_And struct {
-	And *EventQueryAnd `protobuf:"bytes,1,opt,name=and,proto3,oneof"`
-}
-
-type EventQuery_Or struct {
-	Or *EventQueryOr `protobuf:"bytes,2,opt,name=or,proto3,oneof"`
-}
-
-type EventQuery_Not struct {
-	Not *EventQueryNot `protobuf:"bytes,3,opt,name=not,proto3,oneof"`
-}
-
-type EventQuery_EventType struct {
-	EventType EventType `protobuf:"varint,4,opt,name=eventType,proto3,enum=streamd.EventType,oneof"`
-}
-
-type EventQuery_Event struct {
-	Event *Event `protobuf:"bytes,5,opt,name=event,proto3,oneof"`
-}
-
-func (*EventQuery_And) isEventQuery_EventQueryOneOf() {}
-
-func (*EventQuery_Or) isEventQuery_EventQueryOneOf() {}
-
-func (*EventQuery_Not) isEventQuery_EventQueryOneOf() {}
-
-func (*EventQuery_EventType) isEventQuery_EventQueryOneOf() {}
-
-func (*EventQuery_Event) isEventQuery_EventQueryOneOf() {}
-
-type Event struct {
+type ActionDelay struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to EventOneOf:
-	//
-	//	*Event_ObsSceneChange
-	//	*Event_WindowFocusChange
-	EventOneOf isEvent_EventOneOf `protobuf_oneof:"EventOneOf"`
+	DurationNano int64 `protobuf:"varint,1,opt,name=durationNano,proto3" json:"durationNano,omitempty"`
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
+func (x *ActionDelay) Reset() {
+	*x = ActionDelay{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[163]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8251,13 +8602,13 @@ func (x *Event) Reset() {
 	}
 }
 
-func (x *Event) String() string {
+func (x *ActionDelay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*ActionDelay) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
+func (x *ActionDelay) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[163]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8269,60 +8620,30 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionDelay.ProtoReflect.Descriptor instead.
+func (*ActionDelay) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{163}
 }
 
-func (m *Event) GetEventOneOf() isEvent_EventOneOf {
-	if m != nil {
-		return m.EventOneOf
-	}
-	return nil
-}
-
-func (x *Event) GetObsSceneChange() *EventOBSSceneChange {
-	if x, ok := x.GetEventOneOf().(*Event_ObsSceneChange); ok {
-		return x.ObsSceneChange
-	}
-	return nil
-}
-
-func (x *Event) GetWindowFocusChange() *EventWindowFocusChange {
-	if x, ok := x.GetEventOneOf().(*Event_WindowFocusChange); ok {
-		return x.WindowFocusChange
+func (x *ActionDelay) GetDurationNano() int64 {
+	if x != nil {
+		return x.DurationNano
 	}
-	return nil
-}
-
-type isEvent_EventOneOf interface {
-	isEvent_EventOneOf()
-}
-
-type Event_ObsSceneChange struct {
-	ObsSceneChange *EventOBSSceneChange `protobuf:"bytes,1,opt,name=obsSceneChange,proto3,oneof"`
-}
-
-type Event_WindowFocusChange struct {
-	WindowFocusChange *EventWindowFocusChange `protobuf:"bytes,2,opt,name=windowFocusChange,proto3,oneof"`
+	return 0
 }
 
-func (*Event_ObsSceneChange) isEvent_EventOneOf() {}
-
-func (*Event_WindowFocusChange) isEvent_EventOneOf() {}
-
-type TriggerRule struct {
+type ActionIf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Description string      `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
-	EventQuery  *EventQuery `protobuf:"bytes,2,opt,name=eventQuery,proto3" json:"eventQuery,omitempty"`
-	Action      *Action     `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	ConditionExpression string  `protobuf:"bytes,1,opt,name=conditionExpression,proto3" json:"conditionExpression,omitempty"`
+	Then                *Action `protobuf:"bytes,2,opt,name=then,proto3" json:"then,omitempty"`
+	Else                *Action `protobuf:"bytes,3,opt,name=else,proto3" json:"else,omitempty"`
 }
 
-func (x *TriggerRule) Reset() {
-	*x = TriggerRule{}
+func (x *ActionIf) Reset() {
+	*x = ActionIf{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[164]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8330,13 +8651,13 @@ func (x *TriggerRule) Reset() {
 	}
 }
 
-func (x *TriggerRule) String() string {
+func (x *ActionIf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerRule) ProtoMessage() {}
+func (*ActionIf) ProtoMessage() {}
 
-func (x *TriggerRule) ProtoReflect() protoreflect.Message {
+func (x *ActionIf) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[164]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8348,40 +8669,43 @@ func (x *TriggerRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerRule.ProtoReflect.Descriptor instead.
-func (*TriggerRule) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionIf.ProtoReflect.Descriptor instead.
+func (*ActionIf) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *TriggerRule) GetDescription() string {
+func (x *ActionIf) GetConditionExpression() string {
 	if x != nil {
-		return x.Description
+		return x.ConditionExpression
 	}
 	return ""
 }
 
-func (x *TriggerRule) GetEventQuery() *EventQuery {
+func (x *ActionIf) GetThen() *Action {
 	if x != nil {
-		return x.EventQuery
+		return x.Then
 	}
 	return nil
 }
 
-func (x *TriggerRule) GetAction() *Action {
+func (x *ActionIf) GetElse() *Action {
 	if x != nil {
-		return x.Action
+		return x.Else
 	}
 	return nil
 }
 
-type ListTriggerRulesRequest struct {
+type ActionSendChatMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PlatID            string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	MessageExpression string `protobuf:"bytes,2,opt,name=messageExpression,proto3" json:"messageExpression,omitempty"`
 }
 
-func (x *ListTriggerRulesRequest) Reset() {
-	*x = ListTriggerRulesRequest{}
+func (x *ActionSendChatMessage) Reset() {
+	*x = ActionSendChatMessage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[165]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8389,13 +8713,13 @@ func (x *ListTriggerRulesRequest) Reset() {
 	}
 }
 
-func (x *ListTriggerRulesRequest) String() string {
+func (x *ActionSendChatMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTriggerRulesRequest) ProtoMessage() {}
+func (*ActionSendChatMessage) ProtoMessage() {}
 
-func (x *ListTriggerRulesRequest) ProtoReflect() protoreflect.Message {
+func (x *ActionSendChatMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[165]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8407,21 +8731,36 @@ func (x *ListTriggerRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTriggerRulesRequest.ProtoReflect.Descriptor instead.
-func (*ListTriggerRulesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionSendChatMessage.ProtoReflect.Descriptor instead.
+func (*ActionSendChatMessage) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{165}
 }
 
-type ListTriggerRulesReply struct {
+func (x *ActionSendChatMessage) GetPlatID() string {
+	if x != nil {
+		return x.PlatID
+	}
+	return ""
+}
+
+func (x *ActionSendChatMessage) GetMessageExpression() string {
+	if x != nil {
+		return x.MessageExpression
+	}
+	return ""
+}
+
+type ActionSetTitle struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rules []*TriggerRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	PlatID          string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	TitleExpression string `protobuf:"bytes,2,opt,name=titleExpression,proto3" json:"titleExpression,omitempty"`
 }
 
-func (x *ListTriggerRulesReply) Reset() {
-	*x = ListTriggerRulesReply{}
+func (x *ActionSetTitle) Reset() {
+	*x = ActionSetTitle{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[166]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8429,13 +8768,13 @@ func (x *ListTriggerRulesReply) Reset() {
 	}
 }
 
-func (x *ListTriggerRulesReply) String() string {
+func (x *ActionSetTitle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTriggerRulesReply) ProtoMessage() {}
+func (*ActionSetTitle) ProtoMessage() {}
 
-func (x *ListTriggerRulesReply) ProtoReflect() protoreflect.Message {
+func (x *ActionSetTitle) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[166]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8447,28 +8786,36 @@ func (x *ListTriggerRulesReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTriggerRulesReply.ProtoReflect.Descriptor instead.
-func (*ListTriggerRulesReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionSetTitle.ProtoReflect.Descriptor instead.
+func (*ActionSetTitle) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *ListTriggerRulesReply) GetRules() []*TriggerRule {
+func (x *ActionSetTitle) GetPlatID() string {
 	if x != nil {
-		return x.Rules
+		return x.PlatID
 	}
-	return nil
+	return ""
 }
 
-type AddTriggerRuleRequest struct {
+func (x *ActionSetTitle) GetTitleExpression() string {
+	if x != nil {
+		return x.TitleExpression
+	}
+	return ""
+}
+
+type ActionSetDescription struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rule *TriggerRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	PlatID                string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	DescriptionExpression string `protobuf:"bytes,2,opt,name=descriptionExpression,proto3" json:"descriptionExpression,omitempty"`
 }
 
-func (x *AddTriggerRuleRequest) Reset() {
-	*x = AddTriggerRuleRequest{}
+func (x *ActionSetDescription) Reset() {
+	*x = ActionSetDescription{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[167]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8476,13 +8823,13 @@ func (x *AddTriggerRuleRequest) Reset() {
 	}
 }
 
-func (x *AddTriggerRuleRequest) String() string {
+func (x *ActionSetDescription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTriggerRuleRequest) ProtoMessage() {}
+func (*ActionSetDescription) ProtoMessage() {}
 
-func (x *AddTriggerRuleRequest) ProtoReflect() protoreflect.Message {
+func (x *ActionSetDescription) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[167]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8494,28 +8841,36 @@ func (x *AddTriggerRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTriggerRuleRequest.ProtoReflect.Descriptor instead.
-func (*AddTriggerRuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionSetDescription.ProtoReflect.Descriptor instead.
+func (*ActionSetDescription) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *AddTriggerRuleRequest) GetRule() *TriggerRule {
+func (x *ActionSetDescription) GetPlatID() string {
 	if x != nil {
-		return x.Rule
+		return x.PlatID
 	}
-	return nil
+	return ""
 }
 
-type AddTriggerRuleReply struct {
+func (x *ActionSetDescription) GetDescriptionExpression() string {
+	if x != nil {
+		return x.DescriptionExpression
+	}
+	return ""
+}
+
+type ActionApplyProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RuleID uint64 `protobuf:"varint,1,opt,name=ruleID,proto3" json:"ruleID,omitempty"`
+	PlatID                string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	ProfileNameExpression string `protobuf:"bytes,2,opt,name=profileNameExpression,proto3" json:"profileNameExpression,omitempty"`
 }
 
-func (x *AddTriggerRuleReply) Reset() {
-	*x = AddTriggerRuleReply{}
+func (x *ActionApplyProfile) Reset() {
+	*x = ActionApplyProfile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[168]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8523,13 +8878,13 @@ func (x *AddTriggerRuleReply) Reset() {
 	}
 }
 
-func (x *AddTriggerRuleReply) String() string {
+func (x *ActionApplyProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTriggerRuleReply) ProtoMessage() {}
+func (*ActionApplyProfile) ProtoMessage() {}
 
-func (x *AddTriggerRuleReply) ProtoReflect() protoreflect.Message {
+func (x *ActionApplyProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[168]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8541,28 +8896,37 @@ func (x *AddTriggerRuleReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTriggerRuleReply.ProtoReflect.Descriptor instead.
-func (*AddTriggerRuleReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionApplyProfile.ProtoReflect.Descriptor instead.
+func (*ActionApplyProfile) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *AddTriggerRuleReply) GetRuleID() uint64 {
+func (x *ActionApplyProfile) GetPlatID() string {
 	if x != nil {
-		return x.RuleID
+		return x.PlatID
 	}
-	return 0
+	return ""
 }
 
-type RemoveTriggerRuleRequest struct {
+func (x *ActionApplyProfile) GetProfileNameExpression() string {
+	if x != nil {
+		return x.ProfileNameExpression
+	}
+	return ""
+}
+
+type ActionSetStreamForwardEnabled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RuleID uint64 `protobuf:"varint,1,opt,name=ruleID,proto3" json:"ruleID,omitempty"`
+	StreamID      string `protobuf:"bytes,1,opt,name=streamID,proto3" json:"streamID,omitempty"`
+	DestinationID string `protobuf:"bytes,2,opt,name=destinationID,proto3" json:"destinationID,omitempty"`
+	Enabled       bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (x *RemoveTriggerRuleRequest) Reset() {
-	*x = RemoveTriggerRuleRequest{}
+func (x *ActionSetStreamForwardEnabled) Reset() {
+	*x = ActionSetStreamForwardEnabled{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[169]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8570,13 +8934,13 @@ func (x *RemoveTriggerRuleRequest) Reset() {
 	}
 }
 
-func (x *RemoveTriggerRuleRequest) String() string {
+func (x *ActionSetStreamForwardEnabled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTriggerRuleRequest) ProtoMessage() {}
+func (*ActionSetStreamForwardEnabled) ProtoMessage() {}
 
-func (x *RemoveTriggerRuleRequest) ProtoReflect() protoreflect.Message {
+func (x *ActionSetStreamForwardEnabled) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[169]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8588,26 +8952,43 @@ func (x *RemoveTriggerRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTriggerRuleRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTriggerRuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionSetStreamForwardEnabled.ProtoReflect.Descriptor instead.
+func (*ActionSetStreamForwardEnabled) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *RemoveTriggerRuleRequest) GetRuleID() uint64 {
+func (x *ActionSetStreamForwardEnabled) GetStreamID() string {
 	if x != nil {
-		return x.RuleID
+		return x.StreamID
 	}
-	return 0
+	return ""
 }
 
-type RemoveTriggerRuleReply struct {
+func (x *ActionSetStreamForwardEnabled) GetDestinationID() string {
+	if x != nil {
+		return x.DestinationID
+	}
+	return ""
+}
+
+func (x *ActionSetStreamForwardEnabled) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ActionSetVariable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Key             string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ValueExpression string `protobuf:"bytes,2,opt,name=valueExpression,proto3" json:"valueExpression,omitempty"`
 }
 
-func (x *RemoveTriggerRuleReply) Reset() {
-	*x = RemoveTriggerRuleReply{}
+func (x *ActionSetVariable) Reset() {
+	*x = ActionSetVariable{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[170]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8615,13 +8996,13 @@ func (x *RemoveTriggerRuleReply) Reset() {
 	}
 }
 
-func (x *RemoveTriggerRuleReply) String() string {
+func (x *ActionSetVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTriggerRuleReply) ProtoMessage() {}
+func (*ActionSetVariable) ProtoMessage() {}
 
-func (x *RemoveTriggerRuleReply) ProtoReflect() protoreflect.Message {
+func (x *ActionSetVariable) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[170]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8633,22 +9014,36 @@ func (x *RemoveTriggerRuleReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTriggerRuleReply.ProtoReflect.Descriptor instead.
-func (*RemoveTriggerRuleReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionSetVariable.ProtoReflect.Descriptor instead.
+func (*ActionSetVariable) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{170}
 }
 
-type UpdateTriggerRuleRequest struct {
+func (x *ActionSetVariable) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ActionSetVariable) GetValueExpression() string {
+	if x != nil {
+		return x.ValueExpression
+	}
+	return ""
+}
+
+type ActionInsertAdsCuePoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RuleID uint64       `protobuf:"varint,1,opt,name=ruleID,proto3" json:"ruleID,omitempty"`
-	Rule   *TriggerRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	PlatID       string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	DurationNano int64  `protobuf:"varint,2,opt,name=durationNano,proto3" json:"durationNano,omitempty"`
 }
 
-func (x *UpdateTriggerRuleRequest) Reset() {
-	*x = UpdateTriggerRuleRequest{}
+func (x *ActionInsertAdsCuePoint) Reset() {
+	*x = ActionInsertAdsCuePoint{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[171]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8656,13 +9051,13 @@ func (x *UpdateTriggerRuleRequest) Reset() {
 	}
 }
 
-func (x *UpdateTriggerRuleRequest) String() string {
+func (x *ActionInsertAdsCuePoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTriggerRuleRequest) ProtoMessage() {}
+func (*ActionInsertAdsCuePoint) ProtoMessage() {}
 
-func (x *UpdateTriggerRuleRequest) ProtoReflect() protoreflect.Message {
+func (x *ActionInsertAdsCuePoint) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[171]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8674,33 +9069,37 @@ func (x *UpdateTriggerRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTriggerRuleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTriggerRuleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionInsertAdsCuePoint.ProtoReflect.Descriptor instead.
+func (*ActionInsertAdsCuePoint) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *UpdateTriggerRuleRequest) GetRuleID() uint64 {
+func (x *ActionInsertAdsCuePoint) GetPlatID() string {
 	if x != nil {
-		return x.RuleID
+		return x.PlatID
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateTriggerRuleRequest) GetRule() *TriggerRule {
+func (x *ActionInsertAdsCuePoint) GetDurationNano() int64 {
 	if x != nil {
-		return x.Rule
+		return x.DurationNano
 	}
-	return nil
+	return 0
 }
 
-type UpdateTriggerRuleReply struct {
+type ActionRunCommand struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Command     string   `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Args        []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	TimeoutNano int64    `protobuf:"varint,3,opt,name=timeoutNano,proto3" json:"timeoutNano,omitempty"`
 }
 
-func (x *UpdateTriggerRuleReply) Reset() {
-	*x = UpdateTriggerRuleReply{}
+func (x *ActionRunCommand) Reset() {
+	*x = ActionRunCommand{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[172]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8708,13 +9107,13 @@ func (x *UpdateTriggerRuleReply) Reset() {
 	}
 }
 
-func (x *UpdateTriggerRuleReply) String() string {
+func (x *ActionRunCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTriggerRuleReply) ProtoMessage() {}
+func (*ActionRunCommand) ProtoMessage() {}
 
-func (x *UpdateTriggerRuleReply) ProtoReflect() protoreflect.Message {
+func (x *ActionRunCommand) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[172]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8726,21 +9125,42 @@ func (x *UpdateTriggerRuleReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTriggerRuleReply.ProtoReflect.Descriptor instead.
-func (*UpdateTriggerRuleReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionRunCommand.ProtoReflect.Descriptor instead.
+func (*ActionRunCommand) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{172}
 }
 
-type SubmitEventRequest struct {
+func (x *ActionRunCommand) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ActionRunCommand) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ActionRunCommand) GetTimeoutNano() int64 {
+	if x != nil {
+		return x.TimeoutNano
+	}
+	return 0
+}
+
+type ActionTwitchCreateStreamMarker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	DescriptionExpression string `protobuf:"bytes,1,opt,name=descriptionExpression,proto3" json:"descriptionExpression,omitempty"`
 }
 
-func (x *SubmitEventRequest) Reset() {
-	*x = SubmitEventRequest{}
+func (x *ActionTwitchCreateStreamMarker) Reset() {
+	*x = ActionTwitchCreateStreamMarker{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[173]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8748,13 +9168,13 @@ func (x *SubmitEventRequest) Reset() {
 	}
 }
 
-func (x *SubmitEventRequest) String() string {
+func (x *ActionTwitchCreateStreamMarker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitEventRequest) ProtoMessage() {}
+func (*ActionTwitchCreateStreamMarker) ProtoMessage() {}
 
-func (x *SubmitEventRequest) ProtoReflect() protoreflect.Message {
+func (x *ActionTwitchCreateStreamMarker) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[173]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8766,26 +9186,47 @@ func (x *SubmitEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitEventRequest.ProtoReflect.Descriptor instead.
-func (*SubmitEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActionTwitchCreateStreamMarker.ProtoReflect.Descriptor instead.
+func (*ActionTwitchCreateStreamMarker) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *SubmitEventRequest) GetEvent() *Event {
+func (x *ActionTwitchCreateStreamMarker) GetDescriptionExpression() string {
 	if x != nil {
-		return x.Event
+		return x.DescriptionExpression
 	}
-	return nil
+	return ""
 }
 
-type SubmitEventReply struct {
+type Action struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to ActionOneof:
+	//
+	//	*Action_NoopRequest
+	//	*Action_StartStreamRequest
+	//	*Action_EndStreamRequest
+	//	*Action_ObsAction
+	//	*Action_Sequence
+	//	*Action_Parallel
+	//	*Action_Delay
+	//	*Action_If
+	//	*Action_SendChatMessage
+	//	*Action_SetTitle
+	//	*Action_SetDescription
+	//	*Action_ApplyProfile
+	//	*Action_SetStreamForwardEnabled
+	//	*Action_SetVariable
+	//	*Action_InsertAdsCuePoint
+	//	*Action_RunCommand
+	//	*Action_TwitchCreateStreamMarker
+	ActionOneof isAction_ActionOneof `protobuf_oneof:"ActionOneof"`
 }
 
-func (x *SubmitEventReply) Reset() {
-	*x = SubmitEventReply{}
+func (x *Action) Reset() {
+	*x = Action{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[174]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8793,13 +9234,13 @@ func (x *SubmitEventReply) Reset() {
 	}
 }
 
-func (x *SubmitEventReply) String() string {
+func (x *Action) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitEventReply) ProtoMessage() {}
+func (*Action) ProtoMessage() {}
 
-func (x *SubmitEventReply) ProtoReflect() protoreflect.Message {
+func (x *Action) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[174]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8811,214 +9252,269 @@ func (x *SubmitEventReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitEventReply.ProtoReflect.Descriptor instead.
-func (*SubmitEventReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{174}
 }
 
-type SubscribeToChatMessagesRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *Action) GetActionOneof() isAction_ActionOneof {
+	if m != nil {
+		return m.ActionOneof
+	}
+	return nil
 }
 
-func (x *SubscribeToChatMessagesRequest) Reset() {
-	*x = SubscribeToChatMessagesRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[175]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Action) GetNoopRequest() *NoopRequest {
+	if x, ok := x.GetActionOneof().(*Action_NoopRequest); ok {
+		return x.NoopRequest
 	}
+	return nil
 }
 
-func (x *SubscribeToChatMessagesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Action) GetStartStreamRequest() *StartStreamRequest {
+	if x, ok := x.GetActionOneof().(*Action_StartStreamRequest); ok {
+		return x.StartStreamRequest
+	}
+	return nil
 }
 
-func (*SubscribeToChatMessagesRequest) ProtoMessage() {}
-
-func (x *SubscribeToChatMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[175]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Action) GetEndStreamRequest() *EndStreamRequest {
+	if x, ok := x.GetActionOneof().(*Action_EndStreamRequest); ok {
+		return x.EndStreamRequest
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SubscribeToChatMessagesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeToChatMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{175}
+func (x *Action) GetObsAction() *OBSAction {
+	if x, ok := x.GetActionOneof().(*Action_ObsAction); ok {
+		return x.ObsAction
+	}
+	return nil
 }
 
-type ChatMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CreatedAtNano uint64 `protobuf:"varint,1,opt,name=createdAtNano,proto3" json:"createdAtNano,omitempty"`
-	PlatID        string `protobuf:"bytes,2,opt,name=platID,proto3" json:"platID,omitempty"`
-	UserID        string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	Username      string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	MessageID     string `protobuf:"bytes,5,opt,name=messageID,proto3" json:"messageID,omitempty"`
-	Message       string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+func (x *Action) GetSequence() *ActionSequence {
+	if x, ok := x.GetActionOneof().(*Action_Sequence); ok {
+		return x.Sequence
+	}
+	return nil
 }
 
-func (x *ChatMessage) Reset() {
-	*x = ChatMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[176]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Action) GetParallel() *ActionParallel {
+	if x, ok := x.GetActionOneof().(*Action_Parallel); ok {
+		return x.Parallel
 	}
+	return nil
 }
 
-func (x *ChatMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Action) GetDelay() *ActionDelay {
+	if x, ok := x.GetActionOneof().(*Action_Delay); ok {
+		return x.Delay
+	}
+	return nil
 }
 
-func (*ChatMessage) ProtoMessage() {}
-
-func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[176]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Action) GetIf() *ActionIf {
+	if x, ok := x.GetActionOneof().(*Action_If); ok {
+		return x.If
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
-func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{176}
+func (x *Action) GetSendChatMessage() *ActionSendChatMessage {
+	if x, ok := x.GetActionOneof().(*Action_SendChatMessage); ok {
+		return x.SendChatMessage
+	}
+	return nil
 }
 
-func (x *ChatMessage) GetCreatedAtNano() uint64 {
-	if x != nil {
-		return x.CreatedAtNano
+func (x *Action) GetSetTitle() *ActionSetTitle {
+	if x, ok := x.GetActionOneof().(*Action_SetTitle); ok {
+		return x.SetTitle
 	}
-	return 0
+	return nil
 }
 
-func (x *ChatMessage) GetPlatID() string {
-	if x != nil {
-		return x.PlatID
+func (x *Action) GetSetDescription() *ActionSetDescription {
+	if x, ok := x.GetActionOneof().(*Action_SetDescription); ok {
+		return x.SetDescription
 	}
-	return ""
+	return nil
 }
 
-func (x *ChatMessage) GetUserID() string {
-	if x != nil {
-		return x.UserID
+func (x *Action) GetApplyProfile() *ActionApplyProfile {
+	if x, ok := x.GetActionOneof().(*Action_ApplyProfile); ok {
+		return x.ApplyProfile
 	}
-	return ""
+	return nil
 }
 
-func (x *ChatMessage) GetUsername() string {
-	if x != nil {
-		return x.Username
+func (x *Action) GetSetStreamForwardEnabled() *ActionSetStreamForwardEnabled {
+	if x, ok := x.GetActionOneof().(*Action_SetStreamForwardEnabled); ok {
+		return x.SetStreamForwardEnabled
 	}
-	return ""
+	return nil
 }
 
-func (x *ChatMessage) GetMessageID() string {
-	if x != nil {
-		return x.MessageID
+func (x *Action) GetSetVariable() *ActionSetVariable {
+	if x, ok := x.GetActionOneof().(*Action_SetVariable); ok {
+		return x.SetVariable
 	}
-	return ""
+	return nil
 }
 
-func (x *ChatMessage) GetMessage() string {
-	if x != nil {
-		return x.Message
+func (x *Action) GetInsertAdsCuePoint() *ActionInsertAdsCuePoint {
+	if x, ok := x.GetActionOneof().(*Action_InsertAdsCuePoint); ok {
+		return x.InsertAdsCuePoint
 	}
-	return ""
+	return nil
 }
 
-type SendChatMessageRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PlatID  string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (x *Action) GetRunCommand() *ActionRunCommand {
+	if x, ok := x.GetActionOneof().(*Action_RunCommand); ok {
+		return x.RunCommand
+	}
+	return nil
 }
 
-func (x *SendChatMessageRequest) Reset() {
-	*x = SendChatMessageRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[177]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Action) GetTwitchCreateStreamMarker() *ActionTwitchCreateStreamMarker {
+	if x, ok := x.GetActionOneof().(*Action_TwitchCreateStreamMarker); ok {
+		return x.TwitchCreateStreamMarker
 	}
+	return nil
 }
 
-func (x *SendChatMessageRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isAction_ActionOneof interface {
+	isAction_ActionOneof()
 }
 
-func (*SendChatMessageRequest) ProtoMessage() {}
+type Action_NoopRequest struct {
+	NoopRequest *NoopRequest `protobuf:"bytes,1,opt,name=noopRequest,proto3,oneof"`
+}
 
-func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[177]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type Action_StartStreamRequest struct {
+	StartStreamRequest *StartStreamRequest `protobuf:"bytes,2,opt,name=startStreamRequest,proto3,oneof"`
 }
 
-// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{177}
+type Action_EndStreamRequest struct {
+	EndStreamRequest *EndStreamRequest `protobuf:"bytes,3,opt,name=endStreamRequest,proto3,oneof"`
 }
 
-func (x *SendChatMessageRequest) GetPlatID() string {
-	if x != nil {
-		return x.PlatID
-	}
-	return ""
+type Action_ObsAction struct {
+	ObsAction *OBSAction `protobuf:"bytes,4,opt,name=obsAction,proto3,oneof"`
 }
 
-func (x *SendChatMessageRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+type Action_Sequence struct {
+	Sequence *ActionSequence `protobuf:"bytes,5,opt,name=sequence,proto3,oneof"`
 }
 
-type SendChatMessageReply struct {
+type Action_Parallel struct {
+	Parallel *ActionParallel `protobuf:"bytes,6,opt,name=parallel,proto3,oneof"`
+}
+
+type Action_Delay struct {
+	Delay *ActionDelay `protobuf:"bytes,7,opt,name=delay,proto3,oneof"`
+}
+
+type Action_If struct {
+	If *ActionIf `protobuf:"bytes,8,opt,name=if,proto3,oneof"`
+}
+
+type Action_SendChatMessage struct {
+	SendChatMessage *ActionSendChatMessage `protobuf:"bytes,9,opt,name=sendChatMessage,proto3,oneof"`
+}
+
+type Action_SetTitle struct {
+	SetTitle *ActionSetTitle `protobuf:"bytes,10,opt,name=setTitle,proto3,oneof"`
+}
+
+type Action_SetDescription struct {
+	SetDescription *ActionSetDescription `protobuf:"bytes,11,opt,name=setDescription,proto3,oneof"`
+}
+
+type Action_ApplyProfile struct {
+	ApplyProfile *ActionApplyProfile `protobuf:"bytes,12,opt,name=applyProfile,proto3,oneof"`
+}
+
+type Action_SetStreamForwardEnabled struct {
+	SetStreamForwardEnabled *ActionSetStreamForwardEnabled `protobuf:"bytes,13,opt,name=setStreamForwardEnabled,proto3,oneof"`
+}
+
+type Action_SetVariable struct {
+	SetVariable *ActionSetVariable `protobuf:"bytes,14,opt,name=setVariable,proto3,oneof"`
+}
+
+type Action_InsertAdsCuePoint struct {
+	InsertAdsCuePoint *ActionInsertAdsCuePoint `protobuf:"bytes,15,opt,name=insertAdsCuePoint,proto3,oneof"`
+}
+
+type Action_RunCommand struct {
+	RunCommand *ActionRunCommand `protobuf:"bytes,16,opt,name=runCommand,proto3,oneof"`
+}
+
+type Action_TwitchCreateStreamMarker struct {
+	TwitchCreateStreamMarker *ActionTwitchCreateStreamMarker `protobuf:"bytes,17,opt,name=twitchCreateStreamMarker,proto3,oneof"`
+}
+
+func (*Action_NoopRequest) isAction_ActionOneof() {}
+
+func (*Action_StartStreamRequest) isAction_ActionOneof() {}
+
+func (*Action_EndStreamRequest) isAction_ActionOneof() {}
+
+func (*Action_ObsAction) isAction_ActionOneof() {}
+
+func (*Action_Sequence) isAction_ActionOneof() {}
+
+func (*Action_Parallel) isAction_ActionOneof() {}
+
+func (*Action_Delay) isAction_ActionOneof() {}
+
+func (*Action_If) isAction_ActionOneof() {}
+
+func (*Action_SendChatMessage) isAction_ActionOneof() {}
+
+func (*Action_SetTitle) isAction_ActionOneof() {}
+
+func (*Action_SetDescription) isAction_ActionOneof() {}
+
+func (*Action_ApplyProfile) isAction_ActionOneof() {}
+
+func (*Action_SetStreamForwardEnabled) isAction_ActionOneof() {}
+
+func (*Action_SetVariable) isAction_ActionOneof() {}
+
+func (*Action_InsertAdsCuePoint) isAction_ActionOneof() {}
+
+func (*Action_RunCommand) isAction_ActionOneof() {}
+
+func (*Action_TwitchCreateStreamMarker) isAction_ActionOneof() {}
+
+type TimerMissedDeadlinePolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type            TimerMissedDeadlinePolicyType `protobuf:"varint,1,opt,name=type,proto3,enum=streamd.TimerMissedDeadlinePolicyType" json:"type,omitempty"`
+	MaxLatenessNano int64                         `protobuf:"varint,2,opt,name=maxLatenessNano,proto3" json:"maxLatenessNano,omitempty"`
 }
 
-func (x *SendChatMessageReply) Reset() {
-	*x = SendChatMessageReply{}
+func (x *TimerMissedDeadlinePolicy) Reset() {
+	*x = TimerMissedDeadlinePolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[178]
+		mi := &file_streamd_proto_msgTypes[175]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendChatMessageReply) String() string {
+func (x *TimerMissedDeadlinePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendChatMessageReply) ProtoMessage() {}
+func (*TimerMissedDeadlinePolicy) ProtoMessage() {}
 
-func (x *SendChatMessageReply) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[178]
+func (x *TimerMissedDeadlinePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[175]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9029,37 +9525,51 @@ func (x *SendChatMessageReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendChatMessageReply.ProtoReflect.Descriptor instead.
-func (*SendChatMessageReply) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{178}
+// Deprecated: Use TimerMissedDeadlinePolicy.ProtoReflect.Descriptor instead.
+func (*TimerMissedDeadlinePolicy) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{175}
 }
 
-type RemoveChatMessageRequest struct {
+func (x *TimerMissedDeadlinePolicy) GetType() TimerMissedDeadlinePolicyType {
+	if x != nil {
+		return x.Type
+	}
+	return TimerMissedDeadlinePolicyType_TimerMissedDeadlineFireImmediately
+}
+
+func (x *TimerMissedDeadlinePolicy) GetMaxLatenessNano() int64 {
+	if x != nil {
+		return x.MaxLatenessNano
+	}
+	return 0
+}
+
+type TimerRelativeToStreamStart struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PlatID    string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
-	MessageID string `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	DelayNano int64  `protobuf:"varint,2,opt,name=delayNano,proto3" json:"delayNano,omitempty"`
 }
 
-func (x *RemoveChatMessageRequest) Reset() {
-	*x = RemoveChatMessageRequest{}
+func (x *TimerRelativeToStreamStart) Reset() {
+	*x = TimerRelativeToStreamStart{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[179]
+		mi := &file_streamd_proto_msgTypes[176]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveChatMessageRequest) String() string {
+func (x *TimerRelativeToStreamStart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveChatMessageRequest) ProtoMessage() {}
+func (*TimerRelativeToStreamStart) ProtoMessage() {}
 
-func (x *RemoveChatMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[179]
+func (x *TimerRelativeToStreamStart) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[176]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9070,48 +9580,53 @@ func (x *RemoveChatMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveChatMessageRequest.ProtoReflect.Descriptor instead.
-func (*RemoveChatMessageRequest) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{179}
+// Deprecated: Use TimerRelativeToStreamStart.ProtoReflect.Descriptor instead.
+func (*TimerRelativeToStreamStart) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *RemoveChatMessageRequest) GetPlatID() string {
+func (x *TimerRelativeToStreamStart) GetPlatID() string {
 	if x != nil {
 		return x.PlatID
 	}
 	return ""
 }
 
-func (x *RemoveChatMessageRequest) GetMessageID() string {
+func (x *TimerRelativeToStreamStart) GetDelayNano() int64 {
 	if x != nil {
-		return x.MessageID
+		return x.DelayNano
 	}
-	return ""
+	return 0
 }
 
-type RemoveChatMessageReply struct {
+type TimerSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CronExpression        string                      `protobuf:"bytes,1,opt,name=cronExpression,proto3" json:"cronExpression,omitempty"`
+	IntervalNano          int64                       `protobuf:"varint,2,opt,name=intervalNano,proto3" json:"intervalNano,omitempty"`
+	EndAtUnixNano         int64                       `protobuf:"varint,3,opt,name=endAtUnixNano,proto3" json:"endAtUnixNano,omitempty"`
+	RelativeToStreamStart *TimerRelativeToStreamStart `protobuf:"bytes,4,opt,name=relativeToStreamStart,proto3" json:"relativeToStreamStart,omitempty"`
 }
 
-func (x *RemoveChatMessageReply) Reset() {
-	*x = RemoveChatMessageReply{}
+func (x *TimerSchedule) Reset() {
+	*x = TimerSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[180]
+		mi := &file_streamd_proto_msgTypes[177]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveChatMessageReply) String() string {
+func (x *TimerSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveChatMessageReply) ProtoMessage() {}
+func (*TimerSchedule) ProtoMessage() {}
 
-func (x *RemoveChatMessageReply) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[180]
+func (x *TimerSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[177]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9122,39 +9637,67 @@ func (x *RemoveChatMessageReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveChatMessageReply.ProtoReflect.Descriptor instead.
-func (*RemoveChatMessageReply) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{180}
+// Deprecated: Use TimerSchedule.ProtoReflect.Descriptor instead.
+func (*TimerSchedule) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{177}
 }
 
-type BanUserRequest struct {
+func (x *TimerSchedule) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *TimerSchedule) GetIntervalNano() int64 {
+	if x != nil {
+		return x.IntervalNano
+	}
+	return 0
+}
+
+func (x *TimerSchedule) GetEndAtUnixNano() int64 {
+	if x != nil {
+		return x.EndAtUnixNano
+	}
+	return 0
+}
+
+func (x *TimerSchedule) GetRelativeToStreamStart() *TimerRelativeToStreamStart {
+	if x != nil {
+		return x.RelativeToStreamStart
+	}
+	return nil
+}
+
+type AddTimerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlatID           string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
-	UserID           string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	Reason           string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	DeadlineUnixNano *int64 `protobuf:"varint,4,opt,name=deadlineUnixNano,proto3,oneof" json:"deadlineUnixNano,omitempty"`
+	TriggerAtUnixNano    int64                      `protobuf:"varint,1,opt,name=triggerAtUnixNano,proto3" json:"triggerAtUnixNano,omitempty"`
+	Action               *Action                    `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	MissedDeadlinePolicy *TimerMissedDeadlinePolicy `protobuf:"bytes,3,opt,name=missedDeadlinePolicy,proto3" json:"missedDeadlinePolicy,omitempty"`
+	Schedule             *TimerSchedule             `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
 }
 
-func (x *BanUserRequest) Reset() {
-	*x = BanUserRequest{}
+func (x *AddTimerRequest) Reset() {
+	*x = AddTimerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[181]
+		mi := &file_streamd_proto_msgTypes[178]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BanUserRequest) String() string {
+func (x *AddTimerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BanUserRequest) ProtoMessage() {}
+func (*AddTimerRequest) ProtoMessage() {}
 
-func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[181]
+func (x *AddTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[178]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9165,62 +9708,64 @@ func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
-func (*BanUserRequest) Descriptor() ([]byte, []int) {
-	return file_streamd_proto_rawDescGZIP(), []int{181}
+// Deprecated: Use AddTimerRequest.ProtoReflect.Descriptor instead.
+func (*AddTimerRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *BanUserRequest) GetPlatID() string {
+func (x *AddTimerRequest) GetTriggerAtUnixNano() int64 {
 	if x != nil {
-		return x.PlatID
+		return x.TriggerAtUnixNano
 	}
-	return ""
+	return 0
 }
 
-func (x *BanUserRequest) GetUserID() string {
+func (x *AddTimerRequest) GetAction() *Action {
 	if x != nil {
-		return x.UserID
+		return x.Action
 	}
-	return ""
+	return nil
 }
 
-func (x *BanUserRequest) GetReason() string {
+func (x *AddTimerRequest) GetMissedDeadlinePolicy() *TimerMissedDeadlinePolicy {
 	if x != nil {
-		return x.Reason
+		return x.MissedDeadlinePolicy
 	}
-	return ""
+	return nil
 }
 
-func (x *BanUserRequest) GetDeadlineUnixNano() int64 {
-	if x != nil && x.DeadlineUnixNano != nil {
-		return *x.DeadlineUnixNano
+func (x *AddTimerRequest) GetSchedule() *TimerSchedule {
+	if x != nil {
+		return x.Schedule
 	}
-	return 0
+	return nil
 }
 
-type BanUserReply struct {
+type AddTimerReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TimerID int64 `protobuf:"varint,1,opt,name=timerID,proto3" json:"timerID,omitempty"`
 }
 
-func (x *BanUserReply) Reset() {
-	*x = BanUserReply{}
+func (x *AddTimerReply) Reset() {
+	*x = AddTimerReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_streamd_proto_msgTypes[182]
+		mi := &file_streamd_proto_msgTypes[179]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BanUserReply) String() string {
+func (x *AddTimerReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BanUserReply) ProtoMessage() {}
+func (*AddTimerReply) ProtoMessage() {}
 
-func (x *BanUserReply) ProtoReflect() protoreflect.Message {
-	mi := &file_streamd_proto_msgTypes[182]
+func (x *AddTimerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[179]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9231,19 +9776,191 @@ func (x *BanUserReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BanUserReply.ProtoReflect.Descriptor instead.
-func (*BanUserReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddTimerReply.ProtoReflect.Descriptor instead.
+func (*AddTimerReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *AddTimerReply) GetTimerID() int64 {
+	if x != nil {
+		return x.TimerID
+	}
+	return 0
+}
+
+type RemoveTimerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimerID int64 `protobuf:"varint,1,opt,name=timerID,proto3" json:"timerID,omitempty"`
+}
+
+func (x *RemoveTimerRequest) Reset() {
+	*x = RemoveTimerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTimerRequest) ProtoMessage() {}
+
+func (x *RemoveTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTimerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTimerRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *RemoveTimerRequest) GetTimerID() int64 {
+	if x != nil {
+		return x.TimerID
+	}
+	return 0
+}
+
+type RemoveTimerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveTimerReply) Reset() {
+	*x = RemoveTimerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTimerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTimerReply) ProtoMessage() {}
+
+func (x *RemoveTimerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTimerReply.ProtoReflect.Descriptor instead.
+func (*RemoveTimerReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{181}
+}
+
+type Timer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimerID int64 `protobuf:"varint,1,opt,name=timerID,proto3" json:"timerID,omitempty"`
+	// the moment of the next trigger; zero if the timer is not armed
+	TriggerAtUnixNano    int64                      `protobuf:"varint,2,opt,name=triggerAtUnixNano,proto3" json:"triggerAtUnixNano,omitempty"`
+	Action               *Action                    `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	MissedDeadlinePolicy *TimerMissedDeadlinePolicy `protobuf:"bytes,4,opt,name=missedDeadlinePolicy,proto3" json:"missedDeadlinePolicy,omitempty"`
+	Schedule             *TimerSchedule             `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *Timer) Reset() {
+	*x = Timer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timer) ProtoMessage() {}
+
+func (x *Timer) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timer.ProtoReflect.Descriptor instead.
+func (*Timer) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{182}
 }
 
-type GetPeerIDsRequest struct {
+func (x *Timer) GetTimerID() int64 {
+	if x != nil {
+		return x.TimerID
+	}
+	return 0
+}
+
+func (x *Timer) GetTriggerAtUnixNano() int64 {
+	if x != nil {
+		return x.TriggerAtUnixNano
+	}
+	return 0
+}
+
+func (x *Timer) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *Timer) GetMissedDeadlinePolicy() *TimerMissedDeadlinePolicy {
+	if x != nil {
+		return x.MissedDeadlinePolicy
+	}
+	return nil
+}
+
+func (x *Timer) GetSchedule() *TimerSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ListTimersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetPeerIDsRequest) Reset() {
-	*x = GetPeerIDsRequest{}
+func (x *ListTimersRequest) Reset() {
+	*x = ListTimersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[183]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9251,13 +9968,13 @@ func (x *GetPeerIDsRequest) Reset() {
 	}
 }
 
-func (x *GetPeerIDsRequest) String() string {
+func (x *ListTimersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPeerIDsRequest) ProtoMessage() {}
+func (*ListTimersRequest) ProtoMessage() {}
 
-func (x *GetPeerIDsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTimersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[183]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9269,21 +9986,21 @@ func (x *GetPeerIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPeerIDsRequest.ProtoReflect.Descriptor instead.
-func (*GetPeerIDsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTimersRequest.ProtoReflect.Descriptor instead.
+func (*ListTimersRequest) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{183}
 }
 
-type GetPeerIDsReply struct {
+type ListTimersReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerIDs []string `protobuf:"bytes,1,rep,name=peerIDs,proto3" json:"peerIDs,omitempty"`
+	Timers []*Timer `protobuf:"bytes,1,rep,name=timers,proto3" json:"timers,omitempty"`
 }
 
-func (x *GetPeerIDsReply) Reset() {
-	*x = GetPeerIDsReply{}
+func (x *ListTimersReply) Reset() {
+	*x = ListTimersReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_streamd_proto_msgTypes[184]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9291,13 +10008,13 @@ func (x *GetPeerIDsReply) Reset() {
 	}
 }
 
-func (x *GetPeerIDsReply) String() string {
+func (x *ListTimersReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPeerIDsReply) ProtoMessage() {}
+func (*ListTimersReply) ProtoMessage() {}
 
-func (x *GetPeerIDsReply) ProtoReflect() protoreflect.Message {
+func (x *ListTimersReply) ProtoReflect() protoreflect.Message {
 	mi := &file_streamd_proto_msgTypes[184]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9309,64 +10026,4676 @@ func (x *GetPeerIDsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPeerIDsReply.ProtoReflect.Descriptor instead.
-func (*GetPeerIDsReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTimersReply.ProtoReflect.Descriptor instead.
+func (*ListTimersReply) Descriptor() ([]byte, []int) {
 	return file_streamd_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *GetPeerIDsReply) GetPeerIDs() []string {
+func (x *ListTimersReply) GetTimers() []*Timer {
 	if x != nil {
-		return x.PeerIDs
+		return x.Timers
 	}
 	return nil
 }
 
-var File_streamd_proto protoreflect.FileDescriptor
+type EventQueryAnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_streamd_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x1a, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x01,
-	0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
-	0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54,
-	0x6f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x54, 0x6f, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f, 0x49, 0x67, 0x6e, 0x6f, 0x72,
-	0x65, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72,
-	0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x25, 0x0a, 0x09, 0x50,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x22, 0x53, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0c,
-	0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x6f, 0x67,
-	0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x69,
-	0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4c, 0x6f,
-	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x39, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x64, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0c,
-	0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x12, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2a, 0x0a, 0x10, 0x53, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x10, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x11, 0x0a,
-	0x0f, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61,
-	0x63, 0x68, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x0a, 0x10, 0x49, 0x6e, 0x69, 0x74,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x10, 0x0a, 0x0e,
+	Queries []*EventQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (x *EventQueryAnd) Reset() {
+	*x = EventQueryAnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventQueryAnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventQueryAnd) ProtoMessage() {}
+
+func (x *EventQueryAnd) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventQueryAnd.ProtoReflect.Descriptor instead.
+func (*EventQueryAnd) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *EventQueryAnd) GetQueries() []*EventQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type EventQueryOr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries []*EventQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+}
+
+func (x *EventQueryOr) Reset() {
+	*x = EventQueryOr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventQueryOr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventQueryOr) ProtoMessage() {}
+
+func (x *EventQueryOr) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventQueryOr.ProtoReflect.Descriptor instead.
+func (*EventQueryOr) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *EventQueryOr) GetQueries() []*EventQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type EventQueryNot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query *EventQuery `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *EventQueryNot) Reset() {
+	*x = EventQueryNot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventQueryNot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventQueryNot) ProtoMessage() {}
+
+func (x *EventQueryNot) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventQueryNot.ProtoReflect.Descriptor instead.
+func (*EventQueryNot) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *EventQueryNot) GetQuery() *EventQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type EventQueryEventField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Regex *string  `protobuf:"bytes,2,opt,name=regex,proto3,oneof" json:"regex,omitempty"`
+	Min   *float64 `protobuf:"fixed64,3,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max   *float64 `protobuf:"fixed64,4,opt,name=max,proto3,oneof" json:"max,omitempty"`
+}
+
+func (x *EventQueryEventField) Reset() {
+	*x = EventQueryEventField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventQueryEventField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventQueryEventField) ProtoMessage() {}
+
+func (x *EventQueryEventField) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventQueryEventField.ProtoReflect.Descriptor instead.
+func (*EventQueryEventField) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *EventQueryEventField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *EventQueryEventField) GetRegex() string {
+	if x != nil && x.Regex != nil {
+		return *x.Regex
+	}
+	return ""
+}
+
+func (x *EventQueryEventField) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *EventQueryEventField) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type EventQueryStreamIsActive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+}
+
+func (x *EventQueryStreamIsActive) Reset() {
+	*x = EventQueryStreamIsActive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventQueryStreamIsActive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventQueryStreamIsActive) ProtoMessage() {}
+
+func (x *EventQueryStreamIsActive) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventQueryStreamIsActive.ProtoReflect.Descriptor instead.
+func (*EventQueryStreamIsActive) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *EventQueryStreamIsActive) GetPlatID() string {
+	if x != nil {
+		return x.PlatID
+	}
+	return ""
+}
+
+type EventQueryVariableEquals struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EventQueryVariableEquals) Reset() {
+	*x = EventQueryVariableEquals{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventQueryVariableEquals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventQueryVariableEquals) ProtoMessage() {}
+
+func (x *EventQueryVariableEquals) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventQueryVariableEquals.ProtoReflect.Descriptor instead.
+func (*EventQueryVariableEquals) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *EventQueryVariableEquals) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EventQueryVariableEquals) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type EventOBSSceneChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SceneName string `protobuf:"bytes,1,opt,name=sceneName,proto3" json:"sceneName,omitempty"`
+}
+
+func (x *EventOBSSceneChange) Reset() {
+	*x = EventOBSSceneChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventOBSSceneChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventOBSSceneChange) ProtoMessage() {}
+
+func (x *EventOBSSceneChange) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventOBSSceneChange.ProtoReflect.Descriptor instead.
+func (*EventOBSSceneChange) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *EventOBSSceneChange) GetSceneName() string {
+	if x != nil {
+		return x.SceneName
+	}
+	return ""
+}
+
+type EventWindowFocusChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host        *string `protobuf:"bytes,1,opt,name=host,proto3,oneof" json:"host,omitempty"`
+	WindowID    *uint64 `protobuf:"varint,2,opt,name=windowID,proto3,oneof" json:"windowID,omitempty"`
+	WindowTitle *string `protobuf:"bytes,3,opt,name=windowTitle,proto3,oneof" json:"windowTitle,omitempty"`
+	ProcessID   *uint64 `protobuf:"varint,4,opt,name=processID,proto3,oneof" json:"processID,omitempty"`
+	ProcessName *string `protobuf:"bytes,5,opt,name=processName,proto3,oneof" json:"processName,omitempty"`
+	UserID      *uint64 `protobuf:"varint,6,opt,name=userID,proto3,oneof" json:"userID,omitempty"`
+	IsFocused   *bool   `protobuf:"varint,7,opt,name=isFocused,proto3,oneof" json:"isFocused,omitempty"`
+}
+
+func (x *EventWindowFocusChange) Reset() {
+	*x = EventWindowFocusChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventWindowFocusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWindowFocusChange) ProtoMessage() {}
+
+func (x *EventWindowFocusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventWindowFocusChange.ProtoReflect.Descriptor instead.
+func (*EventWindowFocusChange) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *EventWindowFocusChange) GetHost() string {
+	if x != nil && x.Host != nil {
+		return *x.Host
+	}
+	return ""
+}
+
+func (x *EventWindowFocusChange) GetWindowID() uint64 {
+	if x != nil && x.WindowID != nil {
+		return *x.WindowID
+	}
+	return 0
+}
+
+func (x *EventWindowFocusChange) GetWindowTitle() string {
+	if x != nil && x.WindowTitle != nil {
+		return *x.WindowTitle
+	}
+	return ""
+}
+
+func (x *EventWindowFocusChange) GetProcessID() uint64 {
+	if x != nil && x.ProcessID != nil {
+		return *x.ProcessID
+	}
+	return 0
+}
+
+func (x *EventWindowFocusChange) GetProcessName() string {
+	if x != nil && x.ProcessName != nil {
+		return *x.ProcessName
+	}
+	return ""
+}
+
+func (x *EventWindowFocusChange) GetUserID() uint64 {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return 0
+}
+
+func (x *EventWindowFocusChange) GetIsFocused() bool {
+	if x != nil && x.IsFocused != nil {
+		return *x.IsFocused
+	}
+	return false
+}
+
+type EventChatMessageReceived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID          *string          `protobuf:"bytes,1,opt,name=platID,proto3,oneof" json:"platID,omitempty"`
+	UserID          *string          `protobuf:"bytes,2,opt,name=userID,proto3,oneof" json:"userID,omitempty"`
+	Username        *string          `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	MessageID       *string          `protobuf:"bytes,4,opt,name=messageID,proto3,oneof" json:"messageID,omitempty"`
+	Message         *string          `protobuf:"bytes,5,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	MessageContains *string          `protobuf:"bytes,6,opt,name=messageContains,proto3,oneof" json:"messageContains,omitempty"`
+	MessageRegex    *string          `protobuf:"bytes,7,opt,name=messageRegex,proto3,oneof" json:"messageRegex,omitempty"`
+	Roles           []ChatUserRole   `protobuf:"varint,8,rep,packed,name=roles,proto3,enum=streamd.ChatUserRole" json:"roles,omitempty"`
+	Badges          []*ChatBadge     `protobuf:"bytes,9,rep,name=badges,proto3" json:"badges,omitempty"`
+	IsPaid          *bool            `protobuf:"varint,10,opt,name=isPaid,proto3,oneof" json:"isPaid,omitempty"`
+	Paid            *ChatMessagePaid `protobuf:"bytes,11,opt,name=paid,proto3,oneof" json:"paid,omitempty"`
+	PaidCurrency    *string          `protobuf:"bytes,12,opt,name=paidCurrency,proto3,oneof" json:"paidCurrency,omitempty"`
+	PaidAmountMin   *float64         `protobuf:"fixed64,13,opt,name=paidAmountMin,proto3,oneof" json:"paidAmountMin,omitempty"`
+}
+
+func (x *EventChatMessageReceived) Reset() {
+	*x = EventChatMessageReceived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventChatMessageReceived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventChatMessageReceived) ProtoMessage() {}
+
+func (x *EventChatMessageReceived) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventChatMessageReceived.ProtoReflect.Descriptor instead.
+func (*EventChatMessageReceived) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *EventChatMessageReceived) GetPlatID() string {
+	if x != nil && x.PlatID != nil {
+		return *x.PlatID
+	}
+	return ""
+}
+
+func (x *EventChatMessageReceived) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *EventChatMessageReceived) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *EventChatMessageReceived) GetMessageID() string {
+	if x != nil && x.MessageID != nil {
+		return *x.MessageID
+	}
+	return ""
+}
+
+func (x *EventChatMessageReceived) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *EventChatMessageReceived) GetMessageContains() string {
+	if x != nil && x.MessageContains != nil {
+		return *x.MessageContains
+	}
+	return ""
+}
+
+func (x *EventChatMessageReceived) GetMessageRegex() string {
+	if x != nil && x.MessageRegex != nil {
+		return *x.MessageRegex
+	}
+	return ""
+}
+
+func (x *EventChatMessageReceived) GetRoles() []ChatUserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *EventChatMessageReceived) GetBadges() []*ChatBadge {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+func (x *EventChatMessageReceived) GetIsPaid() bool {
+	if x != nil && x.IsPaid != nil {
+		return *x.IsPaid
+	}
+	return false
+}
+
+func (x *EventChatMessageReceived) GetPaid() *ChatMessagePaid {
+	if x != nil {
+		return x.Paid
+	}
+	return nil
+}
+
+func (x *EventChatMessageReceived) GetPaidCurrency() string {
+	if x != nil && x.PaidCurrency != nil {
+		return *x.PaidCurrency
+	}
+	return ""
+}
+
+func (x *EventChatMessageReceived) GetPaidAmountMin() float64 {
+	if x != nil && x.PaidAmountMin != nil {
+		return *x.PaidAmountMin
+	}
+	return 0
+}
+
+type EventStreamStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID *string `protobuf:"bytes,1,opt,name=platID,proto3,oneof" json:"platID,omitempty"`
+}
+
+func (x *EventStreamStarted) Reset() {
+	*x = EventStreamStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStreamStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamStarted) ProtoMessage() {}
+
+func (x *EventStreamStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamStarted.ProtoReflect.Descriptor instead.
+func (*EventStreamStarted) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *EventStreamStarted) GetPlatID() string {
+	if x != nil && x.PlatID != nil {
+		return *x.PlatID
+	}
+	return ""
+}
+
+type EventStreamEnded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID *string `protobuf:"bytes,1,opt,name=platID,proto3,oneof" json:"platID,omitempty"`
+}
+
+func (x *EventStreamEnded) Reset() {
+	*x = EventStreamEnded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStreamEnded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamEnded) ProtoMessage() {}
+
+func (x *EventStreamEnded) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamEnded.ProtoReflect.Descriptor instead.
+func (*EventStreamEnded) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *EventStreamEnded) GetPlatID() string {
+	if x != nil && x.PlatID != nil {
+		return *x.PlatID
+	}
+	return ""
+}
+
+type EventViewerCountChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID               *string `protobuf:"bytes,1,opt,name=platID,proto3,oneof" json:"platID,omitempty"`
+	ViewersCount         *uint64 `protobuf:"varint,2,opt,name=viewersCount,proto3,oneof" json:"viewersCount,omitempty"`
+	PreviousViewersCount *uint64 `protobuf:"varint,3,opt,name=previousViewersCount,proto3,oneof" json:"previousViewersCount,omitempty"`
+	ViewersCountMin      *uint64 `protobuf:"varint,4,opt,name=viewersCountMin,proto3,oneof" json:"viewersCountMin,omitempty"`
+	ViewersCountMax      *uint64 `protobuf:"varint,5,opt,name=viewersCountMax,proto3,oneof" json:"viewersCountMax,omitempty"`
+	CrossedAbove         *uint64 `protobuf:"varint,6,opt,name=crossedAbove,proto3,oneof" json:"crossedAbove,omitempty"`
+	CrossedBelow         *uint64 `protobuf:"varint,7,opt,name=crossedBelow,proto3,oneof" json:"crossedBelow,omitempty"`
+}
+
+func (x *EventViewerCountChanged) Reset() {
+	*x = EventViewerCountChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventViewerCountChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventViewerCountChanged) ProtoMessage() {}
+
+func (x *EventViewerCountChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventViewerCountChanged.ProtoReflect.Descriptor instead.
+func (*EventViewerCountChanged) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *EventViewerCountChanged) GetPlatID() string {
+	if x != nil && x.PlatID != nil {
+		return *x.PlatID
+	}
+	return ""
+}
+
+func (x *EventViewerCountChanged) GetViewersCount() uint64 {
+	if x != nil && x.ViewersCount != nil {
+		return *x.ViewersCount
+	}
+	return 0
+}
+
+func (x *EventViewerCountChanged) GetPreviousViewersCount() uint64 {
+	if x != nil && x.PreviousViewersCount != nil {
+		return *x.PreviousViewersCount
+	}
+	return 0
+}
+
+func (x *EventViewerCountChanged) GetViewersCountMin() uint64 {
+	if x != nil && x.ViewersCountMin != nil {
+		return *x.ViewersCountMin
+	}
+	return 0
+}
+
+func (x *EventViewerCountChanged) GetViewersCountMax() uint64 {
+	if x != nil && x.ViewersCountMax != nil {
+		return *x.ViewersCountMax
+	}
+	return 0
+}
+
+func (x *EventViewerCountChanged) GetCrossedAbove() uint64 {
+	if x != nil && x.CrossedAbove != nil {
+		return *x.CrossedAbove
+	}
+	return 0
+}
+
+func (x *EventViewerCountChanged) GetCrossedBelow() uint64 {
+	if x != nil && x.CrossedBelow != nil {
+		return *x.CrossedBelow
+	}
+	return 0
+}
+
+type EventIncomingStreamPublished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID *string `protobuf:"bytes,1,opt,name=streamID,proto3,oneof" json:"streamID,omitempty"`
+}
+
+func (x *EventIncomingStreamPublished) Reset() {
+	*x = EventIncomingStreamPublished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventIncomingStreamPublished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventIncomingStreamPublished) ProtoMessage() {}
+
+func (x *EventIncomingStreamPublished) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventIncomingStreamPublished.ProtoReflect.Descriptor instead.
+func (*EventIncomingStreamPublished) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *EventIncomingStreamPublished) GetStreamID() string {
+	if x != nil && x.StreamID != nil {
+		return *x.StreamID
+	}
+	return ""
+}
+
+type EventIncomingStreamUnpublished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID *string `protobuf:"bytes,1,opt,name=streamID,proto3,oneof" json:"streamID,omitempty"`
+}
+
+func (x *EventIncomingStreamUnpublished) Reset() {
+	*x = EventIncomingStreamUnpublished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventIncomingStreamUnpublished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventIncomingStreamUnpublished) ProtoMessage() {}
+
+func (x *EventIncomingStreamUnpublished) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventIncomingStreamUnpublished.ProtoReflect.Descriptor instead.
+func (*EventIncomingStreamUnpublished) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *EventIncomingStreamUnpublished) GetStreamID() string {
+	if x != nil && x.StreamID != nil {
+		return *x.StreamID
+	}
+	return ""
+}
+
+type EventStreamForwardStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID      *string `protobuf:"bytes,1,opt,name=streamID,proto3,oneof" json:"streamID,omitempty"`
+	DestinationID *string `protobuf:"bytes,2,opt,name=destinationID,proto3,oneof" json:"destinationID,omitempty"`
+}
+
+func (x *EventStreamForwardStarted) Reset() {
+	*x = EventStreamForwardStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStreamForwardStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamForwardStarted) ProtoMessage() {}
+
+func (x *EventStreamForwardStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamForwardStarted.ProtoReflect.Descriptor instead.
+func (*EventStreamForwardStarted) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *EventStreamForwardStarted) GetStreamID() string {
+	if x != nil && x.StreamID != nil {
+		return *x.StreamID
+	}
+	return ""
+}
+
+func (x *EventStreamForwardStarted) GetDestinationID() string {
+	if x != nil && x.DestinationID != nil {
+		return *x.DestinationID
+	}
+	return ""
+}
+
+type EventIncomingStreamAuthFailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID   *string `protobuf:"bytes,1,opt,name=streamID,proto3,oneof" json:"streamID,omitempty"`
+	Action     *string `protobuf:"bytes,2,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	RemoteAddr *string `protobuf:"bytes,3,opt,name=remoteAddr,proto3,oneof" json:"remoteAddr,omitempty"`
+}
+
+func (x *EventIncomingStreamAuthFailed) Reset() {
+	*x = EventIncomingStreamAuthFailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventIncomingStreamAuthFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventIncomingStreamAuthFailed) ProtoMessage() {}
+
+func (x *EventIncomingStreamAuthFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventIncomingStreamAuthFailed.ProtoReflect.Descriptor instead.
+func (*EventIncomingStreamAuthFailed) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *EventIncomingStreamAuthFailed) GetStreamID() string {
+	if x != nil && x.StreamID != nil {
+		return *x.StreamID
+	}
+	return ""
+}
+
+func (x *EventIncomingStreamAuthFailed) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *EventIncomingStreamAuthFailed) GetRemoteAddr() string {
+	if x != nil && x.RemoteAddr != nil {
+		return *x.RemoteAddr
+	}
+	return ""
+}
+
+type EventStreamForwardFailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID      *string `protobuf:"bytes,1,opt,name=streamID,proto3,oneof" json:"streamID,omitempty"`
+	DestinationID *string `protobuf:"bytes,2,opt,name=destinationID,proto3,oneof" json:"destinationID,omitempty"`
+	Error         *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	ErrorContains *string `protobuf:"bytes,4,opt,name=errorContains,proto3,oneof" json:"errorContains,omitempty"`
+}
+
+func (x *EventStreamForwardFailed) Reset() {
+	*x = EventStreamForwardFailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStreamForwardFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamForwardFailed) ProtoMessage() {}
+
+func (x *EventStreamForwardFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamForwardFailed.ProtoReflect.Descriptor instead.
+func (*EventStreamForwardFailed) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *EventStreamForwardFailed) GetStreamID() string {
+	if x != nil && x.StreamID != nil {
+		return *x.StreamID
+	}
+	return ""
+}
+
+func (x *EventStreamForwardFailed) GetDestinationID() string {
+	if x != nil && x.DestinationID != nil {
+		return *x.DestinationID
+	}
+	return ""
+}
+
+func (x *EventStreamForwardFailed) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *EventStreamForwardFailed) GetErrorContains() string {
+	if x != nil && x.ErrorContains != nil {
+		return *x.ErrorContains
+	}
+	return ""
+}
+
+type EventStreamForwardSourceSwitched struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID      *string `protobuf:"bytes,1,opt,name=streamID,proto3,oneof" json:"streamID,omitempty"`
+	DestinationID *string `protobuf:"bytes,2,opt,name=destinationID,proto3,oneof" json:"destinationID,omitempty"`
+	Source        *string `protobuf:"bytes,3,opt,name=source,proto3,oneof" json:"source,omitempty"`
+	IsFallback    *bool   `protobuf:"varint,4,opt,name=isFallback,proto3,oneof" json:"isFallback,omitempty"`
+}
+
+func (x *EventStreamForwardSourceSwitched) Reset() {
+	*x = EventStreamForwardSourceSwitched{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStreamForwardSourceSwitched) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamForwardSourceSwitched) ProtoMessage() {}
+
+func (x *EventStreamForwardSourceSwitched) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamForwardSourceSwitched.ProtoReflect.Descriptor instead.
+func (*EventStreamForwardSourceSwitched) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *EventStreamForwardSourceSwitched) GetStreamID() string {
+	if x != nil && x.StreamID != nil {
+		return *x.StreamID
+	}
+	return ""
+}
+
+func (x *EventStreamForwardSourceSwitched) GetDestinationID() string {
+	if x != nil && x.DestinationID != nil {
+		return *x.DestinationID
+	}
+	return ""
+}
+
+func (x *EventStreamForwardSourceSwitched) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
+}
+
+func (x *EventStreamForwardSourceSwitched) GetIsFallback() bool {
+	if x != nil && x.IsFallback != nil {
+		return *x.IsFallback
+	}
+	return false
+}
+
+type EventStreamForwardFallbackStopped struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID      *string `protobuf:"bytes,1,opt,name=streamID,proto3,oneof" json:"streamID,omitempty"`
+	DestinationID *string `protobuf:"bytes,2,opt,name=destinationID,proto3,oneof" json:"destinationID,omitempty"`
+	Fallback      *string `protobuf:"bytes,3,opt,name=fallback,proto3,oneof" json:"fallback,omitempty"`
+	Reason        *string `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+}
+
+func (x *EventStreamForwardFallbackStopped) Reset() {
+	*x = EventStreamForwardFallbackStopped{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventStreamForwardFallbackStopped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamForwardFallbackStopped) ProtoMessage() {}
+
+func (x *EventStreamForwardFallbackStopped) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamForwardFallbackStopped.ProtoReflect.Descriptor instead.
+func (*EventStreamForwardFallbackStopped) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *EventStreamForwardFallbackStopped) GetStreamID() string {
+	if x != nil && x.StreamID != nil {
+		return *x.StreamID
+	}
+	return ""
+}
+
+func (x *EventStreamForwardFallbackStopped) GetDestinationID() string {
+	if x != nil && x.DestinationID != nil {
+		return *x.DestinationID
+	}
+	return ""
+}
+
+func (x *EventStreamForwardFallbackStopped) GetFallback() string {
+	if x != nil && x.Fallback != nil {
+		return *x.Fallback
+	}
+	return ""
+}
+
+func (x *EventStreamForwardFallbackStopped) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type EventTwitchFollow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"userID,omitempty"`
+	Username *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+}
+
+func (x *EventTwitchFollow) Reset() {
+	*x = EventTwitchFollow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTwitchFollow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTwitchFollow) ProtoMessage() {}
+
+func (x *EventTwitchFollow) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTwitchFollow.ProtoReflect.Descriptor instead.
+func (*EventTwitchFollow) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *EventTwitchFollow) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *EventTwitchFollow) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+type EventTwitchSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID           *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"userID,omitempty"`
+	Username         *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	Tier             *string `protobuf:"bytes,3,opt,name=tier,proto3,oneof" json:"tier,omitempty"`
+	IsGift           *bool   `protobuf:"varint,4,opt,name=isGift,proto3,oneof" json:"isGift,omitempty"`
+	CumulativeMonths *uint64 `protobuf:"varint,5,opt,name=cumulativeMonths,proto3,oneof" json:"cumulativeMonths,omitempty"`
+	Message          *string `protobuf:"bytes,6,opt,name=message,proto3,oneof" json:"message,omitempty"`
+}
+
+func (x *EventTwitchSubscription) Reset() {
+	*x = EventTwitchSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTwitchSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTwitchSubscription) ProtoMessage() {}
+
+func (x *EventTwitchSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTwitchSubscription.ProtoReflect.Descriptor instead.
+func (*EventTwitchSubscription) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *EventTwitchSubscription) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *EventTwitchSubscription) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *EventTwitchSubscription) GetTier() string {
+	if x != nil && x.Tier != nil {
+		return *x.Tier
+	}
+	return ""
+}
+
+func (x *EventTwitchSubscription) GetIsGift() bool {
+	if x != nil && x.IsGift != nil {
+		return *x.IsGift
+	}
+	return false
+}
+
+func (x *EventTwitchSubscription) GetCumulativeMonths() uint64 {
+	if x != nil && x.CumulativeMonths != nil {
+		return *x.CumulativeMonths
+	}
+	return 0
+}
+
+func (x *EventTwitchSubscription) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+type EventTwitchSubscriptionGift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"userID,omitempty"`
+	Username    *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	Tier        *string `protobuf:"bytes,3,opt,name=tier,proto3,oneof" json:"tier,omitempty"`
+	Total       *uint64 `protobuf:"varint,4,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	IsAnonymous *bool   `protobuf:"varint,5,opt,name=isAnonymous,proto3,oneof" json:"isAnonymous,omitempty"`
+	TotalMin    *uint64 `protobuf:"varint,6,opt,name=totalMin,proto3,oneof" json:"totalMin,omitempty"`
+}
+
+func (x *EventTwitchSubscriptionGift) Reset() {
+	*x = EventTwitchSubscriptionGift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[206]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTwitchSubscriptionGift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTwitchSubscriptionGift) ProtoMessage() {}
+
+func (x *EventTwitchSubscriptionGift) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[206]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTwitchSubscriptionGift.ProtoReflect.Descriptor instead.
+func (*EventTwitchSubscriptionGift) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *EventTwitchSubscriptionGift) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *EventTwitchSubscriptionGift) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *EventTwitchSubscriptionGift) GetTier() string {
+	if x != nil && x.Tier != nil {
+		return *x.Tier
+	}
+	return ""
+}
+
+func (x *EventTwitchSubscriptionGift) GetTotal() uint64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *EventTwitchSubscriptionGift) GetIsAnonymous() bool {
+	if x != nil && x.IsAnonymous != nil {
+		return *x.IsAnonymous
+	}
+	return false
+}
+
+func (x *EventTwitchSubscriptionGift) GetTotalMin() uint64 {
+	if x != nil && x.TotalMin != nil {
+		return *x.TotalMin
+	}
+	return 0
+}
+
+type EventTwitchCheer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"userID,omitempty"`
+	Username    *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	IsAnonymous *bool   `protobuf:"varint,3,opt,name=isAnonymous,proto3,oneof" json:"isAnonymous,omitempty"`
+	Bits        *uint64 `protobuf:"varint,4,opt,name=bits,proto3,oneof" json:"bits,omitempty"`
+	Message     *string `protobuf:"bytes,5,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	BitsMin     *uint64 `protobuf:"varint,6,opt,name=bitsMin,proto3,oneof" json:"bitsMin,omitempty"`
+}
+
+func (x *EventTwitchCheer) Reset() {
+	*x = EventTwitchCheer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[207]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTwitchCheer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTwitchCheer) ProtoMessage() {}
+
+func (x *EventTwitchCheer) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[207]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTwitchCheer.ProtoReflect.Descriptor instead.
+func (*EventTwitchCheer) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *EventTwitchCheer) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *EventTwitchCheer) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *EventTwitchCheer) GetIsAnonymous() bool {
+	if x != nil && x.IsAnonymous != nil {
+		return *x.IsAnonymous
+	}
+	return false
+}
+
+func (x *EventTwitchCheer) GetBits() uint64 {
+	if x != nil && x.Bits != nil {
+		return *x.Bits
+	}
+	return 0
+}
+
+func (x *EventTwitchCheer) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *EventTwitchCheer) GetBitsMin() uint64 {
+	if x != nil && x.BitsMin != nil {
+		return *x.BitsMin
+	}
+	return 0
+}
+
+type EventTwitchRaid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromUserID   *string `protobuf:"bytes,1,opt,name=fromUserID,proto3,oneof" json:"fromUserID,omitempty"`
+	FromUsername *string `protobuf:"bytes,2,opt,name=fromUsername,proto3,oneof" json:"fromUsername,omitempty"`
+	Viewers      *uint64 `protobuf:"varint,3,opt,name=viewers,proto3,oneof" json:"viewers,omitempty"`
+	ViewersMin   *uint64 `protobuf:"varint,4,opt,name=viewersMin,proto3,oneof" json:"viewersMin,omitempty"`
+}
+
+func (x *EventTwitchRaid) Reset() {
+	*x = EventTwitchRaid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[208]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTwitchRaid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTwitchRaid) ProtoMessage() {}
+
+func (x *EventTwitchRaid) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[208]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTwitchRaid.ProtoReflect.Descriptor instead.
+func (*EventTwitchRaid) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *EventTwitchRaid) GetFromUserID() string {
+	if x != nil && x.FromUserID != nil {
+		return *x.FromUserID
+	}
+	return ""
+}
+
+func (x *EventTwitchRaid) GetFromUsername() string {
+	if x != nil && x.FromUsername != nil {
+		return *x.FromUsername
+	}
+	return ""
+}
+
+func (x *EventTwitchRaid) GetViewers() uint64 {
+	if x != nil && x.Viewers != nil {
+		return *x.Viewers
+	}
+	return 0
+}
+
+func (x *EventTwitchRaid) GetViewersMin() uint64 {
+	if x != nil && x.ViewersMin != nil {
+		return *x.ViewersMin
+	}
+	return 0
+}
+
+type EventTwitchChannelPointsRedemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      *string `protobuf:"bytes,1,opt,name=userID,proto3,oneof" json:"userID,omitempty"`
+	Username    *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	RewardID    *string `protobuf:"bytes,3,opt,name=rewardID,proto3,oneof" json:"rewardID,omitempty"`
+	RewardTitle *string `protobuf:"bytes,4,opt,name=rewardTitle,proto3,oneof" json:"rewardTitle,omitempty"`
+	RewardCost  *uint64 `protobuf:"varint,5,opt,name=rewardCost,proto3,oneof" json:"rewardCost,omitempty"`
+	UserInput   *string `protobuf:"bytes,6,opt,name=userInput,proto3,oneof" json:"userInput,omitempty"`
+}
+
+func (x *EventTwitchChannelPointsRedemption) Reset() {
+	*x = EventTwitchChannelPointsRedemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[209]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTwitchChannelPointsRedemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTwitchChannelPointsRedemption) ProtoMessage() {}
+
+func (x *EventTwitchChannelPointsRedemption) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[209]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTwitchChannelPointsRedemption.ProtoReflect.Descriptor instead.
+func (*EventTwitchChannelPointsRedemption) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *EventTwitchChannelPointsRedemption) GetUserID() string {
+	if x != nil && x.UserID != nil {
+		return *x.UserID
+	}
+	return ""
+}
+
+func (x *EventTwitchChannelPointsRedemption) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *EventTwitchChannelPointsRedemption) GetRewardID() string {
+	if x != nil && x.RewardID != nil {
+		return *x.RewardID
+	}
+	return ""
+}
+
+func (x *EventTwitchChannelPointsRedemption) GetRewardTitle() string {
+	if x != nil && x.RewardTitle != nil {
+		return *x.RewardTitle
+	}
+	return ""
+}
+
+func (x *EventTwitchChannelPointsRedemption) GetRewardCost() uint64 {
+	if x != nil && x.RewardCost != nil {
+		return *x.RewardCost
+	}
+	return 0
+}
+
+func (x *EventTwitchChannelPointsRedemption) GetUserInput() string {
+	if x != nil && x.UserInput != nil {
+		return *x.UserInput
+	}
+	return ""
+}
+
+type EventTwitchPoll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PollID        *string `protobuf:"bytes,1,opt,name=pollID,proto3,oneof" json:"pollID,omitempty"`
+	Title         *string `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Stage         *string `protobuf:"bytes,3,opt,name=stage,proto3,oneof" json:"stage,omitempty"`
+	WinningChoice *string `protobuf:"bytes,4,opt,name=winningChoice,proto3,oneof" json:"winningChoice,omitempty"`
+}
+
+func (x *EventTwitchPoll) Reset() {
+	*x = EventTwitchPoll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[210]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTwitchPoll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTwitchPoll) ProtoMessage() {}
+
+func (x *EventTwitchPoll) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[210]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTwitchPoll.ProtoReflect.Descriptor instead.
+func (*EventTwitchPoll) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *EventTwitchPoll) GetPollID() string {
+	if x != nil && x.PollID != nil {
+		return *x.PollID
+	}
+	return ""
+}
+
+func (x *EventTwitchPoll) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *EventTwitchPoll) GetStage() string {
+	if x != nil && x.Stage != nil {
+		return *x.Stage
+	}
+	return ""
+}
+
+func (x *EventTwitchPoll) GetWinningChoice() string {
+	if x != nil && x.WinningChoice != nil {
+		return *x.WinningChoice
+	}
+	return ""
+}
+
+type EventTwitchPrediction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PredictionID   *string `protobuf:"bytes,1,opt,name=predictionID,proto3,oneof" json:"predictionID,omitempty"`
+	Title          *string `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Stage          *string `protobuf:"bytes,3,opt,name=stage,proto3,oneof" json:"stage,omitempty"`
+	WinningOutcome *string `protobuf:"bytes,4,opt,name=winningOutcome,proto3,oneof" json:"winningOutcome,omitempty"`
+}
+
+func (x *EventTwitchPrediction) Reset() {
+	*x = EventTwitchPrediction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[211]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTwitchPrediction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTwitchPrediction) ProtoMessage() {}
+
+func (x *EventTwitchPrediction) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[211]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventTwitchPrediction.ProtoReflect.Descriptor instead.
+func (*EventTwitchPrediction) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *EventTwitchPrediction) GetPredictionID() string {
+	if x != nil && x.PredictionID != nil {
+		return *x.PredictionID
+	}
+	return ""
+}
+
+func (x *EventTwitchPrediction) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *EventTwitchPrediction) GetStage() string {
+	if x != nil && x.Stage != nil {
+		return *x.Stage
+	}
+	return ""
+}
+
+func (x *EventTwitchPrediction) GetWinningOutcome() string {
+	if x != nil && x.WinningOutcome != nil {
+		return *x.WinningOutcome
+	}
+	return ""
+}
+
+type EventQuery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to EventQueryOneOf:
+	//
+	//	*EventQuery_And
+	//	*EventQuery_Or
+	//	*EventQuery_Not
+	//	*EventQuery_EventType
+	//	*EventQuery_Event
+	//	*EventQuery_EventField
+	//	*EventQuery_StreamIsActive
+	//	*EventQuery_VariableEquals
+	EventQueryOneOf isEventQuery_EventQueryOneOf `protobuf_oneof:"EventQueryOneOf"`
+}
+
+func (x *EventQuery) Reset() {
+	*x = EventQuery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[212]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventQuery) ProtoMessage() {}
+
+func (x *EventQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[212]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventQuery.ProtoReflect.Descriptor instead.
+func (*EventQuery) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{212}
+}
+
+func (m *EventQuery) GetEventQueryOneOf() isEventQuery_EventQueryOneOf {
+	if m != nil {
+		return m.EventQueryOneOf
+	}
+	return nil
+}
+
+func (x *EventQuery) GetAnd() *EventQueryAnd {
+	if x, ok := x.GetEventQueryOneOf().(*EventQuery_And); ok {
+		return x.And
+	}
+	return nil
+}
+
+func (x *EventQuery) GetOr() *EventQueryOr {
+	if x, ok := x.GetEventQueryOneOf().(*EventQuery_Or); ok {
+		return x.Or
+	}
+	return nil
+}
+
+func (x *EventQuery) GetNot() *EventQueryNot {
+	if x, ok := x.GetEventQueryOneOf().(*EventQuery_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
+func (x *EventQuery) GetEventType() EventType {
+	if x, ok := x.GetEventQueryOneOf().(*EventQuery_EventType); ok {
+		return x.EventType
+	}
+	return EventType_eventWindowFocusChange
+}
+
+func (x *EventQuery) GetEvent() *Event {
+	if x, ok := x.GetEventQueryOneOf().(*EventQuery_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventQuery) GetEventField() *EventQueryEventField {
+	if x, ok := x.GetEventQueryOneOf().(*EventQuery_EventField); ok {
+		return x.EventField
+	}
+	return nil
+}
+
+func (x *EventQuery) GetStreamIsActive() *EventQueryStreamIsActive {
+	if x, ok := x.GetEventQueryOneOf().(*EventQuery_StreamIsActive); ok {
+		return x.StreamIsActive
+	}
+	return nil
+}
+
+func (x *EventQuery) GetVariableEquals() *EventQueryVariableEquals {
+	if x, ok := x.GetEventQueryOneOf().(*EventQuery_VariableEquals); ok {
+		return x.VariableEquals
+	}
+	return nil
+}
+
+type isEventQuery_EventQueryOneOf interface {
+	isEventQuery_EventQueryOneOf()
+}
+
+type EventQuery_And struct {
+	And *EventQueryAnd `protobuf:"bytes,1,opt,name=and,proto3,oneof"`
+}
+
+type EventQuery_Or struct {
+	Or *EventQueryOr `protobuf:"bytes,2,opt,name=or,proto3,oneof"`
+}
+
+type EventQuery_Not struct {
+	Not *EventQueryNot `protobuf:"bytes,3,opt,name=not,proto3,oneof"`
+}
+
+type EventQuery_EventType struct {
+	EventType EventType `protobuf:"varint,4,opt,name=eventType,proto3,enum=streamd.EventType,oneof"`
+}
+
+type EventQuery_Event struct {
+	Event *Event `protobuf:"bytes,5,opt,name=event,proto3,oneof"`
+}
+
+type EventQuery_EventField struct {
+	EventField *EventQueryEventField `protobuf:"bytes,6,opt,name=eventField,proto3,oneof"`
+}
+
+type EventQuery_StreamIsActive struct {
+	StreamIsActive *EventQueryStreamIsActive `protobuf:"bytes,7,opt,name=streamIsActive,proto3,oneof"`
+}
+
+type EventQuery_VariableEquals struct {
+	VariableEquals *EventQueryVariableEquals `protobuf:"bytes,8,opt,name=variableEquals,proto3,oneof"`
+}
+
+func (*EventQuery_And) isEventQuery_EventQueryOneOf() {}
+
+func (*EventQuery_Or) isEventQuery_EventQueryOneOf() {}
+
+func (*EventQuery_Not) isEventQuery_EventQueryOneOf() {}
+
+func (*EventQuery_EventType) isEventQuery_EventQueryOneOf() {}
+
+func (*EventQuery_Event) isEventQuery_EventQueryOneOf() {}
+
+func (*EventQuery_EventField) isEventQuery_EventQueryOneOf() {}
+
+func (*EventQuery_StreamIsActive) isEventQuery_EventQueryOneOf() {}
+
+func (*EventQuery_VariableEquals) isEventQuery_EventQueryOneOf() {}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to EventOneOf:
+	//
+	//	*Event_ObsSceneChange
+	//	*Event_WindowFocusChange
+	//	*Event_ChatMessageReceived
+	//	*Event_StreamStarted
+	//	*Event_StreamEnded
+	//	*Event_ViewerCountChanged
+	//	*Event_IncomingStreamPublished
+	//	*Event_IncomingStreamUnpublished
+	//	*Event_StreamForwardStarted
+	//	*Event_StreamForwardFailed
+	//	*Event_IncomingStreamAuthFailed
+	//	*Event_StreamForwardSourceSwitched
+	//	*Event_TwitchFollow
+	//	*Event_TwitchSubscription
+	//	*Event_TwitchSubscriptionGift
+	//	*Event_TwitchCheer
+	//	*Event_TwitchRaid
+	//	*Event_TwitchChannelPointsRedemption
+	//	*Event_TwitchPoll
+	//	*Event_TwitchPrediction
+	//	*Event_StreamForwardFallbackStopped
+	EventOneOf isEvent_EventOneOf `protobuf_oneof:"EventOneOf"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[213]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[213]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{213}
+}
+
+func (m *Event) GetEventOneOf() isEvent_EventOneOf {
+	if m != nil {
+		return m.EventOneOf
+	}
+	return nil
+}
+
+func (x *Event) GetObsSceneChange() *EventOBSSceneChange {
+	if x, ok := x.GetEventOneOf().(*Event_ObsSceneChange); ok {
+		return x.ObsSceneChange
+	}
+	return nil
+}
+
+func (x *Event) GetWindowFocusChange() *EventWindowFocusChange {
+	if x, ok := x.GetEventOneOf().(*Event_WindowFocusChange); ok {
+		return x.WindowFocusChange
+	}
+	return nil
+}
+
+func (x *Event) GetChatMessageReceived() *EventChatMessageReceived {
+	if x, ok := x.GetEventOneOf().(*Event_ChatMessageReceived); ok {
+		return x.ChatMessageReceived
+	}
+	return nil
+}
+
+func (x *Event) GetStreamStarted() *EventStreamStarted {
+	if x, ok := x.GetEventOneOf().(*Event_StreamStarted); ok {
+		return x.StreamStarted
+	}
+	return nil
+}
+
+func (x *Event) GetStreamEnded() *EventStreamEnded {
+	if x, ok := x.GetEventOneOf().(*Event_StreamEnded); ok {
+		return x.StreamEnded
+	}
+	return nil
+}
+
+func (x *Event) GetViewerCountChanged() *EventViewerCountChanged {
+	if x, ok := x.GetEventOneOf().(*Event_ViewerCountChanged); ok {
+		return x.ViewerCountChanged
+	}
+	return nil
+}
+
+func (x *Event) GetIncomingStreamPublished() *EventIncomingStreamPublished {
+	if x, ok := x.GetEventOneOf().(*Event_IncomingStreamPublished); ok {
+		return x.IncomingStreamPublished
+	}
+	return nil
+}
+
+func (x *Event) GetIncomingStreamUnpublished() *EventIncomingStreamUnpublished {
+	if x, ok := x.GetEventOneOf().(*Event_IncomingStreamUnpublished); ok {
+		return x.IncomingStreamUnpublished
+	}
+	return nil
+}
+
+func (x *Event) GetStreamForwardStarted() *EventStreamForwardStarted {
+	if x, ok := x.GetEventOneOf().(*Event_StreamForwardStarted); ok {
+		return x.StreamForwardStarted
+	}
+	return nil
+}
+
+func (x *Event) GetStreamForwardFailed() *EventStreamForwardFailed {
+	if x, ok := x.GetEventOneOf().(*Event_StreamForwardFailed); ok {
+		return x.StreamForwardFailed
+	}
+	return nil
+}
+
+func (x *Event) GetIncomingStreamAuthFailed() *EventIncomingStreamAuthFailed {
+	if x, ok := x.GetEventOneOf().(*Event_IncomingStreamAuthFailed); ok {
+		return x.IncomingStreamAuthFailed
+	}
+	return nil
+}
+
+func (x *Event) GetStreamForwardSourceSwitched() *EventStreamForwardSourceSwitched {
+	if x, ok := x.GetEventOneOf().(*Event_StreamForwardSourceSwitched); ok {
+		return x.StreamForwardSourceSwitched
+	}
+	return nil
+}
+
+func (x *Event) GetTwitchFollow() *EventTwitchFollow {
+	if x, ok := x.GetEventOneOf().(*Event_TwitchFollow); ok {
+		return x.TwitchFollow
+	}
+	return nil
+}
+
+func (x *Event) GetTwitchSubscription() *EventTwitchSubscription {
+	if x, ok := x.GetEventOneOf().(*Event_TwitchSubscription); ok {
+		return x.TwitchSubscription
+	}
+	return nil
+}
+
+func (x *Event) GetTwitchSubscriptionGift() *EventTwitchSubscriptionGift {
+	if x, ok := x.GetEventOneOf().(*Event_TwitchSubscriptionGift); ok {
+		return x.TwitchSubscriptionGift
+	}
+	return nil
+}
+
+func (x *Event) GetTwitchCheer() *EventTwitchCheer {
+	if x, ok := x.GetEventOneOf().(*Event_TwitchCheer); ok {
+		return x.TwitchCheer
+	}
+	return nil
+}
+
+func (x *Event) GetTwitchRaid() *EventTwitchRaid {
+	if x, ok := x.GetEventOneOf().(*Event_TwitchRaid); ok {
+		return x.TwitchRaid
+	}
+	return nil
+}
+
+func (x *Event) GetTwitchChannelPointsRedemption() *EventTwitchChannelPointsRedemption {
+	if x, ok := x.GetEventOneOf().(*Event_TwitchChannelPointsRedemption); ok {
+		return x.TwitchChannelPointsRedemption
+	}
+	return nil
+}
+
+func (x *Event) GetTwitchPoll() *EventTwitchPoll {
+	if x, ok := x.GetEventOneOf().(*Event_TwitchPoll); ok {
+		return x.TwitchPoll
+	}
+	return nil
+}
+
+func (x *Event) GetTwitchPrediction() *EventTwitchPrediction {
+	if x, ok := x.GetEventOneOf().(*Event_TwitchPrediction); ok {
+		return x.TwitchPrediction
+	}
+	return nil
+}
+
+func (x *Event) GetStreamForwardFallbackStopped() *EventStreamForwardFallbackStopped {
+	if x, ok := x.GetEventOneOf().(*Event_StreamForwardFallbackStopped); ok {
+		return x.StreamForwardFallbackStopped
+	}
+	return nil
+}
+
+type isEvent_EventOneOf interface {
+	isEvent_EventOneOf()
+}
+
+type Event_ObsSceneChange struct {
+	ObsSceneChange *EventOBSSceneChange `protobuf:"bytes,1,opt,name=obsSceneChange,proto3,oneof"`
+}
+
+type Event_WindowFocusChange struct {
+	WindowFocusChange *EventWindowFocusChange `protobuf:"bytes,2,opt,name=windowFocusChange,proto3,oneof"`
+}
+
+type Event_ChatMessageReceived struct {
+	ChatMessageReceived *EventChatMessageReceived `protobuf:"bytes,3,opt,name=chatMessageReceived,proto3,oneof"`
+}
+
+type Event_StreamStarted struct {
+	StreamStarted *EventStreamStarted `protobuf:"bytes,4,opt,name=streamStarted,proto3,oneof"`
+}
+
+type Event_StreamEnded struct {
+	StreamEnded *EventStreamEnded `protobuf:"bytes,5,opt,name=streamEnded,proto3,oneof"`
+}
+
+type Event_ViewerCountChanged struct {
+	ViewerCountChanged *EventViewerCountChanged `protobuf:"bytes,6,opt,name=viewerCountChanged,proto3,oneof"`
+}
+
+type Event_IncomingStreamPublished struct {
+	IncomingStreamPublished *EventIncomingStreamPublished `protobuf:"bytes,7,opt,name=incomingStreamPublished,proto3,oneof"`
+}
+
+type Event_IncomingStreamUnpublished struct {
+	IncomingStreamUnpublished *EventIncomingStreamUnpublished `protobuf:"bytes,8,opt,name=incomingStreamUnpublished,proto3,oneof"`
+}
+
+type Event_StreamForwardStarted struct {
+	StreamForwardStarted *EventStreamForwardStarted `protobuf:"bytes,9,opt,name=streamForwardStarted,proto3,oneof"`
+}
+
+type Event_StreamForwardFailed struct {
+	StreamForwardFailed *EventStreamForwardFailed `protobuf:"bytes,10,opt,name=streamForwardFailed,proto3,oneof"`
+}
+
+type Event_IncomingStreamAuthFailed struct {
+	IncomingStreamAuthFailed *EventIncomingStreamAuthFailed `protobuf:"bytes,11,opt,name=incomingStreamAuthFailed,proto3,oneof"`
+}
+
+type Event_StreamForwardSourceSwitched struct {
+	StreamForwardSourceSwitched *EventStreamForwardSourceSwitched `protobuf:"bytes,12,opt,name=streamForwardSourceSwitched,proto3,oneof"`
+}
+
+type Event_TwitchFollow struct {
+	TwitchFollow *EventTwitchFollow `protobuf:"bytes,13,opt,name=twitchFollow,proto3,oneof"`
+}
+
+type Event_TwitchSubscription struct {
+	TwitchSubscription *EventTwitchSubscription `protobuf:"bytes,14,opt,name=twitchSubscription,proto3,oneof"`
+}
+
+type Event_TwitchSubscriptionGift struct {
+	TwitchSubscriptionGift *EventTwitchSubscriptionGift `protobuf:"bytes,15,opt,name=twitchSubscriptionGift,proto3,oneof"`
+}
+
+type Event_TwitchCheer struct {
+	TwitchCheer *EventTwitchCheer `protobuf:"bytes,16,opt,name=twitchCheer,proto3,oneof"`
+}
+
+type Event_TwitchRaid struct {
+	TwitchRaid *EventTwitchRaid `protobuf:"bytes,17,opt,name=twitchRaid,proto3,oneof"`
+}
+
+type Event_TwitchChannelPointsRedemption struct {
+	TwitchChannelPointsRedemption *EventTwitchChannelPointsRedemption `protobuf:"bytes,18,opt,name=twitchChannelPointsRedemption,proto3,oneof"`
+}
+
+type Event_TwitchPoll struct {
+	TwitchPoll *EventTwitchPoll `protobuf:"bytes,19,opt,name=twitchPoll,proto3,oneof"`
+}
+
+type Event_TwitchPrediction struct {
+	TwitchPrediction *EventTwitchPrediction `protobuf:"bytes,20,opt,name=twitchPrediction,proto3,oneof"`
+}
+
+type Event_StreamForwardFallbackStopped struct {
+	StreamForwardFallbackStopped *EventStreamForwardFallbackStopped `protobuf:"bytes,21,opt,name=streamForwardFallbackStopped,proto3,oneof"`
+}
+
+func (*Event_ObsSceneChange) isEvent_EventOneOf() {}
+
+func (*Event_WindowFocusChange) isEvent_EventOneOf() {}
+
+func (*Event_ChatMessageReceived) isEvent_EventOneOf() {}
+
+func (*Event_StreamStarted) isEvent_EventOneOf() {}
+
+func (*Event_StreamEnded) isEvent_EventOneOf() {}
+
+func (*Event_ViewerCountChanged) isEvent_EventOneOf() {}
+
+func (*Event_IncomingStreamPublished) isEvent_EventOneOf() {}
+
+func (*Event_IncomingStreamUnpublished) isEvent_EventOneOf() {}
+
+func (*Event_StreamForwardStarted) isEvent_EventOneOf() {}
+
+func (*Event_StreamForwardFailed) isEvent_EventOneOf() {}
+
+func (*Event_IncomingStreamAuthFailed) isEvent_EventOneOf() {}
+
+func (*Event_StreamForwardSourceSwitched) isEvent_EventOneOf() {}
+
+func (*Event_TwitchFollow) isEvent_EventOneOf() {}
+
+func (*Event_TwitchSubscription) isEvent_EventOneOf() {}
+
+func (*Event_TwitchSubscriptionGift) isEvent_EventOneOf() {}
+
+func (*Event_TwitchCheer) isEvent_EventOneOf() {}
+
+func (*Event_TwitchRaid) isEvent_EventOneOf() {}
+
+func (*Event_TwitchChannelPointsRedemption) isEvent_EventOneOf() {}
+
+func (*Event_TwitchPoll) isEvent_EventOneOf() {}
+
+func (*Event_TwitchPrediction) isEvent_EventOneOf() {}
+
+func (*Event_StreamForwardFallbackStopped) isEvent_EventOneOf() {}
+
+type TriggerRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string      `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
+	EventQuery  *EventQuery `protobuf:"bytes,2,opt,name=eventQuery,proto3" json:"eventQuery,omitempty"`
+	Action      *Action     `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *TriggerRule) Reset() {
+	*x = TriggerRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[214]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRule) ProtoMessage() {}
+
+func (x *TriggerRule) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[214]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRule.ProtoReflect.Descriptor instead.
+func (*TriggerRule) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *TriggerRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TriggerRule) GetEventQuery() *EventQuery {
+	if x != nil {
+		return x.EventQuery
+	}
+	return nil
+}
+
+func (x *TriggerRule) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type ListTriggerRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTriggerRulesRequest) Reset() {
+	*x = ListTriggerRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[215]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTriggerRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggerRulesRequest) ProtoMessage() {}
+
+func (x *ListTriggerRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[215]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggerRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListTriggerRulesRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{215}
+}
+
+type ListTriggerRulesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*TriggerRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ListTriggerRulesReply) Reset() {
+	*x = ListTriggerRulesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[216]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTriggerRulesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggerRulesReply) ProtoMessage() {}
+
+func (x *ListTriggerRulesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[216]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggerRulesReply.ProtoReflect.Descriptor instead.
+func (*ListTriggerRulesReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *ListTriggerRulesReply) GetRules() []*TriggerRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type AddTriggerRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *TriggerRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *AddTriggerRuleRequest) Reset() {
+	*x = AddTriggerRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[217]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTriggerRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTriggerRuleRequest) ProtoMessage() {}
+
+func (x *AddTriggerRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[217]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTriggerRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddTriggerRuleRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *AddTriggerRuleRequest) GetRule() *TriggerRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type AddTriggerRuleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleID uint64 `protobuf:"varint,1,opt,name=ruleID,proto3" json:"ruleID,omitempty"`
+}
+
+func (x *AddTriggerRuleReply) Reset() {
+	*x = AddTriggerRuleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[218]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTriggerRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTriggerRuleReply) ProtoMessage() {}
+
+func (x *AddTriggerRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[218]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTriggerRuleReply.ProtoReflect.Descriptor instead.
+func (*AddTriggerRuleReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *AddTriggerRuleReply) GetRuleID() uint64 {
+	if x != nil {
+		return x.RuleID
+	}
+	return 0
+}
+
+type RemoveTriggerRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleID uint64 `protobuf:"varint,1,opt,name=ruleID,proto3" json:"ruleID,omitempty"`
+}
+
+func (x *RemoveTriggerRuleRequest) Reset() {
+	*x = RemoveTriggerRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[219]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTriggerRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTriggerRuleRequest) ProtoMessage() {}
+
+func (x *RemoveTriggerRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[219]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTriggerRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTriggerRuleRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *RemoveTriggerRuleRequest) GetRuleID() uint64 {
+	if x != nil {
+		return x.RuleID
+	}
+	return 0
+}
+
+type RemoveTriggerRuleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveTriggerRuleReply) Reset() {
+	*x = RemoveTriggerRuleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[220]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTriggerRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTriggerRuleReply) ProtoMessage() {}
+
+func (x *RemoveTriggerRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[220]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTriggerRuleReply.ProtoReflect.Descriptor instead.
+func (*RemoveTriggerRuleReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{220}
+}
+
+type UpdateTriggerRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleID uint64       `protobuf:"varint,1,opt,name=ruleID,proto3" json:"ruleID,omitempty"`
+	Rule   *TriggerRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *UpdateTriggerRuleRequest) Reset() {
+	*x = UpdateTriggerRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[221]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTriggerRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTriggerRuleRequest) ProtoMessage() {}
+
+func (x *UpdateTriggerRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[221]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTriggerRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTriggerRuleRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *UpdateTriggerRuleRequest) GetRuleID() uint64 {
+	if x != nil {
+		return x.RuleID
+	}
+	return 0
+}
+
+func (x *UpdateTriggerRuleRequest) GetRule() *TriggerRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateTriggerRuleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateTriggerRuleReply) Reset() {
+	*x = UpdateTriggerRuleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[222]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTriggerRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTriggerRuleReply) ProtoMessage() {}
+
+func (x *UpdateTriggerRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[222]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTriggerRuleReply.ProtoReflect.Descriptor instead.
+func (*UpdateTriggerRuleReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{222}
+}
+
+type TriggerRuleExecution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RuleID            uint64  `protobuf:"varint,1,opt,name=ruleID,proto3" json:"ruleID,omitempty"`
+	Event             *Event  `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Action            *Action `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	StartedAtUnixNano int64   `protobuf:"varint,4,opt,name=startedAtUnixNano,proto3" json:"startedAtUnixNano,omitempty"`
+	EndedAtUnixNano   int64   `protobuf:"varint,5,opt,name=endedAtUnixNano,proto3" json:"endedAtUnixNano,omitempty"`
+	Error             *string `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *TriggerRuleExecution) Reset() {
+	*x = TriggerRuleExecution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[223]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerRuleExecution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRuleExecution) ProtoMessage() {}
+
+func (x *TriggerRuleExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[223]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRuleExecution.ProtoReflect.Descriptor instead.
+func (*TriggerRuleExecution) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *TriggerRuleExecution) GetRuleID() uint64 {
+	if x != nil {
+		return x.RuleID
+	}
+	return 0
+}
+
+func (x *TriggerRuleExecution) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TriggerRuleExecution) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *TriggerRuleExecution) GetStartedAtUnixNano() int64 {
+	if x != nil {
+		return x.StartedAtUnixNano
+	}
+	return 0
+}
+
+func (x *TriggerRuleExecution) GetEndedAtUnixNano() int64 {
+	if x != nil {
+		return x.EndedAtUnixNano
+	}
+	return 0
+}
+
+func (x *TriggerRuleExecution) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type ListTriggerRuleExecutionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTriggerRuleExecutionsRequest) Reset() {
+	*x = ListTriggerRuleExecutionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[224]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTriggerRuleExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggerRuleExecutionsRequest) ProtoMessage() {}
+
+func (x *ListTriggerRuleExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[224]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggerRuleExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTriggerRuleExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{224}
+}
+
+type ListTriggerRuleExecutionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Executions []*TriggerRuleExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+}
+
+func (x *ListTriggerRuleExecutionsReply) Reset() {
+	*x = ListTriggerRuleExecutionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[225]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTriggerRuleExecutionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTriggerRuleExecutionsReply) ProtoMessage() {}
+
+func (x *ListTriggerRuleExecutionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[225]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTriggerRuleExecutionsReply.ProtoReflect.Descriptor instead.
+func (*ListTriggerRuleExecutionsReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *ListTriggerRuleExecutionsReply) GetExecutions() []*TriggerRuleExecution {
+	if x != nil {
+		return x.Executions
+	}
+	return nil
+}
+
+type EvaluatedExpression struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field      string  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Expression string  `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	Value      string  `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Error      *string `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *EvaluatedExpression) Reset() {
+	*x = EvaluatedExpression{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[226]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluatedExpression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatedExpression) ProtoMessage() {}
+
+func (x *EvaluatedExpression) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[226]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatedExpression.ProtoReflect.Descriptor instead.
+func (*EvaluatedExpression) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *EvaluatedExpression) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *EvaluatedExpression) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *EvaluatedExpression) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *EvaluatedExpression) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type TriggerRuleTestAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action      *Action                `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Expressions []*EvaluatedExpression `protobuf:"bytes,2,rep,name=expressions,proto3" json:"expressions,omitempty"`
+}
+
+func (x *TriggerRuleTestAction) Reset() {
+	*x = TriggerRuleTestAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[227]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerRuleTestAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRuleTestAction) ProtoMessage() {}
+
+func (x *TriggerRuleTestAction) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[227]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRuleTestAction.ProtoReflect.Descriptor instead.
+func (*TriggerRuleTestAction) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *TriggerRuleTestAction) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *TriggerRuleTestAction) GetExpressions() []*EvaluatedExpression {
+	if x != nil {
+		return x.Expressions
+	}
+	return nil
+}
+
+type TestTriggerRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule  *TriggerRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Event *Event       `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *TestTriggerRuleRequest) Reset() {
+	*x = TestTriggerRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[228]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestTriggerRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestTriggerRuleRequest) ProtoMessage() {}
+
+func (x *TestTriggerRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[228]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestTriggerRuleRequest.ProtoReflect.Descriptor instead.
+func (*TestTriggerRuleRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *TestTriggerRuleRequest) GetRule() *TriggerRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *TestTriggerRuleRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type TestTriggerRuleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matched bool                     `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Actions []*TriggerRuleTestAction `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *TestTriggerRuleReply) Reset() {
+	*x = TestTriggerRuleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[229]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestTriggerRuleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestTriggerRuleReply) ProtoMessage() {}
+
+func (x *TestTriggerRuleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[229]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestTriggerRuleReply.ProtoReflect.Descriptor instead.
+func (*TestTriggerRuleReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *TestTriggerRuleReply) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *TestTriggerRuleReply) GetActions() []*TriggerRuleTestAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type SubmitEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *SubmitEventRequest) Reset() {
+	*x = SubmitEventRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[230]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitEventRequest) ProtoMessage() {}
+
+func (x *SubmitEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[230]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitEventRequest.ProtoReflect.Descriptor instead.
+func (*SubmitEventRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *SubmitEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type SubmitEventReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubmitEventReply) Reset() {
+	*x = SubmitEventReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[231]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitEventReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitEventReply) ProtoMessage() {}
+
+func (x *SubmitEventReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[231]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitEventReply.ProtoReflect.Descriptor instead.
+func (*SubmitEventReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{231}
+}
+
+type SubscribeToChatMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeToChatMessagesRequest) Reset() {
+	*x = SubscribeToChatMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[232]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeToChatMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeToChatMessagesRequest) ProtoMessage() {}
+
+func (x *SubscribeToChatMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[232]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeToChatMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeToChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{232}
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAtNano    uint64                 `protobuf:"varint,1,opt,name=createdAtNano,proto3" json:"createdAtNano,omitempty"`
+	PlatID           string                 `protobuf:"bytes,2,opt,name=platID,proto3" json:"platID,omitempty"`
+	UserID           string                 `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Username         string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	MessageID        string                 `protobuf:"bytes,5,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	Message          string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Roles            []ChatUserRole         `protobuf:"varint,7,rep,packed,name=roles,proto3,enum=streamd.ChatUserRole" json:"roles,omitempty"`
+	Badges           []*ChatBadge           `protobuf:"bytes,8,rep,name=badges,proto3" json:"badges,omitempty"`
+	Color            string                 `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	Fragments        []*ChatMessageFragment `protobuf:"bytes,10,rep,name=fragments,proto3" json:"fragments,omitempty"`
+	ReplyToMessageID string                 `protobuf:"bytes,11,opt,name=replyToMessageID,proto3" json:"replyToMessageID,omitempty"`
+	Paid             *ChatMessagePaid       `protobuf:"bytes,12,opt,name=paid,proto3,oneof" json:"paid,omitempty"`
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[233]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[233]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *ChatMessage) GetCreatedAtNano() uint64 {
+	if x != nil {
+		return x.CreatedAtNano
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetPlatID() string {
+	if x != nil {
+		return x.PlatID
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetRoles() []ChatUserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetBadges() []*ChatBadge {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetFragments() []*ChatMessageFragment {
+	if x != nil {
+		return x.Fragments
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetReplyToMessageID() string {
+	if x != nil {
+		return x.ReplyToMessageID
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetPaid() *ChatMessagePaid {
+	if x != nil {
+		return x.Paid
+	}
+	return nil
+}
+
+type ChatBadge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ChatBadge) Reset() {
+	*x = ChatBadge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[234]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatBadge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatBadge) ProtoMessage() {}
+
+func (x *ChatBadge) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[234]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatBadge.ProtoReflect.Descriptor instead.
+func (*ChatBadge) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *ChatBadge) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *ChatBadge) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ChatMessageFragment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    ChatMessageFragmentType `protobuf:"varint,1,opt,name=type,proto3,enum=streamd.ChatMessageFragmentType" json:"type,omitempty"`
+	Text    string                  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	EmoteID string                  `protobuf:"bytes,3,opt,name=emoteID,proto3" json:"emoteID,omitempty"`
+}
+
+func (x *ChatMessageFragment) Reset() {
+	*x = ChatMessageFragment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[235]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessageFragment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessageFragment) ProtoMessage() {}
+
+func (x *ChatMessageFragment) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[235]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessageFragment.ProtoReflect.Descriptor instead.
+func (*ChatMessageFragment) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *ChatMessageFragment) GetType() ChatMessageFragmentType {
+	if x != nil {
+		return x.Type
+	}
+	return ChatMessageFragmentType_chatMessageFragmentTypeUndefined
+}
+
+func (x *ChatMessageFragment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessageFragment) GetEmoteID() string {
+	if x != nil {
+		return x.EmoteID
+	}
+	return ""
+}
+
+type ChatMessagePaid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount      float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency    string  `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ChatMessagePaid) Reset() {
+	*x = ChatMessagePaid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[236]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessagePaid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessagePaid) ProtoMessage() {}
+
+func (x *ChatMessagePaid) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[236]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessagePaid.ProtoReflect.Descriptor instead.
+func (*ChatMessagePaid) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *ChatMessagePaid) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ChatMessagePaid) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ChatMessagePaid) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SendChatMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID  string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SendChatMessageRequest) Reset() {
+	*x = SendChatMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[237]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageRequest) ProtoMessage() {}
+
+func (x *SendChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[237]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *SendChatMessageRequest) GetPlatID() string {
+	if x != nil {
+		return x.PlatID
+	}
+	return ""
+}
+
+func (x *SendChatMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SendChatMessageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendChatMessageReply) Reset() {
+	*x = SendChatMessageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[238]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendChatMessageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatMessageReply) ProtoMessage() {}
+
+func (x *SendChatMessageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[238]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatMessageReply.ProtoReflect.Descriptor instead.
+func (*SendChatMessageReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{238}
+}
+
+type RemoveChatMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID    string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	MessageID string `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
+}
+
+func (x *RemoveChatMessageRequest) Reset() {
+	*x = RemoveChatMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[239]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveChatMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveChatMessageRequest) ProtoMessage() {}
+
+func (x *RemoveChatMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[239]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveChatMessageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveChatMessageRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *RemoveChatMessageRequest) GetPlatID() string {
+	if x != nil {
+		return x.PlatID
+	}
+	return ""
+}
+
+func (x *RemoveChatMessageRequest) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+type RemoveChatMessageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveChatMessageReply) Reset() {
+	*x = RemoveChatMessageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[240]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveChatMessageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveChatMessageReply) ProtoMessage() {}
+
+func (x *RemoveChatMessageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[240]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveChatMessageReply.ProtoReflect.Descriptor instead.
+func (*RemoveChatMessageReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{240}
+}
+
+type BanUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID           string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	UserID           string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Reason           string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	DeadlineUnixNano *int64 `protobuf:"varint,4,opt,name=deadlineUnixNano,proto3,oneof" json:"deadlineUnixNano,omitempty"`
+}
+
+func (x *BanUserRequest) Reset() {
+	*x = BanUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[241]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserRequest) ProtoMessage() {}
+
+func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[241]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *BanUserRequest) GetPlatID() string {
+	if x != nil {
+		return x.PlatID
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BanUserRequest) GetDeadlineUnixNano() int64 {
+	if x != nil && x.DeadlineUnixNano != nil {
+		return *x.DeadlineUnixNano
+	}
+	return 0
+}
+
+type BanUserReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BanUserReply) Reset() {
+	*x = BanUserReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[242]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanUserReply) ProtoMessage() {}
+
+func (x *BanUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[242]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanUserReply.ProtoReflect.Descriptor instead.
+func (*BanUserReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{242}
+}
+
+type AddModeratorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *AddModeratorRequest) Reset() {
+	*x = AddModeratorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[243]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddModeratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddModeratorRequest) ProtoMessage() {}
+
+func (x *AddModeratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[243]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddModeratorRequest.ProtoReflect.Descriptor instead.
+func (*AddModeratorRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *AddModeratorRequest) GetPlatID() string {
+	if x != nil {
+		return x.PlatID
+	}
+	return ""
+}
+
+func (x *AddModeratorRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type AddModeratorReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddModeratorReply) Reset() {
+	*x = AddModeratorReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[244]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddModeratorReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddModeratorReply) ProtoMessage() {}
+
+func (x *AddModeratorReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[244]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddModeratorReply.ProtoReflect.Descriptor instead.
+func (*AddModeratorReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{244}
+}
+
+type RemoveModeratorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *RemoveModeratorRequest) Reset() {
+	*x = RemoveModeratorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[245]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveModeratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveModeratorRequest) ProtoMessage() {}
+
+func (x *RemoveModeratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[245]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveModeratorRequest.ProtoReflect.Descriptor instead.
+func (*RemoveModeratorRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *RemoveModeratorRequest) GetPlatID() string {
+	if x != nil {
+		return x.PlatID
+	}
+	return ""
+}
+
+func (x *RemoveModeratorRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type RemoveModeratorReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveModeratorReply) Reset() {
+	*x = RemoveModeratorReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[246]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveModeratorReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveModeratorReply) ProtoMessage() {}
+
+func (x *RemoveModeratorReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[246]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveModeratorReply.ProtoReflect.Descriptor instead.
+func (*RemoveModeratorReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{246}
+}
+
+type InsertAdsCuePointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlatID       string `protobuf:"bytes,1,opt,name=platID,proto3" json:"platID,omitempty"`
+	TsUnixNano   int64  `protobuf:"varint,2,opt,name=tsUnixNano,proto3" json:"tsUnixNano,omitempty"`
+	DurationNano int64  `protobuf:"varint,3,opt,name=durationNano,proto3" json:"durationNano,omitempty"`
+}
+
+func (x *InsertAdsCuePointRequest) Reset() {
+	*x = InsertAdsCuePointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[247]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertAdsCuePointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertAdsCuePointRequest) ProtoMessage() {}
+
+func (x *InsertAdsCuePointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[247]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertAdsCuePointRequest.ProtoReflect.Descriptor instead.
+func (*InsertAdsCuePointRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{247}
+}
+
+func (x *InsertAdsCuePointRequest) GetPlatID() string {
+	if x != nil {
+		return x.PlatID
+	}
+	return ""
+}
+
+func (x *InsertAdsCuePointRequest) GetTsUnixNano() int64 {
+	if x != nil {
+		return x.TsUnixNano
+	}
+	return 0
+}
+
+func (x *InsertAdsCuePointRequest) GetDurationNano() int64 {
+	if x != nil {
+		return x.DurationNano
+	}
+	return 0
+}
+
+type InsertAdsCuePointReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InsertAdsCuePointReply) Reset() {
+	*x = InsertAdsCuePointReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[248]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertAdsCuePointReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertAdsCuePointReply) ProtoMessage() {}
+
+func (x *InsertAdsCuePointReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[248]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertAdsCuePointReply.ProtoReflect.Descriptor instead.
+func (*InsertAdsCuePointReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{248}
+}
+
+type TwitchStreamMarker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	CreatedAtUnixNano int64  `protobuf:"varint,2,opt,name=createdAtUnixNano,proto3" json:"createdAtUnixNano,omitempty"`
+	Description       string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PositionNano      int64  `protobuf:"varint,4,opt,name=positionNano,proto3" json:"positionNano,omitempty"`
+}
+
+func (x *TwitchStreamMarker) Reset() {
+	*x = TwitchStreamMarker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[249]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwitchStreamMarker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwitchStreamMarker) ProtoMessage() {}
+
+func (x *TwitchStreamMarker) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[249]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwitchStreamMarker.ProtoReflect.Descriptor instead.
+func (*TwitchStreamMarker) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{249}
+}
+
+func (x *TwitchStreamMarker) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *TwitchStreamMarker) GetCreatedAtUnixNano() int64 {
+	if x != nil {
+		return x.CreatedAtUnixNano
+	}
+	return 0
+}
+
+func (x *TwitchStreamMarker) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TwitchStreamMarker) GetPositionNano() int64 {
+	if x != nil {
+		return x.PositionNano
+	}
+	return 0
+}
+
+type TwitchCreateStreamMarkerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *TwitchCreateStreamMarkerRequest) Reset() {
+	*x = TwitchCreateStreamMarkerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[250]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwitchCreateStreamMarkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwitchCreateStreamMarkerRequest) ProtoMessage() {}
+
+func (x *TwitchCreateStreamMarkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[250]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwitchCreateStreamMarkerRequest.ProtoReflect.Descriptor instead.
+func (*TwitchCreateStreamMarkerRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{250}
+}
+
+func (x *TwitchCreateStreamMarkerRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type TwitchCreateStreamMarkerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Marker *TwitchStreamMarker `protobuf:"bytes,1,opt,name=marker,proto3" json:"marker,omitempty"`
+}
+
+func (x *TwitchCreateStreamMarkerReply) Reset() {
+	*x = TwitchCreateStreamMarkerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[251]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwitchCreateStreamMarkerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwitchCreateStreamMarkerReply) ProtoMessage() {}
+
+func (x *TwitchCreateStreamMarkerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[251]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwitchCreateStreamMarkerReply.ProtoReflect.Descriptor instead.
+func (*TwitchCreateStreamMarkerReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{251}
+}
+
+func (x *TwitchCreateStreamMarkerReply) GetMarker() *TwitchStreamMarker {
+	if x != nil {
+		return x.Marker
+	}
+	return nil
+}
+
+type TwitchAdSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextAdAtUnixNano        int64  `protobuf:"varint,1,opt,name=nextAdAtUnixNano,proto3" json:"nextAdAtUnixNano,omitempty"`
+	LastAdAtUnixNano        int64  `protobuf:"varint,2,opt,name=lastAdAtUnixNano,proto3" json:"lastAdAtUnixNano,omitempty"`
+	DurationNano            int64  `protobuf:"varint,3,opt,name=durationNano,proto3" json:"durationNano,omitempty"`
+	PrerollFreeTimeNano     int64  `protobuf:"varint,4,opt,name=prerollFreeTimeNano,proto3" json:"prerollFreeTimeNano,omitempty"`
+	SnoozeCount             uint64 `protobuf:"varint,5,opt,name=snoozeCount,proto3" json:"snoozeCount,omitempty"`
+	SnoozeRefreshAtUnixNano int64  `protobuf:"varint,6,opt,name=snoozeRefreshAtUnixNano,proto3" json:"snoozeRefreshAtUnixNano,omitempty"`
+}
+
+func (x *TwitchAdSchedule) Reset() {
+	*x = TwitchAdSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[252]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwitchAdSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwitchAdSchedule) ProtoMessage() {}
+
+func (x *TwitchAdSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[252]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwitchAdSchedule.ProtoReflect.Descriptor instead.
+func (*TwitchAdSchedule) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *TwitchAdSchedule) GetNextAdAtUnixNano() int64 {
+	if x != nil {
+		return x.NextAdAtUnixNano
+	}
+	return 0
+}
+
+func (x *TwitchAdSchedule) GetLastAdAtUnixNano() int64 {
+	if x != nil {
+		return x.LastAdAtUnixNano
+	}
+	return 0
+}
+
+func (x *TwitchAdSchedule) GetDurationNano() int64 {
+	if x != nil {
+		return x.DurationNano
+	}
+	return 0
+}
+
+func (x *TwitchAdSchedule) GetPrerollFreeTimeNano() int64 {
+	if x != nil {
+		return x.PrerollFreeTimeNano
+	}
+	return 0
+}
+
+func (x *TwitchAdSchedule) GetSnoozeCount() uint64 {
+	if x != nil {
+		return x.SnoozeCount
+	}
+	return 0
+}
+
+func (x *TwitchAdSchedule) GetSnoozeRefreshAtUnixNano() int64 {
+	if x != nil {
+		return x.SnoozeRefreshAtUnixNano
+	}
+	return 0
+}
+
+type TwitchGetAdScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TwitchGetAdScheduleRequest) Reset() {
+	*x = TwitchGetAdScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[253]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwitchGetAdScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwitchGetAdScheduleRequest) ProtoMessage() {}
+
+func (x *TwitchGetAdScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[253]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwitchGetAdScheduleRequest.ProtoReflect.Descriptor instead.
+func (*TwitchGetAdScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{253}
+}
+
+type TwitchGetAdScheduleReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule *TwitchAdSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *TwitchGetAdScheduleReply) Reset() {
+	*x = TwitchGetAdScheduleReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[254]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwitchGetAdScheduleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwitchGetAdScheduleReply) ProtoMessage() {}
+
+func (x *TwitchGetAdScheduleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[254]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwitchGetAdScheduleReply.ProtoReflect.Descriptor instead.
+func (*TwitchGetAdScheduleReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *TwitchGetAdScheduleReply) GetSchedule() *TwitchAdSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GetPeerIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPeerIDsRequest) Reset() {
+	*x = GetPeerIDsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[255]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerIDsRequest) ProtoMessage() {}
+
+func (x *GetPeerIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[255]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetPeerIDsRequest) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{255}
+}
+
+type GetPeerIDsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerIDs []string `protobuf:"bytes,1,rep,name=peerIDs,proto3" json:"peerIDs,omitempty"`
+}
+
+func (x *GetPeerIDsReply) Reset() {
+	*x = GetPeerIDsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_streamd_proto_msgTypes[256]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerIDsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerIDsReply) ProtoMessage() {}
+
+func (x *GetPeerIDsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_streamd_proto_msgTypes[256]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerIDsReply.ProtoReflect.Descriptor instead.
+func (*GetPeerIDsReply) Descriptor() ([]byte, []int) {
+	return file_streamd_proto_rawDescGZIP(), []int{256}
+}
+
+func (x *GetPeerIDsReply) GetPeerIDs() []string {
+	if x != nil {
+		return x.PeerIDs
+	}
+	return nil
+}
+
+var File_streamd_proto protoreflect.FileDescriptor
+
+var file_streamd_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x1a, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x01,
+	0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54,
+	0x6f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x54, 0x6f, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f, 0x49, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x12, 0x38, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x25, 0x0a, 0x09, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0x53, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x6f, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x69,
+	0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x39, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0c,
+	0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x12, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2a, 0x0a, 0x10, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x10, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x11, 0x0a,
+	0x0f, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x0a, 0x10, 0x49, 0x6e, 0x69, 0x74,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x10, 0x0a, 0x0e,
 	0x49, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x7e,
 	0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01,
@@ -9510,7 +14839,7 @@ var file_streamd_proto_rawDesc = []byte{
 	0x4f, 0x66, 0x22, 0x37, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
 	0x12, 0x16, 0x0a, 0x05, 0x50, 0x4b, 0x43, 0x53, 0x38, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48,
 	0x00, 0x52, 0x05, 0x50, 0x4b, 0x43, 0x53, 0x38, 0x42, 0x11, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0x90, 0x03, 0x0a, 0x0c,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0xf0, 0x05, 0x0a, 0x0c,
 	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
@@ -9534,8 +14863,30 @@ var file_streamd_proto_rawDesc = []byte{
 	0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
 	0x79, 0x48, 0x01, 0x52, 0x09, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x88, 0x01,
-	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x22, 0x82,
+	0x01, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x52, 0x54, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x52, 0x54, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x48, 0x4c, 0x53, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e,
+	0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x48, 0x4c, 0x53, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x12,
+	0x28, 0x0a, 0x0f, 0x48, 0x4c, 0x53, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x48, 0x4c, 0x53, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x48, 0x4c, 0x53,
+	0x54, 0x65, 0x6d, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x48, 0x4c, 0x53, 0x54, 0x65, 0x6d, 0x70, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x16, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43, 0x49,
+	0x43, 0x45, 0x55, 0x44, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43, 0x49, 0x43, 0x45,
+	0x55, 0x44, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x36, 0x0a,
+	0x16, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43, 0x49, 0x43, 0x45, 0x54, 0x43, 0x50, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x57,
+	0x65, 0x62, 0x52, 0x54, 0x43, 0x49, 0x43, 0x45, 0x54, 0x43, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43, 0x41,
+	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x10,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43, 0x41, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x0b, 0x22, 0x82,
 	0x01, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
 	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x4e, 0x75, 0x6d,
 	0x42, 0x79, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x57, 0x72, 0x6f,
@@ -9572,1038 +14923,2018 @@ var file_streamd_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41, 0x64, 0x64,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x41,
 	0x64, 0x64, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x69, 0x0a, 0x11,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6f, 0x0a, 0x11,
 	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0x1f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x72, 0x65, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x1f, 0x0a,
+	0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69,
+	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a,
 	0x12, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x1b, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x54, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x1b, 0x41, 0x64, 0x64,
 	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x64,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x1b, 0x0a, 0x19,
+	0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x54, 0x0a, 0x1e, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x46, 0x0a, 0x1e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x2c, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22,
-	0x36, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x49, 0x6e,
-	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x39, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x1b, 0x0a, 0x19,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x23, 0x52, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x59, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x52, 0x65,
-	0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x46, 0x0a, 0x1e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x36, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x18, 0x0a,
+	0x16, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x39, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x0f, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x22, 0xd8, 0x01, 0x0a,
+	0x24, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x73, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x6f,
+	0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x62,
+	0x79, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x62, 0x79, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x22, 0xca, 0x01, 0x0a, 0x22, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65,
+	0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18,
 	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e,
-	0x73, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44,
-	0x65, 0x6c, 0x61, 0x79, 0x22, 0x3d, 0x0a, 0x21, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74,
-	0x65, 0x72, 0x59, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69,
-	0x7a, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x22, 0x8f, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x51, 0x75, 0x69, 0x72, 0x6b, 0x73, 0x12, 0x7e, 0x0a, 0x23, 0x72,
-	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x59, 0x6f, 0x75, 0x74, 0x75,
-	0x62, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x59,
-	0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x73,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x23, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55,
-	0x6e, 0x74, 0x69, 0x6c, 0x59, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67,
-	0x6e, 0x69, 0x7a, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x78, 0x0a, 0x21, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x59, 0x6f, 0x75, 0x74, 0x75, 0x62, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
-	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x59, 0x6f, 0x75, 0x74, 0x75,
-	0x62, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x21, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x59, 0x6f,
-	0x75, 0x74, 0x75, 0x62, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xa1, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x71, 0x75, 0x69, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x51, 0x75, 0x69, 0x72, 0x6b,
-	0x73, 0x52, 0x06, 0x71, 0x75, 0x69, 0x72, 0x6b, 0x73, 0x22, 0x63, 0x0a, 0x17, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x57, 0x72, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x75, 0x6d,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x6f, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x75,
-	0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x22, 0x8f,
-	0x01, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x2e,
-	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
-	0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x67, 0x0a,
-	0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4c, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4c, 0x0a, 0x1a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x4c, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4d,
-	0x0a, 0x1d, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x11, 0x0a,
-	0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72,
-	0x22, 0xb0, 0x02, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x62,
-	0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x4a, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x42, 0x75, 0x66, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-	0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x42, 0x75, 0x66, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x12,
-	0x34, 0x0a, 0x15, 0x43, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65,
-	0x65, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15,
-	0x43, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x46,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x13, 0x4d, 0x61, 0x78, 0x43, 0x61, 0x74, 0x63,
-	0x68, 0x75, 0x70, 0x41, 0x74, 0x4c, 0x61, 0x67, 0x53, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x13, 0x4d, 0x61, 0x78, 0x43, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x41, 0x74,
-	0x4c, 0x61, 0x67, 0x53, 0x65, 0x63, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
-	0x65, 0x63, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x53, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x52, 0x65,
-	0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x73, 0x12, 0x24, 0x0a,
-	0x0d, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e,
-	0x55, 0x52, 0x4c, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x79, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x62, 0x79, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x52, 0x4c, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x22, 0x96, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x51, 0x75, 0x69, 0x72, 0x6b, 0x73, 0x12, 0x81, 0x01, 0x0a,
+	0x24, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x73, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e,
+	0x69, 0x7a, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x24, 0x72, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x7b, 0x0a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69,
+	0x7a, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x63,
+	0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xa1, 0x01,
+	0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x71,
+	0x75, 0x69, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x51, 0x75, 0x69, 0x72, 0x6b, 0x73, 0x52, 0x06, 0x71, 0x75, 0x69, 0x72, 0x6b,
+	0x73, 0x22, 0xff, 0x03, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x6f, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72,
+	0x6f, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x32, 0x0a, 0x14, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6b,
+	0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4e,
+	0x61, 0x6e, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x13, 0x6c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61,
+	0x6e, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x1e, 0x0a,
+	0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x24, 0x0a,
+	0x0d, 0x68, 0x61, 0x73, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x67, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4c, 0x0a,
+	0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x41,
+	0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x4c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x1a, 0x0a,
+	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x4c, 0x0a, 0x1a, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0xc9, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x6b,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x22,
+	0xcf, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69,
+	0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69,
+	0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x22, 0x6b, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74,
 	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x15,
+	0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x32, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x38, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4d, 0x0a, 0x1d, 0x57, 0x61, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x22, 0xb0, 0x02, 0x0a, 0x14,
 	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4d, 0x0a, 0x16, 0x41, 0x64,
-	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x64,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x37, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x50, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f,
-	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22,
-	0x34, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x42, 0x75,
+	0x66, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x15, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x42, 0x75, 0x66, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x43, 0x61,
+	0x74, 0x63, 0x68, 0x75, 0x70, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x43, 0x61, 0x74, 0x63, 0x68,
+	0x75, 0x70, 0x4d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x30, 0x0a, 0x13, 0x4d, 0x61, 0x78, 0x43, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x41, 0x74,
+	0x4c, 0x61, 0x67, 0x53, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x4d,
+	0x61, 0x78, 0x43, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x41, 0x74, 0x4c, 0x61, 0x67, 0x53, 0x65,
+	0x63, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x55, 0x52, 0x4c, 0x22, 0xd4,
+	0x01, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x51, 0x0a, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4d, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x37, 0x0a, 0x19,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
 	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x50, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a,
 	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x22, 0x64, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x74, 0x0a, 0x1f, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x50, 0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x2f, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x6a, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46,
-	0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65,
-	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65,
-	0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
-	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6a, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x34, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
-	0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x64, 0x43, 0x68,
-	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x46, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a,
-	0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6a, 0x0a, 0x1a, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65,
+	0x22, 0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x64, 0x0a,
+	0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x70, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x74, 0x0a, 0x1f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x1d, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6a, 0x0a,
+	0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x18, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x6a, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46, 0x0a,
+	0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6a, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12,
+	0x30, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x46, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a,
+	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x72, 0x0a, 0x1e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a,
+	0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a,
+	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6e, 0x0a,
+	0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a,
+	0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x72,
+	0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6c, 0x0a, 0x1b, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65,
 	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65,
 	0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x49,
-	0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x45, 0x6e, 0x64,
-	0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x72,
-	0x0a, 0x1e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x6c, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4e, 0x0a, 0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x6e, 0x0a, 0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x32,
-	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4a, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x2c, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6c,
-	0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65,
-	0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x48, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x05,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x64, 0x0a, 0x17, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x40, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x66, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
 	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x19,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x53,
-	0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x65, 0x70,
-	0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
-	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x6c, 0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75,
-	0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x64,
-	0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74,
-	0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72,
+	0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x16, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x0a,
+	0x1f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x0e, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x22, 0x22, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x26, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x15, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2d, 0x0a, 0x2b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x22, 0x2a, 0x0a, 0x28, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a,
+	0x15, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x27,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x24, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a,
+	0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x28, 0x0a, 0x26, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x0d, 0x0a, 0x0b, 0x4e, 0x6f, 0x6f, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x15, 0x4f, 0x42, 0x53, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x6f, 0x77, 0x48, 0x69, 0x64,
+	0x65, 0x12, 0x1f, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x55, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69,
+	0x74, 0x65, 0x6d, 0x55, 0x55, 0x49, 0x44, 0x22, 0xa7, 0x01, 0x0a, 0x1f, 0x4f, 0x42, 0x53, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x69,
+	0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x69, 0x74, 0x65, 0x6d, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x55, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
+	0x0f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x55, 0x49,
+	0x44, 0x22, 0x4c, 0x0a, 0x18, 0x4f, 0x42, 0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x12, 0x30, 0x0a,
+	0x13, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x63, 0x65, 0x6e,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x96, 0x02, 0x0a, 0x09, 0x4f, 0x42, 0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a,
+	0x0c, 0x69, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x6f, 0x77, 0x48, 0x69, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4f, 0x42,
+	0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x6f, 0x77, 0x48,
+	0x69, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x6f, 0x77, 0x48,
+	0x69, 0x64, 0x65, 0x12, 0x62, 0x0a, 0x16, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4f, 0x42,
+	0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x61, 0x70,
+	0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52,
+	0x16, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65,
+	0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4f, 0x42, 0x53, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x65, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x4f, 0x42, 0x53, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0x6b, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x0a, 0x07, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x07, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3b, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0x3b, 0x0a, 0x0e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6c, 0x6c, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22,
+	0x31, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x22,
+	0x0a, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6e, 0x6f, 0x22, 0x86, 0x01, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x66, 0x12,
+	0x30, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x68, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x74, 0x68, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x65, 0x6c, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x65, 0x6c, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x15, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x0e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c,
+	0x61, 0x74, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x64,
+	0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x12, 0x34,
+	0x0a, 0x15, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x12, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74,
+	0x49, 0x44, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7b, 0x0a, 0x1d, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
-	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a,
-	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52,
-	0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x66, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x2e,
-	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42,
-	0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x21, 0x0a, 0x1f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
-	0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x26, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2d, 0x0a, 0x2b, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x28, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x54, 0x6f, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x29, 0x0a, 0x27, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x28,
-	0x0a, 0x26, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22,
-	0x0d, 0x0a, 0x0b, 0x4e, 0x6f, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9d,
-	0x01, 0x0a, 0x15, 0x4f, 0x42, 0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d,
-	0x53, 0x68, 0x6f, 0x77, 0x48, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x74,
-	0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x69, 0x74, 0x65,
-	0x6d, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x69,
-	0x74, 0x65, 0x6d, 0x55, 0x55, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d,
-	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x55, 0x49, 0x44, 0x22, 0xa7,
-	0x01, 0x0a, 0x1f, 0x4f, 0x42, 0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x1f, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x55, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x55, 0x55, 0x49,
-	0x44, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x69, 0x74, 0x65, 0x6d, 0x55, 0x55, 0x49, 0x44, 0x22, 0xc7, 0x01, 0x0a, 0x09, 0x4f, 0x42, 0x53,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x53, 0x68,
-	0x6f, 0x77, 0x48, 0x69, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4f, 0x42, 0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x6f, 0x77, 0x48, 0x69, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0c,
-	0x69, 0x74, 0x65, 0x6d, 0x53, 0x68, 0x6f, 0x77, 0x48, 0x69, 0x64, 0x65, 0x12, 0x62, 0x0a, 0x16,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4f, 0x42, 0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x16, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x53, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x42, 0x10, 0x0a, 0x0e, 0x4f, 0x42, 0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x65,
-	0x4f, 0x66, 0x22, 0x9d, 0x02, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
-	0x0b, 0x6e, 0x6f, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4e, 0x6f, 0x6f,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6e, 0x6f, 0x6f, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x48, 0x00, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x65,
-	0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x32, 0x0a, 0x09, 0x6f, 0x62, 0x73, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4f, 0x42, 0x53,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x62, 0x73, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x65,
-	0x6f, 0x66, 0x22, 0x68, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x11, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e,
-	0x61, 0x6e, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x0d,
-	0x41, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x12, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x78, 0x0a, 0x05, 0x54,
-	0x69, 0x6d, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2c,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x4f, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x0f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x64, 0x73, 0x43, 0x75, 0x65, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x62, 0x0a,
+	0x10, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4e, 0x61, 0x6e,
+	0x6f, 0x22, 0x56, 0x0a, 0x1e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x09, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x6e, 0x6f, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x4e, 0x6f, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x0b, 0x6e, 0x6f, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d,
+	0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a,
+	0x10, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x6f, 0x62, 0x73, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x4f, 0x42, 0x53, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x09, 0x6f, 0x62, 0x73, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x08,
+	0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x12, 0x2c, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x48, 0x00, 0x52,
+	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x66, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x66, 0x48, 0x00, 0x52, 0x02, 0x69, 0x66, 0x12, 0x4a, 0x0a, 0x0f, 0x73,
+	0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x08, 0x73, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x47,
+	0x0a, 0x0e, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x62, 0x0a, 0x17, 0x73, 0x65,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x17, 0x73, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e,
+	0x0a, 0x0b, 0x73, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x50,
+	0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x64, 0x73, 0x43, 0x75, 0x65, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74,
+	0x41, 0x64, 0x73, 0x43, 0x75, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x69,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x64, 0x73, 0x43, 0x75, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48,
+	0x00, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x65, 0x0a,
+	0x18, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x18, 0x74, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x6e,
+	0x65, 0x6f, 0x66, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x4d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x26, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x4d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6e, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x65, 0x73, 0x73, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x52, 0x0a, 0x1a, 0x54, 0x69, 0x6d, 0x65, 0x72,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0xdc, 0x01, 0x0a, 0x0d,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x72, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x64,
+	0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12,
+	0x59, 0x0a, 0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x0f, 0x41,
+	0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
 	0x0a, 0x11, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e,
-	0x61, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x72, 0x69, 0x67, 0x67,
 	0x65, 0x72, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x27, 0x0a, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x0f, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a,
-	0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x06, 0x74,
-	0x69, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x39, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x22, 0x38, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72,
-	0x12, 0x28, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x0d, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x22, 0x33, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x42, 0x53, 0x53, 0x63, 0x65,
-	0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x65,
-	0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xe0, 0x02, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x17, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x77, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x77,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x02, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0b, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x69, 0x73, 0x46,
-	0x6f, 0x63, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x09,
-	0x69, 0x73, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x49, 0x44, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x69, 0x74,
-	0x6c, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65,
-	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x69, 0x73, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x65, 0x64, 0x22, 0xfc, 0x01, 0x0a, 0x0a, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x03, 0x61, 0x6e, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6e, 0x64, 0x48, 0x00, 0x52,
-	0x03, 0x61, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x72, 0x12, 0x2a, 0x0a,
-	0x03, 0x6e, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e,
-	0x6f, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x48, 0x00, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a,
-	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0xae, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x46, 0x0a, 0x0e, 0x6f, 0x62, 0x73, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x42, 0x53, 0x53, 0x63, 0x65,
-	0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x62, 0x73, 0x53,
-	0x63, 0x65, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x77, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x46, 0x6f, 0x63, 0x75, 0x73,
-	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x46, 0x6f, 0x63, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0a, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x27, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73,
-	0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a,
-	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75,
-	0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x15, 0x41, 0x64, 0x64,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x2d, 0x0a, 0x13,
-	0x41, 0x64, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x32, 0x0a, 0x18, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x32, 0x0a,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x22, 0x29, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x12,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x22, 0x12, 0x0a, 0x10,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x84, 0x02, 0x0a, 0x05, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41,
+	0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61,
+	0x6e, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x14, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x14, 0x6d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x26, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52,
+	0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07,
+	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x12, 0x2d, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x22, 0x8f, 0x01, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x02, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72,
+	0x65, 0x67, 0x65, 0x78, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x6d, 0x61, 0x78, 0x22, 0x32, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x22, 0x42, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x33, 0x0a, 0x13,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x42, 0x53, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x65, 0x6e, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0xe0, 0x02, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x69, 0x73, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x09, 0x69, 0x73, 0x46, 0x6f, 0x63,
+	0x75, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x44, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x44, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x46, 0x6f, 0x63,
+	0x75, 0x73, 0x65, 0x64, 0x22, 0xa5, 0x05, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x68,
+	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d,
+	0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
+	0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x65, 0x78, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x67, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x43, 0x68,
+	0x61, 0x74, 0x42, 0x61, 0x64, 0x67, 0x65, 0x52, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x06, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x07, 0x52, 0x06, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x04,
+	0x70, 0x61, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x50, 0x61, 0x69, 0x64, 0x48, 0x08, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x27, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x69, 0x64, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x70, 0x61, 0x69, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x0a, 0x52, 0x0d, 0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x44, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x67, 0x65, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x61,
+	0x69, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70,
+	0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x22, 0x3c, 0x0a, 0x12,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x22, 0x3a, 0x0a, 0x10, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x22, 0xc7, 0x03, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12,
+	0x27, 0x0a, 0x0c, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0c, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x14, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0f, 0x76, 0x69,
+	0x65, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x2d, 0x0a, 0x0f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x0f, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12,
+	0x27, 0x0a, 0x0c, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x64, 0x41, 0x62, 0x6f, 0x76, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x0c, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x64,
+	0x41, 0x62, 0x6f, 0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x63, 0x72, 0x6f, 0x73,
+	0x73, 0x65, 0x64, 0x42, 0x65, 0x6c, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x06,
+	0x52, 0x0c, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x64, 0x42, 0x65, 0x6c, 0x6f, 0x77, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x17, 0x0a,
+	0x15, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x64, 0x41, 0x62, 0x6f, 0x76, 0x65, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x65, 0x64, 0x42, 0x65, 0x6c, 0x6f, 0x77,
+	0x22, 0x4c, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x12, 0x1f, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x4e,
+	0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x12, 0x1f, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x86,
+	0x01, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x08,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a,
+	0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xa9, 0x01, 0x0a, 0x1d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41,
+	0x75, 0x74, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x1f, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x88, 0x01,
+	0x01, 0x12, 0x29, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x22, 0xe9, 0x01,
+	0x0a, 0x20, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x69,
+	0x73, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x03, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69,
+	0x73, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0xe4, 0x01, 0x0a, 0x21, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x66,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x69, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xaa, 0x02, 0x0a, 0x17,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03,
+	0x52, 0x06, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x10, 0x63,
+	0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x10, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x69, 0x73, 0x47, 0x69, 0x66, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x1b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x69, 0x66, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x69, 0x73,
+	0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x04, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x88,
+	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74,
+	0x69, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x22, 0x97, 0x02, 0x0a, 0x10, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x65, 0x72, 0x12,
+	0x1b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0b, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x62, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x03, 0x52, 0x04, 0x62, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x62, 0x69, 0x74, 0x73, 0x4d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52,
+	0x07, 0x62, 0x69, 0x74, 0x73, 0x4d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d,
+	0x6f, 0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x69, 0x74,
+	0x73, 0x4d, 0x69, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x52, 0x61, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
+	0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x07, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73,
+	0x4d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0a, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x73, 0x4d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x73, 0x4d, 0x69, 0x6e, 0x22, 0xc4, 0x02, 0x0a, 0x22, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x43, 0x6f, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0xc0, 0x01, 0x0a,
+	0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x6c,
+	0x12, 0x1b, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x44, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0d, 0x77, 0x69,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22,
+	0xdb, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x67, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x77,
+	0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xd7, 0x03,
+	0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x03,
+	0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6e,
+	0x64, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x02, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x72, 0x48, 0x00, 0x52, 0x02, 0x6f,
+	0x72, 0x12, 0x2a, 0x0a, 0x03, 0x6e, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4e, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x6f, 0x74, 0x12, 0x32, 0x0a,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0a, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x0a,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x0e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x71, 0x75, 0x61,
+	0x6c, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0x91, 0x0e, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x46, 0x0a, 0x0e, 0x6f, 0x62, 0x73, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x42, 0x53, 0x53, 0x63, 0x65, 0x6e,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x62, 0x73, 0x53, 0x63,
+	0x65, 0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x11, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x46,
+	0x6f, 0x63, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x63, 0x68,
+	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x13, 0x63, 0x68,
+	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x12, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x56, 0x69, 0x65, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x12, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x61, 0x0a, 0x17, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x17, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x67, 0x0a, 0x19,
+	0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x6e,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x6e, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x48, 0x00, 0x52, 0x19, 0x69, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x6e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x58, 0x0a, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x55, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x13, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x64, 0x0a, 0x18, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x18, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x6d, 0x0a, 0x1b,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x64, 0x48, 0x00, 0x52, 0x1b,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x74,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x00, 0x52,
+	0x0c, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x52, 0x0a,
+	0x12, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x74,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5e, 0x0a, 0x16, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x69, 0x66, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x47, 0x69, 0x66, 0x74, 0x48, 0x00, 0x52, 0x16, 0x74, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x69, 0x66,
+	0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x65, 0x72,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x65, 0x72,
+	0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x61, 0x69, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x61, 0x69, 0x64, 0x48, 0x00,
+	0x52, 0x0a, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x61, 0x69, 0x64, 0x12, 0x73, 0x0a, 0x1d,
+	0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x1d, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x6c, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x6c, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x4c, 0x0a,
+	0x10, 0x74, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x65,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x74, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x70, 0x0a, 0x1c, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x1c, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x42, 0x0c, 0x0a,
+	0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0x8d, 0x01, 0x0a, 0x0b,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x15, 0x41,
+	0x64, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x2d,
+	0x0a, 0x13, 0x41, 0x64, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x32, 0x0a,
+	0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49,
+	0x44, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x5c, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x22,
-	0x18, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x5c, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x12,
+	0x28, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x28, 0x0a,
-	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x3a, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x12, 0x0a,
-	0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
-	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61,
-	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49,
-	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a,
-	0x16, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x6e,
-	0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x50, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x6c, 0x61, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x9e, 0x01,
-	0x0a, 0x0e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x10, 0x64, 0x65, 0x61, 0x64,
-	0x6c, 0x69, 0x6e, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x00, 0x52, 0x10, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x6e,
-	0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x64, 0x65,
-	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x0e,
-	0x0a, 0x0c, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73,
-	0x2a, 0x66, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x61,
-	0x74, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x70, 0x61, 0x6e, 0x69, 0x63, 0x10, 0x02,
-	0x12, 0x09, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x77,
-	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
-	0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x10, 0x06, 0x12, 0x09, 0x0a,
-	0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x10, 0x07, 0x2a, 0x5e, 0x0a, 0x0a, 0x43, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x13, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x10, 0x00, 0x12,
-	0x13, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42,
-	0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x10, 0x03, 0x2a, 0x19, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x53, 0x48, 0x41,
-	0x31, 0x10, 0x00, 0x2a, 0x35, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x64, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x64, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x54, 0x53, 0x50, 0x10, 0x01,
-	0x12, 0x08, 0x0a, 0x04, 0x52, 0x54, 0x4d, 0x50, 0x10, 0x02, 0x2a, 0x49, 0x0a, 0x0a, 0x50, 0x6c,
+	0x70, 0x6c, 0x79, 0x22, 0xfa, 0x01, 0x0a, 0x14, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
+	0x75, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x75,
+	0x6c, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e,
+	0x6f, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78,
+	0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x19, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x22, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
+	0x75, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x80,
+	0x01, 0x0a, 0x15, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x68, 0x0a, 0x16, 0x54, 0x65, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x6a, 0x0a, 0x14, 0x54,
+	0x65, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x38, 0x0a,
+	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x52, 0x75, 0x6c, 0x65, 0x54, 0x65, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xca, 0x03, 0x0a, 0x0b, 0x43, 0x68,
+	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x43, 0x68, 0x61,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x42,
+	0x61, 0x64, 0x67, 0x65, 0x52, 0x06, 0x62, 0x61, 0x64, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x09, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x09, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x31, 0x0a, 0x04, 0x70, 0x61,
+	0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61,
+	0x69, 0x64, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x22, 0x35, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x74, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a,
+	0x13, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x22, 0x67, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x4a, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x16, 0x0a,
+	0x14, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x50, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x10,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x10, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61,
+	0x6e, 0x6f, 0x22, 0x0e, 0x0a, 0x0c, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x45, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x64, 0x64,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x48,
+	0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x76, 0x0a, 0x18, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x64, 0x73, 0x43, 0x75, 0x65,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c,
+	0x61, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x73, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61,
+	0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x73, 0x55, 0x6e, 0x69, 0x78,
+	0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x18, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x41, 0x64, 0x73, 0x43, 0x75, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x98, 0x01, 0x0a, 0x12, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55,
+	0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x43, 0x0a,
+	0x1f, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x1d, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72,
+	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x22, 0x9c, 0x02, 0x0a, 0x10, 0x54, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x41, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2a, 0x0a,
+	0x10, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x64, 0x41,
+	0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x41, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69,
+	0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x30, 0x0a, 0x13, 0x70, 0x72, 0x65,
+	0x72, 0x6f, 0x6c, 0x6c, 0x46, 0x72, 0x65, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6e, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x70, 0x72, 0x65, 0x72, 0x6f, 0x6c, 0x6c, 0x46,
+	0x72, 0x65, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x73, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a,
+	0x17, 0x73, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74,
+	0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17,
+	0x73, 0x6e, 0x6f, 0x6f, 0x7a, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x41, 0x74, 0x55,
+	0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x1c, 0x0a, 0x1a, 0x54, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x41, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x51, 0x0a, 0x18, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x41, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x2a, 0x66, 0x0a, 0x0c, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f,
+	0x6e, 0x65, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x66, 0x61, 0x74, 0x61, 0x6c, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x70, 0x61, 0x6e, 0x69, 0x63, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x10, 0x07, 0x2a, 0x85, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x17, 0x0a, 0x13, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x55,
+	0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x65,
+	0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x10, 0x05, 0x2a, 0x19, 0x0a, 0x08, 0x48, 0x61,
+	0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x53,
+	0x48, 0x41, 0x31, 0x10, 0x00, 0x2a, 0x53, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x54, 0x53, 0x50,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x54, 0x4d, 0x50, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
+	0x53, 0x52, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x4c, 0x53, 0x10, 0x04, 0x12, 0x0a,
+	0x0a, 0x06, 0x57, 0x65, 0x62, 0x52, 0x54, 0x43, 0x10, 0x05, 0x2a, 0x49, 0x0a, 0x0a, 0x50, 0x6c,
 	0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79,
 	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
 	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x4c, 0x69, 0x62, 0x56, 0x4c, 0x43,
 	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x4d, 0x50, 0x56, 0x10, 0x02, 0x2a, 0x40, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x00, 0x12, 0x17,
-	0x0a, 0x13, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x42, 0x53, 0x53, 0x63, 0x65, 0x6e, 0x65, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x01, 0x32, 0xc0, 0x34, 0x0a, 0x07, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x44, 0x12, 0x32, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x50, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x6f,
-	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74,
+	0x4d, 0x50, 0x56, 0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x11, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x65, 0x10, 0x01, 0x2a, 0x8f, 0x01, 0x0a, 0x1d, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x4d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x69, 0x6d, 0x65, 0x72,
+	0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x69,
+	0x72, 0x65, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x46, 0x69, 0x72, 0x65, 0x49, 0x66, 0x4c, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x73,
+	0x73, 0x54, 0x68, 0x61, 0x6e, 0x10, 0x02, 0x2a, 0xf4, 0x04, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x42, 0x53, 0x53, 0x63, 0x65,
+	0x6e, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x10, 0x03,
+	0x12, 0x14, 0x0a, 0x10, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x6e, 0x64, 0x65, 0x64, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x56,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x6e, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x07, 0x12, 0x1d, 0x0a, 0x19, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x10, 0x08, 0x12, 0x1c, 0x0a, 0x18, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x10, 0x09, 0x12, 0x21, 0x0a, 0x1d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x74,
+	0x68, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x0a, 0x12, 0x24, 0x0a, 0x20, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x64, 0x10, 0x0b, 0x12,
+	0x15, 0x0a, 0x11, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x10, 0x0c, 0x12, 0x1b, 0x0a, 0x17, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x10, 0x0d, 0x12, 0x1f, 0x0a, 0x1b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x69,
+	0x66, 0x74, 0x10, 0x0e, 0x12, 0x14, 0x0a, 0x10, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x43, 0x68, 0x65, 0x65, 0x72, 0x10, 0x0f, 0x12, 0x13, 0x0a, 0x0f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x61, 0x69, 0x64, 0x10, 0x10, 0x12,
+	0x26, 0x0a, 0x22, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x64, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x11, 0x12, 0x13, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x6f, 0x6c, 0x6c, 0x10, 0x12, 0x12, 0x19, 0x0a, 0x15,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x13, 0x12, 0x25, 0x0a, 0x21, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x10, 0x14, 0x2a, 0x62,
+	0x0a, 0x0c, 0x43, 0x68, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19,
+	0x0a, 0x15, 0x63, 0x68, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x6e,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x72, 0x6f,
+	0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x56, 0x49, 0x50,
+	0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x10, 0x04, 0x2a, 0x54, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a,
+	0x20, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x64, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x10, 0x02, 0x32, 0xbf, 0x3c, 0x0a, 0x07, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x44, 0x12, 0x32, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x2e, 0x73, 0x74,
 	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67,
-	0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
-	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x41, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x61, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a,
-	0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x44,
-	0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1a, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x63, 0x68,
-	0x65, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
-	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x41, 0x0a, 0x09, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x64, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f,
+	0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x44, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1a, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x69,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x09, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
 	0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x49, 0x73, 0x42, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x50, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x62, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
-	0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
-	0x29, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x74,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x48,
-	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x49, 0x73, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x73, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x50, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x53,
-	0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x64, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x92, 0x01, 0x0a, 0x24, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b,
+	0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x92, 0x01, 0x0a, 0x24, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49,
+	0x4d, 0x45, 0x4e, 0x54, 0x41, 0x4c, 0x5f, 0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x34,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d,
 	0x45, 0x4e, 0x54, 0x41, 0x4c, 0x5f, 0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x34, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d, 0x45,
-	0x4e, 0x54, 0x41, 0x4c, 0x5f, 0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45, 0x58,
-	0x50, 0x45, 0x52, 0x49, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x4c, 0x5f, 0x52, 0x65, 0x69, 0x6e, 0x69,
-	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x18, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0f, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x41,
-	0x75, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x45,
+	0x58, 0x50, 0x45, 0x52, 0x49, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x4c, 0x5f, 0x52, 0x65, 0x69, 0x6e,
+	0x69, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x18, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x4f, 0x41, 0x75, 0x74,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0f, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f,
 	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4f,
-	0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x59, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
-	0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x74, 0x0a,
-	0x1f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
-	0x12, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a,
-	0x14, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x6b, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6b,
-	0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x24,
+	0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x4f, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x59, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x74,
+	0x0a, 0x1f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62,
+	0x0a, 0x14, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x6b, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a,
+	0x24, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
 	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61,
 	0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x59, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
-	0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x14,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x59, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a,
+	0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
 	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x5f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x7a, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
-	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
-	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x49, 0x6e, 0x63, 0x6f,
-	0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5c, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x41,
-	0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x5f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x7a, 0x0a, 0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x6f, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5c,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10,
+	0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64,
 	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74,
 	0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
 	0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5e,
-	0x0a, 0x16, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x64, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53,
-	0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x5c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x59, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x74, 0x0a, 0x1f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6e, 0x0a,
-	0x18, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a,
-	0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x75, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x16, 0x57, 0x61, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x57, 0x61,
+	0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x65, 0x72, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0f, 0x41, 0x64, 0x64,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c,
+	0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x1f, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x56, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4f, 0x70, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x70, 0x65,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x23, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x69,
-	0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x61,
-	0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e,
-	0x64, 0x43, 0x68, 0x61, 0x6e, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x13, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
 	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x6e, 0x64, 0x43,
-	0x68, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x5f, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x68, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5f, 0x0a, 0x13,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x73, 0x45, 0x6e,
+	0x64, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
 	0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
-	0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x65, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47,
-	0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6b, 0x0a,
+	0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
 	0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47,
-	0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x24,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x53, 0x70,
-	0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x14, 0x53, 0x74,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x15, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x62, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x24, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74,
 	0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75,
-	0x73, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
-	0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56,
-	0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74,
-	0x6f, 0x70, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x3e, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x18, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x64, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x47, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x72,
-	0x12, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0a, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x56, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
-	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
-	0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12,
-	0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x20, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x59, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x08,
+	0x41, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x41, 0x64, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x20, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x41, 0x64, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x41, 0x64, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
 	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67,
 	0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72,
 	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x47, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x17, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x43,
-	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52,
+	0x12, 0x59, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75,
+	0x6c, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x53,
+	0x0a, 0x0f, 0x54, 0x65, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x17,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0f, 0x53, 0x65,
+	0x6e, 0x64, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x68, 0x61,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x52,
 	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x42, 0x61, 0x6e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07, 0x42, 0x61,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e,
+	0x41, 0x64, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x41, 0x64, 0x73, 0x43, 0x75, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x64,
+	0x73, 0x43, 0x75, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x41, 0x64, 0x73, 0x43, 0x75, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x18, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x12,
+	0x28, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x64, 0x2e, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x13, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x41, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x54, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x47, 0x65, 0x74, 0x41, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x70,
 	0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49,
 	0x44, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x64, 0x2e, 0x47, 0x65, 0x74,
 	0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
@@ -10625,222 +16956,298 @@ func file_streamd_proto_rawDescGZIP() []byte {
 	return file_streamd_proto_rawDescData
 }
 
-var file_streamd_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_streamd_proto_msgTypes = make([]protoimpl.MessageInfo, 185)
+var file_streamd_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_streamd_proto_msgTypes = make([]protoimpl.MessageInfo, 257)
 var file_streamd_proto_goTypes = []interface{}{
 	(LoggingLevel)(0),                                   // 0: streamd.LoggingLevel
 	(Capability)(0),                                     // 1: streamd.Capability
 	(HashType)(0),                                       // 2: streamd.HashType
 	(StreamServerType)(0),                               // 3: streamd.StreamServerType
 	(PlayerType)(0),                                     // 4: streamd.PlayerType
-	(EventType)(0),                                      // 5: streamd.EventType
-	(*PingRequest)(nil),                                 // 6: streamd.PingRequest
-	(*PingReply)(nil),                                   // 7: streamd.PingReply
-	(*SetLoggingLevelRequest)(nil),                      // 8: streamd.SetLoggingLevelRequest
-	(*SetLoggingLevelReply)(nil),                        // 9: streamd.SetLoggingLevelReply
-	(*GetLoggingLevelRequest)(nil),                      // 10: streamd.GetLoggingLevelRequest
-	(*GetLoggingLevelReply)(nil),                        // 11: streamd.GetLoggingLevelReply
-	(*GetConfigRequest)(nil),                            // 12: streamd.GetConfigRequest
-	(*GetConfigReply)(nil),                              // 13: streamd.GetConfigReply
-	(*SetConfigRequest)(nil),                            // 14: streamd.SetConfigRequest
-	(*SetConfigReply)(nil),                              // 15: streamd.SetConfigReply
-	(*SaveConfigRequest)(nil),                           // 16: streamd.SaveConfigRequest
-	(*SaveConfigReply)(nil),                             // 17: streamd.SaveConfigReply
-	(*ResetCacheRequest)(nil),                           // 18: streamd.ResetCacheRequest
-	(*ResetCacheReply)(nil),                             // 19: streamd.ResetCacheReply
-	(*InitCacheRequest)(nil),                            // 20: streamd.InitCacheRequest
-	(*InitCacheReply)(nil),                              // 21: streamd.InitCacheReply
-	(*StartStreamRequest)(nil),                          // 22: streamd.StartStreamRequest
-	(*StartStreamReply)(nil),                            // 23: streamd.StartStreamReply
-	(*EndStreamRequest)(nil),                            // 24: streamd.EndStreamRequest
-	(*EndStreamReply)(nil),                              // 25: streamd.EndStreamReply
-	(*GetStreamStatusRequest)(nil),                      // 26: streamd.GetStreamStatusRequest
-	(*GetStreamStatusReply)(nil),                        // 27: streamd.GetStreamStatusReply
-	(*GetBackendInfoRequest)(nil),                       // 28: streamd.GetBackendInfoRequest
-	(*GetBackendInfoReply)(nil),                         // 29: streamd.GetBackendInfoReply
-	(*IsBackendEnabledRequest)(nil),                     // 30: streamd.IsBackendEnabledRequest
-	(*IsBackendEnabledReply)(nil),                       // 31: streamd.IsBackendEnabledReply
-	(*RestartRequest)(nil),                              // 32: streamd.RestartRequest
-	(*RestartReply)(nil),                                // 33: streamd.RestartReply
-	(*SetTitleRequest)(nil),                             // 34: streamd.SetTitleRequest
-	(*SetTitleReply)(nil),                               // 35: streamd.SetTitleReply
-	(*SetDescriptionRequest)(nil),                       // 36: streamd.SetDescriptionRequest
-	(*SetDescriptionReply)(nil),                         // 37: streamd.SetDescriptionReply
-	(*ApplyProfileRequest)(nil),                         // 38: streamd.ApplyProfileRequest
-	(*ApplyProfileReply)(nil),                           // 39: streamd.ApplyProfileReply
-	(*UpdateStreamRequest)(nil),                         // 40: streamd.UpdateStreamRequest
-	(*UpdateStreamReply)(nil),                           // 41: streamd.UpdateStreamReply
-	(*EXPERIMENTAL_ReinitStreamControllersRequest)(nil), // 42: streamd.EXPERIMENTAL_ReinitStreamControllersRequest
-	(*EXPERIMENTAL_ReinitStreamControllersReply)(nil),   // 43: streamd.EXPERIMENTAL_ReinitStreamControllersReply
-	(*OBSOLETE_FetchConfigRequest)(nil),                 // 44: streamd.OBSOLETE_FetchConfigRequest
-	(*OBSOLETE_FetchConfigReply)(nil),                   // 45: streamd.OBSOLETE_FetchConfigReply
-	(*OBSOLETE_GetGitInfoRequest)(nil),                  // 46: streamd.OBSOLETE_GetGitInfoRequest
-	(*OBSOLETE_GetGitInfoReply)(nil),                    // 47: streamd.OBSOLETE_GetGitInfoReply
-	(*OBSOLETE_GitReloginRequest)(nil),                  // 48: streamd.OBSOLETE_GitReloginRequest
-	(*OBSOLETE_GitReloginReply)(nil),                    // 49: streamd.OBSOLETE_GitReloginReply
-	(*SubscribeToOAuthRequestsRequest)(nil),             // 50: streamd.SubscribeToOAuthRequestsRequest
-	(*OAuthRequest)(nil),                                // 51: streamd.OAuthRequest
-	(*GetVariableRequest)(nil),                          // 52: streamd.GetVariableRequest
-	(*GetVariableReply)(nil),                            // 53: streamd.GetVariableReply
-	(*GetVariableHashRequest)(nil),                      // 54: streamd.GetVariableHashRequest
-	(*GetVariableHashReply)(nil),                        // 55: streamd.GetVariableHashReply
-	(*SetVariableRequest)(nil),                          // 56: streamd.SetVariableRequest
-	(*SetVariableReply)(nil),                            // 57: streamd.SetVariableReply
-	(*SubmitOAuthCodeRequest)(nil),                      // 58: streamd.SubmitOAuthCodeRequest
-	(*SubmitOAuthCodeReply)(nil),                        // 59: streamd.SubmitOAuthCodeReply
-	(*TLSCertificate)(nil),                              // 60: streamd.TLSCertificate
-	(*PrivateKey)(nil),                                  // 61: streamd.PrivateKey
-	(*StreamServer)(nil),                                // 62: streamd.StreamServer
-	(*StreamServerStatistics)(nil),                      // 63: streamd.StreamServerStatistics
-	(*StreamServerWithStatistics)(nil),                  // 64: streamd.StreamServerWithStatistics
-	(*ListStreamServersRequest)(nil),                    // 65: streamd.ListStreamServersRequest
-	(*ListStreamServersReply)(nil),                      // 66: streamd.ListStreamServersReply
-	(*StartStreamServerRequest)(nil),                    // 67: streamd.StartStreamServerRequest
-	(*StartStreamServerReply)(nil),                      // 68: streamd.StartStreamServerReply
-	(*StopStreamServerRequest)(nil),                     // 69: streamd.StopStreamServerRequest
-	(*StopStreamServerReply)(nil),                       // 70: streamd.StopStreamServerReply
-	(*StreamDestination)(nil),                           // 71: streamd.StreamDestination
-	(*ListStreamDestinationsRequest)(nil),               // 72: streamd.ListStreamDestinationsRequest
-	(*ListStreamDestinationsReply)(nil),                 // 73: streamd.ListStreamDestinationsReply
-	(*AddStreamDestinationRequest)(nil),                 // 74: streamd.AddStreamDestinationRequest
-	(*AddStreamDestinationReply)(nil),                   // 75: streamd.AddStreamDestinationReply
-	(*UpdateStreamDestinationRequest)(nil),              // 76: streamd.UpdateStreamDestinationRequest
-	(*UpdateStreamDestinationReply)(nil),                // 77: streamd.UpdateStreamDestinationReply
-	(*RemoveStreamDestinationRequest)(nil),              // 78: streamd.RemoveStreamDestinationRequest
-	(*RemoveStreamDestinationReply)(nil),                // 79: streamd.RemoveStreamDestinationReply
-	(*IncomingStream)(nil),                              // 80: streamd.IncomingStream
-	(*AddIncomingStreamRequest)(nil),                    // 81: streamd.AddIncomingStreamRequest
-	(*AddIncomingStreamReply)(nil),                      // 82: streamd.AddIncomingStreamReply
-	(*RemoveIncomingStreamRequest)(nil),                 // 83: streamd.RemoveIncomingStreamRequest
-	(*RemoveIncomingStreamReply)(nil),                   // 84: streamd.RemoveIncomingStreamReply
-	(*ListIncomingStreamsRequest)(nil),                  // 85: streamd.ListIncomingStreamsRequest
-	(*ListIncomingStreamsReply)(nil),                    // 86: streamd.ListIncomingStreamsReply
-	(*RestartUntilYoutubeRecognizesStream)(nil),         // 87: streamd.RestartUntilYoutubeRecognizesStream
-	(*StartAfterYoutubeRecognizedStream)(nil),           // 88: streamd.StartAfterYoutubeRecognizedStream
-	(*StreamForwardQuirks)(nil),                         // 89: streamd.StreamForwardQuirks
-	(*StreamForward)(nil),                               // 90: streamd.StreamForward
-	(*StreamForwardStatistics)(nil),                     // 91: streamd.StreamForwardStatistics
-	(*StreamForwardWithStatistics)(nil),                 // 92: streamd.StreamForwardWithStatistics
-	(*ListStreamForwardsRequest)(nil),                   // 93: streamd.ListStreamForwardsRequest
-	(*ListStreamForwardsReply)(nil),                     // 94: streamd.ListStreamForwardsReply
-	(*AddStreamForwardRequest)(nil),                     // 95: streamd.AddStreamForwardRequest
-	(*AddStreamForwardReply)(nil),                       // 96: streamd.AddStreamForwardReply
-	(*UpdateStreamForwardRequest)(nil),                  // 97: streamd.UpdateStreamForwardRequest
-	(*UpdateStreamForwardReply)(nil),                    // 98: streamd.UpdateStreamForwardReply
-	(*RemoveStreamForwardRequest)(nil),                  // 99: streamd.RemoveStreamForwardRequest
-	(*RemoveStreamForwardReply)(nil),                    // 100: streamd.RemoveStreamForwardReply
-	(*WaitForStreamPublisherRequest)(nil),               // 101: streamd.WaitForStreamPublisherRequest
-	(*StreamPublisher)(nil),                             // 102: streamd.StreamPublisher
-	(*StreamPlaybackConfig)(nil),                        // 103: streamd.StreamPlaybackConfig
-	(*StreamPlayerConfig)(nil),                          // 104: streamd.StreamPlayerConfig
-	(*AddStreamPlayerRequest)(nil),                      // 105: streamd.AddStreamPlayerRequest
-	(*AddStreamPlayerReply)(nil),                        // 106: streamd.AddStreamPlayerReply
-	(*RemoveStreamPlayerRequest)(nil),                   // 107: streamd.RemoveStreamPlayerRequest
-	(*RemoveStreamPlayerReply)(nil),                     // 108: streamd.RemoveStreamPlayerReply
-	(*UpdateStreamPlayerRequest)(nil),                   // 109: streamd.UpdateStreamPlayerRequest
-	(*UpdateStreamPlayerReply)(nil),                     // 110: streamd.UpdateStreamPlayerReply
-	(*ListStreamPlayersRequest)(nil),                    // 111: streamd.ListStreamPlayersRequest
-	(*ListStreamPlayersReply)(nil),                      // 112: streamd.ListStreamPlayersReply
-	(*GetStreamPlayerRequest)(nil),                      // 113: streamd.GetStreamPlayerRequest
-	(*GetStreamPlayerReply)(nil),                        // 114: streamd.GetStreamPlayerReply
-	(*StreamPlayerOpenRequest)(nil),                     // 115: streamd.StreamPlayerOpenRequest
-	(*StreamPlayerOpenReply)(nil),                       // 116: streamd.StreamPlayerOpenReply
-	(*StreamPlayerProcessTitleRequest)(nil),             // 117: streamd.StreamPlayerProcessTitleRequest
-	(*StreamPlayerProcessTitleReply)(nil),               // 118: streamd.StreamPlayerProcessTitleReply
-	(*StreamPlayerGetLinkRequest)(nil),                  // 119: streamd.StreamPlayerGetLinkRequest
-	(*StreamPlayerGetLinkReply)(nil),                    // 120: streamd.StreamPlayerGetLinkReply
-	(*StreamPlayerEndChanRequest)(nil),                  // 121: streamd.StreamPlayerEndChanRequest
-	(*StreamPlayerEndChanReply)(nil),                    // 122: streamd.StreamPlayerEndChanReply
-	(*StreamPlayerIsEndedRequest)(nil),                  // 123: streamd.StreamPlayerIsEndedRequest
-	(*StreamPlayerIsEndedReply)(nil),                    // 124: streamd.StreamPlayerIsEndedReply
-	(*StreamPlayerGetPositionRequest)(nil),              // 125: streamd.StreamPlayerGetPositionRequest
-	(*StreamPlayerGetPositionReply)(nil),                // 126: streamd.StreamPlayerGetPositionReply
-	(*StreamPlayerGetLengthRequest)(nil),                // 127: streamd.StreamPlayerGetLengthRequest
-	(*StreamPlayerGetLengthReply)(nil),                  // 128: streamd.StreamPlayerGetLengthReply
-	(*StreamPlayerSetSpeedRequest)(nil),                 // 129: streamd.StreamPlayerSetSpeedRequest
-	(*StreamPlayerSetSpeedReply)(nil),                   // 130: streamd.StreamPlayerSetSpeedReply
-	(*StreamPlayerSetPauseRequest)(nil),                 // 131: streamd.StreamPlayerSetPauseRequest
-	(*StreamPlayerSetPauseReply)(nil),                   // 132: streamd.StreamPlayerSetPauseReply
-	(*StreamPlayerStopRequest)(nil),                     // 133: streamd.StreamPlayerStopRequest
-	(*StreamPlayerStopReply)(nil),                       // 134: streamd.StreamPlayerStopReply
-	(*StreamPlayerCloseRequest)(nil),                    // 135: streamd.StreamPlayerCloseRequest
-	(*StreamPlayerCloseReply)(nil),                      // 136: streamd.StreamPlayerCloseReply
-	(*SubscribeToConfigChangesRequest)(nil),             // 137: streamd.SubscribeToConfigChangesRequest
-	(*ConfigChange)(nil),                                // 138: streamd.ConfigChange
-	(*SubscribeToStreamsChangesRequest)(nil),            // 139: streamd.SubscribeToStreamsChangesRequest
-	(*StreamsChange)(nil),                               // 140: streamd.StreamsChange
-	(*SubscribeToStreamServersChangesRequest)(nil),      // 141: streamd.SubscribeToStreamServersChangesRequest
-	(*StreamServersChange)(nil),                         // 142: streamd.StreamServersChange
-	(*SubscribeToStreamDestinationsChangesRequest)(nil), // 143: streamd.SubscribeToStreamDestinationsChangesRequest
-	(*StreamDestinationsChange)(nil),                    // 144: streamd.StreamDestinationsChange
-	(*SubscribeToIncomingStreamsChangesRequest)(nil),    // 145: streamd.SubscribeToIncomingStreamsChangesRequest
-	(*IncomingStreamsChange)(nil),                       // 146: streamd.IncomingStreamsChange
-	(*SubscribeToStreamForwardsChangesRequest)(nil),     // 147: streamd.SubscribeToStreamForwardsChangesRequest
-	(*StreamForwardsChange)(nil),                        // 148: streamd.StreamForwardsChange
-	(*SubscribeToStreamPlayersChangesRequest)(nil),      // 149: streamd.SubscribeToStreamPlayersChangesRequest
-	(*StreamPlayersChange)(nil),                         // 150: streamd.StreamPlayersChange
-	(*NoopRequest)(nil),                                 // 151: streamd.NoopRequest
-	(*OBSActionItemShowHide)(nil),                       // 152: streamd.OBSActionItemShowHide
-	(*OBSActionWindowCaptureSetSource)(nil),             // 153: streamd.OBSActionWindowCaptureSetSource
-	(*OBSAction)(nil),                                   // 154: streamd.OBSAction
-	(*Action)(nil),                                      // 155: streamd.Action
-	(*AddTimerRequest)(nil),                             // 156: streamd.AddTimerRequest
-	(*AddTimerReply)(nil),                               // 157: streamd.AddTimerReply
-	(*RemoveTimerRequest)(nil),                          // 158: streamd.RemoveTimerRequest
-	(*RemoveTimerReply)(nil),                            // 159: streamd.RemoveTimerReply
-	(*Timer)(nil),                                       // 160: streamd.Timer
-	(*ListTimersRequest)(nil),                           // 161: streamd.ListTimersRequest
-	(*ListTimersReply)(nil),                             // 162: streamd.ListTimersReply
-	(*EventQueryAnd)(nil),                               // 163: streamd.EventQueryAnd
-	(*EventQueryOr)(nil),                                // 164: streamd.EventQueryOr
-	(*EventQueryNot)(nil),                               // 165: streamd.EventQueryNot
-	(*EventOBSSceneChange)(nil),                         // 166: streamd.EventOBSSceneChange
-	(*EventWindowFocusChange)(nil),                      // 167: streamd.EventWindowFocusChange
-	(*EventQuery)(nil),                                  // 168: streamd.EventQuery
-	(*Event)(nil),                                       // 169: streamd.Event
-	(*TriggerRule)(nil),                                 // 170: streamd.TriggerRule
-	(*ListTriggerRulesRequest)(nil),                     // 171: streamd.ListTriggerRulesRequest
-	(*ListTriggerRulesReply)(nil),                       // 172: streamd.ListTriggerRulesReply
-	(*AddTriggerRuleRequest)(nil),                       // 173: streamd.AddTriggerRuleRequest
-	(*AddTriggerRuleReply)(nil),                         // 174: streamd.AddTriggerRuleReply
-	(*RemoveTriggerRuleRequest)(nil),                    // 175: streamd.RemoveTriggerRuleRequest
-	(*RemoveTriggerRuleReply)(nil),                      // 176: streamd.RemoveTriggerRuleReply
-	(*UpdateTriggerRuleRequest)(nil),                    // 177: streamd.UpdateTriggerRuleRequest
-	(*UpdateTriggerRuleReply)(nil),                      // 178: streamd.UpdateTriggerRuleReply
-	(*SubmitEventRequest)(nil),                          // 179: streamd.SubmitEventRequest
-	(*SubmitEventReply)(nil),                            // 180: streamd.SubmitEventReply
-	(*SubscribeToChatMessagesRequest)(nil),              // 181: streamd.SubscribeToChatMessagesRequest
-	(*ChatMessage)(nil),                                 // 182: streamd.ChatMessage
-	(*SendChatMessageRequest)(nil),                      // 183: streamd.SendChatMessageRequest
-	(*SendChatMessageReply)(nil),                        // 184: streamd.SendChatMessageReply
-	(*RemoveChatMessageRequest)(nil),                    // 185: streamd.RemoveChatMessageRequest
-	(*RemoveChatMessageReply)(nil),                      // 186: streamd.RemoveChatMessageReply
-	(*BanUserRequest)(nil),                              // 187: streamd.BanUserRequest
-	(*BanUserReply)(nil),                                // 188: streamd.BanUserReply
-	(*GetPeerIDsRequest)(nil),                           // 189: streamd.GetPeerIDsRequest
-	(*GetPeerIDsReply)(nil),                             // 190: streamd.GetPeerIDsReply
-	(*player_grpc.OpenRequest)(nil),                     // 191: player.OpenRequest
-	(*player_grpc.OpenReply)(nil),                       // 192: player.OpenReply
-	(*player_grpc.ProcessTitleRequest)(nil),             // 193: player.ProcessTitleRequest
-	(*player_grpc.ProcessTitleReply)(nil),               // 194: player.ProcessTitleReply
-	(*player_grpc.GetLinkRequest)(nil),                  // 195: player.GetLinkRequest
-	(*player_grpc.GetLinkReply)(nil),                    // 196: player.GetLinkReply
-	(*player_grpc.EndChanRequest)(nil),                  // 197: player.EndChanRequest
-	(*player_grpc.EndChanReply)(nil),                    // 198: player.EndChanReply
-	(*player_grpc.IsEndedRequest)(nil),                  // 199: player.IsEndedRequest
-	(*player_grpc.IsEndedReply)(nil),                    // 200: player.IsEndedReply
-	(*player_grpc.GetPositionRequest)(nil),              // 201: player.GetPositionRequest
-	(*player_grpc.GetPositionReply)(nil),                // 202: player.GetPositionReply
-	(*player_grpc.GetLengthRequest)(nil),                // 203: player.GetLengthRequest
-	(*player_grpc.GetLengthReply)(nil),                  // 204: player.GetLengthReply
-	(*player_grpc.SetSpeedRequest)(nil),                 // 205: player.SetSpeedRequest
-	(*player_grpc.SetSpeedReply)(nil),                   // 206: player.SetSpeedReply
-	(*player_grpc.SetPauseRequest)(nil),                 // 207: player.SetPauseRequest
-	(*player_grpc.SetPauseReply)(nil),                   // 208: player.SetPauseReply
-	(*player_grpc.StopRequest)(nil),                     // 209: player.StopRequest
-	(*player_grpc.StopReply)(nil),                       // 210: player.StopReply
-	(*player_grpc.CloseRequest)(nil),                    // 211: player.CloseRequest
-	(*player_grpc.CloseReply)(nil),                      // 212: player.CloseReply
+	(ActionErrorPolicy)(0),                              // 5: streamd.ActionErrorPolicy
+	(TimerMissedDeadlinePolicyType)(0),                  // 6: streamd.TimerMissedDeadlinePolicyType
+	(EventType)(0),                                      // 7: streamd.EventType
+	(ChatUserRole)(0),                                   // 8: streamd.ChatUserRole
+	(ChatMessageFragmentType)(0),                        // 9: streamd.ChatMessageFragmentType
+	(*PingRequest)(nil),                                 // 10: streamd.PingRequest
+	(*PingReply)(nil),                                   // 11: streamd.PingReply
+	(*SetLoggingLevelRequest)(nil),                      // 12: streamd.SetLoggingLevelRequest
+	(*SetLoggingLevelReply)(nil),                        // 13: streamd.SetLoggingLevelReply
+	(*GetLoggingLevelRequest)(nil),                      // 14: streamd.GetLoggingLevelRequest
+	(*GetLoggingLevelReply)(nil),                        // 15: streamd.GetLoggingLevelReply
+	(*GetConfigRequest)(nil),                            // 16: streamd.GetConfigRequest
+	(*GetConfigReply)(nil),                              // 17: streamd.GetConfigReply
+	(*SetConfigRequest)(nil),                            // 18: streamd.SetConfigRequest
+	(*SetConfigReply)(nil),                              // 19: streamd.SetConfigReply
+	(*SaveConfigRequest)(nil),                           // 20: streamd.SaveConfigRequest
+	(*SaveConfigReply)(nil),                             // 21: streamd.SaveConfigReply
+	(*ResetCacheRequest)(nil),                           // 22: streamd.ResetCacheRequest
+	(*ResetCacheReply)(nil),                             // 23: streamd.ResetCacheReply
+	(*InitCacheRequest)(nil),                            // 24: streamd.InitCacheRequest
+	(*InitCacheReply)(nil),                              // 25: streamd.InitCacheReply
+	(*StartStreamRequest)(nil),                          // 26: streamd.StartStreamRequest
+	(*StartStreamReply)(nil),                            // 27: streamd.StartStreamReply
+	(*EndStreamRequest)(nil),                            // 28: streamd.EndStreamRequest
+	(*EndStreamReply)(nil),                              // 29: streamd.EndStreamReply
+	(*GetStreamStatusRequest)(nil),                      // 30: streamd.GetStreamStatusRequest
+	(*GetStreamStatusReply)(nil),                        // 31: streamd.GetStreamStatusReply
+	(*GetBackendInfoRequest)(nil),                       // 32: streamd.GetBackendInfoRequest
+	(*GetBackendInfoReply)(nil),                         // 33: streamd.GetBackendInfoReply
+	(*IsBackendEnabledRequest)(nil),                     // 34: streamd.IsBackendEnabledRequest
+	(*IsBackendEnabledReply)(nil),                       // 35: streamd.IsBackendEnabledReply
+	(*RestartRequest)(nil),                              // 36: streamd.RestartRequest
+	(*RestartReply)(nil),                                // 37: streamd.RestartReply
+	(*SetTitleRequest)(nil),                             // 38: streamd.SetTitleRequest
+	(*SetTitleReply)(nil),                               // 39: streamd.SetTitleReply
+	(*SetDescriptionRequest)(nil),                       // 40: streamd.SetDescriptionRequest
+	(*SetDescriptionReply)(nil),                         // 41: streamd.SetDescriptionReply
+	(*ApplyProfileRequest)(nil),                         // 42: streamd.ApplyProfileRequest
+	(*ApplyProfileReply)(nil),                           // 43: streamd.ApplyProfileReply
+	(*UpdateStreamRequest)(nil),                         // 44: streamd.UpdateStreamRequest
+	(*UpdateStreamReply)(nil),                           // 45: streamd.UpdateStreamReply
+	(*EXPERIMENTAL_ReinitStreamControllersRequest)(nil), // 46: streamd.EXPERIMENTAL_ReinitStreamControllersRequest
+	(*EXPERIMENTAL_ReinitStreamControllersReply)(nil),   // 47: streamd.EXPERIMENTAL_ReinitStreamControllersReply
+	(*OBSOLETE_FetchConfigRequest)(nil),                 // 48: streamd.OBSOLETE_FetchConfigRequest
+	(*OBSOLETE_FetchConfigReply)(nil),                   // 49: streamd.OBSOLETE_FetchConfigReply
+	(*OBSOLETE_GetGitInfoRequest)(nil),                  // 50: streamd.OBSOLETE_GetGitInfoRequest
+	(*OBSOLETE_GetGitInfoReply)(nil),                    // 51: streamd.OBSOLETE_GetGitInfoReply
+	(*OBSOLETE_GitReloginRequest)(nil),                  // 52: streamd.OBSOLETE_GitReloginRequest
+	(*OBSOLETE_GitReloginReply)(nil),                    // 53: streamd.OBSOLETE_GitReloginReply
+	(*SubscribeToOAuthRequestsRequest)(nil),             // 54: streamd.SubscribeToOAuthRequestsRequest
+	(*OAuthRequest)(nil),                                // 55: streamd.OAuthRequest
+	(*GetVariableRequest)(nil),                          // 56: streamd.GetVariableRequest
+	(*GetVariableReply)(nil),                            // 57: streamd.GetVariableReply
+	(*GetVariableHashRequest)(nil),                      // 58: streamd.GetVariableHashRequest
+	(*GetVariableHashReply)(nil),                        // 59: streamd.GetVariableHashReply
+	(*SetVariableRequest)(nil),                          // 60: streamd.SetVariableRequest
+	(*SetVariableReply)(nil),                            // 61: streamd.SetVariableReply
+	(*SubmitOAuthCodeRequest)(nil),                      // 62: streamd.SubmitOAuthCodeRequest
+	(*SubmitOAuthCodeReply)(nil),                        // 63: streamd.SubmitOAuthCodeReply
+	(*TLSCertificate)(nil),                              // 64: streamd.TLSCertificate
+	(*PrivateKey)(nil),                                  // 65: streamd.PrivateKey
+	(*StreamServer)(nil),                                // 66: streamd.StreamServer
+	(*StreamServerStatistics)(nil),                      // 67: streamd.StreamServerStatistics
+	(*StreamServerWithStatistics)(nil),                  // 68: streamd.StreamServerWithStatistics
+	(*ListStreamServersRequest)(nil),                    // 69: streamd.ListStreamServersRequest
+	(*ListStreamServersReply)(nil),                      // 70: streamd.ListStreamServersReply
+	(*StartStreamServerRequest)(nil),                    // 71: streamd.StartStreamServerRequest
+	(*StartStreamServerReply)(nil),                      // 72: streamd.StartStreamServerReply
+	(*StopStreamServerRequest)(nil),                     // 73: streamd.StopStreamServerRequest
+	(*StopStreamServerReply)(nil),                       // 74: streamd.StopStreamServerReply
+	(*StreamDestination)(nil),                           // 75: streamd.StreamDestination
+	(*ListStreamDestinationsRequest)(nil),               // 76: streamd.ListStreamDestinationsRequest
+	(*ListStreamDestinationsReply)(nil),                 // 77: streamd.ListStreamDestinationsReply
+	(*AddStreamDestinationRequest)(nil),                 // 78: streamd.AddStreamDestinationRequest
+	(*AddStreamDestinationReply)(nil),                   // 79: streamd.AddStreamDestinationReply
+	(*UpdateStreamDestinationRequest)(nil),              // 80: streamd.UpdateStreamDestinationRequest
+	(*UpdateStreamDestinationReply)(nil),                // 81: streamd.UpdateStreamDestinationReply
+	(*RemoveStreamDestinationRequest)(nil),              // 82: streamd.RemoveStreamDestinationRequest
+	(*RemoveStreamDestinationReply)(nil),                // 83: streamd.RemoveStreamDestinationReply
+	(*IncomingStream)(nil),                              // 84: streamd.IncomingStream
+	(*AddIncomingStreamRequest)(nil),                    // 85: streamd.AddIncomingStreamRequest
+	(*AddIncomingStreamReply)(nil),                      // 86: streamd.AddIncomingStreamReply
+	(*RemoveIncomingStreamRequest)(nil),                 // 87: streamd.RemoveIncomingStreamRequest
+	(*RemoveIncomingStreamReply)(nil),                   // 88: streamd.RemoveIncomingStreamReply
+	(*ListIncomingStreamsRequest)(nil),                  // 89: streamd.ListIncomingStreamsRequest
+	(*ListIncomingStreamsReply)(nil),                    // 90: streamd.ListIncomingStreamsReply
+	(*RestartUntilPlatformRecognizesStream)(nil),        // 91: streamd.RestartUntilPlatformRecognizesStream
+	(*StartAfterPlatformRecognizedStream)(nil),          // 92: streamd.StartAfterPlatformRecognizedStream
+	(*StreamForwardQuirks)(nil),                         // 93: streamd.StreamForwardQuirks
+	(*StreamForward)(nil),                               // 94: streamd.StreamForward
+	(*StreamForwardStatistics)(nil),                     // 95: streamd.StreamForwardStatistics
+	(*StreamForwardWithStatistics)(nil),                 // 96: streamd.StreamForwardWithStatistics
+	(*ListStreamForwardsRequest)(nil),                   // 97: streamd.ListStreamForwardsRequest
+	(*ListStreamForwardsReply)(nil),                     // 98: streamd.ListStreamForwardsReply
+	(*AddStreamForwardRequest)(nil),                     // 99: streamd.AddStreamForwardRequest
+	(*AddStreamForwardReply)(nil),                       // 100: streamd.AddStreamForwardReply
+	(*UpdateStreamForwardRequest)(nil),                  // 101: streamd.UpdateStreamForwardRequest
+	(*UpdateStreamForwardReply)(nil),                    // 102: streamd.UpdateStreamForwardReply
+	(*RemoveStreamForwardRequest)(nil),                  // 103: streamd.RemoveStreamForwardRequest
+	(*RemoveStreamForwardReply)(nil),                    // 104: streamd.RemoveStreamForwardReply
+	(*StreamRecordingConfig)(nil),                       // 105: streamd.StreamRecordingConfig
+	(*StreamRecording)(nil),                             // 106: streamd.StreamRecording
+	(*StartRecordingRequest)(nil),                       // 107: streamd.StartRecordingRequest
+	(*StartRecordingReply)(nil),                         // 108: streamd.StartRecordingReply
+	(*StopRecordingRequest)(nil),                        // 109: streamd.StopRecordingRequest
+	(*StopRecordingReply)(nil),                          // 110: streamd.StopRecordingReply
+	(*ListRecordingsRequest)(nil),                       // 111: streamd.ListRecordingsRequest
+	(*ListRecordingsReply)(nil),                         // 112: streamd.ListRecordingsReply
+	(*WaitForStreamPublisherRequest)(nil),               // 113: streamd.WaitForStreamPublisherRequest
+	(*StreamPublisher)(nil),                             // 114: streamd.StreamPublisher
+	(*StreamPlaybackConfig)(nil),                        // 115: streamd.StreamPlaybackConfig
+	(*StreamPlayerConfig)(nil),                          // 116: streamd.StreamPlayerConfig
+	(*AddStreamPlayerRequest)(nil),                      // 117: streamd.AddStreamPlayerRequest
+	(*AddStreamPlayerReply)(nil),                        // 118: streamd.AddStreamPlayerReply
+	(*RemoveStreamPlayerRequest)(nil),                   // 119: streamd.RemoveStreamPlayerRequest
+	(*RemoveStreamPlayerReply)(nil),                     // 120: streamd.RemoveStreamPlayerReply
+	(*UpdateStreamPlayerRequest)(nil),                   // 121: streamd.UpdateStreamPlayerRequest
+	(*UpdateStreamPlayerReply)(nil),                     // 122: streamd.UpdateStreamPlayerReply
+	(*ListStreamPlayersRequest)(nil),                    // 123: streamd.ListStreamPlayersRequest
+	(*ListStreamPlayersReply)(nil),                      // 124: streamd.ListStreamPlayersReply
+	(*GetStreamPlayerRequest)(nil),                      // 125: streamd.GetStreamPlayerRequest
+	(*GetStreamPlayerReply)(nil),                        // 126: streamd.GetStreamPlayerReply
+	(*StreamPlayerOpenRequest)(nil),                     // 127: streamd.StreamPlayerOpenRequest
+	(*StreamPlayerOpenReply)(nil),                       // 128: streamd.StreamPlayerOpenReply
+	(*StreamPlayerProcessTitleRequest)(nil),             // 129: streamd.StreamPlayerProcessTitleRequest
+	(*StreamPlayerProcessTitleReply)(nil),               // 130: streamd.StreamPlayerProcessTitleReply
+	(*StreamPlayerGetLinkRequest)(nil),                  // 131: streamd.StreamPlayerGetLinkRequest
+	(*StreamPlayerGetLinkReply)(nil),                    // 132: streamd.StreamPlayerGetLinkReply
+	(*StreamPlayerEndChanRequest)(nil),                  // 133: streamd.StreamPlayerEndChanRequest
+	(*StreamPlayerEndChanReply)(nil),                    // 134: streamd.StreamPlayerEndChanReply
+	(*StreamPlayerIsEndedRequest)(nil),                  // 135: streamd.StreamPlayerIsEndedRequest
+	(*StreamPlayerIsEndedReply)(nil),                    // 136: streamd.StreamPlayerIsEndedReply
+	(*StreamPlayerGetPositionRequest)(nil),              // 137: streamd.StreamPlayerGetPositionRequest
+	(*StreamPlayerGetPositionReply)(nil),                // 138: streamd.StreamPlayerGetPositionReply
+	(*StreamPlayerGetLengthRequest)(nil),                // 139: streamd.StreamPlayerGetLengthRequest
+	(*StreamPlayerGetLengthReply)(nil),                  // 140: streamd.StreamPlayerGetLengthReply
+	(*StreamPlayerSetSpeedRequest)(nil),                 // 141: streamd.StreamPlayerSetSpeedRequest
+	(*StreamPlayerSetSpeedReply)(nil),                   // 142: streamd.StreamPlayerSetSpeedReply
+	(*StreamPlayerSetPauseRequest)(nil),                 // 143: streamd.StreamPlayerSetPauseRequest
+	(*StreamPlayerSetPauseReply)(nil),                   // 144: streamd.StreamPlayerSetPauseReply
+	(*StreamPlayerStopRequest)(nil),                     // 145: streamd.StreamPlayerStopRequest
+	(*StreamPlayerStopReply)(nil),                       // 146: streamd.StreamPlayerStopReply
+	(*StreamPlayerCloseRequest)(nil),                    // 147: streamd.StreamPlayerCloseRequest
+	(*StreamPlayerCloseReply)(nil),                      // 148: streamd.StreamPlayerCloseReply
+	(*SubscribeToConfigChangesRequest)(nil),             // 149: streamd.SubscribeToConfigChangesRequest
+	(*ConfigChange)(nil),                                // 150: streamd.ConfigChange
+	(*SubscribeToStreamsChangesRequest)(nil),            // 151: streamd.SubscribeToStreamsChangesRequest
+	(*StreamsChange)(nil),                               // 152: streamd.StreamsChange
+	(*SubscribeToStreamServersChangesRequest)(nil),      // 153: streamd.SubscribeToStreamServersChangesRequest
+	(*StreamServersChange)(nil),                         // 154: streamd.StreamServersChange
+	(*SubscribeToStreamDestinationsChangesRequest)(nil), // 155: streamd.SubscribeToStreamDestinationsChangesRequest
+	(*StreamDestinationsChange)(nil),                    // 156: streamd.StreamDestinationsChange
+	(*SubscribeToIncomingStreamsChangesRequest)(nil),    // 157: streamd.SubscribeToIncomingStreamsChangesRequest
+	(*IncomingStreamsChange)(nil),                       // 158: streamd.IncomingStreamsChange
+	(*SubscribeToStreamForwardsChangesRequest)(nil),     // 159: streamd.SubscribeToStreamForwardsChangesRequest
+	(*StreamForwardsChange)(nil),                        // 160: streamd.StreamForwardsChange
+	(*SubscribeToStreamForwardStatsRequest)(nil),        // 161: streamd.SubscribeToStreamForwardStatsRequest
+	(*StreamForwardStatsUpdate)(nil),                    // 162: streamd.StreamForwardStatsUpdate
+	(*SubscribeToStreamPlayersChangesRequest)(nil),      // 163: streamd.SubscribeToStreamPlayersChangesRequest
+	(*StreamPlayersChange)(nil),                         // 164: streamd.StreamPlayersChange
+	(*NoopRequest)(nil),                                 // 165: streamd.NoopRequest
+	(*OBSActionItemShowHide)(nil),                       // 166: streamd.OBSActionItemShowHide
+	(*OBSActionWindowCaptureSetSource)(nil),             // 167: streamd.OBSActionWindowCaptureSetSource
+	(*OBSActionSetCurrentScene)(nil),                    // 168: streamd.OBSActionSetCurrentScene
+	(*OBSAction)(nil),                                   // 169: streamd.OBSAction
+	(*ActionStep)(nil),                                  // 170: streamd.ActionStep
+	(*ActionSequence)(nil),                              // 171: streamd.ActionSequence
+	(*ActionParallel)(nil),                              // 172: streamd.ActionParallel
+	(*ActionDelay)(nil),                                 // 173: streamd.ActionDelay
+	(*ActionIf)(nil),                                    // 174: streamd.ActionIf
+	(*ActionSendChatMessage)(nil),                       // 175: streamd.ActionSendChatMessage
+	(*ActionSetTitle)(nil),                              // 176: streamd.ActionSetTitle
+	(*ActionSetDescription)(nil),                        // 177: streamd.ActionSetDescription
+	(*ActionApplyProfile)(nil),                          // 178: streamd.ActionApplyProfile
+	(*ActionSetStreamForwardEnabled)(nil),               // 179: streamd.ActionSetStreamForwardEnabled
+	(*ActionSetVariable)(nil),                           // 180: streamd.ActionSetVariable
+	(*ActionInsertAdsCuePoint)(nil),                     // 181: streamd.ActionInsertAdsCuePoint
+	(*ActionRunCommand)(nil),                            // 182: streamd.ActionRunCommand
+	(*ActionTwitchCreateStreamMarker)(nil),              // 183: streamd.ActionTwitchCreateStreamMarker
+	(*Action)(nil),                                      // 184: streamd.Action
+	(*TimerMissedDeadlinePolicy)(nil),                   // 185: streamd.TimerMissedDeadlinePolicy
+	(*TimerRelativeToStreamStart)(nil),                  // 186: streamd.TimerRelativeToStreamStart
+	(*TimerSchedule)(nil),                               // 187: streamd.TimerSchedule
+	(*AddTimerRequest)(nil),                             // 188: streamd.AddTimerRequest
+	(*AddTimerReply)(nil),                               // 189: streamd.AddTimerReply
+	(*RemoveTimerRequest)(nil),                          // 190: streamd.RemoveTimerRequest
+	(*RemoveTimerReply)(nil),                            // 191: streamd.RemoveTimerReply
+	(*Timer)(nil),                                       // 192: streamd.Timer
+	(*ListTimersRequest)(nil),                           // 193: streamd.ListTimersRequest
+	(*ListTimersReply)(nil),                             // 194: streamd.ListTimersReply
+	(*EventQueryAnd)(nil),                               // 195: streamd.EventQueryAnd
+	(*EventQueryOr)(nil),                                // 196: streamd.EventQueryOr
+	(*EventQueryNot)(nil),                               // 197: streamd.EventQueryNot
+	(*EventQueryEventField)(nil),                        // 198: streamd.EventQueryEventField
+	(*EventQueryStreamIsActive)(nil),                    // 199: streamd.EventQueryStreamIsActive
+	(*EventQueryVariableEquals)(nil),                    // 200: streamd.EventQueryVariableEquals
+	(*EventOBSSceneChange)(nil),                         // 201: streamd.EventOBSSceneChange
+	(*EventWindowFocusChange)(nil),                      // 202: streamd.EventWindowFocusChange
+	(*EventChatMessageReceived)(nil),                    // 203: streamd.EventChatMessageReceived
+	(*EventStreamStarted)(nil),                          // 204: streamd.EventStreamStarted
+	(*EventStreamEnded)(nil),                            // 205: streamd.EventStreamEnded
+	(*EventViewerCountChanged)(nil),                     // 206: streamd.EventViewerCountChanged
+	(*EventIncomingStreamPublished)(nil),                // 207: streamd.EventIncomingStreamPublished
+	(*EventIncomingStreamUnpublished)(nil),              // 208: streamd.EventIncomingStreamUnpublished
+	(*EventStreamForwardStarted)(nil),                   // 209: streamd.EventStreamForwardStarted
+	(*EventIncomingStreamAuthFailed)(nil),               // 210: streamd.EventIncomingStreamAuthFailed
+	(*EventStreamForwardFailed)(nil),                    // 211: streamd.EventStreamForwardFailed
+	(*EventStreamForwardSourceSwitched)(nil),            // 212: streamd.EventStreamForwardSourceSwitched
+	(*EventStreamForwardFallbackStopped)(nil),           // 213: streamd.EventStreamForwardFallbackStopped
+	(*EventTwitchFollow)(nil),                           // 214: streamd.EventTwitchFollow
+	(*EventTwitchSubscription)(nil),                     // 215: streamd.EventTwitchSubscription
+	(*EventTwitchSubscriptionGift)(nil),                 // 216: streamd.EventTwitchSubscriptionGift
+	(*EventTwitchCheer)(nil),                            // 217: streamd.EventTwitchCheer
+	(*EventTwitchRaid)(nil),                             // 218: streamd.EventTwitchRaid
+	(*EventTwitchChannelPointsRedemption)(nil),          // 219: streamd.EventTwitchChannelPointsRedemption
+	(*EventTwitchPoll)(nil),                             // 220: streamd.EventTwitchPoll
+	(*EventTwitchPrediction)(nil),                       // 221: streamd.EventTwitchPrediction
+	(*EventQuery)(nil),                                  // 222: streamd.EventQuery
+	(*Event)(nil),                                       // 223: streamd.Event
+	(*TriggerRule)(nil),                                 // 224: streamd.TriggerRule
+	(*ListTriggerRulesRequest)(nil),                     // 225: streamd.ListTriggerRulesRequest
+	(*ListTriggerRulesReply)(nil),                       // 226: streamd.ListTriggerRulesReply
+	(*AddTriggerRuleRequest)(nil),                       // 227: streamd.AddTriggerRuleRequest
+	(*AddTriggerRuleReply)(nil),                         // 228: streamd.AddTriggerRuleReply
+	(*RemoveTriggerRuleRequest)(nil),                    // 229: streamd.RemoveTriggerRuleRequest
+	(*RemoveTriggerRuleReply)(nil),                      // 230: streamd.RemoveTriggerRuleReply
+	(*UpdateTriggerRuleRequest)(nil),                    // 231: streamd.UpdateTriggerRuleRequest
+	(*UpdateTriggerRuleReply)(nil),                      // 232: streamd.UpdateTriggerRuleReply
+	(*TriggerRuleExecution)(nil),                        // 233: streamd.TriggerRuleExecution
+	(*ListTriggerRuleExecutionsRequest)(nil),            // 234: streamd.ListTriggerRuleExecutionsRequest
+	(*ListTriggerRuleExecutionsReply)(nil),              // 235: streamd.ListTriggerRuleExecutionsReply
+	(*EvaluatedExpression)(nil),                         // 236: streamd.EvaluatedExpression
+	(*TriggerRuleTestAction)(nil),                       // 237: streamd.TriggerRuleTestAction
+	(*TestTriggerRuleRequest)(nil),                      // 238: streamd.TestTriggerRuleRequest
+	(*TestTriggerRuleReply)(nil),                        // 239: streamd.TestTriggerRuleReply
+	(*SubmitEventRequest)(nil),                          // 240: streamd.SubmitEventRequest
+	(*SubmitEventReply)(nil),                            // 241: streamd.SubmitEventReply
+	(*SubscribeToChatMessagesRequest)(nil),              // 242: streamd.SubscribeToChatMessagesRequest
+	(*ChatMessage)(nil),                                 // 243: streamd.ChatMessage
+	(*ChatBadge)(nil),                                   // 244: streamd.ChatBadge
+	(*ChatMessageFragment)(nil),                         // 245: streamd.ChatMessageFragment
+	(*ChatMessagePaid)(nil),                             // 246: streamd.ChatMessagePaid
+	(*SendChatMessageRequest)(nil),                      // 247: streamd.SendChatMessageRequest
+	(*SendChatMessageReply)(nil),                        // 248: streamd.SendChatMessageReply
+	(*RemoveChatMessageRequest)(nil),                    // 249: streamd.RemoveChatMessageRequest
+	(*RemoveChatMessageReply)(nil),                      // 250: streamd.RemoveChatMessageReply
+	(*BanUserRequest)(nil),                              // 251: streamd.BanUserRequest
+	(*BanUserReply)(nil),                                // 252: streamd.BanUserReply
+	(*AddModeratorRequest)(nil),                         // 253: streamd.AddModeratorRequest
+	(*AddModeratorReply)(nil),                           // 254: streamd.AddModeratorReply
+	(*RemoveModeratorRequest)(nil),                      // 255: streamd.RemoveModeratorRequest
+	(*RemoveModeratorReply)(nil),                        // 256: streamd.RemoveModeratorReply
+	(*InsertAdsCuePointRequest)(nil),                    // 257: streamd.InsertAdsCuePointRequest
+	(*InsertAdsCuePointReply)(nil),                      // 258: streamd.InsertAdsCuePointReply
+	(*TwitchStreamMarker)(nil),                          // 259: streamd.TwitchStreamMarker
+	(*TwitchCreateStreamMarkerRequest)(nil),             // 260: streamd.TwitchCreateStreamMarkerRequest
+	(*TwitchCreateStreamMarkerReply)(nil),               // 261: streamd.TwitchCreateStreamMarkerReply
+	(*TwitchAdSchedule)(nil),                            // 262: streamd.TwitchAdSchedule
+	(*TwitchGetAdScheduleRequest)(nil),                  // 263: streamd.TwitchGetAdScheduleRequest
+	(*TwitchGetAdScheduleReply)(nil),                    // 264: streamd.TwitchGetAdScheduleReply
+	(*GetPeerIDsRequest)(nil),                           // 265: streamd.GetPeerIDsRequest
+	(*GetPeerIDsReply)(nil),                             // 266: streamd.GetPeerIDsReply
+	(*player_grpc.OpenRequest)(nil),                     // 267: player.OpenRequest
+	(*player_grpc.OpenReply)(nil),                       // 268: player.OpenReply
+	(*player_grpc.ProcessTitleRequest)(nil),             // 269: player.ProcessTitleRequest
+	(*player_grpc.ProcessTitleReply)(nil),               // 270: player.ProcessTitleReply
+	(*player_grpc.GetLinkRequest)(nil),                  // 271: player.GetLinkRequest
+	(*player_grpc.GetLinkReply)(nil),                    // 272: player.GetLinkReply
+	(*player_grpc.EndChanRequest)(nil),                  // 273: player.EndChanRequest
+	(*player_grpc.EndChanReply)(nil),                    // 274: player.EndChanReply
+	(*player_grpc.IsEndedRequest)(nil),                  // 275: player.IsEndedRequest
+	(*player_grpc.IsEndedReply)(nil),                    // 276: player.IsEndedReply
+	(*player_grpc.GetPositionRequest)(nil),              // 277: player.GetPositionRequest
+	(*player_grpc.GetPositionReply)(nil),                // 278: player.GetPositionReply
+	(*player_grpc.GetLengthRequest)(nil),                // 279: player.GetLengthRequest
+	(*player_grpc.GetLengthReply)(nil),                  // 280: player.GetLengthReply
+	(*player_grpc.SetSpeedRequest)(nil),                 // 281: player.SetSpeedRequest
+	(*player_grpc.SetSpeedReply)(nil),                   // 282: player.SetSpeedReply
+	(*player_grpc.SetPauseRequest)(nil),                 // 283: player.SetPauseRequest
+	(*player_grpc.SetPauseReply)(nil),                   // 284: player.SetPauseReply
+	(*player_grpc.StopRequest)(nil),                     // 285: player.StopRequest
+	(*player_grpc.StopReply)(nil),                       // 286: player.StopReply
+	(*player_grpc.CloseRequest)(nil),                    // 287: player.CloseRequest
+	(*player_grpc.CloseReply)(nil),                      // 288: player.CloseReply
 }
 var file_streamd_proto_depIdxs = []int32{
 	0,   // 0: streamd.SetLoggingLevelRequest.loggingLevel:type_name -> streamd.LoggingLevel
@@ -10849,233 +17256,326 @@ var file_streamd_proto_depIdxs = []int32{
 	2,   // 3: streamd.GetVariableHashRequest.hashType:type_name -> streamd.HashType
 	2,   // 4: streamd.GetVariableHashReply.hashType:type_name -> streamd.HashType
 	3,   // 5: streamd.StreamServer.serverType:type_name -> streamd.StreamServerType
-	60,  // 6: streamd.StreamServer.ServerCert:type_name -> streamd.TLSCertificate
-	61,  // 7: streamd.StreamServer.ServerKey:type_name -> streamd.PrivateKey
-	62,  // 8: streamd.StreamServerWithStatistics.config:type_name -> streamd.StreamServer
-	63,  // 9: streamd.StreamServerWithStatistics.statistics:type_name -> streamd.StreamServerStatistics
-	64,  // 10: streamd.ListStreamServersReply.streamServers:type_name -> streamd.StreamServerWithStatistics
-	62,  // 11: streamd.StartStreamServerRequest.config:type_name -> streamd.StreamServer
-	71,  // 12: streamd.ListStreamDestinationsReply.streamDestinations:type_name -> streamd.StreamDestination
-	71,  // 13: streamd.AddStreamDestinationRequest.config:type_name -> streamd.StreamDestination
-	71,  // 14: streamd.UpdateStreamDestinationRequest.config:type_name -> streamd.StreamDestination
-	80,  // 15: streamd.ListIncomingStreamsReply.incomingStreams:type_name -> streamd.IncomingStream
-	87,  // 16: streamd.StreamForwardQuirks.restartUntilYoutubeRecognizesStream:type_name -> streamd.RestartUntilYoutubeRecognizesStream
-	88,  // 17: streamd.StreamForwardQuirks.startAfterYoutubeRecognizedStream:type_name -> streamd.StartAfterYoutubeRecognizedStream
-	89,  // 18: streamd.StreamForward.quirks:type_name -> streamd.StreamForwardQuirks
-	90,  // 19: streamd.StreamForwardWithStatistics.config:type_name -> streamd.StreamForward
-	91,  // 20: streamd.StreamForwardWithStatistics.statistics:type_name -> streamd.StreamForwardStatistics
-	92,  // 21: streamd.ListStreamForwardsReply.streamForwards:type_name -> streamd.StreamForwardWithStatistics
-	90,  // 22: streamd.AddStreamForwardRequest.config:type_name -> streamd.StreamForward
-	90,  // 23: streamd.UpdateStreamForwardRequest.config:type_name -> streamd.StreamForward
-	90,  // 24: streamd.RemoveStreamForwardRequest.config:type_name -> streamd.StreamForward
-	4,   // 25: streamd.StreamPlayerConfig.playerType:type_name -> streamd.PlayerType
-	103, // 26: streamd.StreamPlayerConfig.streamPlaybackConfig:type_name -> streamd.StreamPlaybackConfig
-	104, // 27: streamd.AddStreamPlayerRequest.config:type_name -> streamd.StreamPlayerConfig
-	104, // 28: streamd.UpdateStreamPlayerRequest.config:type_name -> streamd.StreamPlayerConfig
-	104, // 29: streamd.ListStreamPlayersReply.players:type_name -> streamd.StreamPlayerConfig
-	104, // 30: streamd.GetStreamPlayerReply.config:type_name -> streamd.StreamPlayerConfig
-	191, // 31: streamd.StreamPlayerOpenRequest.request:type_name -> player.OpenRequest
-	192, // 32: streamd.StreamPlayerOpenReply.reply:type_name -> player.OpenReply
-	193, // 33: streamd.StreamPlayerProcessTitleRequest.request:type_name -> player.ProcessTitleRequest
-	194, // 34: streamd.StreamPlayerProcessTitleReply.reply:type_name -> player.ProcessTitleReply
-	195, // 35: streamd.StreamPlayerGetLinkRequest.request:type_name -> player.GetLinkRequest
-	196, // 36: streamd.StreamPlayerGetLinkReply.reply:type_name -> player.GetLinkReply
-	197, // 37: streamd.StreamPlayerEndChanRequest.request:type_name -> player.EndChanRequest
-	198, // 38: streamd.StreamPlayerEndChanReply.reply:type_name -> player.EndChanReply
-	199, // 39: streamd.StreamPlayerIsEndedRequest.request:type_name -> player.IsEndedRequest
-	200, // 40: streamd.StreamPlayerIsEndedReply.reply:type_name -> player.IsEndedReply
-	201, // 41: streamd.StreamPlayerGetPositionRequest.request:type_name -> player.GetPositionRequest
-	202, // 42: streamd.StreamPlayerGetPositionReply.reply:type_name -> player.GetPositionReply
-	203, // 43: streamd.StreamPlayerGetLengthRequest.request:type_name -> player.GetLengthRequest
-	204, // 44: streamd.StreamPlayerGetLengthReply.reply:type_name -> player.GetLengthReply
-	205, // 45: streamd.StreamPlayerSetSpeedRequest.request:type_name -> player.SetSpeedRequest
-	206, // 46: streamd.StreamPlayerSetSpeedReply.reply:type_name -> player.SetSpeedReply
-	207, // 47: streamd.StreamPlayerSetPauseRequest.request:type_name -> player.SetPauseRequest
-	208, // 48: streamd.StreamPlayerSetPauseReply.reply:type_name -> player.SetPauseReply
-	209, // 49: streamd.StreamPlayerStopRequest.request:type_name -> player.StopRequest
-	210, // 50: streamd.StreamPlayerStopReply.reply:type_name -> player.StopReply
-	211, // 51: streamd.StreamPlayerCloseRequest.request:type_name -> player.CloseRequest
-	212, // 52: streamd.StreamPlayerCloseReply.reply:type_name -> player.CloseReply
-	152, // 53: streamd.OBSAction.itemShowHide:type_name -> streamd.OBSActionItemShowHide
-	153, // 54: streamd.OBSAction.windowCaptureSetSource:type_name -> streamd.OBSActionWindowCaptureSetSource
-	151, // 55: streamd.Action.noopRequest:type_name -> streamd.NoopRequest
-	22,  // 56: streamd.Action.startStreamRequest:type_name -> streamd.StartStreamRequest
-	24,  // 57: streamd.Action.endStreamRequest:type_name -> streamd.EndStreamRequest
-	154, // 58: streamd.Action.obsAction:type_name -> streamd.OBSAction
-	155, // 59: streamd.AddTimerRequest.action:type_name -> streamd.Action
-	155, // 60: streamd.Timer.action:type_name -> streamd.Action
-	160, // 61: streamd.ListTimersReply.timers:type_name -> streamd.Timer
-	169, // 62: streamd.EventQueryAnd.queries:type_name -> streamd.Event
-	169, // 63: streamd.EventQueryOr.queries:type_name -> streamd.Event
-	169, // 64: streamd.EventQueryNot.query:type_name -> streamd.Event
-	163, // 65: streamd.EventQuery.and:type_name -> streamd.EventQueryAnd
-	164, // 66: streamd.EventQuery.or:type_name -> streamd.EventQueryOr
-	165, // 67: streamd.EventQuery.not:type_name -> streamd.EventQueryNot
-	5,   // 68: streamd.EventQuery.eventType:type_name -> streamd.EventType
-	169, // 69: streamd.EventQuery.event:type_name -> streamd.Event
-	166, // 70: streamd.Event.obsSceneChange:type_name -> streamd.EventOBSSceneChange
-	167, // 71: streamd.Event.windowFocusChange:type_name -> streamd.EventWindowFocusChange
-	168, // 72: streamd.TriggerRule.eventQuery:type_name -> streamd.EventQuery
-	155, // 73: streamd.TriggerRule.action:type_name -> streamd.Action
-	170, // 74: streamd.ListTriggerRulesReply.rules:type_name -> streamd.TriggerRule
-	170, // 75: streamd.AddTriggerRuleRequest.rule:type_name -> streamd.TriggerRule
-	170, // 76: streamd.UpdateTriggerRuleRequest.rule:type_name -> streamd.TriggerRule
-	169, // 77: streamd.SubmitEventRequest.event:type_name -> streamd.Event
-	6,   // 78: streamd.StreamD.Ping:input_type -> streamd.PingRequest
-	8,   // 79: streamd.StreamD.SetLoggingLevel:input_type -> streamd.SetLoggingLevelRequest
-	10,  // 80: streamd.StreamD.GetLoggingLevel:input_type -> streamd.GetLoggingLevelRequest
-	12,  // 81: streamd.StreamD.GetConfig:input_type -> streamd.GetConfigRequest
-	14,  // 82: streamd.StreamD.SetConfig:input_type -> streamd.SetConfigRequest
-	16,  // 83: streamd.StreamD.SaveConfig:input_type -> streamd.SaveConfigRequest
-	137, // 84: streamd.StreamD.SubscribeToConfigChanges:input_type -> streamd.SubscribeToConfigChangesRequest
-	18,  // 85: streamd.StreamD.ResetCache:input_type -> streamd.ResetCacheRequest
-	20,  // 86: streamd.StreamD.InitCache:input_type -> streamd.InitCacheRequest
-	22,  // 87: streamd.StreamD.StartStream:input_type -> streamd.StartStreamRequest
-	24,  // 88: streamd.StreamD.EndStream:input_type -> streamd.EndStreamRequest
-	26,  // 89: streamd.StreamD.GetStreamStatus:input_type -> streamd.GetStreamStatusRequest
-	30,  // 90: streamd.StreamD.IsBackendEnabled:input_type -> streamd.IsBackendEnabledRequest
-	28,  // 91: streamd.StreamD.GetBackendInfo:input_type -> streamd.GetBackendInfoRequest
-	139, // 92: streamd.StreamD.SubscribeToStreamsChanges:input_type -> streamd.SubscribeToStreamsChangesRequest
-	32,  // 93: streamd.StreamD.Restart:input_type -> streamd.RestartRequest
-	34,  // 94: streamd.StreamD.SetTitle:input_type -> streamd.SetTitleRequest
-	36,  // 95: streamd.StreamD.SetDescription:input_type -> streamd.SetDescriptionRequest
-	38,  // 96: streamd.StreamD.ApplyProfile:input_type -> streamd.ApplyProfileRequest
-	40,  // 97: streamd.StreamD.UpdateStream:input_type -> streamd.UpdateStreamRequest
-	52,  // 98: streamd.StreamD.GetVariable:input_type -> streamd.GetVariableRequest
-	54,  // 99: streamd.StreamD.GetVariableHash:input_type -> streamd.GetVariableHashRequest
-	56,  // 100: streamd.StreamD.SetVariable:input_type -> streamd.SetVariableRequest
-	42,  // 101: streamd.StreamD.EXPERIMENTAL_ReinitStreamControllers:input_type -> streamd.EXPERIMENTAL_ReinitStreamControllersRequest
-	50,  // 102: streamd.StreamD.SubscribeToOAuthRequests:input_type -> streamd.SubscribeToOAuthRequestsRequest
-	58,  // 103: streamd.StreamD.SubmitOAuthCode:input_type -> streamd.SubmitOAuthCodeRequest
-	65,  // 104: streamd.StreamD.ListStreamServers:input_type -> streamd.ListStreamServersRequest
-	67,  // 105: streamd.StreamD.StartStreamServer:input_type -> streamd.StartStreamServerRequest
-	69,  // 106: streamd.StreamD.StopStreamServer:input_type -> streamd.StopStreamServerRequest
-	141, // 107: streamd.StreamD.SubscribeToStreamServersChanges:input_type -> streamd.SubscribeToStreamServersChangesRequest
-	72,  // 108: streamd.StreamD.ListStreamDestinations:input_type -> streamd.ListStreamDestinationsRequest
-	74,  // 109: streamd.StreamD.AddStreamDestination:input_type -> streamd.AddStreamDestinationRequest
-	76,  // 110: streamd.StreamD.UpdateStreamDestination:input_type -> streamd.UpdateStreamDestinationRequest
-	78,  // 111: streamd.StreamD.RemoveStreamDestination:input_type -> streamd.RemoveStreamDestinationRequest
-	143, // 112: streamd.StreamD.SubscribeToStreamDestinationsChanges:input_type -> streamd.SubscribeToStreamDestinationsChangesRequest
-	81,  // 113: streamd.StreamD.AddIncomingStream:input_type -> streamd.AddIncomingStreamRequest
-	83,  // 114: streamd.StreamD.RemoveIncomingStream:input_type -> streamd.RemoveIncomingStreamRequest
-	85,  // 115: streamd.StreamD.ListIncomingStreams:input_type -> streamd.ListIncomingStreamsRequest
-	145, // 116: streamd.StreamD.SubscribeToIncomingStreamsChanges:input_type -> streamd.SubscribeToIncomingStreamsChangesRequest
-	93,  // 117: streamd.StreamD.ListStreamForwards:input_type -> streamd.ListStreamForwardsRequest
-	95,  // 118: streamd.StreamD.AddStreamForward:input_type -> streamd.AddStreamForwardRequest
-	97,  // 119: streamd.StreamD.UpdateStreamForward:input_type -> streamd.UpdateStreamForwardRequest
-	99,  // 120: streamd.StreamD.RemoveStreamForward:input_type -> streamd.RemoveStreamForwardRequest
-	147, // 121: streamd.StreamD.SubscribeToStreamForwardsChanges:input_type -> streamd.SubscribeToStreamForwardsChangesRequest
-	101, // 122: streamd.StreamD.WaitForStreamPublisher:input_type -> streamd.WaitForStreamPublisherRequest
-	105, // 123: streamd.StreamD.AddStreamPlayer:input_type -> streamd.AddStreamPlayerRequest
-	107, // 124: streamd.StreamD.RemoveStreamPlayer:input_type -> streamd.RemoveStreamPlayerRequest
-	109, // 125: streamd.StreamD.UpdateStreamPlayer:input_type -> streamd.UpdateStreamPlayerRequest
-	111, // 126: streamd.StreamD.ListStreamPlayers:input_type -> streamd.ListStreamPlayersRequest
-	113, // 127: streamd.StreamD.GetStreamPlayer:input_type -> streamd.GetStreamPlayerRequest
-	149, // 128: streamd.StreamD.SubscribeToStreamPlayersChanges:input_type -> streamd.SubscribeToStreamPlayersChangesRequest
-	115, // 129: streamd.StreamD.StreamPlayerOpen:input_type -> streamd.StreamPlayerOpenRequest
-	117, // 130: streamd.StreamD.StreamPlayerProcessTitle:input_type -> streamd.StreamPlayerProcessTitleRequest
-	119, // 131: streamd.StreamD.StreamPlayerGetLink:input_type -> streamd.StreamPlayerGetLinkRequest
-	121, // 132: streamd.StreamD.StreamPlayerEndChan:input_type -> streamd.StreamPlayerEndChanRequest
-	123, // 133: streamd.StreamD.StreamPlayerIsEnded:input_type -> streamd.StreamPlayerIsEndedRequest
-	125, // 134: streamd.StreamD.StreamPlayerGetPosition:input_type -> streamd.StreamPlayerGetPositionRequest
-	127, // 135: streamd.StreamD.StreamPlayerGetLength:input_type -> streamd.StreamPlayerGetLengthRequest
-	129, // 136: streamd.StreamD.StreamPlayerSetSpeed:input_type -> streamd.StreamPlayerSetSpeedRequest
-	131, // 137: streamd.StreamD.StreamPlayerSetPause:input_type -> streamd.StreamPlayerSetPauseRequest
-	133, // 138: streamd.StreamD.StreamPlayerStop:input_type -> streamd.StreamPlayerStopRequest
-	135, // 139: streamd.StreamD.StreamPlayerClose:input_type -> streamd.StreamPlayerCloseRequest
-	156, // 140: streamd.StreamD.AddTimer:input_type -> streamd.AddTimerRequest
-	158, // 141: streamd.StreamD.RemoveTimer:input_type -> streamd.RemoveTimerRequest
-	161, // 142: streamd.StreamD.ListTimers:input_type -> streamd.ListTimersRequest
-	171, // 143: streamd.StreamD.ListTriggerRules:input_type -> streamd.ListTriggerRulesRequest
-	173, // 144: streamd.StreamD.AddTriggerRule:input_type -> streamd.AddTriggerRuleRequest
-	175, // 145: streamd.StreamD.RemoveTriggerRule:input_type -> streamd.RemoveTriggerRuleRequest
-	177, // 146: streamd.StreamD.UpdateTriggerRule:input_type -> streamd.UpdateTriggerRuleRequest
-	179, // 147: streamd.StreamD.SubmitEvent:input_type -> streamd.SubmitEventRequest
-	181, // 148: streamd.StreamD.SubscribeToChatMessages:input_type -> streamd.SubscribeToChatMessagesRequest
-	183, // 149: streamd.StreamD.SendChatMessage:input_type -> streamd.SendChatMessageRequest
-	185, // 150: streamd.StreamD.RemoveChatMessage:input_type -> streamd.RemoveChatMessageRequest
-	187, // 151: streamd.StreamD.BanUser:input_type -> streamd.BanUserRequest
-	189, // 152: streamd.StreamD.GetPeerIDs:input_type -> streamd.GetPeerIDsRequest
-	7,   // 153: streamd.StreamD.Ping:output_type -> streamd.PingReply
-	9,   // 154: streamd.StreamD.SetLoggingLevel:output_type -> streamd.SetLoggingLevelReply
-	11,  // 155: streamd.StreamD.GetLoggingLevel:output_type -> streamd.GetLoggingLevelReply
-	13,  // 156: streamd.StreamD.GetConfig:output_type -> streamd.GetConfigReply
-	15,  // 157: streamd.StreamD.SetConfig:output_type -> streamd.SetConfigReply
-	17,  // 158: streamd.StreamD.SaveConfig:output_type -> streamd.SaveConfigReply
-	138, // 159: streamd.StreamD.SubscribeToConfigChanges:output_type -> streamd.ConfigChange
-	19,  // 160: streamd.StreamD.ResetCache:output_type -> streamd.ResetCacheReply
-	21,  // 161: streamd.StreamD.InitCache:output_type -> streamd.InitCacheReply
-	23,  // 162: streamd.StreamD.StartStream:output_type -> streamd.StartStreamReply
-	25,  // 163: streamd.StreamD.EndStream:output_type -> streamd.EndStreamReply
-	27,  // 164: streamd.StreamD.GetStreamStatus:output_type -> streamd.GetStreamStatusReply
-	31,  // 165: streamd.StreamD.IsBackendEnabled:output_type -> streamd.IsBackendEnabledReply
-	29,  // 166: streamd.StreamD.GetBackendInfo:output_type -> streamd.GetBackendInfoReply
-	140, // 167: streamd.StreamD.SubscribeToStreamsChanges:output_type -> streamd.StreamsChange
-	33,  // 168: streamd.StreamD.Restart:output_type -> streamd.RestartReply
-	35,  // 169: streamd.StreamD.SetTitle:output_type -> streamd.SetTitleReply
-	37,  // 170: streamd.StreamD.SetDescription:output_type -> streamd.SetDescriptionReply
-	39,  // 171: streamd.StreamD.ApplyProfile:output_type -> streamd.ApplyProfileReply
-	41,  // 172: streamd.StreamD.UpdateStream:output_type -> streamd.UpdateStreamReply
-	53,  // 173: streamd.StreamD.GetVariable:output_type -> streamd.GetVariableReply
-	55,  // 174: streamd.StreamD.GetVariableHash:output_type -> streamd.GetVariableHashReply
-	57,  // 175: streamd.StreamD.SetVariable:output_type -> streamd.SetVariableReply
-	43,  // 176: streamd.StreamD.EXPERIMENTAL_ReinitStreamControllers:output_type -> streamd.EXPERIMENTAL_ReinitStreamControllersReply
-	51,  // 177: streamd.StreamD.SubscribeToOAuthRequests:output_type -> streamd.OAuthRequest
-	59,  // 178: streamd.StreamD.SubmitOAuthCode:output_type -> streamd.SubmitOAuthCodeReply
-	66,  // 179: streamd.StreamD.ListStreamServers:output_type -> streamd.ListStreamServersReply
-	68,  // 180: streamd.StreamD.StartStreamServer:output_type -> streamd.StartStreamServerReply
-	70,  // 181: streamd.StreamD.StopStreamServer:output_type -> streamd.StopStreamServerReply
-	142, // 182: streamd.StreamD.SubscribeToStreamServersChanges:output_type -> streamd.StreamServersChange
-	73,  // 183: streamd.StreamD.ListStreamDestinations:output_type -> streamd.ListStreamDestinationsReply
-	75,  // 184: streamd.StreamD.AddStreamDestination:output_type -> streamd.AddStreamDestinationReply
-	77,  // 185: streamd.StreamD.UpdateStreamDestination:output_type -> streamd.UpdateStreamDestinationReply
-	79,  // 186: streamd.StreamD.RemoveStreamDestination:output_type -> streamd.RemoveStreamDestinationReply
-	144, // 187: streamd.StreamD.SubscribeToStreamDestinationsChanges:output_type -> streamd.StreamDestinationsChange
-	82,  // 188: streamd.StreamD.AddIncomingStream:output_type -> streamd.AddIncomingStreamReply
-	84,  // 189: streamd.StreamD.RemoveIncomingStream:output_type -> streamd.RemoveIncomingStreamReply
-	86,  // 190: streamd.StreamD.ListIncomingStreams:output_type -> streamd.ListIncomingStreamsReply
-	146, // 191: streamd.StreamD.SubscribeToIncomingStreamsChanges:output_type -> streamd.IncomingStreamsChange
-	94,  // 192: streamd.StreamD.ListStreamForwards:output_type -> streamd.ListStreamForwardsReply
-	96,  // 193: streamd.StreamD.AddStreamForward:output_type -> streamd.AddStreamForwardReply
-	98,  // 194: streamd.StreamD.UpdateStreamForward:output_type -> streamd.UpdateStreamForwardReply
-	100, // 195: streamd.StreamD.RemoveStreamForward:output_type -> streamd.RemoveStreamForwardReply
-	148, // 196: streamd.StreamD.SubscribeToStreamForwardsChanges:output_type -> streamd.StreamForwardsChange
-	102, // 197: streamd.StreamD.WaitForStreamPublisher:output_type -> streamd.StreamPublisher
-	106, // 198: streamd.StreamD.AddStreamPlayer:output_type -> streamd.AddStreamPlayerReply
-	108, // 199: streamd.StreamD.RemoveStreamPlayer:output_type -> streamd.RemoveStreamPlayerReply
-	110, // 200: streamd.StreamD.UpdateStreamPlayer:output_type -> streamd.UpdateStreamPlayerReply
-	112, // 201: streamd.StreamD.ListStreamPlayers:output_type -> streamd.ListStreamPlayersReply
-	114, // 202: streamd.StreamD.GetStreamPlayer:output_type -> streamd.GetStreamPlayerReply
-	150, // 203: streamd.StreamD.SubscribeToStreamPlayersChanges:output_type -> streamd.StreamPlayersChange
-	116, // 204: streamd.StreamD.StreamPlayerOpen:output_type -> streamd.StreamPlayerOpenReply
-	118, // 205: streamd.StreamD.StreamPlayerProcessTitle:output_type -> streamd.StreamPlayerProcessTitleReply
-	120, // 206: streamd.StreamD.StreamPlayerGetLink:output_type -> streamd.StreamPlayerGetLinkReply
-	122, // 207: streamd.StreamD.StreamPlayerEndChan:output_type -> streamd.StreamPlayerEndChanReply
-	124, // 208: streamd.StreamD.StreamPlayerIsEnded:output_type -> streamd.StreamPlayerIsEndedReply
-	126, // 209: streamd.StreamD.StreamPlayerGetPosition:output_type -> streamd.StreamPlayerGetPositionReply
-	128, // 210: streamd.StreamD.StreamPlayerGetLength:output_type -> streamd.StreamPlayerGetLengthReply
-	130, // 211: streamd.StreamD.StreamPlayerSetSpeed:output_type -> streamd.StreamPlayerSetSpeedReply
-	132, // 212: streamd.StreamD.StreamPlayerSetPause:output_type -> streamd.StreamPlayerSetPauseReply
-	134, // 213: streamd.StreamD.StreamPlayerStop:output_type -> streamd.StreamPlayerStopReply
-	136, // 214: streamd.StreamD.StreamPlayerClose:output_type -> streamd.StreamPlayerCloseReply
-	157, // 215: streamd.StreamD.AddTimer:output_type -> streamd.AddTimerReply
-	159, // 216: streamd.StreamD.RemoveTimer:output_type -> streamd.RemoveTimerReply
-	162, // 217: streamd.StreamD.ListTimers:output_type -> streamd.ListTimersReply
-	172, // 218: streamd.StreamD.ListTriggerRules:output_type -> streamd.ListTriggerRulesReply
-	174, // 219: streamd.StreamD.AddTriggerRule:output_type -> streamd.AddTriggerRuleReply
-	176, // 220: streamd.StreamD.RemoveTriggerRule:output_type -> streamd.RemoveTriggerRuleReply
-	178, // 221: streamd.StreamD.UpdateTriggerRule:output_type -> streamd.UpdateTriggerRuleReply
-	180, // 222: streamd.StreamD.SubmitEvent:output_type -> streamd.SubmitEventReply
-	182, // 223: streamd.StreamD.SubscribeToChatMessages:output_type -> streamd.ChatMessage
-	184, // 224: streamd.StreamD.SendChatMessage:output_type -> streamd.SendChatMessageReply
-	186, // 225: streamd.StreamD.RemoveChatMessage:output_type -> streamd.RemoveChatMessageReply
-	188, // 226: streamd.StreamD.BanUser:output_type -> streamd.BanUserReply
-	190, // 227: streamd.StreamD.GetPeerIDs:output_type -> streamd.GetPeerIDsReply
-	153, // [153:228] is the sub-list for method output_type
-	78,  // [78:153] is the sub-list for method input_type
-	78,  // [78:78] is the sub-list for extension type_name
-	78,  // [78:78] is the sub-list for extension extendee
-	0,   // [0:78] is the sub-list for field type_name
+	64,  // 6: streamd.StreamServer.ServerCert:type_name -> streamd.TLSCertificate
+	65,  // 7: streamd.StreamServer.ServerKey:type_name -> streamd.PrivateKey
+	66,  // 8: streamd.StreamServerWithStatistics.config:type_name -> streamd.StreamServer
+	67,  // 9: streamd.StreamServerWithStatistics.statistics:type_name -> streamd.StreamServerStatistics
+	68,  // 10: streamd.ListStreamServersReply.streamServers:type_name -> streamd.StreamServerWithStatistics
+	66,  // 11: streamd.StartStreamServerRequest.config:type_name -> streamd.StreamServer
+	75,  // 12: streamd.ListStreamDestinationsReply.streamDestinations:type_name -> streamd.StreamDestination
+	75,  // 13: streamd.AddStreamDestinationRequest.config:type_name -> streamd.StreamDestination
+	75,  // 14: streamd.UpdateStreamDestinationRequest.config:type_name -> streamd.StreamDestination
+	84,  // 15: streamd.ListIncomingStreamsReply.incomingStreams:type_name -> streamd.IncomingStream
+	91,  // 16: streamd.StreamForwardQuirks.restartUntilPlatformRecognizesStream:type_name -> streamd.RestartUntilPlatformRecognizesStream
+	92,  // 17: streamd.StreamForwardQuirks.startAfterPlatformRecognizedStream:type_name -> streamd.StartAfterPlatformRecognizedStream
+	93,  // 18: streamd.StreamForward.quirks:type_name -> streamd.StreamForwardQuirks
+	94,  // 19: streamd.StreamForwardWithStatistics.config:type_name -> streamd.StreamForward
+	95,  // 20: streamd.StreamForwardWithStatistics.statistics:type_name -> streamd.StreamForwardStatistics
+	96,  // 21: streamd.ListStreamForwardsReply.streamForwards:type_name -> streamd.StreamForwardWithStatistics
+	94,  // 22: streamd.AddStreamForwardRequest.config:type_name -> streamd.StreamForward
+	94,  // 23: streamd.UpdateStreamForwardRequest.config:type_name -> streamd.StreamForward
+	94,  // 24: streamd.RemoveStreamForwardRequest.config:type_name -> streamd.StreamForward
+	105, // 25: streamd.StartRecordingRequest.config:type_name -> streamd.StreamRecordingConfig
+	106, // 26: streamd.ListRecordingsReply.recordings:type_name -> streamd.StreamRecording
+	4,   // 27: streamd.StreamPlayerConfig.playerType:type_name -> streamd.PlayerType
+	115, // 28: streamd.StreamPlayerConfig.streamPlaybackConfig:type_name -> streamd.StreamPlaybackConfig
+	116, // 29: streamd.AddStreamPlayerRequest.config:type_name -> streamd.StreamPlayerConfig
+	116, // 30: streamd.UpdateStreamPlayerRequest.config:type_name -> streamd.StreamPlayerConfig
+	116, // 31: streamd.ListStreamPlayersReply.players:type_name -> streamd.StreamPlayerConfig
+	116, // 32: streamd.GetStreamPlayerReply.config:type_name -> streamd.StreamPlayerConfig
+	267, // 33: streamd.StreamPlayerOpenRequest.request:type_name -> player.OpenRequest
+	268, // 34: streamd.StreamPlayerOpenReply.reply:type_name -> player.OpenReply
+	269, // 35: streamd.StreamPlayerProcessTitleRequest.request:type_name -> player.ProcessTitleRequest
+	270, // 36: streamd.StreamPlayerProcessTitleReply.reply:type_name -> player.ProcessTitleReply
+	271, // 37: streamd.StreamPlayerGetLinkRequest.request:type_name -> player.GetLinkRequest
+	272, // 38: streamd.StreamPlayerGetLinkReply.reply:type_name -> player.GetLinkReply
+	273, // 39: streamd.StreamPlayerEndChanRequest.request:type_name -> player.EndChanRequest
+	274, // 40: streamd.StreamPlayerEndChanReply.reply:type_name -> player.EndChanReply
+	275, // 41: streamd.StreamPlayerIsEndedRequest.request:type_name -> player.IsEndedRequest
+	276, // 42: streamd.StreamPlayerIsEndedReply.reply:type_name -> player.IsEndedReply
+	277, // 43: streamd.StreamPlayerGetPositionRequest.request:type_name -> player.GetPositionRequest
+	278, // 44: streamd.StreamPlayerGetPositionReply.reply:type_name -> player.GetPositionReply
+	279, // 45: streamd.StreamPlayerGetLengthRequest.request:type_name -> player.GetLengthRequest
+	280, // 46: streamd.StreamPlayerGetLengthReply.reply:type_name -> player.GetLengthReply
+	281, // 47: streamd.StreamPlayerSetSpeedRequest.request:type_name -> player.SetSpeedRequest
+	282, // 48: streamd.StreamPlayerSetSpeedReply.reply:type_name -> player.SetSpeedReply
+	283, // 49: streamd.StreamPlayerSetPauseRequest.request:type_name -> player.SetPauseRequest
+	284, // 50: streamd.StreamPlayerSetPauseReply.reply:type_name -> player.SetPauseReply
+	285, // 51: streamd.StreamPlayerStopRequest.request:type_name -> player.StopRequest
+	286, // 52: streamd.StreamPlayerStopReply.reply:type_name -> player.StopReply
+	287, // 53: streamd.StreamPlayerCloseRequest.request:type_name -> player.CloseRequest
+	288, // 54: streamd.StreamPlayerCloseReply.reply:type_name -> player.CloseReply
+	223, // 55: streamd.IncomingStreamsChange.event:type_name -> streamd.Event
+	223, // 56: streamd.StreamForwardsChange.event:type_name -> streamd.Event
+	96,  // 57: streamd.StreamForwardStatsUpdate.streamForwards:type_name -> streamd.StreamForwardWithStatistics
+	166, // 58: streamd.OBSAction.itemShowHide:type_name -> streamd.OBSActionItemShowHide
+	167, // 59: streamd.OBSAction.windowCaptureSetSource:type_name -> streamd.OBSActionWindowCaptureSetSource
+	168, // 60: streamd.OBSAction.setCurrentScene:type_name -> streamd.OBSActionSetCurrentScene
+	184, // 61: streamd.ActionStep.action:type_name -> streamd.Action
+	5,   // 62: streamd.ActionStep.onError:type_name -> streamd.ActionErrorPolicy
+	170, // 63: streamd.ActionSequence.steps:type_name -> streamd.ActionStep
+	170, // 64: streamd.ActionParallel.steps:type_name -> streamd.ActionStep
+	184, // 65: streamd.ActionIf.then:type_name -> streamd.Action
+	184, // 66: streamd.ActionIf.else:type_name -> streamd.Action
+	165, // 67: streamd.Action.noopRequest:type_name -> streamd.NoopRequest
+	26,  // 68: streamd.Action.startStreamRequest:type_name -> streamd.StartStreamRequest
+	28,  // 69: streamd.Action.endStreamRequest:type_name -> streamd.EndStreamRequest
+	169, // 70: streamd.Action.obsAction:type_name -> streamd.OBSAction
+	171, // 71: streamd.Action.sequence:type_name -> streamd.ActionSequence
+	172, // 72: streamd.Action.parallel:type_name -> streamd.ActionParallel
+	173, // 73: streamd.Action.delay:type_name -> streamd.ActionDelay
+	174, // 74: streamd.Action.if:type_name -> streamd.ActionIf
+	175, // 75: streamd.Action.sendChatMessage:type_name -> streamd.ActionSendChatMessage
+	176, // 76: streamd.Action.setTitle:type_name -> streamd.ActionSetTitle
+	177, // 77: streamd.Action.setDescription:type_name -> streamd.ActionSetDescription
+	178, // 78: streamd.Action.applyProfile:type_name -> streamd.ActionApplyProfile
+	179, // 79: streamd.Action.setStreamForwardEnabled:type_name -> streamd.ActionSetStreamForwardEnabled
+	180, // 80: streamd.Action.setVariable:type_name -> streamd.ActionSetVariable
+	181, // 81: streamd.Action.insertAdsCuePoint:type_name -> streamd.ActionInsertAdsCuePoint
+	182, // 82: streamd.Action.runCommand:type_name -> streamd.ActionRunCommand
+	183, // 83: streamd.Action.twitchCreateStreamMarker:type_name -> streamd.ActionTwitchCreateStreamMarker
+	6,   // 84: streamd.TimerMissedDeadlinePolicy.type:type_name -> streamd.TimerMissedDeadlinePolicyType
+	186, // 85: streamd.TimerSchedule.relativeToStreamStart:type_name -> streamd.TimerRelativeToStreamStart
+	184, // 86: streamd.AddTimerRequest.action:type_name -> streamd.Action
+	185, // 87: streamd.AddTimerRequest.missedDeadlinePolicy:type_name -> streamd.TimerMissedDeadlinePolicy
+	187, // 88: streamd.AddTimerRequest.schedule:type_name -> streamd.TimerSchedule
+	184, // 89: streamd.Timer.action:type_name -> streamd.Action
+	185, // 90: streamd.Timer.missedDeadlinePolicy:type_name -> streamd.TimerMissedDeadlinePolicy
+	187, // 91: streamd.Timer.schedule:type_name -> streamd.TimerSchedule
+	192, // 92: streamd.ListTimersReply.timers:type_name -> streamd.Timer
+	222, // 93: streamd.EventQueryAnd.queries:type_name -> streamd.EventQuery
+	222, // 94: streamd.EventQueryOr.queries:type_name -> streamd.EventQuery
+	222, // 95: streamd.EventQueryNot.query:type_name -> streamd.EventQuery
+	8,   // 96: streamd.EventChatMessageReceived.roles:type_name -> streamd.ChatUserRole
+	244, // 97: streamd.EventChatMessageReceived.badges:type_name -> streamd.ChatBadge
+	246, // 98: streamd.EventChatMessageReceived.paid:type_name -> streamd.ChatMessagePaid
+	195, // 99: streamd.EventQuery.and:type_name -> streamd.EventQueryAnd
+	196, // 100: streamd.EventQuery.or:type_name -> streamd.EventQueryOr
+	197, // 101: streamd.EventQuery.not:type_name -> streamd.EventQueryNot
+	7,   // 102: streamd.EventQuery.eventType:type_name -> streamd.EventType
+	223, // 103: streamd.EventQuery.event:type_name -> streamd.Event
+	198, // 104: streamd.EventQuery.eventField:type_name -> streamd.EventQueryEventField
+	199, // 105: streamd.EventQuery.streamIsActive:type_name -> streamd.EventQueryStreamIsActive
+	200, // 106: streamd.EventQuery.variableEquals:type_name -> streamd.EventQueryVariableEquals
+	201, // 107: streamd.Event.obsSceneChange:type_name -> streamd.EventOBSSceneChange
+	202, // 108: streamd.Event.windowFocusChange:type_name -> streamd.EventWindowFocusChange
+	203, // 109: streamd.Event.chatMessageReceived:type_name -> streamd.EventChatMessageReceived
+	204, // 110: streamd.Event.streamStarted:type_name -> streamd.EventStreamStarted
+	205, // 111: streamd.Event.streamEnded:type_name -> streamd.EventStreamEnded
+	206, // 112: streamd.Event.viewerCountChanged:type_name -> streamd.EventViewerCountChanged
+	207, // 113: streamd.Event.incomingStreamPublished:type_name -> streamd.EventIncomingStreamPublished
+	208, // 114: streamd.Event.incomingStreamUnpublished:type_name -> streamd.EventIncomingStreamUnpublished
+	209, // 115: streamd.Event.streamForwardStarted:type_name -> streamd.EventStreamForwardStarted
+	211, // 116: streamd.Event.streamForwardFailed:type_name -> streamd.EventStreamForwardFailed
+	210, // 117: streamd.Event.incomingStreamAuthFailed:type_name -> streamd.EventIncomingStreamAuthFailed
+	212, // 118: streamd.Event.streamForwardSourceSwitched:type_name -> streamd.EventStreamForwardSourceSwitched
+	214, // 119: streamd.Event.twitchFollow:type_name -> streamd.EventTwitchFollow
+	215, // 120: streamd.Event.twitchSubscription:type_name -> streamd.EventTwitchSubscription
+	216, // 121: streamd.Event.twitchSubscriptionGift:type_name -> streamd.EventTwitchSubscriptionGift
+	217, // 122: streamd.Event.twitchCheer:type_name -> streamd.EventTwitchCheer
+	218, // 123: streamd.Event.twitchRaid:type_name -> streamd.EventTwitchRaid
+	219, // 124: streamd.Event.twitchChannelPointsRedemption:type_name -> streamd.EventTwitchChannelPointsRedemption
+	220, // 125: streamd.Event.twitchPoll:type_name -> streamd.EventTwitchPoll
+	221, // 126: streamd.Event.twitchPrediction:type_name -> streamd.EventTwitchPrediction
+	213, // 127: streamd.Event.streamForwardFallbackStopped:type_name -> streamd.EventStreamForwardFallbackStopped
+	222, // 128: streamd.TriggerRule.eventQuery:type_name -> streamd.EventQuery
+	184, // 129: streamd.TriggerRule.action:type_name -> streamd.Action
+	224, // 130: streamd.ListTriggerRulesReply.rules:type_name -> streamd.TriggerRule
+	224, // 131: streamd.AddTriggerRuleRequest.rule:type_name -> streamd.TriggerRule
+	224, // 132: streamd.UpdateTriggerRuleRequest.rule:type_name -> streamd.TriggerRule
+	223, // 133: streamd.TriggerRuleExecution.event:type_name -> streamd.Event
+	184, // 134: streamd.TriggerRuleExecution.action:type_name -> streamd.Action
+	233, // 135: streamd.ListTriggerRuleExecutionsReply.executions:type_name -> streamd.TriggerRuleExecution
+	184, // 136: streamd.TriggerRuleTestAction.action:type_name -> streamd.Action
+	236, // 137: streamd.TriggerRuleTestAction.expressions:type_name -> streamd.EvaluatedExpression
+	224, // 138: streamd.TestTriggerRuleRequest.rule:type_name -> streamd.TriggerRule
+	223, // 139: streamd.TestTriggerRuleRequest.event:type_name -> streamd.Event
+	237, // 140: streamd.TestTriggerRuleReply.actions:type_name -> streamd.TriggerRuleTestAction
+	223, // 141: streamd.SubmitEventRequest.event:type_name -> streamd.Event
+	8,   // 142: streamd.ChatMessage.roles:type_name -> streamd.ChatUserRole
+	244, // 143: streamd.ChatMessage.badges:type_name -> streamd.ChatBadge
+	245, // 144: streamd.ChatMessage.fragments:type_name -> streamd.ChatMessageFragment
+	246, // 145: streamd.ChatMessage.paid:type_name -> streamd.ChatMessagePaid
+	9,   // 146: streamd.ChatMessageFragment.type:type_name -> streamd.ChatMessageFragmentType
+	259, // 147: streamd.TwitchCreateStreamMarkerReply.marker:type_name -> streamd.TwitchStreamMarker
+	262, // 148: streamd.TwitchGetAdScheduleReply.schedule:type_name -> streamd.TwitchAdSchedule
+	10,  // 149: streamd.StreamD.Ping:input_type -> streamd.PingRequest
+	12,  // 150: streamd.StreamD.SetLoggingLevel:input_type -> streamd.SetLoggingLevelRequest
+	14,  // 151: streamd.StreamD.GetLoggingLevel:input_type -> streamd.GetLoggingLevelRequest
+	16,  // 152: streamd.StreamD.GetConfig:input_type -> streamd.GetConfigRequest
+	18,  // 153: streamd.StreamD.SetConfig:input_type -> streamd.SetConfigRequest
+	20,  // 154: streamd.StreamD.SaveConfig:input_type -> streamd.SaveConfigRequest
+	149, // 155: streamd.StreamD.SubscribeToConfigChanges:input_type -> streamd.SubscribeToConfigChangesRequest
+	22,  // 156: streamd.StreamD.ResetCache:input_type -> streamd.ResetCacheRequest
+	24,  // 157: streamd.StreamD.InitCache:input_type -> streamd.InitCacheRequest
+	26,  // 158: streamd.StreamD.StartStream:input_type -> streamd.StartStreamRequest
+	28,  // 159: streamd.StreamD.EndStream:input_type -> streamd.EndStreamRequest
+	30,  // 160: streamd.StreamD.GetStreamStatus:input_type -> streamd.GetStreamStatusRequest
+	34,  // 161: streamd.StreamD.IsBackendEnabled:input_type -> streamd.IsBackendEnabledRequest
+	32,  // 162: streamd.StreamD.GetBackendInfo:input_type -> streamd.GetBackendInfoRequest
+	151, // 163: streamd.StreamD.SubscribeToStreamsChanges:input_type -> streamd.SubscribeToStreamsChangesRequest
+	36,  // 164: streamd.StreamD.Restart:input_type -> streamd.RestartRequest
+	38,  // 165: streamd.StreamD.SetTitle:input_type -> streamd.SetTitleRequest
+	40,  // 166: streamd.StreamD.SetDescription:input_type -> streamd.SetDescriptionRequest
+	42,  // 167: streamd.StreamD.ApplyProfile:input_type -> streamd.ApplyProfileRequest
+	44,  // 168: streamd.StreamD.UpdateStream:input_type -> streamd.UpdateStreamRequest
+	56,  // 169: streamd.StreamD.GetVariable:input_type -> streamd.GetVariableRequest
+	58,  // 170: streamd.StreamD.GetVariableHash:input_type -> streamd.GetVariableHashRequest
+	60,  // 171: streamd.StreamD.SetVariable:input_type -> streamd.SetVariableRequest
+	46,  // 172: streamd.StreamD.EXPERIMENTAL_ReinitStreamControllers:input_type -> streamd.EXPERIMENTAL_ReinitStreamControllersRequest
+	54,  // 173: streamd.StreamD.SubscribeToOAuthRequests:input_type -> streamd.SubscribeToOAuthRequestsRequest
+	62,  // 174: streamd.StreamD.SubmitOAuthCode:input_type -> streamd.SubmitOAuthCodeRequest
+	69,  // 175: streamd.StreamD.ListStreamServers:input_type -> streamd.ListStreamServersRequest
+	71,  // 176: streamd.StreamD.StartStreamServer:input_type -> streamd.StartStreamServerRequest
+	73,  // 177: streamd.StreamD.StopStreamServer:input_type -> streamd.StopStreamServerRequest
+	153, // 178: streamd.StreamD.SubscribeToStreamServersChanges:input_type -> streamd.SubscribeToStreamServersChangesRequest
+	76,  // 179: streamd.StreamD.ListStreamDestinations:input_type -> streamd.ListStreamDestinationsRequest
+	78,  // 180: streamd.StreamD.AddStreamDestination:input_type -> streamd.AddStreamDestinationRequest
+	80,  // 181: streamd.StreamD.UpdateStreamDestination:input_type -> streamd.UpdateStreamDestinationRequest
+	82,  // 182: streamd.StreamD.RemoveStreamDestination:input_type -> streamd.RemoveStreamDestinationRequest
+	155, // 183: streamd.StreamD.SubscribeToStreamDestinationsChanges:input_type -> streamd.SubscribeToStreamDestinationsChangesRequest
+	85,  // 184: streamd.StreamD.AddIncomingStream:input_type -> streamd.AddIncomingStreamRequest
+	87,  // 185: streamd.StreamD.RemoveIncomingStream:input_type -> streamd.RemoveIncomingStreamRequest
+	89,  // 186: streamd.StreamD.ListIncomingStreams:input_type -> streamd.ListIncomingStreamsRequest
+	157, // 187: streamd.StreamD.SubscribeToIncomingStreamsChanges:input_type -> streamd.SubscribeToIncomingStreamsChangesRequest
+	97,  // 188: streamd.StreamD.ListStreamForwards:input_type -> streamd.ListStreamForwardsRequest
+	99,  // 189: streamd.StreamD.AddStreamForward:input_type -> streamd.AddStreamForwardRequest
+	101, // 190: streamd.StreamD.UpdateStreamForward:input_type -> streamd.UpdateStreamForwardRequest
+	103, // 191: streamd.StreamD.RemoveStreamForward:input_type -> streamd.RemoveStreamForwardRequest
+	159, // 192: streamd.StreamD.SubscribeToStreamForwardsChanges:input_type -> streamd.SubscribeToStreamForwardsChangesRequest
+	161, // 193: streamd.StreamD.SubscribeToStreamForwardStats:input_type -> streamd.SubscribeToStreamForwardStatsRequest
+	107, // 194: streamd.StreamD.StartRecording:input_type -> streamd.StartRecordingRequest
+	109, // 195: streamd.StreamD.StopRecording:input_type -> streamd.StopRecordingRequest
+	111, // 196: streamd.StreamD.ListRecordings:input_type -> streamd.ListRecordingsRequest
+	113, // 197: streamd.StreamD.WaitForStreamPublisher:input_type -> streamd.WaitForStreamPublisherRequest
+	117, // 198: streamd.StreamD.AddStreamPlayer:input_type -> streamd.AddStreamPlayerRequest
+	119, // 199: streamd.StreamD.RemoveStreamPlayer:input_type -> streamd.RemoveStreamPlayerRequest
+	121, // 200: streamd.StreamD.UpdateStreamPlayer:input_type -> streamd.UpdateStreamPlayerRequest
+	123, // 201: streamd.StreamD.ListStreamPlayers:input_type -> streamd.ListStreamPlayersRequest
+	125, // 202: streamd.StreamD.GetStreamPlayer:input_type -> streamd.GetStreamPlayerRequest
+	163, // 203: streamd.StreamD.SubscribeToStreamPlayersChanges:input_type -> streamd.SubscribeToStreamPlayersChangesRequest
+	127, // 204: streamd.StreamD.StreamPlayerOpen:input_type -> streamd.StreamPlayerOpenRequest
+	129, // 205: streamd.StreamD.StreamPlayerProcessTitle:input_type -> streamd.StreamPlayerProcessTitleRequest
+	131, // 206: streamd.StreamD.StreamPlayerGetLink:input_type -> streamd.StreamPlayerGetLinkRequest
+	133, // 207: streamd.StreamD.StreamPlayerEndChan:input_type -> streamd.StreamPlayerEndChanRequest
+	135, // 208: streamd.StreamD.StreamPlayerIsEnded:input_type -> streamd.StreamPlayerIsEndedRequest
+	137, // 209: streamd.StreamD.StreamPlayerGetPosition:input_type -> streamd.StreamPlayerGetPositionRequest
+	139, // 210: streamd.StreamD.StreamPlayerGetLength:input_type -> streamd.StreamPlayerGetLengthRequest
+	141, // 211: streamd.StreamD.StreamPlayerSetSpeed:input_type -> streamd.StreamPlayerSetSpeedRequest
+	143, // 212: streamd.StreamD.StreamPlayerSetPause:input_type -> streamd.StreamPlayerSetPauseRequest
+	145, // 213: streamd.StreamD.StreamPlayerStop:input_type -> streamd.StreamPlayerStopRequest
+	147, // 214: streamd.StreamD.StreamPlayerClose:input_type -> streamd.StreamPlayerCloseRequest
+	188, // 215: streamd.StreamD.AddTimer:input_type -> streamd.AddTimerRequest
+	190, // 216: streamd.StreamD.RemoveTimer:input_type -> streamd.RemoveTimerRequest
+	193, // 217: streamd.StreamD.ListTimers:input_type -> streamd.ListTimersRequest
+	225, // 218: streamd.StreamD.ListTriggerRules:input_type -> streamd.ListTriggerRulesRequest
+	227, // 219: streamd.StreamD.AddTriggerRule:input_type -> streamd.AddTriggerRuleRequest
+	229, // 220: streamd.StreamD.RemoveTriggerRule:input_type -> streamd.RemoveTriggerRuleRequest
+	231, // 221: streamd.StreamD.UpdateTriggerRule:input_type -> streamd.UpdateTriggerRuleRequest
+	234, // 222: streamd.StreamD.ListTriggerRuleExecutions:input_type -> streamd.ListTriggerRuleExecutionsRequest
+	238, // 223: streamd.StreamD.TestTriggerRule:input_type -> streamd.TestTriggerRuleRequest
+	240, // 224: streamd.StreamD.SubmitEvent:input_type -> streamd.SubmitEventRequest
+	242, // 225: streamd.StreamD.SubscribeToChatMessages:input_type -> streamd.SubscribeToChatMessagesRequest
+	247, // 226: streamd.StreamD.SendChatMessage:input_type -> streamd.SendChatMessageRequest
+	249, // 227: streamd.StreamD.RemoveChatMessage:input_type -> streamd.RemoveChatMessageRequest
+	251, // 228: streamd.StreamD.BanUser:input_type -> streamd.BanUserRequest
+	253, // 229: streamd.StreamD.AddModerator:input_type -> streamd.AddModeratorRequest
+	255, // 230: streamd.StreamD.RemoveModerator:input_type -> streamd.RemoveModeratorRequest
+	257, // 231: streamd.StreamD.InsertAdsCuePoint:input_type -> streamd.InsertAdsCuePointRequest
+	260, // 232: streamd.StreamD.TwitchCreateStreamMarker:input_type -> streamd.TwitchCreateStreamMarkerRequest
+	263, // 233: streamd.StreamD.TwitchGetAdSchedule:input_type -> streamd.TwitchGetAdScheduleRequest
+	265, // 234: streamd.StreamD.GetPeerIDs:input_type -> streamd.GetPeerIDsRequest
+	11,  // 235: streamd.StreamD.Ping:output_type -> streamd.PingReply
+	13,  // 236: streamd.StreamD.SetLoggingLevel:output_type -> streamd.SetLoggingLevelReply
+	15,  // 237: streamd.StreamD.GetLoggingLevel:output_type -> streamd.GetLoggingLevelReply
+	17,  // 238: streamd.StreamD.GetConfig:output_type -> streamd.GetConfigReply
+	19,  // 239: streamd.StreamD.SetConfig:output_type -> streamd.SetConfigReply
+	21,  // 240: streamd.StreamD.SaveConfig:output_type -> streamd.SaveConfigReply
+	150, // 241: streamd.StreamD.SubscribeToConfigChanges:output_type -> streamd.ConfigChange
+	23,  // 242: streamd.StreamD.ResetCache:output_type -> streamd.ResetCacheReply
+	25,  // 243: streamd.StreamD.InitCache:output_type -> streamd.InitCacheReply
+	27,  // 244: streamd.StreamD.StartStream:output_type -> streamd.StartStreamReply
+	29,  // 245: streamd.StreamD.EndStream:output_type -> streamd.EndStreamReply
+	31,  // 246: streamd.StreamD.GetStreamStatus:output_type -> streamd.GetStreamStatusReply
+	35,  // 247: streamd.StreamD.IsBackendEnabled:output_type -> streamd.IsBackendEnabledReply
+	33,  // 248: streamd.StreamD.GetBackendInfo:output_type -> streamd.GetBackendInfoReply
+	152, // 249: streamd.StreamD.SubscribeToStreamsChanges:output_type -> streamd.StreamsChange
+	37,  // 250: streamd.StreamD.Restart:output_type -> streamd.RestartReply
+	39,  // 251: streamd.StreamD.SetTitle:output_type -> streamd.SetTitleReply
+	41,  // 252: streamd.StreamD.SetDescription:output_type -> streamd.SetDescriptionReply
+	43,  // 253: streamd.StreamD.ApplyProfile:output_type -> streamd.ApplyProfileReply
+	45,  // 254: streamd.StreamD.UpdateStream:output_type -> streamd.UpdateStreamReply
+	57,  // 255: streamd.StreamD.GetVariable:output_type -> streamd.GetVariableReply
+	59,  // 256: streamd.StreamD.GetVariableHash:output_type -> streamd.GetVariableHashReply
+	61,  // 257: streamd.StreamD.SetVariable:output_type -> streamd.SetVariableReply
+	47,  // 258: streamd.StreamD.EXPERIMENTAL_ReinitStreamControllers:output_type -> streamd.EXPERIMENTAL_ReinitStreamControllersReply
+	55,  // 259: streamd.StreamD.SubscribeToOAuthRequests:output_type -> streamd.OAuthRequest
+	63,  // 260: streamd.StreamD.SubmitOAuthCode:output_type -> streamd.SubmitOAuthCodeReply
+	70,  // 261: streamd.StreamD.ListStreamServers:output_type -> streamd.ListStreamServersReply
+	72,  // 262: streamd.StreamD.StartStreamServer:output_type -> streamd.StartStreamServerReply
+	74,  // 263: streamd.StreamD.StopStreamServer:output_type -> streamd.StopStreamServerReply
+	154, // 264: streamd.StreamD.SubscribeToStreamServersChanges:output_type -> streamd.StreamServersChange
+	77,  // 265: streamd.StreamD.ListStreamDestinations:output_type -> streamd.ListStreamDestinationsReply
+	79,  // 266: streamd.StreamD.AddStreamDestination:output_type -> streamd.AddStreamDestinationReply
+	81,  // 267: streamd.StreamD.UpdateStreamDestination:output_type -> streamd.UpdateStreamDestinationReply
+	83,  // 268: streamd.StreamD.RemoveStreamDestination:output_type -> streamd.RemoveStreamDestinationReply
+	156, // 269: streamd.StreamD.SubscribeToStreamDestinationsChanges:output_type -> streamd.StreamDestinationsChange
+	86,  // 270: streamd.StreamD.AddIncomingStream:output_type -> streamd.AddIncomingStreamReply
+	88,  // 271: streamd.StreamD.RemoveIncomingStream:output_type -> streamd.RemoveIncomingStreamReply
+	90,  // 272: streamd.StreamD.ListIncomingStreams:output_type -> streamd.ListIncomingStreamsReply
+	158, // 273: streamd.StreamD.SubscribeToIncomingStreamsChanges:output_type -> streamd.IncomingStreamsChange
+	98,  // 274: streamd.StreamD.ListStreamForwards:output_type -> streamd.ListStreamForwardsReply
+	100, // 275: streamd.StreamD.AddStreamForward:output_type -> streamd.AddStreamForwardReply
+	102, // 276: streamd.StreamD.UpdateStreamForward:output_type -> streamd.UpdateStreamForwardReply
+	104, // 277: streamd.StreamD.RemoveStreamForward:output_type -> streamd.RemoveStreamForwardReply
+	160, // 278: streamd.StreamD.SubscribeToStreamForwardsChanges:output_type -> streamd.StreamForwardsChange
+	162, // 279: streamd.StreamD.SubscribeToStreamForwardStats:output_type -> streamd.StreamForwardStatsUpdate
+	108, // 280: streamd.StreamD.StartRecording:output_type -> streamd.StartRecordingReply
+	110, // 281: streamd.StreamD.StopRecording:output_type -> streamd.StopRecordingReply
+	112, // 282: streamd.StreamD.ListRecordings:output_type -> streamd.ListRecordingsReply
+	114, // 283: streamd.StreamD.WaitForStreamPublisher:output_type -> streamd.StreamPublisher
+	118, // 284: streamd.StreamD.AddStreamPlayer:output_type -> streamd.AddStreamPlayerReply
+	120, // 285: streamd.StreamD.RemoveStreamPlayer:output_type -> streamd.RemoveStreamPlayerReply
+	122, // 286: streamd.StreamD.UpdateStreamPlayer:output_type -> streamd.UpdateStreamPlayerReply
+	124, // 287: streamd.StreamD.ListStreamPlayers:output_type -> streamd.ListStreamPlayersReply
+	126, // 288: streamd.StreamD.GetStreamPlayer:output_type -> streamd.GetStreamPlayerReply
+	164, // 289: streamd.StreamD.SubscribeToStreamPlayersChanges:output_type -> streamd.StreamPlayersChange
+	128, // 290: streamd.StreamD.StreamPlayerOpen:output_type -> streamd.StreamPlayerOpenReply
+	130, // 291: streamd.StreamD.StreamPlayerProcessTitle:output_type -> streamd.StreamPlayerProcessTitleReply
+	132, // 292: streamd.StreamD.StreamPlayerGetLink:output_type -> streamd.StreamPlayerGetLinkReply
+	134, // 293: streamd.StreamD.StreamPlayerEndChan:output_type -> streamd.StreamPlayerEndChanReply
+	136, // 294: streamd.StreamD.StreamPlayerIsEnded:output_type -> streamd.StreamPlayerIsEndedReply
+	138, // 295: streamd.StreamD.StreamPlayerGetPosition:output_type -> streamd.StreamPlayerGetPositionReply
+	140, // 296: streamd.StreamD.StreamPlayerGetLength:output_type -> streamd.StreamPlayerGetLengthReply
+	142, // 297: streamd.StreamD.StreamPlayerSetSpeed:output_type -> streamd.StreamPlayerSetSpeedReply
+	144, // 298: streamd.StreamD.StreamPlayerSetPause:output_type -> streamd.StreamPlayerSetPauseReply
+	146, // 299: streamd.StreamD.StreamPlayerStop:output_type -> streamd.StreamPlayerStopReply
+	148, // 300: streamd.StreamD.StreamPlayerClose:output_type -> streamd.StreamPlayerCloseReply
+	189, // 301: streamd.StreamD.AddTimer:output_type -> streamd.AddTimerReply
+	191, // 302: streamd.StreamD.RemoveTimer:output_type -> streamd.RemoveTimerReply
+	194, // 303: streamd.StreamD.ListTimers:output_type -> streamd.ListTimersReply
+	226, // 304: streamd.StreamD.ListTriggerRules:output_type -> streamd.ListTriggerRulesReply
+	228, // 305: streamd.StreamD.AddTriggerRule:output_type -> streamd.AddTriggerRuleReply
+	230, // 306: streamd.StreamD.RemoveTriggerRule:output_type -> streamd.RemoveTriggerRuleReply
+	232, // 307: streamd.StreamD.UpdateTriggerRule:output_type -> streamd.UpdateTriggerRuleReply
+	235, // 308: streamd.StreamD.ListTriggerRuleExecutions:output_type -> streamd.ListTriggerRuleExecutionsReply
+	239, // 309: streamd.StreamD.TestTriggerRule:output_type -> streamd.TestTriggerRuleReply
+	241, // 310: streamd.StreamD.SubmitEvent:output_type -> streamd.SubmitEventReply
+	243, // 311: streamd.StreamD.SubscribeToChatMessages:output_type -> streamd.ChatMessage
+	248, // 312: streamd.StreamD.SendChatMessage:output_type -> streamd.SendChatMessageReply
+	250, // 313: streamd.StreamD.RemoveChatMessage:output_type -> streamd.RemoveChatMessageReply
+	252, // 314: streamd.StreamD.BanUser:output_type -> streamd.BanUserReply
+	254, // 315: streamd.StreamD.AddModerator:output_type -> streamd.AddModeratorReply
+	256, // 316: streamd.StreamD.RemoveModerator:output_type -> streamd.RemoveModeratorReply
+	258, // 317: streamd.StreamD.InsertAdsCuePoint:output_type -> streamd.InsertAdsCuePointReply
+	261, // 318: streamd.StreamD.TwitchCreateStreamMarker:output_type -> streamd.TwitchCreateStreamMarkerReply
+	264, // 319: streamd.StreamD.TwitchGetAdSchedule:output_type -> streamd.TwitchGetAdScheduleReply
+	266, // 320: streamd.StreamD.GetPeerIDs:output_type -> streamd.GetPeerIDsReply
+	235, // [235:321] is the sub-list for method output_type
+	149, // [149:235] is the sub-list for method input_type
+	149, // [149:149] is the sub-list for extension type_name
+	149, // [149:149] is the sub-list for extension extendee
+	0,   // [0:149] is the sub-list for field type_name
 }
 
 func init() { file_streamd_proto_init() }
@@ -11096,8 +17596,692 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingReply); i {
+		file_streamd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLoggingLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLoggingLevelReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoggingLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoggingLevelReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetCacheReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitCacheReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartStreamReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndStreamReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBackendInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBackendInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsBackendEnabledRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsBackendEnabledReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTitleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTitleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDescriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDescriptionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyProfileReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStreamReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EXPERIMENTAL_ReinitStreamControllersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EXPERIMENTAL_ReinitStreamControllersReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSOLETE_FetchConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSOLETE_FetchConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSOLETE_GetGitInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSOLETE_GetGitInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSOLETE_GitReloginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSOLETE_GitReloginReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToOAuthRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OAuthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVariableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVariableReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVariableHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVariableHashReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVariableRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVariableReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitOAuthCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitOAuthCodeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TLSCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamServerStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamServerWithStatistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11108,8 +18292,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLoggingLevelRequest); i {
+		file_streamd_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamServersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11120,8 +18304,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLoggingLevelReply); i {
+		file_streamd_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamServersReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11132,8 +18316,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLoggingLevelRequest); i {
+		file_streamd_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartStreamServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11144,8 +18328,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLoggingLevelReply); i {
+		file_streamd_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartStreamServerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11156,8 +18340,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigRequest); i {
+		file_streamd_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopStreamServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11168,8 +18352,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigReply); i {
+		file_streamd_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopStreamServerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11180,8 +18364,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetConfigRequest); i {
+		file_streamd_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamDestination); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11192,8 +18376,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetConfigReply); i {
+		file_streamd_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamDestinationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11204,8 +18388,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveConfigRequest); i {
+		file_streamd_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamDestinationsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11216,8 +18400,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveConfigReply); i {
+		file_streamd_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStreamDestinationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11228,8 +18412,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetCacheRequest); i {
+		file_streamd_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStreamDestinationReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11240,8 +18424,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetCacheReply); i {
+		file_streamd_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStreamDestinationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11252,8 +18436,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitCacheRequest); i {
+		file_streamd_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStreamDestinationReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11264,8 +18448,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitCacheReply); i {
+		file_streamd_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStreamDestinationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11276,8 +18460,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartStreamRequest); i {
+		file_streamd_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStreamDestinationReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11288,8 +18472,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartStreamReply); i {
+		file_streamd_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncomingStream); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11300,8 +18484,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EndStreamRequest); i {
+		file_streamd_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddIncomingStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11312,8 +18496,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EndStreamReply); i {
+		file_streamd_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddIncomingStreamReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11324,8 +18508,188 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamStatusRequest); i {
+		file_streamd_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveIncomingStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveIncomingStreamReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIncomingStreamsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIncomingStreamsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartUntilPlatformRecognizesStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartAfterPlatformRecognizedStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamForwardQuirks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamForward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamForwardStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamForwardWithStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamForwardsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamForwardsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStreamForwardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStreamForwardReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStreamForwardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_streamd_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStreamForwardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11336,8 +18700,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamStatusReply); i {
+		file_streamd_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStreamForwardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11348,8 +18712,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBackendInfoRequest); i {
+		file_streamd_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStreamForwardReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11360,8 +18724,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBackendInfoReply); i {
+		file_streamd_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRecordingConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11372,8 +18736,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsBackendEnabledRequest); i {
+		file_streamd_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRecording); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11384,8 +18748,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsBackendEnabledReply); i {
+		file_streamd_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRecordingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11396,8 +18760,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartRequest); i {
+		file_streamd_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartRecordingReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11408,8 +18772,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartReply); i {
+		file_streamd_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRecordingRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11420,8 +18784,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTitleRequest); i {
+		file_streamd_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRecordingReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11432,8 +18796,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTitleReply); i {
+		file_streamd_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecordingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11444,8 +18808,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDescriptionRequest); i {
+		file_streamd_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRecordingsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11456,8 +18820,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDescriptionReply); i {
+		file_streamd_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForStreamPublisherRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11468,8 +18832,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyProfileRequest); i {
+		file_streamd_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPublisher); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11480,8 +18844,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyProfileReply); i {
+		file_streamd_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlaybackConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11492,8 +18856,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStreamRequest); i {
+		file_streamd_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11504,8 +18868,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStreamReply); i {
+		file_streamd_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStreamPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11516,8 +18880,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EXPERIMENTAL_ReinitStreamControllersRequest); i {
+		file_streamd_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStreamPlayerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11528,8 +18892,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EXPERIMENTAL_ReinitStreamControllersReply); i {
+		file_streamd_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStreamPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11540,8 +18904,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBSOLETE_FetchConfigRequest); i {
+		file_streamd_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStreamPlayerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11552,8 +18916,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBSOLETE_FetchConfigReply); i {
+		file_streamd_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStreamPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11564,8 +18928,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBSOLETE_GetGitInfoRequest); i {
+		file_streamd_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStreamPlayerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11576,8 +18940,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBSOLETE_GetGitInfoReply); i {
+		file_streamd_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamPlayersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11588,8 +18952,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBSOLETE_GitReloginRequest); i {
+		file_streamd_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStreamPlayersReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11600,8 +18964,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBSOLETE_GitReloginReply); i {
+		file_streamd_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamPlayerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11612,8 +18976,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToOAuthRequestsRequest); i {
+		file_streamd_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamPlayerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11624,8 +18988,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OAuthRequest); i {
+		file_streamd_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerOpenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11636,8 +19000,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVariableRequest); i {
+		file_streamd_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerOpenReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11648,8 +19012,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVariableReply); i {
+		file_streamd_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerProcessTitleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11660,8 +19024,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVariableHashRequest); i {
+		file_streamd_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerProcessTitleReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11672,8 +19036,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVariableHashReply); i {
+		file_streamd_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerGetLinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11684,8 +19048,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetVariableRequest); i {
+		file_streamd_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerGetLinkReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11696,8 +19060,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetVariableReply); i {
+		file_streamd_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerEndChanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11708,8 +19072,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitOAuthCodeRequest); i {
+		file_streamd_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerEndChanReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11720,8 +19084,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitOAuthCodeReply); i {
+		file_streamd_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerIsEndedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11732,8 +19096,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TLSCertificate); i {
+		file_streamd_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerIsEndedReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11744,8 +19108,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrivateKey); i {
+		file_streamd_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerGetPositionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11756,8 +19120,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamServer); i {
+		file_streamd_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerGetPositionReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11768,8 +19132,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamServerStatistics); i {
+		file_streamd_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerGetLengthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11780,8 +19144,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamServerWithStatistics); i {
+		file_streamd_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerGetLengthReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11792,8 +19156,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStreamServersRequest); i {
+		file_streamd_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerSetSpeedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11804,8 +19168,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStreamServersReply); i {
+		file_streamd_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerSetSpeedReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11816,8 +19180,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartStreamServerRequest); i {
+		file_streamd_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerSetPauseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11828,8 +19192,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartStreamServerReply); i {
+		file_streamd_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerSetPauseReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11840,8 +19204,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopStreamServerRequest); i {
+		file_streamd_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerStopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11852,8 +19216,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopStreamServerReply); i {
+		file_streamd_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerStopReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11864,8 +19228,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamDestination); i {
+		file_streamd_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerCloseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11876,8 +19240,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStreamDestinationsRequest); i {
+		file_streamd_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayerCloseReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11888,8 +19252,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStreamDestinationsReply); i {
+		file_streamd_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToConfigChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11900,8 +19264,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddStreamDestinationRequest); i {
+		file_streamd_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11912,8 +19276,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddStreamDestinationReply); i {
+		file_streamd_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToStreamsChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11924,8 +19288,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStreamDestinationRequest); i {
+		file_streamd_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamsChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11936,8 +19300,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStreamDestinationReply); i {
+		file_streamd_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToStreamServersChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11948,8 +19312,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveStreamDestinationRequest); i {
+		file_streamd_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamServersChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11960,8 +19324,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveStreamDestinationReply); i {
+		file_streamd_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToStreamDestinationsChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11972,8 +19336,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncomingStream); i {
+		file_streamd_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamDestinationsChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11984,8 +19348,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddIncomingStreamRequest); i {
+		file_streamd_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToIncomingStreamsChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11996,8 +19360,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddIncomingStreamReply); i {
+		file_streamd_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncomingStreamsChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12008,8 +19372,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveIncomingStreamRequest); i {
+		file_streamd_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToStreamForwardsChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12020,8 +19384,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveIncomingStreamReply); i {
+		file_streamd_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamForwardsChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12032,8 +19396,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIncomingStreamsRequest); i {
+		file_streamd_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToStreamForwardStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12044,8 +19408,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListIncomingStreamsReply); i {
+		file_streamd_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamForwardStatsUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12056,8 +19420,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartUntilYoutubeRecognizesStream); i {
+		file_streamd_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToStreamPlayersChangesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12068,8 +19432,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartAfterYoutubeRecognizedStream); i {
+		file_streamd_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlayersChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12080,8 +19444,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamForwardQuirks); i {
+		file_streamd_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12092,8 +19456,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamForward); i {
+		file_streamd_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSActionItemShowHide); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12104,8 +19468,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamForwardStatistics); i {
+		file_streamd_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSActionWindowCaptureSetSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12116,8 +19480,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamForwardWithStatistics); i {
+		file_streamd_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSActionSetCurrentScene); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12127,9 +19491,9 @@ func file_streamd_proto_init() {
 			default:
 				return nil
 			}
-		}
-		file_streamd_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStreamForwardsRequest); i {
+		}
+		file_streamd_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OBSAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12140,8 +19504,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStreamForwardsReply); i {
+		file_streamd_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12152,8 +19516,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddStreamForwardRequest); i {
+		file_streamd_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionSequence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12164,8 +19528,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddStreamForwardReply); i {
+		file_streamd_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionParallel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12176,8 +19540,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStreamForwardRequest); i {
+		file_streamd_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionDelay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12188,8 +19552,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStreamForwardReply); i {
+		file_streamd_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionIf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12200,8 +19564,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveStreamForwardRequest); i {
+		file_streamd_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionSendChatMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12212,8 +19576,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveStreamForwardReply); i {
+		file_streamd_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionSetTitle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12224,8 +19588,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaitForStreamPublisherRequest); i {
+		file_streamd_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionSetDescription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12236,8 +19600,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPublisher); i {
+		file_streamd_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionApplyProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12248,8 +19612,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlaybackConfig); i {
+		file_streamd_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionSetStreamForwardEnabled); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12260,8 +19624,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerConfig); i {
+		file_streamd_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionSetVariable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12272,8 +19636,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddStreamPlayerRequest); i {
+		file_streamd_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionInsertAdsCuePoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12284,8 +19648,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddStreamPlayerReply); i {
+		file_streamd_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionRunCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12296,8 +19660,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveStreamPlayerRequest); i {
+		file_streamd_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionTwitchCreateStreamMarker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12308,8 +19672,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveStreamPlayerReply); i {
+		file_streamd_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Action); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12320,8 +19684,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStreamPlayerRequest); i {
+		file_streamd_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerMissedDeadlinePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12332,8 +19696,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateStreamPlayerReply); i {
+		file_streamd_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerRelativeToStreamStart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12344,8 +19708,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStreamPlayersRequest); i {
+		file_streamd_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12356,8 +19720,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStreamPlayersReply); i {
+		file_streamd_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTimerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12368,8 +19732,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamPlayerRequest); i {
+		file_streamd_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTimerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12380,8 +19744,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamPlayerReply); i {
+		file_streamd_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTimerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12392,8 +19756,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerOpenRequest); i {
+		file_streamd_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTimerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12404,8 +19768,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerOpenReply); i {
+		file_streamd_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12416,8 +19780,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerProcessTitleRequest); i {
+		file_streamd_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTimersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12428,8 +19792,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerProcessTitleReply); i {
+		file_streamd_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTimersReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12440,8 +19804,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerGetLinkRequest); i {
+		file_streamd_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventQueryAnd); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12452,8 +19816,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerGetLinkReply); i {
+		file_streamd_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventQueryOr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12464,8 +19828,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerEndChanRequest); i {
+		file_streamd_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventQueryNot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12476,8 +19840,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerEndChanReply); i {
+		file_streamd_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventQueryEventField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12488,8 +19852,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerIsEndedRequest); i {
+		file_streamd_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventQueryStreamIsActive); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12500,8 +19864,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerIsEndedReply); i {
+		file_streamd_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventQueryVariableEquals); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12512,8 +19876,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerGetPositionRequest); i {
+		file_streamd_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventOBSSceneChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12524,8 +19888,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerGetPositionReply); i {
+		file_streamd_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventWindowFocusChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12536,8 +19900,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerGetLengthRequest); i {
+		file_streamd_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventChatMessageReceived); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12548,8 +19912,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerGetLengthReply); i {
+		file_streamd_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStreamStarted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12560,8 +19924,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerSetSpeedRequest); i {
+		file_streamd_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStreamEnded); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12572,8 +19936,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerSetSpeedReply); i {
+		file_streamd_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventViewerCountChanged); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12584,8 +19948,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerSetPauseRequest); i {
+		file_streamd_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventIncomingStreamPublished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12596,8 +19960,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerSetPauseReply); i {
+		file_streamd_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventIncomingStreamUnpublished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12608,8 +19972,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerStopRequest); i {
+		file_streamd_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStreamForwardStarted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12620,8 +19984,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerStopReply); i {
+		file_streamd_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventIncomingStreamAuthFailed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12632,8 +19996,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerCloseRequest); i {
+		file_streamd_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStreamForwardFailed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12644,8 +20008,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayerCloseReply); i {
+		file_streamd_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStreamForwardSourceSwitched); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12656,8 +20020,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToConfigChangesRequest); i {
+		file_streamd_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventStreamForwardFallbackStopped); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12668,8 +20032,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigChange); i {
+		file_streamd_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTwitchFollow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12680,8 +20044,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToStreamsChangesRequest); i {
+		file_streamd_proto_msgTypes[205].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTwitchSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12692,8 +20056,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamsChange); i {
+		file_streamd_proto_msgTypes[206].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTwitchSubscriptionGift); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12704,8 +20068,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToStreamServersChangesRequest); i {
+		file_streamd_proto_msgTypes[207].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTwitchCheer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12716,8 +20080,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamServersChange); i {
+		file_streamd_proto_msgTypes[208].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTwitchRaid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12728,8 +20092,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToStreamDestinationsChangesRequest); i {
+		file_streamd_proto_msgTypes[209].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTwitchChannelPointsRedemption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12740,8 +20104,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamDestinationsChange); i {
+		file_streamd_proto_msgTypes[210].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTwitchPoll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12752,8 +20116,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToIncomingStreamsChangesRequest); i {
+		file_streamd_proto_msgTypes[211].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTwitchPrediction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12764,8 +20128,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IncomingStreamsChange); i {
+		file_streamd_proto_msgTypes[212].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12776,8 +20140,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToStreamForwardsChangesRequest); i {
+		file_streamd_proto_msgTypes[213].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12788,8 +20152,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamForwardsChange); i {
+		file_streamd_proto_msgTypes[214].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12800,8 +20164,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToStreamPlayersChangesRequest); i {
+		file_streamd_proto_msgTypes[215].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTriggerRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12812,8 +20176,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamPlayersChange); i {
+		file_streamd_proto_msgTypes[216].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTriggerRulesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12824,8 +20188,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NoopRequest); i {
+		file_streamd_proto_msgTypes[217].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTriggerRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12836,8 +20200,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBSActionItemShowHide); i {
+		file_streamd_proto_msgTypes[218].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTriggerRuleReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12848,8 +20212,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBSActionWindowCaptureSetSource); i {
+		file_streamd_proto_msgTypes[219].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTriggerRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12860,8 +20224,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OBSAction); i {
+		file_streamd_proto_msgTypes[220].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTriggerRuleReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12872,8 +20236,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Action); i {
+		file_streamd_proto_msgTypes[221].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTriggerRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12884,8 +20248,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTimerRequest); i {
+		file_streamd_proto_msgTypes[222].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTriggerRuleReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12896,8 +20260,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTimerReply); i {
+		file_streamd_proto_msgTypes[223].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerRuleExecution); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12908,8 +20272,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveTimerRequest); i {
+		file_streamd_proto_msgTypes[224].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTriggerRuleExecutionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12920,8 +20284,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveTimerReply); i {
+		file_streamd_proto_msgTypes[225].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTriggerRuleExecutionsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12932,8 +20296,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Timer); i {
+		file_streamd_proto_msgTypes[226].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluatedExpression); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12944,8 +20308,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTimersRequest); i {
+		file_streamd_proto_msgTypes[227].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerRuleTestAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12956,8 +20320,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTimersReply); i {
+		file_streamd_proto_msgTypes[228].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestTriggerRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12968,8 +20332,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventQueryAnd); i {
+		file_streamd_proto_msgTypes[229].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestTriggerRuleReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12980,8 +20344,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventQueryOr); i {
+		file_streamd_proto_msgTypes[230].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitEventRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12992,8 +20356,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventQueryNot); i {
+		file_streamd_proto_msgTypes[231].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitEventReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13004,8 +20368,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventOBSSceneChange); i {
+		file_streamd_proto_msgTypes[232].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeToChatMessagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13016,8 +20380,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventWindowFocusChange); i {
+		file_streamd_proto_msgTypes[233].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13028,8 +20392,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventQuery); i {
+		file_streamd_proto_msgTypes[234].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatBadge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13040,8 +20404,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+		file_streamd_proto_msgTypes[235].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatMessageFragment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13052,8 +20416,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerRule); i {
+		file_streamd_proto_msgTypes[236].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatMessagePaid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13064,8 +20428,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTriggerRulesRequest); i {
+		file_streamd_proto_msgTypes[237].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13076,8 +20440,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTriggerRulesReply); i {
+		file_streamd_proto_msgTypes[238].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendChatMessageReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13088,8 +20452,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTriggerRuleRequest); i {
+		file_streamd_proto_msgTypes[239].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveChatMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13100,8 +20464,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddTriggerRuleReply); i {
+		file_streamd_proto_msgTypes[240].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveChatMessageReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13112,8 +20476,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveTriggerRuleRequest); i {
+		file_streamd_proto_msgTypes[241].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13124,8 +20488,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveTriggerRuleReply); i {
+		file_streamd_proto_msgTypes[242].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanUserReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13136,8 +20500,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTriggerRuleRequest); i {
+		file_streamd_proto_msgTypes[243].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddModeratorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13148,8 +20512,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateTriggerRuleReply); i {
+		file_streamd_proto_msgTypes[244].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddModeratorReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13160,8 +20524,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitEventRequest); i {
+		file_streamd_proto_msgTypes[245].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveModeratorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13172,8 +20536,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmitEventReply); i {
+		file_streamd_proto_msgTypes[246].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveModeratorReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13184,8 +20548,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToChatMessagesRequest); i {
+		file_streamd_proto_msgTypes[247].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertAdsCuePointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13196,8 +20560,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatMessage); i {
+		file_streamd_proto_msgTypes[248].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertAdsCuePointReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13208,8 +20572,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendChatMessageRequest); i {
+		file_streamd_proto_msgTypes[249].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwitchStreamMarker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13220,8 +20584,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendChatMessageReply); i {
+		file_streamd_proto_msgTypes[250].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwitchCreateStreamMarkerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13232,8 +20596,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveChatMessageRequest); i {
+		file_streamd_proto_msgTypes[251].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwitchCreateStreamMarkerReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13244,8 +20608,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveChatMessageReply); i {
+		file_streamd_proto_msgTypes[252].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwitchAdSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13256,8 +20620,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BanUserRequest); i {
+		file_streamd_proto_msgTypes[253].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwitchGetAdScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13268,8 +20632,8 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BanUserReply); i {
+		file_streamd_proto_msgTypes[254].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwitchGetAdScheduleReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -13280,7 +20644,7 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+		file_streamd_proto_msgTypes[255].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPeerIDsRequest); i {
 			case 0:
 				return &v.state
@@ -13292,7 +20656,7 @@ func file_streamd_proto_init() {
 				return nil
 			}
 		}
-		file_streamd_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+		file_streamd_proto_msgTypes[256].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPeerIDsReply); i {
 			case 0:
 				return &v.state
@@ -13313,39 +20677,98 @@ func file_streamd_proto_init() {
 		(*PrivateKey_PKCS8)(nil),
 	}
 	file_streamd_proto_msgTypes[56].OneofWrappers = []interface{}{}
-	file_streamd_proto_msgTypes[95].OneofWrappers = []interface{}{}
-	file_streamd_proto_msgTypes[146].OneofWrappers = []interface{}{}
-	file_streamd_proto_msgTypes[147].OneofWrappers = []interface{}{}
-	file_streamd_proto_msgTypes[148].OneofWrappers = []interface{}{
+	file_streamd_proto_msgTypes[103].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[156].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[157].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[159].OneofWrappers = []interface{}{
 		(*OBSAction_ItemShowHide)(nil),
 		(*OBSAction_WindowCaptureSetSource)(nil),
+		(*OBSAction_SetCurrentScene)(nil),
 	}
-	file_streamd_proto_msgTypes[149].OneofWrappers = []interface{}{
+	file_streamd_proto_msgTypes[174].OneofWrappers = []interface{}{
 		(*Action_NoopRequest)(nil),
 		(*Action_StartStreamRequest)(nil),
 		(*Action_EndStreamRequest)(nil),
 		(*Action_ObsAction)(nil),
-	}
-	file_streamd_proto_msgTypes[161].OneofWrappers = []interface{}{}
-	file_streamd_proto_msgTypes[162].OneofWrappers = []interface{}{
+		(*Action_Sequence)(nil),
+		(*Action_Parallel)(nil),
+		(*Action_Delay)(nil),
+		(*Action_If)(nil),
+		(*Action_SendChatMessage)(nil),
+		(*Action_SetTitle)(nil),
+		(*Action_SetDescription)(nil),
+		(*Action_ApplyProfile)(nil),
+		(*Action_SetStreamForwardEnabled)(nil),
+		(*Action_SetVariable)(nil),
+		(*Action_InsertAdsCuePoint)(nil),
+		(*Action_RunCommand)(nil),
+		(*Action_TwitchCreateStreamMarker)(nil),
+	}
+	file_streamd_proto_msgTypes[188].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[192].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[193].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[194].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[195].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[196].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[197].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[198].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[199].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[200].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[201].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[202].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[203].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[204].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[205].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[206].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[207].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[208].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[209].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[210].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[211].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[212].OneofWrappers = []interface{}{
 		(*EventQuery_And)(nil),
 		(*EventQuery_Or)(nil),
 		(*EventQuery_Not)(nil),
 		(*EventQuery_EventType)(nil),
 		(*EventQuery_Event)(nil),
+		(*EventQuery_EventField)(nil),
+		(*EventQuery_StreamIsActive)(nil),
+		(*EventQuery_VariableEquals)(nil),
 	}
-	file_streamd_proto_msgTypes[163].OneofWrappers = []interface{}{
+	file_streamd_proto_msgTypes[213].OneofWrappers = []interface{}{
 		(*Event_ObsSceneChange)(nil),
 		(*Event_WindowFocusChange)(nil),
-	}
-	file_streamd_proto_msgTypes[181].OneofWrappers = []interface{}{}
+		(*Event_ChatMessageReceived)(nil),
+		(*Event_StreamStarted)(nil),
+		(*Event_StreamEnded)(nil),
+		(*Event_ViewerCountChanged)(nil),
+		(*Event_IncomingStreamPublished)(nil),
+		(*Event_IncomingStreamUnpublished)(nil),
+		(*Event_StreamForwardStarted)(nil),
+		(*Event_StreamForwardFailed)(nil),
+		(*Event_IncomingStreamAuthFailed)(nil),
+		(*Event_StreamForwardSourceSwitched)(nil),
+		(*Event_TwitchFollow)(nil),
+		(*Event_TwitchSubscription)(nil),
+		(*Event_TwitchSubscriptionGift)(nil),
+		(*Event_TwitchCheer)(nil),
+		(*Event_TwitchRaid)(nil),
+		(*Event_TwitchChannelPointsRedemption)(nil),
+		(*Event_TwitchPoll)(nil),
+		(*Event_TwitchPrediction)(nil),
+		(*Event_StreamForwardFallbackStopped)(nil),
+	}
+	file_streamd_proto_msgTypes[223].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[226].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[233].OneofWrappers = []interface{}{}
+	file_streamd_proto_msgTypes[241].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_streamd_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   185,
+			NumEnums:      10,
+			NumMessages:   257,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -61,6 +61,10 @@ type StreamDClient interface {
 	UpdateStreamForward(ctx context.Context, in *UpdateStreamForwardRequest, opts ...grpc.CallOption) (*UpdateStreamForwardReply, error)
 	RemoveStreamForward(ctx context.Context, in *RemoveStreamForwardRequest, opts ...grpc.CallOption) (*RemoveStreamForwardReply, error)
 	SubscribeToStreamForwardsChanges(ctx context.Context, in *SubscribeToStreamForwardsChangesRequest, opts ...grpc.CallOption) (StreamD_SubscribeToStreamForwardsChangesClient, error)
+	SubscribeToStreamForwardStats(ctx context.Context, in *SubscribeToStreamForwardStatsRequest, opts ...grpc.CallOption) (StreamD_SubscribeToStreamForwardStatsClient, error)
+	StartRecording(ctx context.Context, in *StartRecordingRequest, opts ...grpc.CallOption) (*StartRecordingReply, error)
+	StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*StopRecordingReply, error)
+	ListRecordings(ctx context.Context, in *ListRecordingsRequest, opts ...grpc.CallOption) (*ListRecordingsReply, error)
 	WaitForStreamPublisher(ctx context.Context, in *WaitForStreamPublisherRequest, opts ...grpc.CallOption) (StreamD_WaitForStreamPublisherClient, error)
 	AddStreamPlayer(ctx context.Context, in *AddStreamPlayerRequest, opts ...grpc.CallOption) (*AddStreamPlayerReply, error)
 	RemoveStreamPlayer(ctx context.Context, in *RemoveStreamPlayerRequest, opts ...grpc.CallOption) (*RemoveStreamPlayerReply, error)
@@ -86,11 +90,18 @@ type StreamDClient interface {
 	AddTriggerRule(ctx context.Context, in *AddTriggerRuleRequest, opts ...grpc.CallOption) (*AddTriggerRuleReply, error)
 	RemoveTriggerRule(ctx context.Context, in *RemoveTriggerRuleRequest, opts ...grpc.CallOption) (*RemoveTriggerRuleReply, error)
 	UpdateTriggerRule(ctx context.Context, in *UpdateTriggerRuleRequest, opts ...grpc.CallOption) (*UpdateTriggerRuleReply, error)
+	ListTriggerRuleExecutions(ctx context.Context, in *ListTriggerRuleExecutionsRequest, opts ...grpc.CallOption) (*ListTriggerRuleExecutionsReply, error)
+	TestTriggerRule(ctx context.Context, in *TestTriggerRuleRequest, opts ...grpc.CallOption) (*TestTriggerRuleReply, error)
 	SubmitEvent(ctx context.Context, in *SubmitEventRequest, opts ...grpc.CallOption) (*SubmitEventReply, error)
 	SubscribeToChatMessages(ctx context.Context, in *SubscribeToChatMessagesRequest, opts ...grpc.CallOption) (StreamD_SubscribeToChatMessagesClient, error)
 	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*SendChatMessageReply, error)
 	RemoveChatMessage(ctx context.Context, in *RemoveChatMessageRequest, opts ...grpc.CallOption) (*RemoveChatMessageReply, error)
 	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BanUserReply, error)
+	AddModerator(ctx context.Context, in *AddModeratorRequest, opts ...grpc.CallOption) (*AddModeratorReply, error)
+	RemoveModerator(ctx context.Context, in *RemoveModeratorRequest, opts ...grpc.CallOption) (*RemoveModeratorReply, error)
+	InsertAdsCuePoint(ctx context.Context, in *InsertAdsCuePointRequest, opts ...grpc.CallOption) (*InsertAdsCuePointReply, error)
+	TwitchCreateStreamMarker(ctx context.Context, in *TwitchCreateStreamMarkerRequest, opts ...grpc.CallOption) (*TwitchCreateStreamMarkerReply, error)
+	TwitchGetAdSchedule(ctx context.Context, in *TwitchGetAdScheduleRequest, opts ...grpc.CallOption) (*TwitchGetAdScheduleReply, error)
 	GetPeerIDs(ctx context.Context, in *GetPeerIDsRequest, opts ...grpc.CallOption) (*GetPeerIDsReply, error)
 }
 
@@ -659,8 +670,67 @@ func (x *streamDSubscribeToStreamForwardsChangesClient) Recv() (*StreamForwardsC
 	return m, nil
 }
 
+func (c *streamDClient) SubscribeToStreamForwardStats(ctx context.Context, in *SubscribeToStreamForwardStatsRequest, opts ...grpc.CallOption) (StreamD_SubscribeToStreamForwardStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StreamD_serviceDesc.Streams[7], "/streamd.StreamD/SubscribeToStreamForwardStats", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamDSubscribeToStreamForwardStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StreamD_SubscribeToStreamForwardStatsClient interface {
+	Recv() (*StreamForwardStatsUpdate, error)
+	grpc.ClientStream
+}
+
+type streamDSubscribeToStreamForwardStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamDSubscribeToStreamForwardStatsClient) Recv() (*StreamForwardStatsUpdate, error) {
+	m := new(StreamForwardStatsUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *streamDClient) StartRecording(ctx context.Context, in *StartRecordingRequest, opts ...grpc.CallOption) (*StartRecordingReply, error) {
+	out := new(StartRecordingReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/StartRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamDClient) StopRecording(ctx context.Context, in *StopRecordingRequest, opts ...grpc.CallOption) (*StopRecordingReply, error) {
+	out := new(StopRecordingReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/StopRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamDClient) ListRecordings(ctx context.Context, in *ListRecordingsRequest, opts ...grpc.CallOption) (*ListRecordingsReply, error) {
+	out := new(ListRecordingsReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/ListRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *streamDClient) WaitForStreamPublisher(ctx context.Context, in *WaitForStreamPublisherRequest, opts ...grpc.CallOption) (StreamD_WaitForStreamPublisherClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_StreamD_serviceDesc.Streams[7], "/streamd.StreamD/WaitForStreamPublisher", opts...)
+	stream, err := c.cc.NewStream(ctx, &_StreamD_serviceDesc.Streams[8], "/streamd.StreamD/WaitForStreamPublisher", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -737,7 +807,7 @@ func (c *streamDClient) GetStreamPlayer(ctx context.Context, in *GetStreamPlayer
 }
 
 func (c *streamDClient) SubscribeToStreamPlayersChanges(ctx context.Context, in *SubscribeToStreamPlayersChangesRequest, opts ...grpc.CallOption) (StreamD_SubscribeToStreamPlayersChangesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_StreamD_serviceDesc.Streams[8], "/streamd.StreamD/SubscribeToStreamPlayersChanges", opts...)
+	stream, err := c.cc.NewStream(ctx, &_StreamD_serviceDesc.Streams[9], "/streamd.StreamD/SubscribeToStreamPlayersChanges", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -796,7 +866,7 @@ func (c *streamDClient) StreamPlayerGetLink(ctx context.Context, in *StreamPlaye
 }
 
 func (c *streamDClient) StreamPlayerEndChan(ctx context.Context, in *StreamPlayerEndChanRequest, opts ...grpc.CallOption) (StreamD_StreamPlayerEndChanClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_StreamD_serviceDesc.Streams[9], "/streamd.StreamD/StreamPlayerEndChan", opts...)
+	stream, err := c.cc.NewStream(ctx, &_StreamD_serviceDesc.Streams[10], "/streamd.StreamD/StreamPlayerEndChan", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -953,6 +1023,24 @@ func (c *streamDClient) UpdateTriggerRule(ctx context.Context, in *UpdateTrigger
 	return out, nil
 }
 
+func (c *streamDClient) ListTriggerRuleExecutions(ctx context.Context, in *ListTriggerRuleExecutionsRequest, opts ...grpc.CallOption) (*ListTriggerRuleExecutionsReply, error) {
+	out := new(ListTriggerRuleExecutionsReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/ListTriggerRuleExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamDClient) TestTriggerRule(ctx context.Context, in *TestTriggerRuleRequest, opts ...grpc.CallOption) (*TestTriggerRuleReply, error) {
+	out := new(TestTriggerRuleReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/TestTriggerRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *streamDClient) SubmitEvent(ctx context.Context, in *SubmitEventRequest, opts ...grpc.CallOption) (*SubmitEventReply, error) {
 	out := new(SubmitEventReply)
 	err := c.cc.Invoke(ctx, "/streamd.StreamD/SubmitEvent", in, out, opts...)
@@ -963,7 +1051,7 @@ func (c *streamDClient) SubmitEvent(ctx context.Context, in *SubmitEventRequest,
 }
 
 func (c *streamDClient) SubscribeToChatMessages(ctx context.Context, in *SubscribeToChatMessagesRequest, opts ...grpc.CallOption) (StreamD_SubscribeToChatMessagesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_StreamD_serviceDesc.Streams[10], "/streamd.StreamD/SubscribeToChatMessages", opts...)
+	stream, err := c.cc.NewStream(ctx, &_StreamD_serviceDesc.Streams[11], "/streamd.StreamD/SubscribeToChatMessages", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1021,6 +1109,51 @@ func (c *streamDClient) BanUser(ctx context.Context, in *BanUserRequest, opts ..
 	return out, nil
 }
 
+func (c *streamDClient) AddModerator(ctx context.Context, in *AddModeratorRequest, opts ...grpc.CallOption) (*AddModeratorReply, error) {
+	out := new(AddModeratorReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/AddModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamDClient) RemoveModerator(ctx context.Context, in *RemoveModeratorRequest, opts ...grpc.CallOption) (*RemoveModeratorReply, error) {
+	out := new(RemoveModeratorReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/RemoveModerator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamDClient) InsertAdsCuePoint(ctx context.Context, in *InsertAdsCuePointRequest, opts ...grpc.CallOption) (*InsertAdsCuePointReply, error) {
+	out := new(InsertAdsCuePointReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/InsertAdsCuePoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamDClient) TwitchCreateStreamMarker(ctx context.Context, in *TwitchCreateStreamMarkerRequest, opts ...grpc.CallOption) (*TwitchCreateStreamMarkerReply, error) {
+	out := new(TwitchCreateStreamMarkerReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/TwitchCreateStreamMarker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamDClient) TwitchGetAdSchedule(ctx context.Context, in *TwitchGetAdScheduleRequest, opts ...grpc.CallOption) (*TwitchGetAdScheduleReply, error) {
+	out := new(TwitchGetAdScheduleReply)
+	err := c.cc.Invoke(ctx, "/streamd.StreamD/TwitchGetAdSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *streamDClient) GetPeerIDs(ctx context.Context, in *GetPeerIDsRequest, opts ...grpc.CallOption) (*GetPeerIDsReply, error) {
 	out := new(GetPeerIDsReply)
 	err := c.cc.Invoke(ctx, "/streamd.StreamD/GetPeerIDs", in, out, opts...)
@@ -1078,6 +1211,10 @@ type StreamDServer interface {
 	UpdateStreamForward(context.Context, *UpdateStreamForwardRequest) (*UpdateStreamForwardReply, error)
 	RemoveStreamForward(context.Context, *RemoveStreamForwardRequest) (*RemoveStreamForwardReply, error)
 	SubscribeToStreamForwardsChanges(*SubscribeToStreamForwardsChangesRequest, StreamD_SubscribeToStreamForwardsChangesServer) error
+	SubscribeToStreamForwardStats(*SubscribeToStreamForwardStatsRequest, StreamD_SubscribeToStreamForwardStatsServer) error
+	StartRecording(context.Context, *StartRecordingRequest) (*StartRecordingReply, error)
+	StopRecording(context.Context, *StopRecordingRequest) (*StopRecordingReply, error)
+	ListRecordings(context.Context, *ListRecordingsRequest) (*ListRecordingsReply, error)
 	WaitForStreamPublisher(*WaitForStreamPublisherRequest, StreamD_WaitForStreamPublisherServer) error
 	AddStreamPlayer(context.Context, *AddStreamPlayerRequest) (*AddStreamPlayerReply, error)
 	RemoveStreamPlayer(context.Context, *RemoveStreamPlayerRequest) (*RemoveStreamPlayerReply, error)
@@ -1103,11 +1240,18 @@ type StreamDServer interface {
 	AddTriggerRule(context.Context, *AddTriggerRuleRequest) (*AddTriggerRuleReply, error)
 	RemoveTriggerRule(context.Context, *RemoveTriggerRuleRequest) (*RemoveTriggerRuleReply, error)
 	UpdateTriggerRule(context.Context, *UpdateTriggerRuleRequest) (*UpdateTriggerRuleReply, error)
+	ListTriggerRuleExecutions(context.Context, *ListTriggerRuleExecutionsRequest) (*ListTriggerRuleExecutionsReply, error)
+	TestTriggerRule(context.Context, *TestTriggerRuleRequest) (*TestTriggerRuleReply, error)
 	SubmitEvent(context.Context, *SubmitEventRequest) (*SubmitEventReply, error)
 	SubscribeToChatMessages(*SubscribeToChatMessagesRequest, StreamD_SubscribeToChatMessagesServer) error
 	SendChatMessage(context.Context, *SendChatMessageRequest) (*SendChatMessageReply, error)
 	RemoveChatMessage(context.Context, *RemoveChatMessageRequest) (*RemoveChatMessageReply, error)
 	BanUser(context.Context, *BanUserRequest) (*BanUserReply, error)
+	AddModerator(context.Context, *AddModeratorRequest) (*AddModeratorReply, error)
+	RemoveModerator(context.Context, *RemoveModeratorRequest) (*RemoveModeratorReply, error)
+	InsertAdsCuePoint(context.Context, *InsertAdsCuePointRequest) (*InsertAdsCuePointReply, error)
+	TwitchCreateStreamMarker(context.Context, *TwitchCreateStreamMarkerRequest) (*TwitchCreateStreamMarkerReply, error)
+	TwitchGetAdSchedule(context.Context, *TwitchGetAdScheduleRequest) (*TwitchGetAdScheduleReply, error)
 	GetPeerIDs(context.Context, *GetPeerIDsRequest) (*GetPeerIDsReply, error)
 	mustEmbedUnimplementedStreamDServer()
 }
@@ -1248,6 +1392,18 @@ func (UnimplementedStreamDServer) RemoveStreamForward(context.Context, *RemoveSt
 func (UnimplementedStreamDServer) SubscribeToStreamForwardsChanges(*SubscribeToStreamForwardsChangesRequest, StreamD_SubscribeToStreamForwardsChangesServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToStreamForwardsChanges not implemented")
 }
+func (UnimplementedStreamDServer) SubscribeToStreamForwardStats(*SubscribeToStreamForwardStatsRequest, StreamD_SubscribeToStreamForwardStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeToStreamForwardStats not implemented")
+}
+func (UnimplementedStreamDServer) StartRecording(context.Context, *StartRecordingRequest) (*StartRecordingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRecording not implemented")
+}
+func (UnimplementedStreamDServer) StopRecording(context.Context, *StopRecordingRequest) (*StopRecordingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRecording not implemented")
+}
+func (UnimplementedStreamDServer) ListRecordings(context.Context, *ListRecordingsRequest) (*ListRecordingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecordings not implemented")
+}
 func (UnimplementedStreamDServer) WaitForStreamPublisher(*WaitForStreamPublisherRequest, StreamD_WaitForStreamPublisherServer) error {
 	return status.Errorf(codes.Unimplemented, "method WaitForStreamPublisher not implemented")
 }
@@ -1323,6 +1479,12 @@ func (UnimplementedStreamDServer) RemoveTriggerRule(context.Context, *RemoveTrig
 func (UnimplementedStreamDServer) UpdateTriggerRule(context.Context, *UpdateTriggerRuleRequest) (*UpdateTriggerRuleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTriggerRule not implemented")
 }
+func (UnimplementedStreamDServer) ListTriggerRuleExecutions(context.Context, *ListTriggerRuleExecutionsRequest) (*ListTriggerRuleExecutionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTriggerRuleExecutions not implemented")
+}
+func (UnimplementedStreamDServer) TestTriggerRule(context.Context, *TestTriggerRuleRequest) (*TestTriggerRuleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestTriggerRule not implemented")
+}
 func (UnimplementedStreamDServer) SubmitEvent(context.Context, *SubmitEventRequest) (*SubmitEventReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvent not implemented")
 }
@@ -1338,6 +1500,21 @@ func (UnimplementedStreamDServer) RemoveChatMessage(context.Context, *RemoveChat
 func (UnimplementedStreamDServer) BanUser(context.Context, *BanUserRequest) (*BanUserReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
 }
+func (UnimplementedStreamDServer) AddModerator(context.Context, *AddModeratorRequest) (*AddModeratorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddModerator not implemented")
+}
+func (UnimplementedStreamDServer) RemoveModerator(context.Context, *RemoveModeratorRequest) (*RemoveModeratorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveModerator not implemented")
+}
+func (UnimplementedStreamDServer) InsertAdsCuePoint(context.Context, *InsertAdsCuePointRequest) (*InsertAdsCuePointReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertAdsCuePoint not implemented")
+}
+func (UnimplementedStreamDServer) TwitchCreateStreamMarker(context.Context, *TwitchCreateStreamMarkerRequest) (*TwitchCreateStreamMarkerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwitchCreateStreamMarker not implemented")
+}
+func (UnimplementedStreamDServer) TwitchGetAdSchedule(context.Context, *TwitchGetAdScheduleRequest) (*TwitchGetAdScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TwitchGetAdSchedule not implemented")
+}
 func (UnimplementedStreamDServer) GetPeerIDs(context.Context, *GetPeerIDsRequest) (*GetPeerIDsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPeerIDs not implemented")
 }
@@ -2167,6 +2344,81 @@ func (x *streamDSubscribeToStreamForwardsChangesServer) Send(m *StreamForwardsCh
 	return x.ServerStream.SendMsg(m)
 }
 
+func _StreamD_SubscribeToStreamForwardStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeToStreamForwardStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamDServer).SubscribeToStreamForwardStats(m, &streamDSubscribeToStreamForwardStatsServer{stream})
+}
+
+type StreamD_SubscribeToStreamForwardStatsServer interface {
+	Send(*StreamForwardStatsUpdate) error
+	grpc.ServerStream
+}
+
+type streamDSubscribeToStreamForwardStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamDSubscribeToStreamForwardStatsServer) Send(m *StreamForwardStatsUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StreamD_StartRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).StartRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/StartRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).StartRecording(ctx, req.(*StartRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamD_StopRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).StopRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/StopRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).StopRecording(ctx, req.(*StopRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamD_ListRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).ListRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/ListRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).ListRecordings(ctx, req.(*ListRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StreamD_WaitForStreamPublisher_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(WaitForStreamPublisherRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2626,6 +2878,42 @@ func _StreamD_UpdateTriggerRule_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreamD_ListTriggerRuleExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTriggerRuleExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).ListTriggerRuleExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/ListTriggerRuleExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).ListTriggerRuleExecutions(ctx, req.(*ListTriggerRuleExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamD_TestTriggerRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestTriggerRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).TestTriggerRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/TestTriggerRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).TestTriggerRule(ctx, req.(*TestTriggerRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StreamD_SubmitEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitEventRequest)
 	if err := dec(in); err != nil {
@@ -2719,6 +3007,96 @@ func _StreamD_BanUser_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreamD_AddModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).AddModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/AddModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).AddModerator(ctx, req.(*AddModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamD_RemoveModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).RemoveModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/RemoveModerator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).RemoveModerator(ctx, req.(*RemoveModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamD_InsertAdsCuePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertAdsCuePointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).InsertAdsCuePoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/InsertAdsCuePoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).InsertAdsCuePoint(ctx, req.(*InsertAdsCuePointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamD_TwitchCreateStreamMarker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwitchCreateStreamMarkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).TwitchCreateStreamMarker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/TwitchCreateStreamMarker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).TwitchCreateStreamMarker(ctx, req.(*TwitchCreateStreamMarkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamD_TwitchGetAdSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwitchGetAdScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamDServer).TwitchGetAdSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/streamd.StreamD/TwitchGetAdSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamDServer).TwitchGetAdSchedule(ctx, req.(*TwitchGetAdScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StreamD_GetPeerIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPeerIDsRequest)
 	if err := dec(in); err != nil {
@@ -2889,6 +3267,18 @@ var _StreamD_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveStreamForward",
 			Handler:    _StreamD_RemoveStreamForward_Handler,
 		},
+		{
+			MethodName: "StartRecording",
+			Handler:    _StreamD_StartRecording_Handler,
+		},
+		{
+			MethodName: "StopRecording",
+			Handler:    _StreamD_StopRecording_Handler,
+		},
+		{
+			MethodName: "ListRecordings",
+			Handler:    _StreamD_ListRecordings_Handler,
+		},
 		{
 			MethodName: "AddStreamPlayer",
 			Handler:    _StreamD_AddStreamPlayer_Handler,
@@ -2977,6 +3367,14 @@ var _StreamD_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTriggerRule",
 			Handler:    _StreamD_UpdateTriggerRule_Handler,
 		},
+		{
+			MethodName: "ListTriggerRuleExecutions",
+			Handler:    _StreamD_ListTriggerRuleExecutions_Handler,
+		},
+		{
+			MethodName: "TestTriggerRule",
+			Handler:    _StreamD_TestTriggerRule_Handler,
+		},
 		{
 			MethodName: "SubmitEvent",
 			Handler:    _StreamD_SubmitEvent_Handler,
@@ -2993,6 +3391,26 @@ var _StreamD_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BanUser",
 			Handler:    _StreamD_BanUser_Handler,
 		},
+		{
+			MethodName: "AddModerator",
+			Handler:    _StreamD_AddModerator_Handler,
+		},
+		{
+			MethodName: "RemoveModerator",
+			Handler:    _StreamD_RemoveModerator_Handler,
+		},
+		{
+			MethodName: "InsertAdsCuePoint",
+			Handler:    _StreamD_InsertAdsCuePoint_Handler,
+		},
+		{
+			MethodName: "TwitchCreateStreamMarker",
+			Handler:    _StreamD_TwitchCreateStreamMarker_Handler,
+		},
+		{
+			MethodName: "TwitchGetAdSchedule",
+			Handler:    _StreamD_TwitchGetAdSchedule_Handler,
+		},
 		{
 			MethodName: "GetPeerIDs",
 			Handler:    _StreamD_GetPeerIDs_Handler,
@@ -3034,6 +3452,11 @@ var _StreamD_serviceDesc = grpc.ServiceDesc{
 			Handler:       _StreamD_SubscribeToStreamForwardsChanges_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeToStreamForwardStats",
+			Handler:       _StreamD_SubscribeToStreamForwardStats_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "WaitForStreamPublisher",
 			Handler:       _StreamD_WaitForStreamPublisher_Handler,
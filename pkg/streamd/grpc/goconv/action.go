@@ -2,18 +2,21 @@ package goconv
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/xaionaro-go/streamctl/pkg/expression"
 	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/consts"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
+	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
 )
 
 func ActionGRPC2Go(
 	actionGRPC *streamd_grpc.Action,
 ) (action.Action, error) {
 	var result action.Action
-	switch a := actionGRPC.ActionOneof.(type) {
+	switch a := actionGRPC.GetActionOneof().(type) {
 	case *streamd_grpc.Action_NoopRequest:
 		result = &action.Noop{}
 	case *streamd_grpc.Action_StartStreamRequest:
@@ -47,9 +50,110 @@ func ActionGRPC2Go(
 				ItemUUID:        o.WindowCaptureSetSource.ItemUUID,
 				ValueExpression: expression.Expression(o.WindowCaptureSetSource.ValueExpression),
 			}
+		case *streamd_grpc.OBSAction_SetCurrentScene:
+			result = &action.OBSSetCurrentScene{
+				SceneNameExpression: expression.Expression(o.SetCurrentScene.GetSceneNameExpression()),
+			}
 		default:
 			return nil, fmt.Errorf("unexpected OBS action type: %T", o)
 		}
+	case *streamd_grpc.Action_Sequence:
+		steps, err := actionStepsGRPC2Go(a.Sequence.GetSteps())
+		if err != nil {
+			return nil, err
+		}
+		result = &action.Sequence{
+			Steps: steps,
+		}
+	case *streamd_grpc.Action_Parallel:
+		steps, err := actionStepsGRPC2Go(a.Parallel.GetSteps())
+		if err != nil {
+			return nil, err
+		}
+		result = &action.Parallel{
+			Steps: steps,
+		}
+	case *streamd_grpc.Action_Delay:
+		result = &action.Delay{
+			Duration: time.Duration(a.Delay.GetDurationNano()),
+		}
+	case *streamd_grpc.Action_If:
+		r := &action.If{
+			Condition: expression.Expression(a.If.GetConditionExpression()),
+		}
+		if a.If.GetThen() != nil {
+			then, err := ActionGRPC2Go(a.If.GetThen())
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert the 'then' action: %w", err)
+			}
+			r.Then = then
+		}
+		if a.If.GetElse() != nil {
+			_else, err := ActionGRPC2Go(a.If.GetElse())
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert the 'else' action: %w", err)
+			}
+			r.Else = _else
+		}
+		result = r
+	case *streamd_grpc.Action_SendChatMessage:
+		result = &action.SendChatMessage{
+			PlatID:            streamcontrol.PlatformName(a.SendChatMessage.GetPlatID()),
+			MessageExpression: expression.Expression(a.SendChatMessage.GetMessageExpression()),
+		}
+	case *streamd_grpc.Action_SetTitle:
+		result = &action.SetTitle{
+			PlatID:          streamcontrol.PlatformName(a.SetTitle.GetPlatID()),
+			TitleExpression: expression.Expression(a.SetTitle.GetTitleExpression()),
+		}
+	case *streamd_grpc.Action_SetDescription:
+		result = &action.SetDescription{
+			PlatID:                streamcontrol.PlatformName(a.SetDescription.GetPlatID()),
+			DescriptionExpression: expression.Expression(a.SetDescription.GetDescriptionExpression()),
+		}
+	case *streamd_grpc.Action_ApplyProfile:
+		result = &action.ApplyProfile{
+			PlatID:                streamcontrol.PlatformName(a.ApplyProfile.GetPlatID()),
+			ProfileNameExpression: expression.Expression(a.ApplyProfile.GetProfileNameExpression()),
+		}
+	case *streamd_grpc.Action_SetStreamForwardEnabled:
+		streamID := streamtypes.StreamID(a.SetStreamForwardEnabled.GetStreamID())
+		destinationID := streamtypes.DestinationID(a.SetStreamForwardEnabled.GetDestinationID())
+		if a.SetStreamForwardEnabled.GetEnabled() {
+			result = &action.EnableStreamForward{
+				StreamID:      streamID,
+				DestinationID: destinationID,
+			}
+		} else {
+			result = &action.DisableStreamForward{
+				StreamID:      streamID,
+				DestinationID: destinationID,
+			}
+		}
+	case *streamd_grpc.Action_SetVariable:
+		result = &action.SetVariable{
+			Key:             consts.VarKey(a.SetVariable.GetKey()),
+			ValueExpression: expression.Expression(a.SetVariable.GetValueExpression()),
+		}
+	case *streamd_grpc.Action_InsertAdsCuePoint:
+		result = &action.InsertAdsCuePoint{
+			PlatID:   streamcontrol.PlatformName(a.InsertAdsCuePoint.GetPlatID()),
+			Duration: time.Duration(a.InsertAdsCuePoint.GetDurationNano()),
+		}
+	case *streamd_grpc.Action_RunCommand:
+		args := make([]action.ValueExpression, 0, len(a.RunCommand.GetArgs()))
+		for _, arg := range a.RunCommand.GetArgs() {
+			args = append(args, expression.Expression(arg))
+		}
+		result = &action.RunCommand{
+			Command: a.RunCommand.GetCommand(),
+			Args:    args,
+			Timeout: time.Duration(a.RunCommand.GetTimeoutNano()),
+		}
+	case *streamd_grpc.Action_TwitchCreateStreamMarker:
+		result = &action.TwitchCreateStreamMarker{
+			DescriptionExpression: expression.Expression(a.TwitchCreateStreamMarker.GetDescriptionExpression()),
+		}
 	default:
 		return nil, fmt.Errorf("unexpected action type: %T", a)
 	}
@@ -106,8 +210,207 @@ func ActionGo2GRPC(
 				},
 			},
 		}
+	case *action.OBSSetCurrentScene:
+		result.ActionOneof = &streamd_grpc.Action_ObsAction{
+			ObsAction: &streamd_grpc.OBSAction{
+				OBSActionOneOf: &streamd_grpc.OBSAction_SetCurrentScene{
+					SetCurrentScene: &streamd_grpc.OBSActionSetCurrentScene{
+						SceneNameExpression: string(a.SceneNameExpression),
+					},
+				},
+			},
+		}
+	case *action.SendChatMessage:
+		result.ActionOneof = &streamd_grpc.Action_SendChatMessage{
+			SendChatMessage: &streamd_grpc.ActionSendChatMessage{
+				PlatID:            string(a.PlatID),
+				MessageExpression: string(a.MessageExpression),
+			},
+		}
+	case *action.SetTitle:
+		result.ActionOneof = &streamd_grpc.Action_SetTitle{
+			SetTitle: &streamd_grpc.ActionSetTitle{
+				PlatID:          string(a.PlatID),
+				TitleExpression: string(a.TitleExpression),
+			},
+		}
+	case *action.SetDescription:
+		result.ActionOneof = &streamd_grpc.Action_SetDescription{
+			SetDescription: &streamd_grpc.ActionSetDescription{
+				PlatID:                string(a.PlatID),
+				DescriptionExpression: string(a.DescriptionExpression),
+			},
+		}
+	case *action.ApplyProfile:
+		result.ActionOneof = &streamd_grpc.Action_ApplyProfile{
+			ApplyProfile: &streamd_grpc.ActionApplyProfile{
+				PlatID:                string(a.PlatID),
+				ProfileNameExpression: string(a.ProfileNameExpression),
+			},
+		}
+	case *action.EnableStreamForward:
+		result.ActionOneof = &streamd_grpc.Action_SetStreamForwardEnabled{
+			SetStreamForwardEnabled: &streamd_grpc.ActionSetStreamForwardEnabled{
+				StreamID:      string(a.StreamID),
+				DestinationID: string(a.DestinationID),
+				Enabled:       true,
+			},
+		}
+	case *action.DisableStreamForward:
+		result.ActionOneof = &streamd_grpc.Action_SetStreamForwardEnabled{
+			SetStreamForwardEnabled: &streamd_grpc.ActionSetStreamForwardEnabled{
+				StreamID:      string(a.StreamID),
+				DestinationID: string(a.DestinationID),
+				Enabled:       false,
+			},
+		}
+	case *action.SetVariable:
+		result.ActionOneof = &streamd_grpc.Action_SetVariable{
+			SetVariable: &streamd_grpc.ActionSetVariable{
+				Key:             string(a.Key),
+				ValueExpression: string(a.ValueExpression),
+			},
+		}
+	case *action.InsertAdsCuePoint:
+		result.ActionOneof = &streamd_grpc.Action_InsertAdsCuePoint{
+			InsertAdsCuePoint: &streamd_grpc.ActionInsertAdsCuePoint{
+				PlatID:       string(a.PlatID),
+				DurationNano: int64(a.Duration),
+			},
+		}
+	case *action.RunCommand:
+		args := make([]string, 0, len(a.Args))
+		for _, arg := range a.Args {
+			args = append(args, string(arg))
+		}
+		result.ActionOneof = &streamd_grpc.Action_RunCommand{
+			RunCommand: &streamd_grpc.ActionRunCommand{
+				Command:     a.Command,
+				Args:        args,
+				TimeoutNano: int64(a.Timeout),
+			},
+		}
+	case *action.TwitchCreateStreamMarker:
+		result.ActionOneof = &streamd_grpc.Action_TwitchCreateStreamMarker{
+			TwitchCreateStreamMarker: &streamd_grpc.ActionTwitchCreateStreamMarker{
+				DescriptionExpression: string(a.DescriptionExpression),
+			},
+		}
+	case *action.Sequence:
+		steps, err := actionStepsGo2GRPC(a.Steps)
+		if err != nil {
+			return nil, err
+		}
+		result.ActionOneof = &streamd_grpc.Action_Sequence{
+			Sequence: &streamd_grpc.ActionSequence{
+				Steps: steps,
+			},
+		}
+	case *action.Parallel:
+		steps, err := actionStepsGo2GRPC(a.Steps)
+		if err != nil {
+			return nil, err
+		}
+		result.ActionOneof = &streamd_grpc.Action_Parallel{
+			Parallel: &streamd_grpc.ActionParallel{
+				Steps: steps,
+			},
+		}
+	case *action.Delay:
+		result.ActionOneof = &streamd_grpc.Action_Delay{
+			Delay: &streamd_grpc.ActionDelay{
+				DurationNano: int64(a.Duration),
+			},
+		}
+	case *action.If:
+		r := &streamd_grpc.ActionIf{
+			ConditionExpression: string(a.Condition),
+		}
+		if a.Then != nil {
+			then, err := ActionGo2GRPC(a.Then)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert the 'then' action: %w", err)
+			}
+			r.Then = then
+		}
+		if a.Else != nil {
+			_else, err := ActionGo2GRPC(a.Else)
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert the 'else' action: %w", err)
+			}
+			r.Else = _else
+		}
+		result.ActionOneof = &streamd_grpc.Action_If{
+			If: r,
+		}
 	default:
 		return nil, fmt.Errorf("unknown action type: %T", a)
 	}
 	return &result, nil
 }
+
+func ActionErrorPolicyGo2GRPC(
+	p action.ErrorPolicy,
+) (streamd_grpc.ActionErrorPolicy, error) {
+	switch p {
+	case action.ErrorPolicyUndefined, action.ErrorPolicyAbort:
+		return streamd_grpc.ActionErrorPolicy_ActionErrorPolicyAbort, nil
+	case action.ErrorPolicyContinue:
+		return streamd_grpc.ActionErrorPolicy_ActionErrorPolicyContinue, nil
+	}
+	return streamd_grpc.ActionErrorPolicy_ActionErrorPolicyAbort, fmt.Errorf("unexpected value: %v", p)
+}
+
+func ActionErrorPolicyGRPC2Go(
+	p streamd_grpc.ActionErrorPolicy,
+) (action.ErrorPolicy, error) {
+	switch p {
+	case streamd_grpc.ActionErrorPolicy_ActionErrorPolicyAbort:
+		return action.ErrorPolicyAbort, nil
+	case streamd_grpc.ActionErrorPolicy_ActionErrorPolicyContinue:
+		return action.ErrorPolicyContinue, nil
+	}
+	return action.ErrorPolicyUndefined, fmt.Errorf("unexpected value: %v", p)
+}
+
+func actionStepsGo2GRPC(
+	steps []action.Step,
+) ([]*streamd_grpc.ActionStep, error) {
+	result := make([]*streamd_grpc.ActionStep, 0, len(steps))
+	for idx, step := range steps {
+		a, err := ActionGo2GRPC(step.Action)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert the action of step #%d: %w", idx, err)
+		}
+		onError, err := ActionErrorPolicyGo2GRPC(step.OnError)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert the error policy of step #%d: %w", idx, err)
+		}
+		result = append(result, &streamd_grpc.ActionStep{
+			Action:  a,
+			OnError: onError,
+		})
+	}
+	return result, nil
+}
+
+func actionStepsGRPC2Go(
+	steps []*streamd_grpc.ActionStep,
+) ([]action.Step, error) {
+	result := make([]action.Step, 0, len(steps))
+	for idx, step := range steps {
+		a, err := ActionGRPC2Go(step.GetAction())
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert the action of step #%d: %w", idx, err)
+		}
+		onError, err := ActionErrorPolicyGRPC2Go(step.GetOnError())
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert the error policy of step #%d: %w", idx, err)
+		}
+		result = append(result, action.Step{
+			Action:  a,
+			OnError: onError,
+		})
+	}
+	return result, nil
+}
@@ -23,6 +23,10 @@ func CapabilitiesGRPC2Go(
 			r = streamcontrol.CapabilityDeleteChatMessage
 		case streamd_grpc.Capability_BanUser:
 			r = streamcontrol.CapabilityBanUser
+		case streamd_grpc.Capability_TimeoutUser:
+			r = streamcontrol.CapabilityTimeoutUser
+		case streamd_grpc.Capability_ManageModerators:
+			r = streamcontrol.CapabilityManageModerators
 		default:
 			logger.Warnf(ctx, "unexpected capability: %v", cap)
 			continue
@@ -47,6 +51,10 @@ func CapabilitiesGo2GRPC(
 			item = streamd_grpc.Capability_DeleteChatMessage
 		case streamcontrol.CapabilityBanUser:
 			item = streamd_grpc.Capability_BanUser
+		case streamcontrol.CapabilityTimeoutUser:
+			item = streamd_grpc.Capability_TimeoutUser
+		case streamcontrol.CapabilityManageModerators:
+			item = streamd_grpc.Capability_ManageModerators
 		default:
 			logger.Warnf(ctx, "unexpected capability: %v", cap)
 			continue
@@ -0,0 +1,138 @@
+package goconv
+
+import (
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
+)
+
+func ChatUserRolesGo2GRPC(
+	roles []streamcontrol.ChatUserRole,
+) []streamd_grpc.ChatUserRole {
+	var result []streamd_grpc.ChatUserRole
+	for _, role := range roles {
+		switch role {
+		case streamcontrol.ChatUserRoleBroadcaster:
+			result = append(result, streamd_grpc.ChatUserRole_Broadcaster)
+		case streamcontrol.ChatUserRoleModerator:
+			result = append(result, streamd_grpc.ChatUserRole_Moderator)
+		case streamcontrol.ChatUserRoleVIP:
+			result = append(result, streamd_grpc.ChatUserRole_VIP)
+		case streamcontrol.ChatUserRoleSubscriber:
+			result = append(result, streamd_grpc.ChatUserRole_Subscriber)
+		}
+	}
+	return result
+}
+
+func ChatUserRolesGRPC2Go(
+	roles []streamd_grpc.ChatUserRole,
+) []streamcontrol.ChatUserRole {
+	var result []streamcontrol.ChatUserRole
+	for _, role := range roles {
+		switch role {
+		case streamd_grpc.ChatUserRole_Broadcaster:
+			result = append(result, streamcontrol.ChatUserRoleBroadcaster)
+		case streamd_grpc.ChatUserRole_Moderator:
+			result = append(result, streamcontrol.ChatUserRoleModerator)
+		case streamd_grpc.ChatUserRole_VIP:
+			result = append(result, streamcontrol.ChatUserRoleVIP)
+		case streamd_grpc.ChatUserRole_Subscriber:
+			result = append(result, streamcontrol.ChatUserRoleSubscriber)
+		}
+	}
+	return result
+}
+
+func ChatBadgesGo2GRPC(
+	badges []streamcontrol.ChatBadge,
+) []*streamd_grpc.ChatBadge {
+	var result []*streamd_grpc.ChatBadge
+	for _, badge := range badges {
+		result = append(result, &streamd_grpc.ChatBadge{
+			ID:      badge.ID,
+			Version: badge.Version,
+		})
+	}
+	return result
+}
+
+func ChatBadgesGRPC2Go(
+	badges []*streamd_grpc.ChatBadge,
+) []streamcontrol.ChatBadge {
+	var result []streamcontrol.ChatBadge
+	for _, badge := range badges {
+		result = append(result, streamcontrol.ChatBadge{
+			ID:      badge.GetID(),
+			Version: badge.GetVersion(),
+		})
+	}
+	return result
+}
+
+func ChatMessageFragmentsGo2GRPC(
+	fragments []streamcontrol.ChatMessageFragment,
+) []*streamd_grpc.ChatMessageFragment {
+	var result []*streamd_grpc.ChatMessageFragment
+	for _, fragment := range fragments {
+		var fragmentType streamd_grpc.ChatMessageFragmentType
+		switch fragment.Type {
+		case streamcontrol.ChatMessageFragmentTypeText:
+			fragmentType = streamd_grpc.ChatMessageFragmentType_Text
+		case streamcontrol.ChatMessageFragmentTypeEmote:
+			fragmentType = streamd_grpc.ChatMessageFragmentType_Emote
+		}
+		result = append(result, &streamd_grpc.ChatMessageFragment{
+			Type:    fragmentType,
+			Text:    fragment.Text,
+			EmoteID: fragment.EmoteID,
+		})
+	}
+	return result
+}
+
+func ChatMessageFragmentsGRPC2Go(
+	fragments []*streamd_grpc.ChatMessageFragment,
+) []streamcontrol.ChatMessageFragment {
+	var result []streamcontrol.ChatMessageFragment
+	for _, fragment := range fragments {
+		var fragmentType streamcontrol.ChatMessageFragmentType
+		switch fragment.GetType() {
+		case streamd_grpc.ChatMessageFragmentType_Text:
+			fragmentType = streamcontrol.ChatMessageFragmentTypeText
+		case streamd_grpc.ChatMessageFragmentType_Emote:
+			fragmentType = streamcontrol.ChatMessageFragmentTypeEmote
+		}
+		result = append(result, streamcontrol.ChatMessageFragment{
+			Type:    fragmentType,
+			Text:    fragment.GetText(),
+			EmoteID: fragment.GetEmoteID(),
+		})
+	}
+	return result
+}
+
+func ChatMessagePaidGo2GRPC(
+	paid *streamcontrol.ChatMessagePaid,
+) *streamd_grpc.ChatMessagePaid {
+	if paid == nil {
+		return nil
+	}
+	return &streamd_grpc.ChatMessagePaid{
+		Amount:      paid.Amount,
+		Currency:    paid.Currency,
+		Description: paid.Description,
+	}
+}
+
+func ChatMessagePaidGRPC2Go(
+	paid *streamd_grpc.ChatMessagePaid,
+) *streamcontrol.ChatMessagePaid {
+	if paid == nil {
+		return nil
+	}
+	return &streamcontrol.ChatMessagePaid{
+		Amount:      paid.GetAmount(),
+		Currency:    paid.GetCurrency(),
+		Description: paid.GetDescription(),
+	}
+}
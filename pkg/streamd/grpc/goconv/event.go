@@ -3,9 +3,11 @@ package goconv
 import (
 	"fmt"
 
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
+	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
 )
 
 func EventGo2GRPC(in event.Event) (*streamd_grpc.Event, error) {
@@ -16,6 +18,196 @@ func EventGo2GRPC(in event.Event) (*streamd_grpc.Event, error) {
 				WindowFocusChange: triggerGo2GRPCWindowFocusChange(q),
 			},
 		}, nil
+	case *event.ChatMessageReceived:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_ChatMessageReceived{
+				ChatMessageReceived: triggerGo2GRPCChatMessageReceived(q),
+			},
+		}, nil
+	case *event.StreamStarted:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_StreamStarted{
+				StreamStarted: &streamd_grpc.EventStreamStarted{
+					PlatID: convertStringPtr[string](q.Platform),
+				},
+			},
+		}, nil
+	case *event.StreamEnded:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_StreamEnded{
+				StreamEnded: &streamd_grpc.EventStreamEnded{
+					PlatID: convertStringPtr[string](q.Platform),
+				},
+			},
+		}, nil
+	case *event.ViewerCountChanged:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_ViewerCountChanged{
+				ViewerCountChanged: triggerGo2GRPCViewerCountChanged(q),
+			},
+		}, nil
+	case *event.IncomingStreamPublished:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_IncomingStreamPublished{
+				IncomingStreamPublished: &streamd_grpc.EventIncomingStreamPublished{
+					StreamID: convertStringPtr[string](q.StreamID),
+				},
+			},
+		}, nil
+	case *event.IncomingStreamUnpublished:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_IncomingStreamUnpublished{
+				IncomingStreamUnpublished: &streamd_grpc.EventIncomingStreamUnpublished{
+					StreamID: convertStringPtr[string](q.StreamID),
+				},
+			},
+		}, nil
+	case *event.IncomingStreamAuthFailed:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_IncomingStreamAuthFailed{
+				IncomingStreamAuthFailed: &streamd_grpc.EventIncomingStreamAuthFailed{
+					StreamID:   convertStringPtr[string](q.StreamID),
+					Action:     q.Action,
+					RemoteAddr: q.RemoteAddr,
+				},
+			},
+		}, nil
+	case *event.StreamForwardStarted:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_StreamForwardStarted{
+				StreamForwardStarted: &streamd_grpc.EventStreamForwardStarted{
+					StreamID:      convertStringPtr[string](q.StreamID),
+					DestinationID: convertStringPtr[string](q.DestinationID),
+				},
+			},
+		}, nil
+	case *event.StreamForwardFailed:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_StreamForwardFailed{
+				StreamForwardFailed: &streamd_grpc.EventStreamForwardFailed{
+					StreamID:      convertStringPtr[string](q.StreamID),
+					DestinationID: convertStringPtr[string](q.DestinationID),
+					Error:         q.Error,
+					ErrorContains: q.ErrorContains,
+				},
+			},
+		}, nil
+	case *event.StreamForwardSourceSwitched:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_StreamForwardSourceSwitched{
+				StreamForwardSourceSwitched: &streamd_grpc.EventStreamForwardSourceSwitched{
+					StreamID:      convertStringPtr[string](q.StreamID),
+					DestinationID: convertStringPtr[string](q.DestinationID),
+					Source:        q.Source,
+					IsFallback:    q.IsFallback,
+				},
+			},
+		}, nil
+	case *event.StreamForwardFallbackStopped:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_StreamForwardFallbackStopped{
+				StreamForwardFallbackStopped: &streamd_grpc.EventStreamForwardFallbackStopped{
+					StreamID:      convertStringPtr[string](q.StreamID),
+					DestinationID: convertStringPtr[string](q.DestinationID),
+					Fallback:      q.Fallback,
+					Reason:        q.Reason,
+				},
+			},
+		}, nil
+	case *event.TwitchFollow:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_TwitchFollow{
+				TwitchFollow: &streamd_grpc.EventTwitchFollow{
+					UserID:   convertStringPtr[string](q.UserID),
+					Username: q.Username,
+				},
+			},
+		}, nil
+	case *event.TwitchSubscription:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_TwitchSubscription{
+				TwitchSubscription: &streamd_grpc.EventTwitchSubscription{
+					UserID:           convertStringPtr[string](q.UserID),
+					Username:         q.Username,
+					Tier:             q.Tier,
+					IsGift:           q.IsGift,
+					CumulativeMonths: q.CumulativeMonths,
+					Message:          q.Message,
+				},
+			},
+		}, nil
+	case *event.TwitchSubscriptionGift:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_TwitchSubscriptionGift{
+				TwitchSubscriptionGift: &streamd_grpc.EventTwitchSubscriptionGift{
+					UserID:      convertStringPtr[string](q.UserID),
+					Username:    q.Username,
+					Tier:        q.Tier,
+					Total:       q.Total,
+					IsAnonymous: q.IsAnonymous,
+					TotalMin:    q.TotalMin,
+				},
+			},
+		}, nil
+	case *event.TwitchCheer:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_TwitchCheer{
+				TwitchCheer: &streamd_grpc.EventTwitchCheer{
+					UserID:      convertStringPtr[string](q.UserID),
+					Username:    q.Username,
+					IsAnonymous: q.IsAnonymous,
+					Bits:        q.Bits,
+					Message:     q.Message,
+					BitsMin:     q.BitsMin,
+				},
+			},
+		}, nil
+	case *event.TwitchRaid:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_TwitchRaid{
+				TwitchRaid: &streamd_grpc.EventTwitchRaid{
+					FromUserID:   convertStringPtr[string](q.FromUserID),
+					FromUsername: q.FromUsername,
+					Viewers:      q.Viewers,
+					ViewersMin:   q.ViewersMin,
+				},
+			},
+		}, nil
+	case *event.TwitchChannelPointsRedemption:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_TwitchChannelPointsRedemption{
+				TwitchChannelPointsRedemption: &streamd_grpc.EventTwitchChannelPointsRedemption{
+					UserID:      convertStringPtr[string](q.UserID),
+					Username:    q.Username,
+					RewardID:    q.RewardID,
+					RewardTitle: q.RewardTitle,
+					RewardCost:  q.RewardCost,
+					UserInput:   q.UserInput,
+				},
+			},
+		}, nil
+	case *event.TwitchPoll:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_TwitchPoll{
+				TwitchPoll: &streamd_grpc.EventTwitchPoll{
+					PollID:        q.PollID,
+					Title:         q.Title,
+					Stage:         q.Stage,
+					WinningChoice: q.WinningChoice,
+				},
+			},
+		}, nil
+	case *event.TwitchPrediction:
+		return &streamd_grpc.Event{
+			EventOneOf: &streamd_grpc.Event_TwitchPrediction{
+				TwitchPrediction: &streamd_grpc.EventTwitchPrediction{
+					PredictionID:   q.PredictionID,
+					Title:          q.Title,
+					Stage:          q.Stage,
+					WinningOutcome: q.WinningOutcome,
+				},
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("conversion of type %T is not implemented, yet", q)
 	}
@@ -33,10 +225,154 @@ func triggerGo2GRPCWindowFocusChange(q *event.WindowFocusChange) *streamd_grpc.E
 	}
 }
 
+func triggerGo2GRPCChatMessageReceived(q *event.ChatMessageReceived) *streamd_grpc.EventChatMessageReceived {
+	return &streamd_grpc.EventChatMessageReceived{
+		PlatID:          convertStringPtr[string](q.Platform),
+		UserID:          convertStringPtr[string](q.UserID),
+		Username:        q.Username,
+		MessageID:       convertStringPtr[string](q.MessageID),
+		Message:         q.Message,
+		MessageContains: q.MessageContains,
+		MessageRegex:    q.MessageRegex,
+		Roles:           ChatUserRolesGo2GRPC(q.Roles),
+		Badges:          ChatBadgesGo2GRPC(q.Badges),
+		IsPaid:          q.IsPaid,
+		Paid:            ChatMessagePaidGo2GRPC(q.Paid),
+		PaidCurrency:    q.PaidCurrency,
+		PaidAmountMin:   q.PaidAmountMin,
+	}
+}
+
+func triggerGo2GRPCViewerCountChanged(q *event.ViewerCountChanged) *streamd_grpc.EventViewerCountChanged {
+	return &streamd_grpc.EventViewerCountChanged{
+		PlatID:               convertStringPtr[string](q.Platform),
+		ViewersCount:         q.ViewersCount,
+		PreviousViewersCount: q.PreviousViewersCount,
+		ViewersCountMin:      q.ViewersCountMin,
+		ViewersCountMax:      q.ViewersCountMax,
+		CrossedAbove:         q.CrossedAbove,
+		CrossedBelow:         q.CrossedBelow,
+	}
+}
+
 func EventGRPC2Go(in *streamd_grpc.Event) (config.Event, error) {
 	switch q := in.EventOneOf.(type) {
 	case *streamd_grpc.Event_WindowFocusChange:
 		return triggerGRPC2GoWindowFocusChange(q.WindowFocusChange), nil
+	case *streamd_grpc.Event_ChatMessageReceived:
+		return triggerGRPC2GoChatMessageReceived(q.ChatMessageReceived), nil
+	case *streamd_grpc.Event_StreamStarted:
+		return &event.StreamStarted{
+			Platform: convertStringPtr[streamcontrol.PlatformName](q.StreamStarted.PlatID),
+		}, nil
+	case *streamd_grpc.Event_StreamEnded:
+		return &event.StreamEnded{
+			Platform: convertStringPtr[streamcontrol.PlatformName](q.StreamEnded.PlatID),
+		}, nil
+	case *streamd_grpc.Event_ViewerCountChanged:
+		return triggerGRPC2GoViewerCountChanged(q.ViewerCountChanged), nil
+	case *streamd_grpc.Event_IncomingStreamPublished:
+		return &event.IncomingStreamPublished{
+			StreamID: convertStringPtr[streamtypes.StreamID](q.IncomingStreamPublished.StreamID),
+		}, nil
+	case *streamd_grpc.Event_IncomingStreamUnpublished:
+		return &event.IncomingStreamUnpublished{
+			StreamID: convertStringPtr[streamtypes.StreamID](q.IncomingStreamUnpublished.StreamID),
+		}, nil
+	case *streamd_grpc.Event_IncomingStreamAuthFailed:
+		return &event.IncomingStreamAuthFailed{
+			StreamID:   convertStringPtr[streamtypes.StreamID](q.IncomingStreamAuthFailed.StreamID),
+			Action:     q.IncomingStreamAuthFailed.Action,
+			RemoteAddr: q.IncomingStreamAuthFailed.RemoteAddr,
+		}, nil
+	case *streamd_grpc.Event_StreamForwardStarted:
+		return &event.StreamForwardStarted{
+			StreamID:      convertStringPtr[streamtypes.StreamID](q.StreamForwardStarted.StreamID),
+			DestinationID: convertStringPtr[streamtypes.DestinationID](q.StreamForwardStarted.DestinationID),
+		}, nil
+	case *streamd_grpc.Event_StreamForwardFailed:
+		return &event.StreamForwardFailed{
+			StreamID:      convertStringPtr[streamtypes.StreamID](q.StreamForwardFailed.StreamID),
+			DestinationID: convertStringPtr[streamtypes.DestinationID](q.StreamForwardFailed.DestinationID),
+			Error:         q.StreamForwardFailed.Error,
+			ErrorContains: q.StreamForwardFailed.ErrorContains,
+		}, nil
+	case *streamd_grpc.Event_StreamForwardSourceSwitched:
+		return &event.StreamForwardSourceSwitched{
+			StreamID:      convertStringPtr[streamtypes.StreamID](q.StreamForwardSourceSwitched.StreamID),
+			DestinationID: convertStringPtr[streamtypes.DestinationID](q.StreamForwardSourceSwitched.DestinationID),
+			Source:        q.StreamForwardSourceSwitched.Source,
+			IsFallback:    q.StreamForwardSourceSwitched.IsFallback,
+		}, nil
+	case *streamd_grpc.Event_StreamForwardFallbackStopped:
+		return &event.StreamForwardFallbackStopped{
+			StreamID:      convertStringPtr[streamtypes.StreamID](q.StreamForwardFallbackStopped.StreamID),
+			DestinationID: convertStringPtr[streamtypes.DestinationID](q.StreamForwardFallbackStopped.DestinationID),
+			Fallback:      q.StreamForwardFallbackStopped.Fallback,
+			Reason:        q.StreamForwardFallbackStopped.Reason,
+		}, nil
+	case *streamd_grpc.Event_TwitchFollow:
+		return &event.TwitchFollow{
+			UserID:   convertStringPtr[streamcontrol.ChatUserID](q.TwitchFollow.UserID),
+			Username: q.TwitchFollow.Username,
+		}, nil
+	case *streamd_grpc.Event_TwitchSubscription:
+		return &event.TwitchSubscription{
+			UserID:           convertStringPtr[streamcontrol.ChatUserID](q.TwitchSubscription.UserID),
+			Username:         q.TwitchSubscription.Username,
+			Tier:             q.TwitchSubscription.Tier,
+			IsGift:           q.TwitchSubscription.IsGift,
+			CumulativeMonths: q.TwitchSubscription.CumulativeMonths,
+			Message:          q.TwitchSubscription.Message,
+		}, nil
+	case *streamd_grpc.Event_TwitchSubscriptionGift:
+		return &event.TwitchSubscriptionGift{
+			UserID:      convertStringPtr[streamcontrol.ChatUserID](q.TwitchSubscriptionGift.UserID),
+			Username:    q.TwitchSubscriptionGift.Username,
+			Tier:        q.TwitchSubscriptionGift.Tier,
+			Total:       q.TwitchSubscriptionGift.Total,
+			IsAnonymous: q.TwitchSubscriptionGift.IsAnonymous,
+			TotalMin:    q.TwitchSubscriptionGift.TotalMin,
+		}, nil
+	case *streamd_grpc.Event_TwitchCheer:
+		return &event.TwitchCheer{
+			UserID:      convertStringPtr[streamcontrol.ChatUserID](q.TwitchCheer.UserID),
+			Username:    q.TwitchCheer.Username,
+			IsAnonymous: q.TwitchCheer.IsAnonymous,
+			Bits:        q.TwitchCheer.Bits,
+			Message:     q.TwitchCheer.Message,
+			BitsMin:     q.TwitchCheer.BitsMin,
+		}, nil
+	case *streamd_grpc.Event_TwitchRaid:
+		return &event.TwitchRaid{
+			FromUserID:   convertStringPtr[streamcontrol.ChatUserID](q.TwitchRaid.FromUserID),
+			FromUsername: q.TwitchRaid.FromUsername,
+			Viewers:      q.TwitchRaid.Viewers,
+			ViewersMin:   q.TwitchRaid.ViewersMin,
+		}, nil
+	case *streamd_grpc.Event_TwitchChannelPointsRedemption:
+		return &event.TwitchChannelPointsRedemption{
+			UserID:      convertStringPtr[streamcontrol.ChatUserID](q.TwitchChannelPointsRedemption.UserID),
+			Username:    q.TwitchChannelPointsRedemption.Username,
+			RewardID:    q.TwitchChannelPointsRedemption.RewardID,
+			RewardTitle: q.TwitchChannelPointsRedemption.RewardTitle,
+			RewardCost:  q.TwitchChannelPointsRedemption.RewardCost,
+			UserInput:   q.TwitchChannelPointsRedemption.UserInput,
+		}, nil
+	case *streamd_grpc.Event_TwitchPoll:
+		return &event.TwitchPoll{
+			PollID:        q.TwitchPoll.PollID,
+			Title:         q.TwitchPoll.Title,
+			Stage:         q.TwitchPoll.Stage,
+			WinningChoice: q.TwitchPoll.WinningChoice,
+		}, nil
+	case *streamd_grpc.Event_TwitchPrediction:
+		return &event.TwitchPrediction{
+			PredictionID:   q.TwitchPrediction.PredictionID,
+			Title:          q.TwitchPrediction.Title,
+			Stage:          q.TwitchPrediction.Stage,
+			WinningOutcome: q.TwitchPrediction.WinningOutcome,
+		}, nil
 	default:
 		return nil, fmt.Errorf("conversion of type %T is not implemented, yet", q)
 	}
@@ -55,3 +391,37 @@ func triggerGRPC2GoWindowFocusChange(
 		IsFocused:   q.IsFocused,
 	}
 }
+
+func triggerGRPC2GoChatMessageReceived(
+	q *streamd_grpc.EventChatMessageReceived,
+) config.Event {
+	return &event.ChatMessageReceived{
+		Platform:        convertStringPtr[streamcontrol.PlatformName](q.PlatID),
+		UserID:          convertStringPtr[streamcontrol.ChatUserID](q.UserID),
+		Username:        q.Username,
+		MessageID:       convertStringPtr[streamcontrol.ChatMessageID](q.MessageID),
+		Message:         q.Message,
+		MessageContains: q.MessageContains,
+		MessageRegex:    q.MessageRegex,
+		Roles:           ChatUserRolesGRPC2Go(q.GetRoles()),
+		Badges:          ChatBadgesGRPC2Go(q.GetBadges()),
+		IsPaid:          q.IsPaid,
+		Paid:            ChatMessagePaidGRPC2Go(q.Paid),
+		PaidCurrency:    q.PaidCurrency,
+		PaidAmountMin:   q.PaidAmountMin,
+	}
+}
+
+func triggerGRPC2GoViewerCountChanged(
+	q *streamd_grpc.EventViewerCountChanged,
+) config.Event {
+	return &event.ViewerCountChanged{
+		Platform:             convertStringPtr[streamcontrol.PlatformName](q.PlatID),
+		ViewersCount:         q.ViewersCount,
+		PreviousViewersCount: q.PreviousViewersCount,
+		ViewersCountMin:      q.ViewersCountMin,
+		ViewersCountMax:      q.ViewersCountMax,
+		CrossedAbove:         q.CrossedAbove,
+		CrossedBelow:         q.CrossedBelow,
+	}
+}
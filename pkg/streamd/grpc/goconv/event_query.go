@@ -3,14 +3,80 @@ package goconv
 import (
 	"fmt"
 
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event/eventquery"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/consts"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
 )
 
 func EventQueryGo2GRPC(in eventquery.EventQuery) (*streamd_grpc.EventQuery, error) {
 	switch q := in.(type) {
+	case *eventquery.And:
+		queries, err := eventQueriesGo2GRPC(q.Queries)
+		if err != nil {
+			return nil, err
+		}
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_And{
+				And: &streamd_grpc.EventQueryAnd{
+					Queries: queries,
+				},
+			},
+		}, nil
+	case *eventquery.Or:
+		queries, err := eventQueriesGo2GRPC(q.Queries)
+		if err != nil {
+			return nil, err
+		}
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_Or{
+				Or: &streamd_grpc.EventQueryOr{
+					Queries: queries,
+				},
+			},
+		}, nil
+	case *eventquery.Not:
+		query, err := EventQueryGo2GRPC(q.Query)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert the negated query: %w", err)
+		}
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_Not{
+				Not: &streamd_grpc.EventQueryNot{
+					Query: query,
+				},
+			},
+		}, nil
+	case *eventquery.EventField:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventField{
+				EventField: &streamd_grpc.EventQueryEventField{
+					Field: q.Field,
+					Regex: q.Regex,
+					Min:   q.Min,
+					Max:   q.Max,
+				},
+			},
+		}, nil
+	case *eventquery.StreamIsActive:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_StreamIsActive{
+				StreamIsActive: &streamd_grpc.EventQueryStreamIsActive{
+					PlatID: string(q.PlatID),
+				},
+			},
+		}, nil
+	case *eventquery.VariableEquals:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_VariableEquals{
+				VariableEquals: &streamd_grpc.EventQueryVariableEquals{
+					Key:   string(q.Key),
+					Value: q.Value,
+				},
+			},
+		}, nil
 	case *eventquery.Event:
 		ev, err := EventGo2GRPC(q.Event)
 		if err != nil {
@@ -27,6 +93,120 @@ func EventQueryGo2GRPC(in eventquery.EventQuery) (*streamd_grpc.EventQuery, erro
 				EventType: streamd_grpc.EventType_eventWindowFocusChange,
 			},
 		}, nil
+	case *eventquery.EventType[*event.ChatMessageReceived]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventChatMessageReceived,
+			},
+		}, nil
+	case *eventquery.EventType[*event.StreamStarted]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventStreamStarted,
+			},
+		}, nil
+	case *eventquery.EventType[*event.StreamEnded]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventStreamEnded,
+			},
+		}, nil
+	case *eventquery.EventType[*event.ViewerCountChanged]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventViewerCountChanged,
+			},
+		}, nil
+	case *eventquery.EventType[*event.IncomingStreamPublished]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventIncomingStreamPublished,
+			},
+		}, nil
+	case *eventquery.EventType[*event.IncomingStreamUnpublished]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventIncomingStreamUnpublished,
+			},
+		}, nil
+	case *eventquery.EventType[*event.IncomingStreamAuthFailed]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventIncomingStreamAuthFailed,
+			},
+		}, nil
+	case *eventquery.EventType[*event.StreamForwardStarted]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventStreamForwardStarted,
+			},
+		}, nil
+	case *eventquery.EventType[*event.StreamForwardFailed]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventStreamForwardFailed,
+			},
+		}, nil
+	case *eventquery.EventType[*event.StreamForwardSourceSwitched]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventStreamForwardSourceSwitched,
+			},
+		}, nil
+	case *eventquery.EventType[*event.StreamForwardFallbackStopped]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventStreamForwardFallbackStopped,
+			},
+		}, nil
+	case *eventquery.EventType[*event.TwitchFollow]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventTwitchFollow,
+			},
+		}, nil
+	case *eventquery.EventType[*event.TwitchSubscription]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventTwitchSubscription,
+			},
+		}, nil
+	case *eventquery.EventType[*event.TwitchSubscriptionGift]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventTwitchSubscriptionGift,
+			},
+		}, nil
+	case *eventquery.EventType[*event.TwitchCheer]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventTwitchCheer,
+			},
+		}, nil
+	case *eventquery.EventType[*event.TwitchRaid]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventTwitchRaid,
+			},
+		}, nil
+	case *eventquery.EventType[*event.TwitchChannelPointsRedemption]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventTwitchChannelPointsRedemption,
+			},
+		}, nil
+	case *eventquery.EventType[*event.TwitchPoll]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventTwitchPoll,
+			},
+		}, nil
+	case *eventquery.EventType[*event.TwitchPrediction]:
+		return &streamd_grpc.EventQuery{
+			EventQueryOneOf: &streamd_grpc.EventQuery_EventType{
+				EventType: streamd_grpc.EventType_eventTwitchPrediction,
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("conversion of type %T is not implemented, yet", q)
 	}
@@ -34,6 +214,46 @@ func EventQueryGo2GRPC(in eventquery.EventQuery) (*streamd_grpc.EventQuery, erro
 
 func EventQueryGRPC2Go(in *streamd_grpc.EventQuery) (config.EventQuery, error) {
 	switch q := in.GetEventQueryOneOf().(type) {
+	case *streamd_grpc.EventQuery_And:
+		queries, err := eventQueriesGRPC2Go(q.And.GetQueries())
+		if err != nil {
+			return nil, err
+		}
+		return &eventquery.And{
+			Queries: queries,
+		}, nil
+	case *streamd_grpc.EventQuery_Or:
+		queries, err := eventQueriesGRPC2Go(q.Or.GetQueries())
+		if err != nil {
+			return nil, err
+		}
+		return &eventquery.Or{
+			Queries: queries,
+		}, nil
+	case *streamd_grpc.EventQuery_Not:
+		query, err := EventQueryGRPC2Go(q.Not.GetQuery())
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert the negated query: %w", err)
+		}
+		return &eventquery.Not{
+			Query: query,
+		}, nil
+	case *streamd_grpc.EventQuery_EventField:
+		return &eventquery.EventField{
+			Field: q.EventField.GetField(),
+			Regex: q.EventField.Regex,
+			Min:   q.EventField.Min,
+			Max:   q.EventField.Max,
+		}, nil
+	case *streamd_grpc.EventQuery_StreamIsActive:
+		return &eventquery.StreamIsActive{
+			PlatID: streamcontrol.PlatformName(q.StreamIsActive.GetPlatID()),
+		}, nil
+	case *streamd_grpc.EventQuery_VariableEquals:
+		return &eventquery.VariableEquals{
+			Key:   consts.VarKey(q.VariableEquals.GetKey()),
+			Value: q.VariableEquals.GetValue(),
+		}, nil
 	case *streamd_grpc.EventQuery_Event:
 		ev, err := EventGRPC2Go(q.Event)
 		if err != nil {
@@ -46,6 +266,44 @@ func EventQueryGRPC2Go(in *streamd_grpc.EventQuery) (config.EventQuery, error) {
 		switch q.EventType {
 		case streamd_grpc.EventType_eventWindowFocusChange:
 			return &eventquery.EventType[*event.WindowFocusChange]{}, nil
+		case streamd_grpc.EventType_eventChatMessageReceived:
+			return &eventquery.EventType[*event.ChatMessageReceived]{}, nil
+		case streamd_grpc.EventType_eventStreamStarted:
+			return &eventquery.EventType[*event.StreamStarted]{}, nil
+		case streamd_grpc.EventType_eventStreamEnded:
+			return &eventquery.EventType[*event.StreamEnded]{}, nil
+		case streamd_grpc.EventType_eventViewerCountChanged:
+			return &eventquery.EventType[*event.ViewerCountChanged]{}, nil
+		case streamd_grpc.EventType_eventIncomingStreamPublished:
+			return &eventquery.EventType[*event.IncomingStreamPublished]{}, nil
+		case streamd_grpc.EventType_eventIncomingStreamUnpublished:
+			return &eventquery.EventType[*event.IncomingStreamUnpublished]{}, nil
+		case streamd_grpc.EventType_eventIncomingStreamAuthFailed:
+			return &eventquery.EventType[*event.IncomingStreamAuthFailed]{}, nil
+		case streamd_grpc.EventType_eventStreamForwardStarted:
+			return &eventquery.EventType[*event.StreamForwardStarted]{}, nil
+		case streamd_grpc.EventType_eventStreamForwardFailed:
+			return &eventquery.EventType[*event.StreamForwardFailed]{}, nil
+		case streamd_grpc.EventType_eventStreamForwardSourceSwitched:
+			return &eventquery.EventType[*event.StreamForwardSourceSwitched]{}, nil
+		case streamd_grpc.EventType_eventStreamForwardFallbackStopped:
+			return &eventquery.EventType[*event.StreamForwardFallbackStopped]{}, nil
+		case streamd_grpc.EventType_eventTwitchFollow:
+			return &eventquery.EventType[*event.TwitchFollow]{}, nil
+		case streamd_grpc.EventType_eventTwitchSubscription:
+			return &eventquery.EventType[*event.TwitchSubscription]{}, nil
+		case streamd_grpc.EventType_eventTwitchSubscriptionGift:
+			return &eventquery.EventType[*event.TwitchSubscriptionGift]{}, nil
+		case streamd_grpc.EventType_eventTwitchCheer:
+			return &eventquery.EventType[*event.TwitchCheer]{}, nil
+		case streamd_grpc.EventType_eventTwitchRaid:
+			return &eventquery.EventType[*event.TwitchRaid]{}, nil
+		case streamd_grpc.EventType_eventTwitchChannelPointsRedemption:
+			return &eventquery.EventType[*event.TwitchChannelPointsRedemption]{}, nil
+		case streamd_grpc.EventType_eventTwitchPoll:
+			return &eventquery.EventType[*event.TwitchPoll]{}, nil
+		case streamd_grpc.EventType_eventTwitchPrediction:
+			return &eventquery.EventType[*event.TwitchPrediction]{}, nil
 		default:
 			return nil, fmt.Errorf("unable to convert event type %v", q.EventType)
 		}
@@ -53,3 +311,27 @@ func EventQueryGRPC2Go(in *streamd_grpc.EventQuery) (config.EventQuery, error) {
 		return nil, fmt.Errorf("conversion of type %T is not implemented, yet", q)
 	}
 }
+
+func eventQueriesGo2GRPC(in []eventquery.EventQuery) ([]*streamd_grpc.EventQuery, error) {
+	result := make([]*streamd_grpc.EventQuery, 0, len(in))
+	for idx, q := range in {
+		item, err := EventQueryGo2GRPC(q)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert query #%d: %w", idx, err)
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+func eventQueriesGRPC2Go(in []*streamd_grpc.EventQuery) ([]eventquery.EventQuery, error) {
+	result := make([]eventquery.EventQuery, 0, len(in))
+	for idx, q := range in {
+		item, err := EventQueryGRPC2Go(q)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert query #%d: %w", idx, err)
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
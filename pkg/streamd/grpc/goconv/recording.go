@@ -0,0 +1,59 @@
+package goconv
+
+import (
+	"time"
+
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
+)
+
+func RecordingConfigGo2GRPC(
+	cfg api.RecordingConfig,
+) *streamd_grpc.StreamRecordingConfig {
+	return &streamd_grpc.StreamRecordingConfig{
+		Directory:           cfg.Directory,
+		Format:              cfg.Format,
+		SegmentDurationNano: int64(cfg.SegmentDuration),
+		MaxDiskUsage:        cfg.MaxDiskUsage,
+		RetentionNano:       int64(cfg.Retention),
+	}
+}
+
+func RecordingConfigGRPC2Go(
+	cfg *streamd_grpc.StreamRecordingConfig,
+) api.RecordingConfig {
+	return api.RecordingConfig{
+		Enabled:         true,
+		Directory:       cfg.GetDirectory(),
+		Format:          cfg.GetFormat(),
+		SegmentDuration: time.Duration(cfg.GetSegmentDurationNano()),
+		MaxDiskUsage:    cfg.GetMaxDiskUsage(),
+		Retention:       time.Duration(cfg.GetRetentionNano()),
+	}
+}
+
+func RecordingGo2GRPC(
+	recording api.Recording,
+) *streamd_grpc.StreamRecording {
+	return &streamd_grpc.StreamRecording{
+		StreamID:           string(recording.StreamID),
+		Path:               recording.Path,
+		Size:               recording.Size,
+		StartedAtUnixNano:  UnixGo2GRPC(recording.StartedAt),
+		ModifiedAtUnixNano: UnixGo2GRPC(recording.ModifiedAt),
+		IsActive:           recording.IsActive,
+	}
+}
+
+func RecordingGRPC2Go(
+	recording *streamd_grpc.StreamRecording,
+) api.Recording {
+	return api.Recording{
+		StreamID:   api.StreamID(recording.GetStreamID()),
+		Path:       recording.GetPath(),
+		Size:       recording.GetSize(),
+		StartedAt:  UnixGRPC2GoOrZero(recording.GetStartedAtUnixNano()),
+		ModifiedAt: UnixGRPC2GoOrZero(recording.GetModifiedAtUnixNano()),
+		IsActive:   recording.GetIsActive(),
+	}
+}
@@ -0,0 +1,118 @@
+package goconv
+
+import (
+	"time"
+
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
+)
+
+func StreamForwardQuirksGo2GRPC(
+	quirks api.StreamForwardingQuirks,
+) *streamd_grpc.StreamForwardQuirks {
+	restart := quirks.RestartUntilPlatformRecognizesStream
+	startAfter := quirks.StartAfterPlatformRecognizedStream
+	return &streamd_grpc.StreamForwardQuirks{
+		RestartUntilPlatformRecognizesStream: &streamd_grpc.RestartUntilPlatformRecognizesStream{
+			Enabled:          restart.Enabled,
+			StartTimeout:     restart.StartTimeout.Seconds(),
+			StopStartDelay:   restart.StopStartDelay.Seconds(),
+			PlatformID:       string(restart.PlatformID),
+			ByDestinationURL: restart.ByDestinationURL,
+		},
+		StartAfterPlatformRecognizedStream: &streamd_grpc.StartAfterPlatformRecognizedStream{
+			Enabled:          startAfter.Enabled,
+			PlatformID:       string(startAfter.PlatformID),
+			ByDestinationURL: startAfter.ByDestinationURL,
+			CheckInterval:    startAfter.CheckInterval.Seconds(),
+			Timeout:          startAfter.Timeout.Seconds(),
+		},
+	}
+}
+
+func StreamForwardQuirksGRPC2Go(
+	quirks *streamd_grpc.StreamForwardQuirks,
+) api.StreamForwardingQuirks {
+	restart := quirks.GetRestartUntilPlatformRecognizesStream()
+	startAfter := quirks.GetStartAfterPlatformRecognizedStream()
+	return api.StreamForwardingQuirks{
+		RestartUntilPlatformRecognizesStream: api.RestartUntilPlatformRecognizesStream{
+			Enabled: restart.GetEnabled(),
+			RecognizingPlatform: api.RecognizingPlatform{
+				PlatformID:       streamcontrol.PlatformName(restart.GetPlatformID()),
+				ByDestinationURL: restart.GetByDestinationURL(),
+			},
+			StartTimeout:   secondsGRPC2Go(restart.GetStartTimeout()),
+			StopStartDelay: secondsGRPC2Go(restart.GetStopStartDelay()),
+		},
+		StartAfterPlatformRecognizedStream: api.StartAfterPlatformRecognizedStream{
+			Enabled: startAfter.GetEnabled(),
+			RecognizingPlatform: api.RecognizingPlatform{
+				PlatformID:       streamcontrol.PlatformName(startAfter.GetPlatformID()),
+				ByDestinationURL: startAfter.GetByDestinationURL(),
+			},
+			CheckInterval: secondsGRPC2Go(startAfter.GetCheckInterval()),
+			Timeout:       secondsGRPC2Go(startAfter.GetTimeout()),
+		},
+	}
+}
+
+func StreamForwardGo2GRPC(
+	fwd api.StreamForward,
+) *streamd_grpc.StreamForwardWithStatistics {
+	return &streamd_grpc.StreamForwardWithStatistics{
+		Config: &streamd_grpc.StreamForward{
+			StreamID:      string(fwd.StreamID),
+			DestinationID: string(fwd.DestinationID),
+			Enabled:       fwd.Enabled,
+			Quirks:        StreamForwardQuirksGo2GRPC(fwd.Quirks),
+		},
+		Statistics: &streamd_grpc.StreamForwardStatistics{
+			NumBytesWrote:        int64(fwd.NumBytesWrote),
+			NumBytesRead:         int64(fwd.NumBytesRead),
+			InputBitrate:         fwd.Stats.InputBitrate,
+			OutputBitrate:        fwd.Stats.OutputBitrate,
+			HasFrameStats:        fwd.Stats.HasFrameStats,
+			FrameRate:            fwd.Stats.FrameRate,
+			KeyframeIntervalNano: int64(fwd.Stats.KeyframeInterval),
+			DroppedPackets:       fwd.Stats.DroppedPackets,
+			LatePackets:          fwd.Stats.LatePackets,
+			Reconnects:           fwd.Stats.Reconnects,
+			LastError:            fwd.Stats.LastError,
+			LastErrorAtUnixNano:  UnixGo2GRPC(fwd.Stats.LastErrorAt),
+			UptimeNano:           int64(fwd.Stats.Uptime),
+		},
+	}
+}
+
+func StreamForwardGRPC2Go(
+	fwd *streamd_grpc.StreamForwardWithStatistics,
+) api.StreamForward {
+	stats := fwd.GetStatistics()
+	return api.StreamForward{
+		Enabled:       fwd.GetConfig().GetEnabled(),
+		StreamID:      api.StreamID(fwd.GetConfig().GetStreamID()),
+		DestinationID: api.DestinationID(fwd.GetConfig().GetDestinationID()),
+		Quirks:        StreamForwardQuirksGRPC2Go(fwd.GetConfig().GetQuirks()),
+		NumBytesWrote: uint64(stats.GetNumBytesWrote()),
+		NumBytesRead:  uint64(stats.GetNumBytesRead()),
+		Stats: api.StreamForwardStats{
+			InputBitrate:     stats.GetInputBitrate(),
+			OutputBitrate:    stats.GetOutputBitrate(),
+			HasFrameStats:    stats.GetHasFrameStats(),
+			FrameRate:        stats.GetFrameRate(),
+			KeyframeInterval: time.Duration(stats.GetKeyframeIntervalNano()),
+			DroppedPackets:   stats.GetDroppedPackets(),
+			LatePackets:      stats.GetLatePackets(),
+			Reconnects:       stats.GetReconnects(),
+			LastError:        stats.GetLastError(),
+			LastErrorAt:      UnixGRPC2GoOrZero(stats.GetLastErrorAtUnixNano()),
+			Uptime:           time.Duration(stats.GetUptimeNano()),
+		},
+	}
+}
+
+func secondsGRPC2Go(in float64) time.Duration {
+	return time.Duration(float64(time.Second) * in)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/streamctl/pkg/secret"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
 	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
@@ -21,6 +22,12 @@ func StreamServerTypeGo2GRPC(t api.StreamServerType) (streamd_grpc.StreamServerT
 		return streamd_grpc.StreamServerType_RTMP, nil
 	case streamtypes.ServerTypeRTSP:
 		return streamd_grpc.StreamServerType_RTSP, nil
+	case streamtypes.ServerTypeSRT:
+		return streamd_grpc.StreamServerType_SRT, nil
+	case streamtypes.ServerTypeHLS:
+		return streamd_grpc.StreamServerType_HLS, nil
+	case streamtypes.ServerTypeWebRTC:
+		return streamd_grpc.StreamServerType_WebRTC, nil
 	}
 	return streamd_grpc.StreamServerType_Undefined, fmt.Errorf("unexpected value: %v", t)
 }
@@ -33,6 +40,12 @@ func StreamServerTypeGRPC2Go(t streamd_grpc.StreamServerType) (api.StreamServerT
 		return streamtypes.ServerTypeRTMP, nil
 	case streamd_grpc.StreamServerType_RTSP:
 		return streamtypes.ServerTypeRTSP, nil
+	case streamd_grpc.StreamServerType_SRT:
+		return streamtypes.ServerTypeSRT, nil
+	case streamd_grpc.StreamServerType_HLS:
+		return streamtypes.ServerTypeHLS, nil
+	case streamd_grpc.StreamServerType_WebRTC:
+		return streamtypes.ServerTypeWebRTC, nil
 	}
 	return streamtypes.ServerTypeUndefined, fmt.Errorf("unexpected value: %v", t)
 }
@@ -74,14 +87,21 @@ func StreamServerConfigGo2GRPC(
 	}
 
 	return &streamd_grpc.StreamServer{
-		ServerType:       t,
-		ListenAddr:       listenAddr,
-		IsTLS:            cfg.IsTLS,
-		WriteQueueSize:   cfg.WriteQueueSize,
-		WriteTimeoutNano: uint64(cfg.WriteTimeout.Nanoseconds()),
-		ReadTimeoutNano:  uint64(cfg.ReadTimeout.Nanoseconds()),
-		ServerCert:       serverCert,
-		ServerKey:        privateKey,
+		ServerType:             t,
+		ListenAddr:             listenAddr,
+		IsTLS:                  cfg.IsTLS,
+		WriteQueueSize:         cfg.WriteQueueSize,
+		WriteTimeoutNano:       uint64(cfg.WriteTimeout.Nanoseconds()),
+		ReadTimeoutNano:        uint64(cfg.ReadTimeout.Nanoseconds()),
+		ServerCert:             serverCert,
+		ServerKey:              privateKey,
+		SRTPassphrase:          cfg.SRTPassphrase.Get(),
+		HLSSegmentDurationNano: uint64(cfg.HLSSegmentDuration.Nanoseconds()),
+		HLSSegmentCount:        cfg.HLSSegmentCount,
+		HLSTempDirectory:       cfg.HLSTempDirectory,
+		WebRTCICEUDPListenAddr: cfg.WebRTCICEUDPListenAddr,
+		WebRTCICETCPListenAddr: cfg.WebRTCICETCPListenAddr,
+		WebRTCAdditionalHosts:  cfg.WebRTCAdditionalHosts,
 	}, nil
 }
 
@@ -105,12 +125,19 @@ func StreamServerConfigGRPC2Go(
 	}
 
 	psCfg := &streamportserver.ProtocolSpecificConfig{
-		IsTLS:          srv.GetIsTLS(),
-		WriteQueueSize: srv.GetWriteQueueSize(),
-		WriteTimeout:   time.Nanosecond * time.Duration(srv.GetWriteTimeoutNano()),
-		ReadTimeout:    time.Nanosecond * time.Duration(srv.GetReadTimeoutNano()),
-		ServerCert:     serverCert,
-		ServerKey:      serverKey,
+		IsTLS:                  srv.GetIsTLS(),
+		WriteQueueSize:         srv.GetWriteQueueSize(),
+		WriteTimeout:           time.Nanosecond * time.Duration(srv.GetWriteTimeoutNano()),
+		ReadTimeout:            time.Nanosecond * time.Duration(srv.GetReadTimeoutNano()),
+		ServerCert:             serverCert,
+		ServerKey:              serverKey,
+		SRTPassphrase:          secret.New(srv.GetSRTPassphrase()),
+		HLSSegmentDuration:     time.Nanosecond * time.Duration(srv.GetHLSSegmentDurationNano()),
+		HLSSegmentCount:        srv.GetHLSSegmentCount(),
+		HLSTempDirectory:       srv.GetHLSTempDirectory(),
+		WebRTCICEUDPListenAddr: srv.GetWebRTCICEUDPListenAddr(),
+		WebRTCICETCPListenAddr: srv.GetWebRTCICETCPListenAddr(),
+		WebRTCAdditionalHosts:  srv.GetWebRTCAdditionalHosts(),
 	}
 
 	return srvType, srv.GetListenAddr(), psCfg.Options(), nil
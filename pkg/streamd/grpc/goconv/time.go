@@ -10,3 +10,21 @@ func UnixGRPC2Go(unixNano int64) time.Time {
 		unixNano%int64(time.Second),
 	)
 }
+
+// UnixGRPC2GoOrZero is the same as UnixGRPC2Go, but converts
+// zero to a zero time.Time.
+func UnixGRPC2GoOrZero(unixNano int64) time.Time {
+	if unixNano == 0 {
+		return time.Time{}
+	}
+	return UnixGRPC2Go(unixNano)
+}
+
+// UnixGo2GRPC converts the time to Unix nanoseconds, and
+// a zero time.Time to zero.
+func UnixGo2GRPC(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
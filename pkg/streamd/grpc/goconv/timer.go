@@ -0,0 +1,145 @@
+package goconv
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
+)
+
+func TimerMissedDeadlinePolicyTypeGo2GRPC(
+	t config.TimerMissedDeadlinePolicyType,
+) (streamd_grpc.TimerMissedDeadlinePolicyType, error) {
+	switch t {
+	case config.TimerMissedDeadlinePolicyTypeUndefined, config.TimerMissedDeadlinePolicyTypeFireImmediately:
+		return streamd_grpc.TimerMissedDeadlinePolicyType_TimerMissedDeadlineFireImmediately, nil
+	case config.TimerMissedDeadlinePolicyTypeSkip:
+		return streamd_grpc.TimerMissedDeadlinePolicyType_TimerMissedDeadlineSkip, nil
+	case config.TimerMissedDeadlinePolicyTypeFireIfLateLessThan:
+		return streamd_grpc.TimerMissedDeadlinePolicyType_TimerMissedDeadlineFireIfLateLessThan, nil
+	}
+	return streamd_grpc.TimerMissedDeadlinePolicyType_TimerMissedDeadlineFireImmediately, fmt.Errorf("unexpected value: %v", t)
+}
+
+func TimerMissedDeadlinePolicyTypeGRPC2Go(
+	t streamd_grpc.TimerMissedDeadlinePolicyType,
+) (config.TimerMissedDeadlinePolicyType, error) {
+	switch t {
+	case streamd_grpc.TimerMissedDeadlinePolicyType_TimerMissedDeadlineFireImmediately:
+		return config.TimerMissedDeadlinePolicyTypeFireImmediately, nil
+	case streamd_grpc.TimerMissedDeadlinePolicyType_TimerMissedDeadlineSkip:
+		return config.TimerMissedDeadlinePolicyTypeSkip, nil
+	case streamd_grpc.TimerMissedDeadlinePolicyType_TimerMissedDeadlineFireIfLateLessThan:
+		return config.TimerMissedDeadlinePolicyTypeFireIfLateLessThan, nil
+	}
+	return config.TimerMissedDeadlinePolicyTypeUndefined, fmt.Errorf("unexpected value: %v", t)
+}
+
+func TimerMissedDeadlinePolicyGo2GRPC(
+	policy api.TimerMissedDeadlinePolicy,
+) (*streamd_grpc.TimerMissedDeadlinePolicy, error) {
+	t, err := TimerMissedDeadlinePolicyTypeGo2GRPC(policy.Type)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the policy type: %w", err)
+	}
+	return &streamd_grpc.TimerMissedDeadlinePolicy{
+		Type:            t,
+		MaxLatenessNano: int64(policy.MaxLateness),
+	}, nil
+}
+
+func TimerMissedDeadlinePolicyGRPC2Go(
+	policy *streamd_grpc.TimerMissedDeadlinePolicy,
+) (api.TimerMissedDeadlinePolicy, error) {
+	if policy == nil {
+		return api.TimerMissedDeadlinePolicy{}, nil
+	}
+	t, err := TimerMissedDeadlinePolicyTypeGRPC2Go(policy.GetType())
+	if err != nil {
+		return api.TimerMissedDeadlinePolicy{}, fmt.Errorf("unable to convert the policy type: %w", err)
+	}
+	return api.TimerMissedDeadlinePolicy{
+		Type:        t,
+		MaxLateness: time.Duration(policy.GetMaxLatenessNano()),
+	}, nil
+}
+
+func TimerScheduleGo2GRPC(
+	schedule api.TimerSchedule,
+) *streamd_grpc.TimerSchedule {
+	result := &streamd_grpc.TimerSchedule{
+		CronExpression: schedule.CronExpression,
+		IntervalNano:   int64(schedule.Interval),
+		EndAtUnixNano:  UnixGo2GRPC(schedule.EndAt),
+	}
+	if rel := schedule.RelativeToStreamStart; rel != nil {
+		result.RelativeToStreamStart = &streamd_grpc.TimerRelativeToStreamStart{
+			PlatID:    string(rel.PlatID),
+			DelayNano: int64(rel.Delay),
+		}
+	}
+	return result
+}
+
+func TimerScheduleGRPC2Go(
+	schedule *streamd_grpc.TimerSchedule,
+) api.TimerSchedule {
+	if schedule == nil {
+		return api.TimerSchedule{}
+	}
+	result := api.TimerSchedule{
+		CronExpression: schedule.GetCronExpression(),
+		Interval:       time.Duration(schedule.GetIntervalNano()),
+		EndAt:          UnixGRPC2GoOrZero(schedule.GetEndAtUnixNano()),
+	}
+	if rel := schedule.GetRelativeToStreamStart(); rel != nil {
+		result.RelativeToStreamStart = &api.TimerRelativeToStreamStart{
+			PlatID: streamcontrol.PlatformName(rel.GetPlatID()),
+			Delay:  time.Duration(rel.GetDelayNano()),
+		}
+	}
+	return result
+}
+
+func TimerGo2GRPC(
+	timer api.Timer,
+) (*streamd_grpc.Timer, error) {
+	action, err := ActionGo2GRPC(timer.Action)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the action: %w", err)
+	}
+	missedDeadlinePolicy, err := TimerMissedDeadlinePolicyGo2GRPC(timer.MissedDeadlinePolicy)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the missed deadline policy: %w", err)
+	}
+	return &streamd_grpc.Timer{
+		TimerID:              int64(timer.ID),
+		TriggerAtUnixNano:    UnixGo2GRPC(timer.TriggerAt),
+		Action:               action,
+		MissedDeadlinePolicy: missedDeadlinePolicy,
+		Schedule:             TimerScheduleGo2GRPC(timer.Schedule),
+	}, nil
+}
+
+func TimerGRPC2Go(
+	timer *streamd_grpc.Timer,
+) (api.Timer, error) {
+	action, err := ActionGRPC2Go(timer.GetAction())
+	if err != nil {
+		return api.Timer{}, fmt.Errorf("unable to convert the action: %w", err)
+	}
+	missedDeadlinePolicy, err := TimerMissedDeadlinePolicyGRPC2Go(timer.GetMissedDeadlinePolicy())
+	if err != nil {
+		return api.Timer{}, fmt.Errorf("unable to convert the missed deadline policy: %w", err)
+	}
+	return api.Timer{
+		ID:                   api.TimerID(timer.GetTimerID()),
+		TriggerAt:            UnixGRPC2GoOrZero(timer.GetTriggerAtUnixNano()),
+		Action:               action,
+		MissedDeadlinePolicy: missedDeadlinePolicy,
+		Schedule:             TimerScheduleGRPC2Go(timer.GetSchedule()),
+	}, nil
+}
@@ -3,6 +3,7 @@ package goconv
 import (
 	"fmt"
 
+	"github.com/xaionaro-go/streamctl/pkg/expression"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
 )
@@ -48,3 +49,108 @@ func TriggerRuleGRPC2Go(
 		Action:      resultAction,
 	}, nil
 }
+
+func TriggerRuleExecutionGo2GRPC(
+	execution api.TriggerRuleExecution,
+) (*streamd_grpc.TriggerRuleExecution, error) {
+	ev, err := EventGo2GRPC(execution.Event)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the event: %w", err)
+	}
+
+	a, err := ActionGo2GRPC(execution.Action)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the action: %w", err)
+	}
+
+	return &streamd_grpc.TriggerRuleExecution{
+		RuleID:            uint64(execution.RuleID),
+		Event:             ev,
+		Action:            a,
+		StartedAtUnixNano: UnixGo2GRPC(execution.StartedAt),
+		EndedAtUnixNano:   UnixGo2GRPC(execution.EndedAt),
+		Error:             errorGo2GRPC(execution.Error),
+	}, nil
+}
+
+func TriggerRuleExecutionGRPC2Go(
+	execution *streamd_grpc.TriggerRuleExecution,
+) (api.TriggerRuleExecution, error) {
+	if execution.GetEvent() == nil {
+		return api.TriggerRuleExecution{}, fmt.Errorf("the event is not set")
+	}
+	ev, err := EventGRPC2Go(execution.GetEvent())
+	if err != nil {
+		return api.TriggerRuleExecution{}, fmt.Errorf("unable to convert the event: %w", err)
+	}
+
+	a, err := ActionGRPC2Go(execution.GetAction())
+	if err != nil {
+		return api.TriggerRuleExecution{}, fmt.Errorf("unable to convert the action: %w", err)
+	}
+
+	return api.TriggerRuleExecution{
+		RuleID:    api.TriggerRuleID(execution.GetRuleID()),
+		Event:     ev,
+		Action:    a,
+		StartedAt: UnixGRPC2GoOrZero(execution.GetStartedAtUnixNano()),
+		EndedAt:   UnixGRPC2GoOrZero(execution.GetEndedAtUnixNano()),
+		Error:     errorGRPC2Go(execution.Error),
+	}, nil
+}
+
+func TriggerRuleTestResultGo2GRPC(
+	result *api.TriggerRuleTestResult,
+) (*streamd_grpc.TestTriggerRuleReply, error) {
+	reply := &streamd_grpc.TestTriggerRuleReply{
+		Matched: result.Matched,
+	}
+	for idx, testAction := range result.Actions {
+		a, err := ActionGo2GRPC(testAction.Action)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert action #%d: %w", idx, err)
+		}
+		expressions := make([]*streamd_grpc.EvaluatedExpression, 0, len(testAction.Expressions))
+		for _, expr := range testAction.Expressions {
+			expressions = append(expressions, &streamd_grpc.EvaluatedExpression{
+				Field:      expr.Field,
+				Expression: string(expr.Expression),
+				Value:      expr.Value,
+				Error:      errorGo2GRPC(expr.Error),
+			})
+		}
+		reply.Actions = append(reply.Actions, &streamd_grpc.TriggerRuleTestAction{
+			Action:      a,
+			Expressions: expressions,
+		})
+	}
+	return reply, nil
+}
+
+func TriggerRuleTestResultGRPC2Go(
+	reply *streamd_grpc.TestTriggerRuleReply,
+) (*api.TriggerRuleTestResult, error) {
+	result := &api.TriggerRuleTestResult{
+		Matched: reply.GetMatched(),
+	}
+	for idx, testAction := range reply.GetActions() {
+		a, err := ActionGRPC2Go(testAction.GetAction())
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert action #%d: %w", idx, err)
+		}
+		expressions := make([]api.EvaluatedExpression, 0, len(testAction.GetExpressions()))
+		for _, expr := range testAction.GetExpressions() {
+			expressions = append(expressions, api.EvaluatedExpression{
+				Field:      expr.GetField(),
+				Expression: expression.Expression(expr.GetExpression()),
+				Value:      expr.GetValue(),
+				Error:      errorGRPC2Go(expr.Error),
+			})
+		}
+		result.Actions = append(result.Actions, api.TriggerRuleTestAction{
+			Action:      a,
+			Expressions: expressions,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package goconv
+
+import (
+	"time"
+
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/grpc/go/streamd_grpc"
+)
+
+func TwitchStreamMarkerGo2GRPC(
+	marker api.TwitchStreamMarker,
+) *streamd_grpc.TwitchStreamMarker {
+	return &streamd_grpc.TwitchStreamMarker{
+		ID:                marker.ID,
+		CreatedAtUnixNano: UnixGo2GRPC(marker.CreatedAt),
+		Description:       marker.Description,
+		PositionNano:      int64(marker.Position),
+	}
+}
+
+func TwitchStreamMarkerGRPC2Go(
+	marker *streamd_grpc.TwitchStreamMarker,
+) api.TwitchStreamMarker {
+	return api.TwitchStreamMarker{
+		ID:          marker.GetID(),
+		CreatedAt:   UnixGRPC2GoOrZero(marker.GetCreatedAtUnixNano()),
+		Description: marker.GetDescription(),
+		Position:    time.Duration(marker.GetPositionNano()),
+	}
+}
+
+func TwitchAdScheduleGo2GRPC(
+	s api.TwitchAdSchedule,
+) *streamd_grpc.TwitchAdSchedule {
+	return &streamd_grpc.TwitchAdSchedule{
+		NextAdAtUnixNano:        UnixGo2GRPC(s.NextAdAt),
+		LastAdAtUnixNano:        UnixGo2GRPC(s.LastAdAt),
+		DurationNano:            int64(s.Duration),
+		PrerollFreeTimeNano:     int64(s.PrerollFreeTime),
+		SnoozeCount:             uint64(s.SnoozeCount),
+		SnoozeRefreshAtUnixNano: UnixGo2GRPC(s.SnoozeRefreshAt),
+	}
+}
+
+func TwitchAdScheduleGRPC2Go(
+	s *streamd_grpc.TwitchAdSchedule,
+) api.TwitchAdSchedule {
+	return api.TwitchAdSchedule{
+		NextAdAt:        UnixGRPC2GoOrZero(s.GetNextAdAtUnixNano()),
+		LastAdAt:        UnixGRPC2GoOrZero(s.GetLastAdAtUnixNano()),
+		Duration:        time.Duration(s.GetDurationNano()),
+		PrerollFreeTime: time.Duration(s.GetPrerollFreeTimeNano()),
+		SnoozeCount:     uint(s.GetSnoozeCount()),
+		SnoozeRefreshAt: UnixGRPC2GoOrZero(s.GetSnoozeRefreshAtUnixNano()),
+	}
+}
@@ -1,9 +1,33 @@
 package goconv
 
 import (
+	"errors"
 	"time"
 )
 
 func secsGRPC2Go(secs float64) time.Duration {
 	return time.Duration(float64(time.Second) * secs)
 }
+
+func convertStringPtr[OUT, IN ~string](in *IN) *OUT {
+	if in == nil {
+		return nil
+	}
+	out := OUT(*in)
+	return &out
+}
+
+func errorGo2GRPC(err error) *string {
+	if err == nil {
+		return nil
+	}
+	s := err.Error()
+	return &s
+}
+
+func errorGRPC2Go(s *string) error {
+	if s == nil {
+		return nil
+	}
+	return errors.New(*s)
+}
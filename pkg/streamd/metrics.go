@@ -0,0 +1,363 @@
+package streamd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/xsync"
+)
+
+const metricsNamespace = "streamd"
+
+// metricsCollectTimeout limits how long a scrape may wait for the locks
+// of the stream server and of the stream players.
+const metricsCollectTimeout = 5 * time.Second
+
+// metrics are the Prometheus metrics of a StreamD.
+//
+// The event-like values (API calls, chat messages, trigger rule executions)
+// are counted as they happen, while the state-like values (traffic, forwards,
+// players, viewers) are collected from StreamD on each scrape.
+type metrics struct {
+	Registry *prometheus.Registry
+
+	PlatformAPICalls        *prometheus.CounterVec
+	PlatformAPIErrors       *prometheus.CounterVec
+	PlatformAPICallDuration *prometheus.HistogramVec
+	ChatMessages            *prometheus.CounterVec
+	TriggerRuleExecutions   *prometheus.CounterVec
+}
+
+func newMetrics(d *StreamD) *metrics {
+	m := &metrics{
+		Registry: prometheus.NewRegistry(),
+		PlatformAPICalls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "platform_api_calls_total",
+			Help:      "The amount of calls to the streaming platform APIs.",
+		}, []string{"platform", "method"}),
+		PlatformAPIErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "platform_api_errors_total",
+			Help:      "The amount of failed calls to the streaming platform APIs.",
+		}, []string{"platform", "method"}),
+		PlatformAPICallDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "platform_api_call_duration_seconds",
+			Help:      "The latency of the calls to the streaming platform APIs.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+		}, []string{"platform", "method"}),
+		ChatMessages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "chat_messages_total",
+			Help:      "The amount of received chat messages.",
+		}, []string{"platform"}),
+		TriggerRuleExecutions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "trigger_rule_executions_total",
+			Help:      "The amount of executions of the trigger rules.",
+		}, []string{"rule_id", "result"}),
+	}
+	m.Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.PlatformAPICalls,
+		m.PlatformAPIErrors,
+		m.PlatformAPICallDuration,
+		m.ChatMessages,
+		m.TriggerRuleExecutions,
+		&stateCollector{StreamD: d},
+	)
+	return m
+}
+
+// MetricsHandler returns the HTTP handler which exports the metrics
+// in the Prometheus/OpenMetrics text format.
+func (d *StreamD) MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(d.metrics.Registry, promhttp.HandlerOpts{
+		EnableOpenMetrics: true,
+	})
+}
+
+func (m *metrics) observePlatformCall(
+	platID streamcontrol.PlatformName,
+	method string,
+	startedAt time.Time,
+	err error,
+) {
+	if m == nil {
+		return
+	}
+	m.PlatformAPICalls.WithLabelValues(string(platID), method).Inc()
+	m.PlatformAPICallDuration.WithLabelValues(string(platID), method).Observe(time.Since(startedAt).Seconds())
+	if err != nil {
+		m.PlatformAPIErrors.WithLabelValues(string(platID), method).Inc()
+	}
+}
+
+func (m *metrics) observeChatMessage(
+	platID streamcontrol.PlatformName,
+) {
+	if m == nil {
+		return
+	}
+	m.ChatMessages.WithLabelValues(string(platID)).Inc()
+}
+
+func (m *metrics) observeTriggerRuleExecution(
+	ruleID api.TriggerRuleID,
+	err error,
+) {
+	if m == nil {
+		return
+	}
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	m.TriggerRuleExecutions.WithLabelValues(fmt.Sprint(ruleID), result).Inc()
+}
+
+var (
+	descStreamServerBytesReceived = prometheus.NewDesc(
+		metricsNamespace+"_stream_server_bytes_received_total",
+		"The amount of bytes published to the stream server.",
+		[]string{"type", "listen_addr"}, nil,
+	)
+	descStreamServerBytesSent = prometheus.NewDesc(
+		metricsNamespace+"_stream_server_bytes_sent_total",
+		"The amount of bytes sent by the stream server to the readers.",
+		[]string{"type", "listen_addr"}, nil,
+	)
+	descStreamForwardEnabled = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_enabled",
+		"If the stream forwarding is enabled (1) or not (0).",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamForwardUp = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_up",
+		"If the stream forwarding is sending the stream right now (1) or not (0).",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamForwardBytesRead = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_bytes_read_total",
+		"The amount of bytes read from the source by the current forwarding session.",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamForwardBytesWrote = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_bytes_wrote_total",
+		"The amount of bytes written to the destination by the current forwarding session.",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamForwardInputBitrate = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_input_bitrate_bps",
+		"The bitrate of the data read from the source.",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamForwardOutputBitrate = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_output_bitrate_bps",
+		"The bitrate of the data written to the destination.",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamForwardFrameRate = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_frame_rate",
+		"The frames per second written to the destination.",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamForwardDroppedPackets = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_dropped_packets",
+		"The amount of packets dropped by the current forwarding session.",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamForwardReconnects = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_reconnects_total",
+		"The amount of times the stream forwarding was restarted.",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamForwardUptime = prometheus.NewDesc(
+		metricsNamespace+"_stream_forward_uptime_seconds",
+		"The duration of the current forwarding session.",
+		[]string{"stream_id", "destination_id"}, nil,
+	)
+	descStreamPlayerLag = prometheus.NewDesc(
+		metricsNamespace+"_stream_player_lag_seconds",
+		"How far the stream player is behind the end of the received stream.",
+		[]string{"stream_id"}, nil,
+	)
+	descStreamActive = prometheus.NewDesc(
+		metricsNamespace+"_stream_active",
+		"If the stream is live on the platform (1) or not (0).",
+		[]string{"platform"}, nil,
+	)
+	descStreamViewers = prometheus.NewDesc(
+		metricsNamespace+"_stream_viewers",
+		"The amount of viewers of the stream on the platform.",
+		[]string{"platform"}, nil,
+	)
+)
+
+// stateCollector collects the current state of StreamD on each scrape.
+type stateCollector struct {
+	StreamD *StreamD
+}
+
+var _ prometheus.Collector = (*stateCollector)(nil)
+
+func (c *stateCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		descStreamServerBytesReceived,
+		descStreamServerBytesSent,
+		descStreamForwardEnabled,
+		descStreamForwardUp,
+		descStreamForwardBytesRead,
+		descStreamForwardBytesWrote,
+		descStreamForwardInputBitrate,
+		descStreamForwardOutputBitrate,
+		descStreamForwardFrameRate,
+		descStreamForwardDroppedPackets,
+		descStreamForwardReconnects,
+		descStreamForwardUptime,
+		descStreamPlayerLag,
+		descStreamActive,
+		descStreamViewers,
+	} {
+		ch <- desc
+	}
+}
+
+func (c *stateCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), metricsCollectTimeout)
+	defer cancelFn()
+	d := c.StreamD
+
+	hasStreamServer := xsync.RDoR1(ctx, &d.StreamServerLocker, func() bool {
+		return d.StreamServer != nil
+	})
+	if hasStreamServer {
+		c.collectStreamServers(ctx, ch)
+		c.collectStreamForwards(ctx, ch)
+		c.collectStreamPlayers(ctx, ch)
+	}
+	c.collectStreamStatuses(ctx, ch)
+}
+
+func (c *stateCollector) collectStreamServers(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	servers, err := c.StreamD.ListStreamServers(ctx)
+	if err != nil {
+		logger.Debugf(ctx, "unable to list the stream servers: %v", err)
+		return
+	}
+	for _, srv := range servers {
+		labels := []string{srv.Type.String(), srv.ListenAddr}
+		ch <- prometheus.MustNewConstMetric(
+			descStreamServerBytesReceived, prometheus.CounterValue,
+			float64(srv.NumBytesConsumerWrote), labels...,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			descStreamServerBytesSent, prometheus.CounterValue,
+			float64(srv.NumBytesProducerRead), labels...,
+		)
+	}
+}
+
+func (c *stateCollector) collectStreamForwards(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	fwds, err := c.StreamD.ListStreamForwards(ctx)
+	if err != nil {
+		logger.Debugf(ctx, "unable to list the stream forwards: %v", err)
+		return
+	}
+	for _, fwd := range fwds {
+		labels := []string{string(fwd.StreamID), string(fwd.DestinationID)}
+		gauge := func(desc *prometheus.Desc, value float64) {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labels...)
+		}
+		counter := func(desc *prometheus.Desc, value float64) {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value, labels...)
+		}
+		gauge(descStreamForwardEnabled, boolToFloat(fwd.Enabled))
+		gauge(descStreamForwardUp, boolToFloat(fwd.Stats.Uptime > 0))
+		counter(descStreamForwardBytesRead, float64(fwd.NumBytesRead))
+		counter(descStreamForwardBytesWrote, float64(fwd.NumBytesWrote))
+		gauge(descStreamForwardInputBitrate, float64(fwd.Stats.InputBitrate))
+		gauge(descStreamForwardOutputBitrate, float64(fwd.Stats.OutputBitrate))
+		if fwd.Stats.HasFrameStats {
+			gauge(descStreamForwardFrameRate, fwd.Stats.FrameRate)
+			gauge(descStreamForwardDroppedPackets, float64(fwd.Stats.DroppedPackets))
+		}
+		counter(descStreamForwardReconnects, float64(fwd.Stats.Reconnects))
+		gauge(descStreamForwardUptime, fwd.Stats.Uptime.Seconds())
+	}
+}
+
+func (c *stateCollector) collectStreamPlayers(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	players, err := c.StreamD.ListStreamPlayers(ctx)
+	if err != nil {
+		logger.Debugf(ctx, "unable to list the stream players: %v", err)
+		return
+	}
+	for _, player := range players {
+		if player.Disabled {
+			continue
+		}
+		pos, err := c.StreamD.StreamPlayerGetPosition(ctx, player.StreamID)
+		if err != nil {
+			continue
+		}
+		length, err := c.StreamD.StreamPlayerGetLength(ctx, player.StreamID)
+		if err != nil {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(
+			descStreamPlayerLag, prometheus.GaugeValue,
+			(length - pos).Seconds(), string(player.StreamID),
+		)
+	}
+}
+
+// collectStreamStatuses reports the statuses last seen by the stream
+// status watcher, so that scrapes do not consume the API quotas.
+func (c *stateCollector) collectStreamStatuses(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+) {
+	d := c.StreamD
+	d.streamStatusLocker.Do(ctx, func() {
+		for platID, status := range d.lastStreamStatus {
+			ch <- prometheus.MustNewConstMetric(
+				descStreamActive, prometheus.GaugeValue,
+				boolToFloat(status.IsActive), string(platID),
+			)
+			if status.ViewersCount == nil {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(
+				descStreamViewers, prometheus.GaugeValue,
+				float64(*status.ViewersCount), string(platID),
+			)
+		}
+	})
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
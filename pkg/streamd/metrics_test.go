@@ -0,0 +1,70 @@
+package streamd
+
+import (
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+)
+
+func TestMetricsStateCollector(t *testing.T) {
+	viewers := uint(42)
+	d := &StreamD{
+		lastStreamStatus: map[streamcontrol.PlatformName]streamcontrol.StreamStatus{
+			"twitch":  {IsActive: true, ViewersCount: &viewers},
+			"youtube": {IsActive: false},
+		},
+	}
+	d.metrics = newMetrics(d)
+
+	// without a stream server only the stream statuses are collected:
+	err := testutil.CollectAndCompare(&stateCollector{StreamD: d}, strings.NewReader(`
+# HELP streamd_stream_active If the stream is live on the platform (1) or not (0).
+# TYPE streamd_stream_active gauge
+streamd_stream_active{platform="twitch"} 1
+streamd_stream_active{platform="youtube"} 0
+# HELP streamd_stream_viewers The amount of viewers of the stream on the platform.
+# TYPE streamd_stream_viewers gauge
+streamd_stream_viewers{platform="twitch"} 42
+`))
+	require.NoError(t, err)
+	require.NoError(t, testutil.CollectAndCompare(&stateCollector{StreamD: d}, strings.NewReader(""), "streamd_stream_forward_up"))
+}
+
+func TestMetricsEvents(t *testing.T) {
+	d := &StreamD{}
+	d.metrics = newMetrics(d)
+
+	d.metrics.observePlatformCall("twitch", "SetTitle", time.Now(), nil)
+	d.metrics.observePlatformCall("twitch", "SetTitle", time.Now(), fmt.Errorf("some error"))
+	d.metrics.observeChatMessage("kick")
+	d.metrics.observeTriggerRuleExecution(3, nil)
+	d.metrics.observeTriggerRuleExecution(3, fmt.Errorf("some error"))
+	d.metrics.observeTriggerRuleExecution(3, fmt.Errorf("some error"))
+
+	require.Equal(t, 2.0, testutil.ToFloat64(d.metrics.PlatformAPICalls.WithLabelValues("twitch", "SetTitle")))
+	require.Equal(t, 1.0, testutil.ToFloat64(d.metrics.PlatformAPIErrors.WithLabelValues("twitch", "SetTitle")))
+	require.Equal(t, 1.0, testutil.ToFloat64(d.metrics.ChatMessages.WithLabelValues("kick")))
+	require.Equal(t, 1.0, testutil.ToFloat64(d.metrics.TriggerRuleExecutions.WithLabelValues("3", "ok")))
+	require.Equal(t, 2.0, testutil.ToFloat64(d.metrics.TriggerRuleExecutions.WithLabelValues("3", "error")))
+
+	// a nil metrics (if disabled) are just ignored:
+	var m *metrics
+	m.observeChatMessage("kick")
+
+	srv := httptest.NewServer(d.MetricsHandler())
+	defer srv.Close()
+	resp, err := srv.Client().Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `streamd_chat_messages_total{platform="kick"} 1`)
+	require.Contains(t, string(body), `streamd_platform_api_call_duration_seconds_count{method="SetTitle",platform="twitch"} 2`)
+}
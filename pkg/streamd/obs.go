@@ -275,3 +275,62 @@ func (d *StreamD) OBSElementSetShow(
 
 	return nil
 }
+
+func (d *StreamD) OBSSetCurrentScene(
+	ctx context.Context,
+	sceneName string,
+) error {
+	obsServer, obsServerClose, err := d.OBS(ctx)
+	if obsServerClose != nil {
+		defer obsServerClose()
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get a client to OBS: %w", err)
+	}
+
+	_, err = obsServer.SetCurrentProgramScene(ctx, &obs_grpc.SetCurrentProgramSceneRequest{
+		SceneName: &sceneName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set the current scene to '%s': %w", sceneName, err)
+	}
+	return nil
+}
+
+func (d *StreamD) OBSWindowCaptureSetSource(
+	ctx context.Context,
+	elID SceneElementIdentifier,
+	windowSource string,
+) error {
+	if elID.Name == nil && elID.UUID == nil {
+		return fmt.Errorf("elID.Name == nil && elID.UUID == nil (which is legit, but unexpected, so we fail just in case)")
+	}
+
+	obsServer, obsServerClose, err := d.OBS(ctx)
+	if obsServerClose != nil {
+		defer obsServerClose()
+	}
+	if err != nil {
+		return fmt.Errorf("unable to get a client to OBS: %w", err)
+	}
+
+	overlay := true
+	_, err = obsServer.SetInputSettings(ctx, &obs_grpc.SetInputSettingsRequest{
+		InputName: elID.Name,
+		InputUUID: elID.UUID,
+		InputSettings: &obs_grpc.AbstractObject{
+			Fields: map[string]*obs_grpc.Any{
+				"window": {
+					Union: &obs_grpc.Any_String_{
+						String_: []byte(windowSource),
+					},
+				},
+			},
+		},
+		Overlay: &overlay,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set the window source of %#+v to '%s': %w", elID, windowSource, err)
+	}
+	return nil
+}
@@ -1080,28 +1080,7 @@ func (grpc *GRPCServer) ListStreamForwards(
 
 	var result []*streamd_grpc.StreamForwardWithStatistics
 	for _, s := range streamFwds {
-		item := &streamd_grpc.StreamForwardWithStatistics{
-			Config: &streamd_grpc.StreamForward{
-				StreamID:      string(s.StreamID),
-				DestinationID: string(s.DestinationID),
-				Enabled:       s.Enabled,
-			},
-			Statistics: &streamd_grpc.StreamForwardStatistics{
-				NumBytesWrote: int64(s.NumBytesWrote),
-				NumBytesRead:  int64(s.NumBytesRead),
-			},
-		}
-		item.Config.Quirks = &streamd_grpc.StreamForwardQuirks{
-			RestartUntilYoutubeRecognizesStream: &streamd_grpc.RestartUntilYoutubeRecognizesStream{
-				Enabled:        s.Quirks.RestartUntilYoutubeRecognizesStream.Enabled,
-				StartTimeout:   s.Quirks.RestartUntilYoutubeRecognizesStream.StartTimeout.Seconds(),
-				StopStartDelay: s.Quirks.RestartUntilYoutubeRecognizesStream.StopStartDelay.Seconds(),
-			},
-			StartAfterYoutubeRecognizedStream: &streamd_grpc.StartAfterYoutubeRecognizedStream{
-				Enabled: s.Quirks.StartAfterYoutubeRecognizedStream.Enabled,
-			},
-		}
-		result = append(result, item)
+		result = append(result, goconv.StreamForwardGo2GRPC(s))
 	}
 	return &streamd_grpc.ListStreamForwardsReply{
 		StreamForwards: result,
@@ -1120,20 +1099,7 @@ func (grpc *GRPCServer) AddStreamForward(
 			req.GetConfig().GetDestinationID(),
 		),
 		cfg.Enabled,
-		api.StreamForwardingQuirks{
-			RestartUntilYoutubeRecognizesStream: api.RestartUntilYoutubeRecognizesStream{
-				Enabled: cfg.Quirks.RestartUntilYoutubeRecognizesStream.Enabled,
-				StartTimeout: sec2dur(
-					cfg.Quirks.RestartUntilYoutubeRecognizesStream.StartTimeout,
-				),
-				StopStartDelay: sec2dur(
-					cfg.Quirks.RestartUntilYoutubeRecognizesStream.StopStartDelay,
-				),
-			},
-			StartAfterYoutubeRecognizedStream: api.StartAfterYoutubeRecognizedStream{
-				Enabled: cfg.Quirks.StartAfterYoutubeRecognizedStream.Enabled,
-			},
-		},
+		goconv.StreamForwardQuirksGRPC2Go(cfg.GetQuirks()),
 	)
 	if err != nil {
 		return nil, err
@@ -1153,20 +1119,7 @@ func (grpc *GRPCServer) UpdateStreamForward(
 			req.GetConfig().GetDestinationID(),
 		),
 		cfg.Enabled,
-		api.StreamForwardingQuirks{
-			RestartUntilYoutubeRecognizesStream: api.RestartUntilYoutubeRecognizesStream{
-				Enabled: cfg.Quirks.RestartUntilYoutubeRecognizesStream.Enabled,
-				StartTimeout: sec2dur(
-					cfg.Quirks.RestartUntilYoutubeRecognizesStream.StartTimeout,
-				),
-				StopStartDelay: sec2dur(
-					cfg.Quirks.RestartUntilYoutubeRecognizesStream.StopStartDelay,
-				),
-			},
-			StartAfterYoutubeRecognizedStream: api.StartAfterYoutubeRecognizedStream{
-				Enabled: cfg.Quirks.StartAfterYoutubeRecognizedStream.Enabled,
-			},
-		},
+		goconv.StreamForwardQuirksGRPC2Go(cfg.GetQuirks()),
 	)
 	if err != nil {
 		return nil, err
@@ -1191,6 +1144,53 @@ func (grpc *GRPCServer) RemoveStreamForward(
 	return &streamd_grpc.RemoveStreamForwardReply{}, nil
 }
 
+func (grpc *GRPCServer) StartRecording(
+	ctx context.Context,
+	req *streamd_grpc.StartRecordingRequest,
+) (*streamd_grpc.StartRecordingReply, error) {
+	err := grpc.StreamD.StartRecording(
+		ctx,
+		api.StreamID(req.GetStreamID()),
+		goconv.RecordingConfigGRPC2Go(req.GetConfig()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &streamd_grpc.StartRecordingReply{}, nil
+}
+
+func (grpc *GRPCServer) StopRecording(
+	ctx context.Context,
+	req *streamd_grpc.StopRecordingRequest,
+) (*streamd_grpc.StopRecordingReply, error) {
+	err := grpc.StreamD.StopRecording(
+		ctx,
+		api.StreamID(req.GetStreamID()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &streamd_grpc.StopRecordingReply{}, nil
+}
+
+func (grpc *GRPCServer) ListRecordings(
+	ctx context.Context,
+	req *streamd_grpc.ListRecordingsRequest,
+) (*streamd_grpc.ListRecordingsReply, error) {
+	recordings, err := grpc.StreamD.ListRecordings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*streamd_grpc.StreamRecording, 0, len(recordings))
+	for _, recording := range recordings {
+		result = append(result, goconv.RecordingGo2GRPC(recording))
+	}
+	return &streamd_grpc.ListRecordingsReply{
+		Recordings: result,
+	}, nil
+}
+
 func (grpc *GRPCServer) WaitForStreamPublisher(
 	req *streamd_grpc.WaitForStreamPublisherRequest,
 	sender streamd_grpc.StreamD_WaitForStreamPublisherServer,
@@ -1660,7 +1660,17 @@ func (grpc *GRPCServer) SubscribeToIncomingStreamsChanges(
 		grpc.StreamD.SubscribeToIncomingStreamsChanges,
 		srv,
 		func(input api.DiffIncomingStreams) streamd_grpc.IncomingStreamsChange {
-			return streamd_grpc.IncomingStreamsChange{}
+			if input.Event == nil {
+				return streamd_grpc.IncomingStreamsChange{}
+			}
+			ev, err := goconv.EventGo2GRPC(input.Event)
+			if err != nil {
+				logger.Errorf(srv.Context(), "unable to convert the event %s: %v", input.Event, err)
+				return streamd_grpc.IncomingStreamsChange{}
+			}
+			return streamd_grpc.IncomingStreamsChange{
+				Event: ev,
+			}
 		},
 	)
 }
@@ -1672,7 +1682,36 @@ func (grpc *GRPCServer) SubscribeToStreamForwardsChanges(
 		grpc.StreamD.SubscribeToStreamForwardsChanges,
 		srv,
 		func(input api.DiffStreamForwards) streamd_grpc.StreamForwardsChange {
-			return streamd_grpc.StreamForwardsChange{}
+			if input.Event == nil {
+				return streamd_grpc.StreamForwardsChange{}
+			}
+			ev, err := goconv.EventGo2GRPC(input.Event)
+			if err != nil {
+				logger.Errorf(srv.Context(), "unable to convert the event %s: %v", input.Event, err)
+				return streamd_grpc.StreamForwardsChange{}
+			}
+			return streamd_grpc.StreamForwardsChange{
+				Event: ev,
+			}
+		},
+	)
+}
+
+func (grpc *GRPCServer) SubscribeToStreamForwardStats(
+	req *streamd_grpc.SubscribeToStreamForwardStatsRequest,
+	srv streamd_grpc.StreamD_SubscribeToStreamForwardStatsServer,
+) error {
+	return wrapChan(
+		grpc.StreamD.SubscribeToStreamForwardStats,
+		srv,
+		func(input []api.StreamForward) streamd_grpc.StreamForwardStatsUpdate {
+			var result []*streamd_grpc.StreamForwardWithStatistics
+			for _, fwd := range input {
+				result = append(result, goconv.StreamForwardGo2GRPC(fwd))
+			}
+			return streamd_grpc.StreamForwardStatsUpdate{
+				StreamForwards: result,
+			}
 		},
 	)
 }
@@ -1694,11 +1733,7 @@ func (grpc *GRPCServer) AddTimer(
 	ctx context.Context,
 	req *streamd_grpc.AddTimerRequest,
 ) (*streamd_grpc.AddTimerReply, error) {
-	triggerAtUnixNano := req.GetTriggerAtUnixNano()
-	triggerAt := time.Unix(
-		triggerAtUnixNano/1000000000,
-		triggerAtUnixNano%1000000000,
-	)
+	triggerAt := goconv.UnixGRPC2GoOrZero(req.GetTriggerAtUnixNano())
 
 	action, err := goconv.ActionGRPC2Go(req.Action)
 	if err != nil {
@@ -1708,10 +1743,20 @@ func (grpc *GRPCServer) AddTimer(
 		)
 	}
 
+	missedDeadlinePolicy, err := goconv.TimerMissedDeadlinePolicyGRPC2Go(req.GetMissedDeadlinePolicy())
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to convert the missed deadline policy: %w",
+			err,
+		)
+	}
+
 	timerID, err := grpc.StreamD.AddTimer(
 		ctx,
 		triggerAt,
 		action,
+		missedDeadlinePolicy,
+		goconv.TimerScheduleGRPC2Go(req.GetSchedule()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -1748,20 +1793,15 @@ func (grpc *GRPCServer) ListTimers(
 
 	var result []*streamd_grpc.Timer
 	for _, timer := range timers {
-		resultAction, err := goconv.ActionGo2GRPC(
-			timer.Action,
-		)
+		timerGRPC, err := goconv.TimerGo2GRPC(timer)
 		if err != nil {
 			return nil, fmt.Errorf(
-				"unable to convert the action: %w",
+				"unable to convert the timer %d: %w",
+				timer.ID,
 				err,
 			)
 		}
-		result = append(result, &streamd_grpc.Timer{
-			TimerID:           int64(timer.ID),
-			TriggerAtUnixNano: timer.TriggerAt.UnixNano(),
-			Action:            resultAction,
-		})
+		result = append(result, timerGRPC)
 	}
 	return &streamd_grpc.ListTimersReply{
 		Timers: result,
@@ -1850,6 +1890,52 @@ func (grpc *GRPCServer) UpdateTriggerRule(
 	return &streamd_grpc.UpdateTriggerRuleReply{}, nil
 }
 
+func (grpc *GRPCServer) ListTriggerRuleExecutions(
+	ctx context.Context,
+	req *streamd_grpc.ListTriggerRuleExecutionsRequest,
+) (*streamd_grpc.ListTriggerRuleExecutionsReply, error) {
+	executions, err := grpc.StreamD.ListTriggerRuleExecutions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*streamd_grpc.TriggerRuleExecution, 0, len(executions))
+	for _, execution := range executions {
+		executionGRPC, err := goconv.TriggerRuleExecutionGo2GRPC(execution)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert the trigger rule execution: %w", err)
+		}
+		result = append(result, executionGRPC)
+	}
+	return &streamd_grpc.ListTriggerRuleExecutionsReply{
+		Executions: result,
+	}, nil
+}
+
+func (grpc *GRPCServer) TestTriggerRule(
+	ctx context.Context,
+	req *streamd_grpc.TestTriggerRuleRequest,
+) (*streamd_grpc.TestTriggerRuleReply, error) {
+	triggerRule, err := goconv.TriggerRuleGRPC2Go(req.GetRule())
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the trigger rule: %w", err)
+	}
+
+	if req.GetEvent() == nil {
+		return nil, fmt.Errorf("the event is not set")
+	}
+	event, err := goconv.EventGRPC2Go(req.GetEvent())
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert the event: %w", err)
+	}
+
+	result, err := grpc.StreamD.TestTriggerRule(ctx, triggerRule, event)
+	if err != nil {
+		return nil, err
+	}
+	return goconv.TriggerRuleTestResultGo2GRPC(result)
+}
+
 func (grpc *GRPCServer) SubmitEvent(
 	ctx context.Context,
 	req *streamd_grpc.SubmitEventRequest,
@@ -1875,12 +1961,18 @@ func (grpc *GRPCServer) SubscribeToChatMessages(
 		srv,
 		func(input api.ChatMessage) streamd_grpc.ChatMessage {
 			return streamd_grpc.ChatMessage{
-				CreatedAtNano: uint64(input.CreatedAt.UnixNano()),
-				PlatID:        string(input.Platform),
-				UserID:        string(input.UserID),
-				Username:      input.Username,
-				MessageID:     string(input.MessageID),
-				Message:       input.Message,
+				CreatedAtNano:    uint64(input.CreatedAt.UnixNano()),
+				PlatID:           string(input.Platform),
+				UserID:           string(input.UserID),
+				Username:         input.Username,
+				MessageID:        string(input.MessageID),
+				Message:          input.Message,
+				Roles:            goconv.ChatUserRolesGo2GRPC(input.Roles),
+				Badges:           goconv.ChatBadgesGo2GRPC(input.Badges),
+				Color:            input.Color,
+				Fragments:        goconv.ChatMessageFragmentsGo2GRPC(input.Fragments),
+				ReplyToMessageID: string(input.ReplyToMessageID),
+				Paid:             goconv.ChatMessagePaidGo2GRPC(input.Paid),
 			}
 		},
 	)
@@ -1938,6 +2030,78 @@ func (grpc *GRPCServer) BanUser(
 	return &streamd_grpc.BanUserReply{}, nil
 }
 
+func (grpc *GRPCServer) AddModerator(
+	ctx context.Context,
+	req *streamd_grpc.AddModeratorRequest,
+) (*streamd_grpc.AddModeratorReply, error) {
+	err := grpc.StreamD.AddModerator(
+		ctx,
+		streamcontrol.PlatformName(req.GetPlatID()),
+		streamcontrol.ChatUserID(req.GetUserID()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &streamd_grpc.AddModeratorReply{}, nil
+}
+
+func (grpc *GRPCServer) RemoveModerator(
+	ctx context.Context,
+	req *streamd_grpc.RemoveModeratorRequest,
+) (*streamd_grpc.RemoveModeratorReply, error) {
+	err := grpc.StreamD.RemoveModerator(
+		ctx,
+		streamcontrol.PlatformName(req.GetPlatID()),
+		streamcontrol.ChatUserID(req.GetUserID()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &streamd_grpc.RemoveModeratorReply{}, nil
+}
+
+func (grpc *GRPCServer) InsertAdsCuePoint(
+	ctx context.Context,
+	req *streamd_grpc.InsertAdsCuePointRequest,
+) (*streamd_grpc.InsertAdsCuePointReply, error) {
+	err := grpc.StreamD.InsertAdsCuePoint(
+		ctx,
+		streamcontrol.PlatformName(req.GetPlatID()),
+		goconv.UnixGRPC2Go(req.GetTsUnixNano()),
+		time.Duration(req.GetDurationNano()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &streamd_grpc.InsertAdsCuePointReply{}, nil
+}
+
+func (grpc *GRPCServer) TwitchCreateStreamMarker(
+	ctx context.Context,
+	req *streamd_grpc.TwitchCreateStreamMarkerRequest,
+) (*streamd_grpc.TwitchCreateStreamMarkerReply, error) {
+	marker, err := grpc.StreamD.TwitchCreateStreamMarker(ctx, req.GetDescription())
+	if err != nil {
+		return nil, err
+	}
+	return &streamd_grpc.TwitchCreateStreamMarkerReply{
+		Marker: goconv.TwitchStreamMarkerGo2GRPC(*marker),
+	}, nil
+}
+
+func (grpc *GRPCServer) TwitchGetAdSchedule(
+	ctx context.Context,
+	req *streamd_grpc.TwitchGetAdScheduleRequest,
+) (*streamd_grpc.TwitchGetAdScheduleReply, error) {
+	schedule, err := grpc.StreamD.TwitchGetAdSchedule(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &streamd_grpc.TwitchGetAdScheduleReply{
+		Schedule: goconv.TwitchAdScheduleGo2GRPC(*schedule),
+	}, nil
+}
+
 /*func (grpc *GRPCServer) ProxyConnect(
 	req *streamd_grpc.ProxyConnectRequest,
 	srv streamd_grpc.StreamD_ProxyConnectServer,
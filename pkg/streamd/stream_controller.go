@@ -80,6 +80,12 @@ func (d *StreamD) reinitStreamControllers(ctx context.Context) error {
 			logger.Errorf(ctx, "unable to initialize the reader of chat messages for '%s': %v", string(platName), err)
 			continue
 		}
+		if platName == twitch.ID {
+			err = d.startListeningForTwitchEventSub(ctx)
+			if err != nil {
+				logger.Errorf(ctx, "unable to initialize the reader of Twitch EventSub events: %v", err)
+			}
+		}
 	}
 	return result.ErrorOrNil()
 }
@@ -169,8 +175,8 @@ func newKick(
 	ctx context.Context,
 	cfg *streamcontrol.AbstractPlatformConfig,
 	saveCfgFunc func(*streamcontrol.AbstractPlatformConfig) error,
-	_ kick.OAuthHandler,
-	_ func() []uint16,
+	customOAuthHandler kick.OAuthHandler,
+	getOAuthListenPorts func() []uint16,
 ) (
 	*kick.Kick,
 	error,
@@ -191,6 +197,8 @@ func newKick(
 	}
 
 	logger.Debugf(ctx, "kick config: %#+v", platCfg)
+	platCfg.Config.CustomOAuthHandler = customOAuthHandler
+	platCfg.Config.GetOAuthListenPorts = getOAuthListenPorts
 	kick, err := kick.New(ctx, *platCfg,
 		func(c kick.Config) error {
 			return saveCfgFunc(&streamcontrol.AbstractPlatformConfig{
@@ -0,0 +1,149 @@
+package streamd
+
+import (
+	"context"
+	"time"
+
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+)
+
+// meteredStreamController counts the calls to a stream controller (and
+// their latencies and errors) in the metrics of StreamD.
+type meteredStreamController struct {
+	streamcontrol.AbstractStreamController
+	PlatformID streamcontrol.PlatformName
+	Metrics    *metrics
+}
+
+var _ streamcontrol.AbstractStreamController = (*meteredStreamController)(nil)
+
+func newMeteredStreamController(
+	c streamcontrol.AbstractStreamController,
+	platID streamcontrol.PlatformName,
+	m *metrics,
+) streamcontrol.AbstractStreamController {
+	if m == nil {
+		return c
+	}
+	return &meteredStreamController{
+		AbstractStreamController: c,
+		PlatformID:               platID,
+		Metrics:                  m,
+	}
+}
+
+func (c *meteredStreamController) observe(
+	method string,
+	startedAt time.Time,
+	err error,
+) {
+	c.Metrics.observePlatformCall(c.PlatformID, method, startedAt, err)
+}
+
+func (c *meteredStreamController) ApplyProfile(
+	ctx context.Context,
+	profile streamcontrol.AbstractStreamProfile,
+	customArgs ...any,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("ApplyProfile", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.ApplyProfile(ctx, profile, customArgs...)
+}
+
+func (c *meteredStreamController) SetTitle(
+	ctx context.Context,
+	title string,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("SetTitle", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.SetTitle(ctx, title)
+}
+
+func (c *meteredStreamController) SetDescription(
+	ctx context.Context,
+	description string,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("SetDescription", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.SetDescription(ctx, description)
+}
+
+func (c *meteredStreamController) InsertAdsCuePoint(
+	ctx context.Context,
+	ts time.Time,
+	duration time.Duration,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("InsertAdsCuePoint", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.InsertAdsCuePoint(ctx, ts, duration)
+}
+
+func (c *meteredStreamController) Flush(
+	ctx context.Context,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("Flush", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.Flush(ctx)
+}
+
+func (c *meteredStreamController) StartStream(
+	ctx context.Context,
+	title string,
+	description string,
+	profile streamcontrol.AbstractStreamProfile,
+	customArgs ...any,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("StartStream", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.StartStream(ctx, title, description, profile, customArgs...)
+}
+
+func (c *meteredStreamController) EndStream(
+	ctx context.Context,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("EndStream", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.EndStream(ctx)
+}
+
+func (c *meteredStreamController) GetStreamStatus(
+	ctx context.Context,
+) (_ret *streamcontrol.StreamStatus, _err error) {
+	defer func(startedAt time.Time) { c.observe("GetStreamStatus", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.GetStreamStatus(ctx)
+}
+
+func (c *meteredStreamController) SendChatMessage(
+	ctx context.Context,
+	message string,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("SendChatMessage", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.SendChatMessage(ctx, message)
+}
+
+func (c *meteredStreamController) RemoveChatMessage(
+	ctx context.Context,
+	messageID streamcontrol.ChatMessageID,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("RemoveChatMessage", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.RemoveChatMessage(ctx, messageID)
+}
+
+func (c *meteredStreamController) BanUser(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+	reason string,
+	deadline time.Time,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("BanUser", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.BanUser(ctx, userID, reason, deadline)
+}
+
+func (c *meteredStreamController) AddModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("AddModerator", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.AddModerator(ctx, userID)
+}
+
+func (c *meteredStreamController) RemoveModerator(
+	ctx context.Context,
+	userID streamcontrol.ChatUserID,
+) (_err error) {
+	defer func(startedAt time.Time) { c.observe("RemoveModerator", startedAt, _err) }(time.Now())
+	return c.AbstractStreamController.RemoveModerator(ctx, userID)
+}
@@ -0,0 +1,48 @@
+package streamd
+
+import (
+	"context"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+)
+
+// streamForwardStatsInterval is how often the statistics of the stream
+// forwards are sent to the subscribers; the statistics themselves are
+// updated by the stream server once per second.
+const streamForwardStatsInterval = time.Second
+
+func (d *StreamD) SubscribeToStreamForwardStats(
+	ctx context.Context,
+) (<-chan []api.StreamForward, error) {
+	logger.Debugf(ctx, "SubscribeToStreamForwardStats")
+	defer logger.Debugf(ctx, "/SubscribeToStreamForwardStats")
+
+	r := make(chan []api.StreamForward, 1)
+	observability.Go(ctx, func() {
+		defer close(r)
+		t := time.NewTicker(streamForwardStatsInterval)
+		defer t.Stop()
+		for {
+			fwds, err := d.ListStreamForwards(ctx)
+			if err != nil {
+				logger.Errorf(ctx, "unable to list the stream forwards: %v", err)
+			} else {
+				select {
+				case r <- fwds:
+				default:
+					logger.Debugf(ctx, "the subscriber is too slow, skipping the stream forward statistics")
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+		}
+	})
+	return r, nil
+}
@@ -0,0 +1,261 @@
+package streamd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+	sstypes "github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/xcontext"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	incomingStreamWatcherMinRetryInterval = 100 * time.Millisecond
+	incomingStreamWatcherMaxRetryInterval = 5 * time.Second
+)
+
+// initIncomingStreamWatcher starts watching the publishers of every
+// incoming stream to submit the IncomingStreamPublished and
+// IncomingStreamUnpublished events. The list of watched streams
+// is updated on every change of the incoming streams.
+func (d *StreamD) initIncomingStreamWatcher(ctx context.Context) error {
+	changesCh, err := eventSubToChan[api.DiffIncomingStreams](ctx, d)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to the changes of incoming streams: %w", err)
+	}
+
+	observability.Go(ctx, func() {
+		logger.Debugf(ctx, "incomingStreamWatcher")
+		defer logger.Debugf(ctx, "/incomingStreamWatcher")
+
+		watchers := map[api.StreamID]context.CancelFunc{}
+		defer func() {
+			for _, cancelFn := range watchers {
+				cancelFn()
+			}
+		}()
+		for {
+			d.syncIncomingStreamWatchers(ctx, watchers)
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-changesCh:
+				if !ok {
+					return
+				}
+			}
+		}
+	})
+	return nil
+}
+
+func (d *StreamD) syncIncomingStreamWatchers(
+	ctx context.Context,
+	watchers map[api.StreamID]context.CancelFunc,
+) {
+	streams, err := d.ListIncomingStreams(ctx)
+	if err != nil {
+		logger.Errorf(ctx, "unable to get the list of incoming streams: %v", err)
+		return
+	}
+
+	actual := map[api.StreamID]struct{}{}
+	for _, stream := range streams {
+		actual[stream.StreamID] = struct{}{}
+		if _, ok := watchers[stream.StreamID]; ok {
+			continue
+		}
+		watcherCtx, cancelFn := context.WithCancel(ctx)
+		watchers[stream.StreamID] = cancelFn
+		streamID := stream.StreamID
+		observability.Go(watcherCtx, func() {
+			d.watchIncomingStream(watcherCtx, streamID)
+		})
+	}
+
+	for streamID, cancelFn := range watchers {
+		if _, ok := actual[streamID]; ok {
+			continue
+		}
+		cancelFn()
+		delete(watchers, streamID)
+	}
+}
+
+func (d *StreamD) watchIncomingStream(
+	ctx context.Context,
+	streamID api.StreamID,
+) {
+	logger.Debugf(ctx, "watchIncomingStream(ctx, '%s')", streamID)
+	defer logger.Debugf(ctx, "/watchIncomingStream(ctx, '%s')", streamID)
+
+	var prevPublisher sstypes.Publisher
+	retryInterval := incomingStreamWatcherMinRetryInterval
+	for {
+		publisherCh, err := xsync.RDoR2(ctx, &d.StreamServerLocker, func() (<-chan sstypes.Publisher, error) {
+			if d.StreamServer == nil {
+				return nil, fmt.Errorf("stream server is not initialized")
+			}
+			return d.StreamServer.WaitPublisherChan(ctx, sstypes.StreamID(streamID), false)
+		})
+		if err != nil {
+			logger.Errorf(ctx, "unable to wait for a publisher of '%s': %v", streamID, err)
+			return
+		}
+
+		var publisher sstypes.Publisher
+		select {
+		case <-ctx.Done():
+			return
+		case publisher = <-publisherCh:
+		}
+		if publisher == nil {
+			continue
+		}
+		if publisher == prevPublisher {
+			// the closed publisher is not yet unregistered (or the stream
+			// server never unregisters it), retrying with a backoff to
+			// avoid spinning:
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+			retryInterval = min(retryInterval*2, incomingStreamWatcherMaxRetryInterval)
+			continue
+		}
+		prevPublisher = publisher
+		retryInterval = incomingStreamWatcherMinRetryInterval
+
+		d.submitIncomingStreamEvent(ctx, &event.IncomingStreamPublished{
+			StreamID: ptr(streamID),
+		})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-publisher.ClosedChan():
+		}
+
+		d.submitIncomingStreamEvent(ctx, &event.IncomingStreamUnpublished{
+			StreamID: ptr(streamID),
+		})
+	}
+}
+
+func (d *StreamD) submitIncomingStreamEvent(
+	ctx context.Context,
+	ev event.Event,
+) {
+	d.publishEvent(ctx, api.DiffIncomingStreams{Event: ev})
+	if err := d.SubmitEvent(ctx, ev); err != nil {
+		logger.Errorf(ctx, "unable to submit event %s: %v", ev, err)
+	}
+}
+
+func (d *StreamD) notifyStreamAuthFail(
+	ctx context.Context,
+	streamID sstypes.StreamID,
+	action sstypes.StreamAuthAction,
+	remoteAddr string,
+) {
+	logger.Debugf(ctx, "notifyStreamAuthFail(ctx, '%s', '%s', '%s')", streamID, action, remoteAddr)
+	defer logger.Debugf(ctx, "/notifyStreamAuthFail(ctx, '%s', '%s', '%s')", streamID, action, remoteAddr)
+
+	// asynchronous, because it is called from the stream server routines
+	ctx = xcontext.DetachDone(ctx)
+	observability.Go(ctx, func() {
+		d.submitIncomingStreamEvent(ctx, &event.IncomingStreamAuthFailed{
+			StreamID:   ptr(streamID),
+			Action:     ptr(string(action)),
+			RemoteAddr: ptr(remoteAddr),
+		})
+	})
+}
+
+func (d *StreamD) notifyStreamForwardStart(
+	ctx context.Context,
+	streamID sstypes.StreamID,
+	destinationID sstypes.DestinationID,
+) {
+	logger.Debugf(ctx, "notifyStreamForwardStart(ctx, '%s', '%s')", streamID, destinationID)
+	defer logger.Debugf(ctx, "/notifyStreamForwardStart(ctx, '%s', '%s')", streamID, destinationID)
+
+	d.submitStreamForwardEvent(ctx, &event.StreamForwardStarted{
+		StreamID:      ptr(streamID),
+		DestinationID: ptr(destinationID),
+	})
+}
+
+func (d *StreamD) notifyStreamForwardFail(
+	ctx context.Context,
+	streamID sstypes.StreamID,
+	destinationID sstypes.DestinationID,
+	err error,
+) {
+	logger.Debugf(ctx, "notifyStreamForwardFail(ctx, '%s', '%s', %v)", streamID, destinationID, err)
+	defer logger.Debugf(ctx, "/notifyStreamForwardFail(ctx, '%s', '%s', %v)", streamID, destinationID, err)
+
+	d.submitStreamForwardEvent(ctx, &event.StreamForwardFailed{
+		StreamID:      ptr(streamID),
+		DestinationID: ptr(destinationID),
+		Error:         ptr(err.Error()),
+	})
+}
+
+func (d *StreamD) notifyStreamForwardSourceSwitch(
+	ctx context.Context,
+	streamID sstypes.StreamID,
+	destinationID sstypes.DestinationID,
+	source string,
+	isFallback bool,
+) {
+	logger.Debugf(ctx, "notifyStreamForwardSourceSwitch(ctx, '%s', '%s', '%s', %v)", streamID, destinationID, source, isFallback)
+	defer logger.Debugf(ctx, "/notifyStreamForwardSourceSwitch(ctx, '%s', '%s', '%s', %v)", streamID, destinationID, source, isFallback)
+
+	d.submitStreamForwardEvent(ctx, &event.StreamForwardSourceSwitched{
+		StreamID:      ptr(streamID),
+		DestinationID: ptr(destinationID),
+		Source:        ptr(source),
+		IsFallback:    ptr(isFallback),
+	})
+}
+
+func (d *StreamD) notifyStreamForwardFallbackStop(
+	ctx context.Context,
+	streamID sstypes.StreamID,
+	destinationID sstypes.DestinationID,
+	fallback string,
+	reason error,
+) {
+	logger.Debugf(ctx, "notifyStreamForwardFallbackStop(ctx, '%s', '%s', '%s', %v)", streamID, destinationID, fallback, reason)
+	defer logger.Debugf(ctx, "/notifyStreamForwardFallbackStop(ctx, '%s', '%s', '%s', %v)", streamID, destinationID, fallback, reason)
+
+	d.submitStreamForwardEvent(ctx, &event.StreamForwardFallbackStopped{
+		StreamID:      ptr(streamID),
+		DestinationID: ptr(destinationID),
+		Fallback:      ptr(fallback),
+		Reason:        ptr(reason.Error()),
+	})
+}
+
+// submitStreamForwardEvent is asynchronous, because it is called from
+// the stream forwarding routines, while actions of the trigger rules
+// may modify the stream forwardings.
+func (d *StreamD) submitStreamForwardEvent(
+	ctx context.Context,
+	ev event.Event,
+) {
+	ctx = xcontext.DetachDone(ctx)
+	observability.Go(ctx, func() {
+		d.publishEvent(ctx, api.DiffStreamForwards{Event: ev})
+		if err := d.SubmitEvent(ctx, ev); err != nil {
+			logger.Errorf(ctx, "unable to submit event %s: %v", ev, err)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package streamd
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	eventbus "github.com/asaskevich/EventBus"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver"
+	sstypes "github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+)
+
+type testPublisher struct {
+	closedChan chan struct{}
+}
+
+func (p *testPublisher) ClosedChan() <-chan struct{} {
+	return p.closedChan
+}
+
+// testStreamServer never unregisters a publisher, even if it is closed.
+type testStreamServer struct {
+	streamserver.StreamServer
+
+	locker    sync.Mutex
+	publisher *testPublisher
+	waitCount int
+}
+
+func (s *testStreamServer) setPublisher(p *testPublisher) {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.publisher = p
+}
+
+func (s *testStreamServer) getWaitCount() int {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	return s.waitCount
+}
+
+func (s *testStreamServer) WaitPublisherChan(
+	ctx context.Context,
+	_ sstypes.StreamID,
+	_ bool,
+) (<-chan sstypes.Publisher, error) {
+	s.locker.Lock()
+	defer s.locker.Unlock()
+	s.waitCount++
+	ch := make(chan sstypes.Publisher, 1)
+	if s.publisher != nil {
+		ch <- s.publisher
+	}
+	return ch, nil
+}
+
+func TestWatchIncomingStream(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	streamServer := &testStreamServer{}
+	d := &StreamD{
+		EventBus:     eventbus.New(),
+		StreamServer: streamServer,
+	}
+	eventsCh, err := eventSubToChan[api.DiffIncomingStreams](ctx, d)
+	require.NoError(t, err)
+	nextEvent := func() event.Event {
+		select {
+		case diff := <-eventsCh:
+			return diff.Event
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+			return nil
+		}
+	}
+
+	publisher0 := &testPublisher{closedChan: make(chan struct{})}
+	streamServer.setPublisher(publisher0)
+	go d.watchIncomingStream(ctx, "test")
+
+	require.Equal(t, &event.IncomingStreamPublished{StreamID: ptr(api.StreamID("test"))}, nextEvent())
+	close(publisher0.closedChan)
+	require.Equal(t, &event.IncomingStreamUnpublished{StreamID: ptr(api.StreamID("test"))}, nextEvent())
+
+	// the closed publisher is still registered, the watcher should
+	// back off instead of spinning:
+	waitCount := streamServer.getWaitCount()
+	time.Sleep(time.Second)
+	require.Less(t, streamServer.getWaitCount()-waitCount, 6)
+
+	streamServer.setPublisher(&testPublisher{closedChan: make(chan struct{})})
+	require.Equal(t, &event.IncomingStreamPublished{StreamID: ptr(api.StreamID("test"))}, nextEvent())
+}
@@ -0,0 +1,170 @@
+package streamd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol/kick"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol/obs"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol/twitch"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol/youtube"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+	"github.com/xaionaro-go/xsync"
+)
+
+// streamStatusWatcherInterval is how often the stream statuses are
+// checked; the actual requests to the platforms are additionally limited
+// by the cache of GetStreamStatus (see the cache durations there).
+const streamStatusWatcherInterval = 10 * time.Second
+
+func (d *StreamD) initStreamStatusWatcher(ctx context.Context) error {
+	observability.Go(ctx, func() {
+		logger.Debugf(ctx, "streamStatusWatcher")
+		defer logger.Debugf(ctx, "/streamStatusWatcher")
+
+		t := time.NewTicker(streamStatusWatcherInterval)
+		defer t.Stop()
+		for {
+			d.checkStreamStatuses(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+		}
+	})
+	return nil
+}
+
+func (d *StreamD) initializedPlatforms(ctx context.Context) []streamcontrol.PlatformName {
+	return xsync.RDoR1(ctx, &d.ControllersLocker, func() []streamcontrol.PlatformName {
+		var result []streamcontrol.PlatformName
+		if d.StreamControllers.OBS != nil {
+			result = append(result, obs.ID)
+		}
+		if d.StreamControllers.Twitch != nil {
+			result = append(result, twitch.ID)
+		}
+		if d.StreamControllers.Kick != nil {
+			result = append(result, kick.ID)
+		}
+		if d.StreamControllers.YouTube != nil {
+			result = append(result, youtube.ID)
+		}
+		return result
+	})
+}
+
+func (d *StreamD) checkStreamStatuses(ctx context.Context) {
+	for _, platID := range d.initializedPlatforms(ctx) {
+		d.checkStreamStatus(ctx, platID)
+	}
+}
+
+// checkStreamStatus gets the current stream status on the platform and
+// submits the StreamStarted/StreamEnded/ViewerCountChanged events if the
+// status changed since the previous check. The first check of a platform
+// only remembers the status, without submitting any events.
+func (d *StreamD) checkStreamStatus(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+) {
+	logger.Tracef(ctx, "checkStreamStatus(ctx, '%s')", platID)
+	defer logger.Tracef(ctx, "/checkStreamStatus(ctx, '%s')", platID)
+
+	status, err := d.GetStreamStatus(ctx, platID)
+	if err != nil {
+		logger.Debugf(ctx, "unable to get the stream status of '%s': %v", platID, err)
+		return
+	}
+
+	events := xsync.DoR1(ctx, &d.streamStatusLocker, func() []event.Event {
+		if d.lastStreamStatus == nil {
+			d.lastStreamStatus = map[streamcontrol.PlatformName]streamcontrol.StreamStatus{}
+		}
+		prev, ok := d.lastStreamStatus[platID]
+		d.lastStreamStatus[platID] = *status
+		if !ok {
+			return nil
+		}
+		return streamStatusChangeEvents(platID, prev, *status)
+	})
+
+	for _, ev := range events {
+		if err := d.SubmitEvent(ctx, ev); err != nil {
+			logger.Errorf(ctx, "unable to submit event %s: %v", ev, err)
+		}
+	}
+}
+
+// GetLastStreamStatus returns the stream status last seen by the stream
+// status watcher. Unlike GetStreamStatus it never calls the platform,
+// so it is cheap enough to be used on every event.
+func (d *StreamD) GetLastStreamStatus(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+) (*streamcontrol.StreamStatus, error) {
+	return xsync.DoR2(ctx, &d.streamStatusLocker, func() (*streamcontrol.StreamStatus, error) {
+		status, ok := d.lastStreamStatus[platID]
+		if !ok {
+			return nil, fmt.Errorf("the stream status of '%s' is not known, yet", platID)
+		}
+		return &status, nil
+	})
+}
+
+// expectStreamStatus is used to be sure the next check of the stream
+// status will generate an event, even if it is the first check of
+// the platform.
+func (d *StreamD) expectStreamStatus(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+	isActive bool,
+) {
+	d.streamStatusLocker.Do(ctx, func() {
+		if d.lastStreamStatus == nil {
+			d.lastStreamStatus = map[streamcontrol.PlatformName]streamcontrol.StreamStatus{}
+		}
+		if _, ok := d.lastStreamStatus[platID]; ok {
+			return
+		}
+		d.lastStreamStatus[platID] = streamcontrol.StreamStatus{
+			IsActive: !isActive,
+		}
+	})
+}
+
+func streamStatusChangeEvents(
+	platID streamcontrol.PlatformName,
+	prev streamcontrol.StreamStatus,
+	cur streamcontrol.StreamStatus,
+) []event.Event {
+	var result []event.Event
+	switch {
+	case !prev.IsActive && cur.IsActive:
+		result = append(result, &event.StreamStarted{
+			Platform: ptr(platID),
+		})
+	case prev.IsActive && !cur.IsActive:
+		result = append(result, &event.StreamEnded{
+			Platform: ptr(platID),
+		})
+	}
+
+	if cur.ViewersCount != nil && (prev.ViewersCount == nil || *prev.ViewersCount != *cur.ViewersCount) {
+		ev := &event.ViewerCountChanged{
+			Platform:     ptr(platID),
+			ViewersCount: ptr(uint64(*cur.ViewersCount)),
+		}
+		if prev.ViewersCount != nil {
+			ev.PreviousViewersCount = ptr(uint64(*prev.ViewersCount))
+		}
+		result = append(result, ev)
+	}
+
+	return result
+}
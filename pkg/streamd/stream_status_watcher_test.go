@@ -0,0 +1,87 @@
+package streamd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+)
+
+func TestStreamStatusChangeEvents(t *testing.T) {
+	const platID = streamcontrol.PlatformName("twitch")
+	viewers := func(v uint) *uint { return &v }
+
+	for _, tc := range []struct {
+		name     string
+		prev     streamcontrol.StreamStatus
+		cur      streamcontrol.StreamStatus
+		expected []event.Event
+	}{
+		{
+			name: "nothing_changed",
+			prev: streamcontrol.StreamStatus{IsActive: true, ViewersCount: viewers(5)},
+			cur:  streamcontrol.StreamStatus{IsActive: true, ViewersCount: viewers(5)},
+		},
+		{
+			name:     "started",
+			prev:     streamcontrol.StreamStatus{},
+			cur:      streamcontrol.StreamStatus{IsActive: true},
+			expected: []event.Event{&event.StreamStarted{Platform: ptr(platID)}},
+		},
+		{
+			name: "started_with_viewers",
+			prev: streamcontrol.StreamStatus{},
+			cur:  streamcontrol.StreamStatus{IsActive: true, ViewersCount: viewers(1)},
+			expected: []event.Event{
+				&event.StreamStarted{Platform: ptr(platID)},
+				&event.ViewerCountChanged{Platform: ptr(platID), ViewersCount: ptr(uint64(1))},
+			},
+		},
+		{
+			name: "ended",
+			prev: streamcontrol.StreamStatus{IsActive: true, ViewersCount: viewers(3)},
+			cur:  streamcontrol.StreamStatus{IsActive: false},
+			expected: []event.Event{
+				&event.StreamEnded{Platform: ptr(platID)},
+			},
+		},
+		{
+			name: "viewers_changed",
+			prev: streamcontrol.StreamStatus{IsActive: true, ViewersCount: viewers(99)},
+			cur:  streamcontrol.StreamStatus{IsActive: true, ViewersCount: viewers(100)},
+			expected: []event.Event{
+				&event.ViewerCountChanged{
+					Platform:             ptr(platID),
+					ViewersCount:         ptr(uint64(100)),
+					PreviousViewersCount: ptr(uint64(99)),
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, streamStatusChangeEvents(platID, tc.prev, tc.cur))
+		})
+	}
+}
+
+func TestExpectStreamStatus(t *testing.T) {
+	ctx := context.Background()
+	d := &StreamD{}
+
+	_, err := d.GetLastStreamStatus(ctx, "youtube")
+	require.Error(t, err)
+
+	// the first check after starting a stream should report the start:
+	d.expectStreamStatus(ctx, "youtube", true)
+	status, err := d.GetLastStreamStatus(ctx, "youtube")
+	require.NoError(t, err)
+	require.False(t, status.IsActive)
+
+	// but an already known status is not overridden:
+	d.expectStreamStatus(ctx, "youtube", false)
+	status, err = d.GetLastStreamStatus(ctx, "youtube")
+	require.NoError(t, err)
+	require.False(t, status.IsActive)
+}
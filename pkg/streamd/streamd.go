@@ -106,6 +106,13 @@ type StreamD struct {
 	imageTakerWG     sync.WaitGroup
 
 	obsRestarter *obsRestarter
+
+	streamStatusLocker xsync.Mutex
+	lastStreamStatus   map[streamcontrol.PlatformName]streamcontrol.StreamStatus
+
+	triggerRuleHistory triggerRuleExecutions
+
+	metrics *metrics
 }
 
 type imageHash uint64
@@ -143,6 +150,7 @@ func New(
 		Timers:  map[api.TimerID]*Timer{},
 		Options: Options(options).Aggregate(),
 	}
+	d.metrics = newMetrics(d)
 
 	err = d.readCache(ctx)
 	if err != nil {
@@ -187,6 +195,11 @@ func (d *StreamD) Run(ctx context.Context) (_ret error) { // TODO: delete the fe
 		d.UI.DisplayError(fmt.Errorf("unable to initialize the stream server: %w", err))
 	}
 
+	d.UI.SetStatus("Incoming stream watcher...")
+	if err := d.initIncomingStreamWatcher(ctx); err != nil {
+		d.UI.DisplayError(fmt.Errorf("unable to initialize the incoming stream watcher: %w", err))
+	}
+
 	d.UI.SetStatus("Starting the image taker...")
 	if err := d.initImageTaker(ctx); err != nil {
 		d.UI.DisplayError(fmt.Errorf("unable to initialize the image taker: %w", err))
@@ -202,6 +215,16 @@ func (d *StreamD) Run(ctx context.Context) (_ret error) { // TODO: delete the fe
 		d.UI.DisplayError(fmt.Errorf("unable to initialize the OBS restarter: %w", err))
 	}
 
+	d.UI.SetStatus("Stream status watcher...")
+	if err := d.initStreamStatusWatcher(ctx); err != nil {
+		d.UI.DisplayError(fmt.Errorf("unable to initialize the stream status watcher: %w", err))
+	}
+
+	d.UI.SetStatus("Timers...")
+	if err := d.initTimers(ctx); err != nil {
+		d.UI.DisplayError(fmt.Errorf("unable to initialize the timers: %w", err))
+	}
+
 	d.UI.SetStatus("Initializing UI...")
 	return nil
 }
@@ -257,6 +280,21 @@ func (d *StreamD) initStreamServer(ctx context.Context) (_err error) {
 	return d.StreamServer.Init(
 		ctx,
 		sstypes.InitOptionDefaultStreamPlayerOptions(d.streamPlayerOptions()),
+		sstypes.InitOptionNotifierStreamForwardStart{
+			d.notifyStreamForwardStart,
+		},
+		sstypes.InitOptionNotifierStreamForwardFail{
+			d.notifyStreamForwardFail,
+		},
+		sstypes.InitOptionNotifierStreamAuthFail{
+			d.notifyStreamAuthFail,
+		},
+		sstypes.InitOptionNotifierStreamForwardSourceSwitch{
+			d.notifyStreamForwardSourceSwitch,
+		},
+		sstypes.InitOptionNotifierStreamForwardFallbackStop{
+			d.notifyStreamForwardFallbackStop,
+		},
 	)
 }
 
@@ -538,6 +576,24 @@ func (d *StreamD) StartStream(
 	customArgs ...any,
 ) (_err error) {
 	logger.Debugf(ctx, "StartStream(%s)", platID)
+	defer func(startedAt time.Time) {
+		// the controllers are called directly below, so counting the call here
+		d.metrics.observePlatformCall(platID, "StartStream", startedAt, _err)
+	}(time.Now())
+	defer func() {
+		if _err != nil {
+			return
+		}
+		// asynchronous, because this might be called from a timer itself
+		ctx := xcontext.DetachDone(ctx)
+		observability.Go(ctx, func() {
+			if err := d.onStreamStartTimers(ctx, platID); err != nil {
+				logger.Errorf(ctx, "unable to arm the timers relative to the stream start: %v", err)
+			}
+			d.expectStreamStatus(ctx, platID, true)
+			d.checkStreamStatus(memoize.SetNoCache(ctx, true), platID)
+		})
+	}()
 	return xsync.RDoR1(ctx, &d.ControllersLocker, func() error {
 		defer func() { logger.Debugf(ctx, "/StartStream(%s): %v", platID, _err) }()
 		defer d.publishEvent(ctx, api.DiffStreams{})
@@ -664,10 +720,25 @@ func getYTBroadcastID(d youtube.StreamStatusCustomData) string {
 	return ""
 }
 
-func (d *StreamD) EndStream(ctx context.Context, platID streamcontrol.PlatformName) error {
+func (d *StreamD) EndStream(ctx context.Context, platID streamcontrol.PlatformName) (_err error) {
 	logger.Debugf(ctx, "EndStream(ctx, '%s')", platID)
 	defer logger.Debugf(ctx, "/EndStream(ctx, '%s')", platID)
 
+	defer func() {
+		if _err != nil {
+			return
+		}
+		// asynchronous, because this might be called from a timer itself
+		ctx := xcontext.DetachDone(ctx)
+		observability.Go(ctx, func() {
+			if err := d.onStreamEndTimers(ctx, platID); err != nil {
+				logger.Errorf(ctx, "unable to disarm the timers relative to the stream start: %v", err)
+			}
+			d.expectStreamStatus(ctx, platID, false)
+			d.checkStreamStatus(memoize.SetNoCache(ctx, true), platID)
+		})
+	}()
+
 	defer d.publishEvent(ctx, api.DiffStreams{})
 
 	return xsync.RDoR1(ctx, &d.ControllersLocker, func() error {
@@ -819,7 +890,7 @@ func (d *StreamD) streamController(
 	if result == nil {
 		return nil, fmt.Errorf("controller '%s' is not initialized", platID)
 	}
-	return result, nil
+	return newMeteredStreamController(result, platID, d.metrics), nil
 }
 func (d *StreamD) GetStreamStatus(
 	ctx context.Context,
@@ -1358,6 +1429,7 @@ func (d *StreamD) listStreamForwards(
 			DestinationID: api.DestinationID(streamFwd.DestinationID),
 			NumBytesWrote: streamFwd.NumBytesWrote,
 			NumBytesRead:  streamFwd.NumBytesRead,
+			Stats:         streamFwd.Stats,
 			Quirks:        streamFwd.Quirks,
 		}
 		result = append(result, item)
@@ -1475,6 +1547,82 @@ func (d *StreamD) RemoveStreamForward(
 	})
 }
 
+func (d *StreamD) StartRecording(
+	ctx context.Context,
+	streamID api.StreamID,
+	cfg api.RecordingConfig,
+) error {
+	logger.Debugf(ctx, "StartRecording")
+	defer logger.Debugf(ctx, "/StartRecording")
+
+	return xsync.DoR1(ctx, &d.StreamServerLocker, func() error {
+		if d.StreamServer == nil {
+			return fmt.Errorf("stream server is not initialized")
+		}
+		err := d.StreamServer.StartStreamRecording(
+			resetContextCancellers(ctx),
+			sstypes.StreamID(streamID),
+			cfg,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to start the recording: %w", err)
+		}
+
+		err = d.SaveConfig(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to save the config: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (d *StreamD) StopRecording(
+	ctx context.Context,
+	streamID api.StreamID,
+) error {
+	logger.Debugf(ctx, "StopRecording")
+	defer logger.Debugf(ctx, "/StopRecording")
+
+	return xsync.DoR1(ctx, &d.StreamServerLocker, func() error {
+		if d.StreamServer == nil {
+			return fmt.Errorf("stream server is not initialized")
+		}
+		err := d.StreamServer.StopStreamRecording(
+			ctx,
+			sstypes.StreamID(streamID),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to stop the recording: %w", err)
+		}
+
+		err = d.SaveConfig(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to save the config: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (d *StreamD) ListRecordings(
+	ctx context.Context,
+) ([]api.Recording, error) {
+	logger.Debugf(ctx, "ListRecordings")
+	defer logger.Debugf(ctx, "/ListRecordings")
+
+	return xsync.DoR2(ctx, &d.StreamServerLocker, func() ([]api.Recording, error) {
+		if d.StreamServer == nil {
+			return nil, fmt.Errorf("stream server is not initialized")
+		}
+		recordings, err := d.StreamServer.ListStreamRecordings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the recordings: %w", err)
+		}
+		return recordings, nil
+	})
+}
+
 func resetContextCancellers(ctx context.Context) context.Context {
 	return belt.CtxWithBelt(context.Background(), belt.CtxBelt(ctx))
 }
@@ -1764,21 +1912,43 @@ func (d *StreamD) AddTimer(
 	ctx context.Context,
 	triggerAt time.Time,
 	action api.Action,
+	missedDeadlinePolicy api.TimerMissedDeadlinePolicy,
+	schedule api.TimerSchedule,
 ) (api.TimerID, error) {
-	return xsync.DoA3R2(ctx, &d.TimersLocker, d.addTimer, ctx, triggerAt, action)
+	return xsync.DoR2(ctx, &d.TimersLocker, func() (api.TimerID, error) {
+		return d.addTimer(ctx, triggerAt, action, missedDeadlinePolicy, schedule)
+	})
 }
 
 func (d *StreamD) addTimer(
 	ctx context.Context,
 	triggerAt time.Time,
 	action api.Action,
+	missedDeadlinePolicy api.TimerMissedDeadlinePolicy,
+	schedule api.TimerSchedule,
 ) (api.TimerID, error) {
-	logger.Debugf(ctx, "addTimer(ctx, %v, %v)", triggerAt, action)
-	defer logger.Debugf(ctx, "/addTimer(ctx, %v, %v)", triggerAt, action)
+	logger.Debugf(ctx, "addTimer(ctx, %v, %v, %#+v, %#+v)", triggerAt, action, missedDeadlinePolicy, schedule)
+	defer logger.Debugf(ctx, "/addTimer(ctx, %v, %v, %#+v, %#+v)", triggerAt, action, missedDeadlinePolicy, schedule)
+	if err := schedule.Validate(); err != nil {
+		return 0, fmt.Errorf("invalid schedule: %w", err)
+	}
+	triggerAt, err := schedule.First(triggerAt, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("unable to calculate the first trigger time: %w", err)
+	}
 	timerID := api.TimerID(atomic.AddUint64(&d.NextTimerID, 1))
-	timer := NewTimer(d, timerID, triggerAt, action)
+	timer := NewTimer(d, api.Timer{
+		ID:                   timerID,
+		TriggerAt:            triggerAt,
+		Action:               action,
+		MissedDeadlinePolicy: missedDeadlinePolicy,
+		Schedule:             schedule,
+	})
 	timer.Start(xcontext.DetachDone(ctx))
 	d.Timers[timerID] = timer
+	if err := d.saveTimers(ctx); err != nil {
+		return timerID, fmt.Errorf("unable to save the timers: %w", err)
+	}
 	return timerID, nil
 }
 
@@ -1801,6 +1971,9 @@ func (d *StreamD) removeTimer(
 	}
 	delete(d.Timers, timerID)
 	timer.Stop(ctx)
+	if err := d.saveTimers(ctx); err != nil {
+		return fmt.Errorf("unable to save the timers: %w", err)
+	}
 	return nil
 }
 
@@ -1825,6 +1998,171 @@ func (d *StreamD) listTimers(
 	return result, nil
 }
 
+func (d *StreamD) rescheduleTimer(
+	ctx context.Context,
+	timerID api.TimerID,
+	triggerAt time.Time,
+) error {
+	return xsync.DoR1(ctx, &d.TimersLocker, func() error {
+		logger.Debugf(ctx, "rescheduleTimer(ctx, %d, %v)", timerID, triggerAt)
+		defer logger.Debugf(ctx, "/rescheduleTimer(ctx, %d, %v)", timerID, triggerAt)
+		timer, ok := d.Timers[timerID]
+		if !ok {
+			return fmt.Errorf("timer %d is not found", timerID)
+		}
+		timer.Do(ctx, func() {
+			timer.stop(ctx)
+			timer.Timer.TriggerAt = triggerAt
+			timer.start(ctx)
+		})
+		if err := d.saveTimers(ctx); err != nil {
+			return fmt.Errorf("unable to save the timers: %w", err)
+		}
+		return nil
+	})
+}
+
+// onStreamStartTimers arms the timers relative to a stream start.
+func (d *StreamD) onStreamStartTimers(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+) error {
+	return d.setTimersRelativeToStreamStart(ctx, platID, true)
+}
+
+// onStreamEndTimers disarms the timers relative to a stream start.
+func (d *StreamD) onStreamEndTimers(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+) error {
+	return d.setTimersRelativeToStreamStart(ctx, platID, false)
+}
+
+func (d *StreamD) setTimersRelativeToStreamStart(
+	ctx context.Context,
+	platID streamcontrol.PlatformName,
+	arm bool,
+) error {
+	ctx = xcontext.DetachDone(ctx)
+	return xsync.DoR1(ctx, &d.TimersLocker, func() error {
+		logger.Debugf(ctx, "setTimersRelativeToStreamStart(ctx, '%s', %v)", platID, arm)
+		defer logger.Debugf(ctx, "/setTimersRelativeToStreamStart(ctx, '%s', %v)", platID, arm)
+
+		now := time.Now()
+		changed := false
+		for _, timer := range d.Timers {
+			rel := timer.Timer.Schedule.RelativeToStreamStart
+			if rel == nil {
+				continue
+			}
+			if rel.PlatID != "" && rel.PlatID != platID {
+				continue
+			}
+			var triggerAt time.Time
+			if arm {
+				triggerAt = now.Add(rel.Delay)
+			}
+			timer.Do(ctx, func() {
+				timer.stop(ctx)
+				timer.Timer.TriggerAt = triggerAt
+				timer.start(ctx)
+			})
+			changed = true
+		}
+		if !changed {
+			return nil
+		}
+		if err := d.saveTimers(ctx); err != nil {
+			return fmt.Errorf("unable to save the timers: %w", err)
+		}
+		return nil
+	})
+}
+
+// saveTimers stores the currently active timers into the config, so that
+// they survive a restart of streamd.
+func (d *StreamD) saveTimers(
+	ctx context.Context,
+) error {
+	timers, err := d.listTimers(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list the timers: %w", err)
+	}
+	return xsync.DoR1(ctx, &d.ConfigLock, func() error {
+		d.Config.Timers = make(api.Timers, 0, len(timers))
+		for idx := range timers {
+			d.Config.Timers = append(d.Config.Timers, &timers[idx])
+		}
+		d.Config.NextTimerID = api.TimerID(atomic.LoadUint64(&d.NextTimerID))
+		if err := d.SaveConfig(ctx); err != nil {
+			return fmt.Errorf("unable to save the config: %w", err)
+		}
+		return nil
+	})
+}
+
+func (d *StreamD) initTimers(
+	ctx context.Context,
+) error {
+	return xsync.DoA1R1(ctx, &d.TimersLocker, d.loadTimers, ctx)
+}
+
+// loadTimers starts the timers stored in the config. Timers which deadline
+// was missed while streamd was not running are either fired immediately
+// or dropped, according to their MissedDeadlinePolicy.
+func (d *StreamD) loadTimers(
+	ctx context.Context,
+) (_err error) {
+	logger.Debugf(ctx, "loadTimers")
+	defer func() { logger.Debugf(ctx, "/loadTimers: %v", _err) }()
+
+	var (
+		timers      api.Timers
+		nextTimerID api.TimerID
+	)
+	d.ConfigLock.Do(ctx, func() {
+		timers = d.Config.Timers
+		nextTimerID = d.Config.NextTimerID
+	})
+
+	for _, timer := range timers {
+		if timer.ID > nextTimerID {
+			nextTimerID = timer.ID
+		}
+	}
+	if uint64(nextTimerID) > atomic.LoadUint64(&d.NextTimerID) {
+		atomic.StoreUint64(&d.NextTimerID, uint64(nextTimerID))
+	}
+
+	now := time.Now()
+	for _, timer := range timers {
+		if _, ok := d.Timers[timer.ID]; ok {
+			logger.Debugf(ctx, "timer %d is already running", timer.ID)
+			continue
+		}
+		t := NewTimer(d, *timer)
+		if !timer.TriggerAt.IsZero() {
+			lateness := now.Sub(timer.TriggerAt)
+			if !timer.MissedDeadlinePolicy.ShouldFire(lateness) {
+				nextTriggerAt, err := timer.Schedule.Next(timer.TriggerAt, now)
+				if err != nil {
+					logger.Errorf(ctx, "unable to calculate the next trigger time of timer %d: %v", timer.ID, err)
+				}
+				if nextTriggerAt.IsZero() && timer.Schedule.RelativeToStreamStart == nil {
+					logger.Infof(ctx, "skipping timer %d (%s), it is late by %v", timer.ID, timer.Action, lateness)
+					continue
+				}
+				logger.Infof(ctx, "skipping a trigger of timer %d (%s), it is late by %v; the next trigger is at %v", timer.ID, timer.Action, lateness, nextTriggerAt)
+				t.Timer.TriggerAt = nextTriggerAt
+			}
+		}
+		t.Start(ctx)
+		d.Timers[timer.ID] = t
+	}
+
+	return d.saveTimers(ctx)
+}
+
 func (d *StreamD) SubscribeToChatMessages(
 	ctx context.Context,
 ) (<-chan api.ChatMessage, error) {
@@ -19,17 +19,11 @@ type Timer struct {
 
 func NewTimer(
 	streamD *StreamD,
-	timerID api.TimerID,
-	triggerAt time.Time,
-	action api.Action,
+	timer api.Timer,
 ) *Timer {
 	return &Timer{
 		StreamD: streamD,
-		Timer: api.Timer{
-			ID:        timerID,
-			TriggerAt: triggerAt,
-			Action:    action,
-		},
+		Timer:   timer,
 	}
 }
 
@@ -59,6 +53,11 @@ func (t *Timer) start(ctx context.Context) {
 		return
 	}
 
+	if t.TriggerAt.IsZero() {
+		logger.Debugf(ctx, "timer %d is not armed", t.Timer.ID)
+		return
+	}
+
 	runningTimer := time.NewTimer(time.Until(t.TriggerAt))
 	t.RunningTimer = runningTimer
 
@@ -101,14 +100,31 @@ func (t *Timer) trigger(ctx context.Context) {
 	logger.Debugf(ctx, "trigger (%T)", t.Timer.Action)
 	defer logger.Debugf(ctx, "/trigger (%T)", t.Timer.Action)
 
+	timerID := t.Timer.ID
+	isRelativeToStreamStart := t.Timer.Schedule.RelativeToStreamStart != nil
+	nextTriggerAt, err := t.Timer.Schedule.Next(t.Timer.TriggerAt, time.Now())
+	if err != nil {
+		logger.Errorf(ctx, "unable to calculate the next trigger time of timer %d: %v", timerID, err)
+	}
+
 	observability.Go(ctx, func() {
-		err := t.StreamD.RemoveTimer(ctx, t.Timer.ID)
+		if nextTriggerAt.IsZero() && !isRelativeToStreamStart {
+			err := t.StreamD.RemoveTimer(ctx, timerID)
+			if err != nil {
+				logger.Errorf(ctx, "unable to remove timer %d: %v", timerID, err)
+			}
+			return
+		}
+
+		// a zero nextTriggerAt disarms a timer relative to a stream start,
+		// until the next stream start
+		err := t.StreamD.rescheduleTimer(ctx, timerID, nextTriggerAt)
 		if err != nil {
-			logger.Error(ctx, "unable to remove timer %d: %v", t.Timer.ID, err)
+			logger.Errorf(ctx, "unable to reschedule timer %d: %v", timerID, err)
 		}
 	})
 
-	err := t.StreamD.doAction(ctx, t.Timer.Action, nil)
+	err = t.StreamD.doAction(ctx, t.Timer.Action, nil)
 	if err != nil {
 		logger.Errorf(ctx, "unable to perform action %#+v: %v", t.Timer.Action, err)
 	}
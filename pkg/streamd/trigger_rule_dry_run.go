@@ -0,0 +1,152 @@
+package streamd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/streamctl/pkg/expression"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+)
+
+// TestTriggerRule checks if the rule matches the event and evaluates
+// the expressions of the actions which would be performed, but does not
+// perform the actions.
+func (d *StreamD) TestTriggerRule(
+	ctx context.Context,
+	rule *api.TriggerRule,
+	ev event.Event,
+) (_ret *api.TriggerRuleTestResult, _err error) {
+	logger.Debugf(ctx, "TestTriggerRule(ctx, %s, %s)", rule, ev)
+	defer func() { logger.Debugf(ctx, "/TestTriggerRule(ctx, %s, %s): %v", rule, ev, _err) }()
+
+	if rule == nil {
+		return nil, fmt.Errorf("the rule is not set")
+	}
+	if rule.EventQuery == nil {
+		return nil, fmt.Errorf("the event query of the rule is not set")
+	}
+
+	result := &api.TriggerRuleTestResult{}
+	if !rule.EventQuery.Match(ctx, ev, d) {
+		return result, nil
+	}
+	result.Matched = true
+	result.Actions = dryRunAction(rule.Action, objToMap(ev))
+	return result, nil
+}
+
+func dryRunAction(
+	a action.Action,
+	exprCtx any,
+) []api.TriggerRuleTestAction {
+	switch a := a.(type) {
+	case nil:
+		return nil
+	case *action.Sequence:
+		return dryRunSteps(a.Steps, exprCtx)
+	case *action.Parallel:
+		return dryRunSteps(a.Steps, exprCtx)
+	case *action.If:
+		cond, err := expression.EvalDryRun[bool](a.Condition, exprCtx)
+		condition := api.EvaluatedExpression{
+			Field:      "Condition",
+			Expression: a.Condition,
+			Error:      err,
+		}
+		if err == nil {
+			condition.Value = fmt.Sprint(cond)
+		}
+		result := []api.TriggerRuleTestAction{{
+			Action:      a,
+			Expressions: []api.EvaluatedExpression{condition},
+		}}
+		if err != nil {
+			return result
+		}
+		next := a.Else
+		if cond {
+			next = a.Then
+		}
+		return append(result, dryRunAction(next, exprCtx)...)
+	default:
+		return []api.TriggerRuleTestAction{{
+			Action:      a,
+			Expressions: evalActionExpressions(a, exprCtx),
+		}}
+	}
+}
+
+func dryRunSteps(
+	steps []action.Step,
+	exprCtx any,
+) []api.TriggerRuleTestAction {
+	var result []api.TriggerRuleTestAction
+	for _, step := range steps {
+		result = append(result, dryRunAction(step.Action, exprCtx)...)
+	}
+	return result
+}
+
+var valueExpressionType = reflect.TypeOf(action.ValueExpression(""))
+
+// evalActionExpressions evaluates all the fields of type ValueExpression
+// (including slices of them) of the action.
+func evalActionExpressions(
+	a action.Action,
+	exprCtx any,
+) []api.EvaluatedExpression {
+	v := reflect.ValueOf(a)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var result []api.EvaluatedExpression
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldValue := v.Field(i)
+		switch {
+		case field.Type == valueExpressionType:
+			result = append(result, evalExpression(
+				field.Name,
+				fieldValue.Interface().(action.ValueExpression),
+				exprCtx,
+			))
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem() == valueExpressionType:
+			for idx := 0; idx < fieldValue.Len(); idx++ {
+				result = append(result, evalExpression(
+					fmt.Sprintf("%s[%d]", field.Name, idx),
+					fieldValue.Index(idx).Interface().(action.ValueExpression),
+					exprCtx,
+				))
+			}
+		}
+	}
+	return result
+}
+
+func evalExpression(
+	fieldName string,
+	expr action.ValueExpression,
+	exprCtx any,
+) api.EvaluatedExpression {
+	value, err := expression.EvalDryRun[string](expr, exprCtx)
+	return api.EvaluatedExpression{
+		Field:      fieldName,
+		Expression: expr,
+		Value:      value,
+		Error:      err,
+	}
+}
@@ -0,0 +1,151 @@
+package streamd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/expression"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/consts"
+)
+
+func TestDryRunAction(t *testing.T) {
+	setVar := func(key, expr string) *action.SetVariable {
+		return &action.SetVariable{Key: consts.VarKey(key), ValueExpression: action.ValueExpression(expr)}
+	}
+	exprCtx := map[string]any{"user": "someone", "viewers": 10}
+
+	type evaluated struct {
+		Field string
+		Value string
+		Error bool
+	}
+	for _, tc := range []struct {
+		name     string
+		action   action.Action
+		expected [][]evaluated
+	}{
+		{
+			name:     "nil",
+			action:   nil,
+			expected: nil,
+		},
+		{
+			name:     "simple",
+			action:   setVar("last", "{{ .user }}"),
+			expected: [][]evaluated{{{Field: "ValueExpression", Value: "someone"}}},
+		},
+		{
+			name: "sequence",
+			action: &action.Sequence{Steps: []action.Step{
+				{Action: setVar("a", "{{ .user }}")},
+				{Action: &action.Parallel{Steps: []action.Step{
+					{Action: setVar("b", "{{ .viewers }}")},
+					{Action: &action.Noop{}},
+				}}},
+			}},
+			expected: [][]evaluated{
+				{{Field: "ValueExpression", Value: "someone"}},
+				{{Field: "ValueExpression", Value: "10"}},
+				nil,
+			},
+		},
+		{
+			name: "if_then",
+			action: &action.If{
+				Condition: `{{ gt .viewers 5 }}`,
+				Then:      setVar("big", "yes"),
+				Else:      setVar("big", "no"),
+			},
+			expected: [][]evaluated{
+				{{Field: "Condition", Value: "true"}},
+				{{Field: "ValueExpression", Value: "yes"}},
+			},
+		},
+		{
+			name: "if_else",
+			action: &action.If{
+				Condition: `{{ gt .viewers 50 }}`,
+				Then:      setVar("big", "yes"),
+				Else:      setVar("big", "no"),
+			},
+			expected: [][]evaluated{
+				{{Field: "Condition", Value: "false"}},
+				{{Field: "ValueExpression", Value: "no"}},
+			},
+		},
+		{
+			name: "if_error",
+			action: &action.If{
+				Condition: `{{ .viewers.nope }}`,
+				Then:      setVar("big", "yes"),
+			},
+			expected: [][]evaluated{
+				{{Field: "Condition", Error: true}},
+			},
+		},
+		{
+			name: "slice",
+			action: &action.RunCommand{
+				Command: "/bin/true",
+				Args:    []action.ValueExpression{"x", "{{ .user }}"},
+			},
+			expected: [][]evaluated{{
+				{Field: "Args[0]", Value: "x"},
+				{Field: "Args[1]", Value: "someone"},
+			}},
+		},
+		{
+			name:     "network",
+			action:   setVar("remote", `{{ httpGET "http://example.com/" }}`),
+			expected: [][]evaluated{{{Field: "ValueExpression", Error: true}}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var result [][]evaluated
+			for _, a := range dryRunAction(tc.action, exprCtx) {
+				var exprs []evaluated
+				for _, expr := range a.Expressions {
+					exprs = append(exprs, evaluated{
+						Field: expr.Field,
+						Value: expr.Value,
+						Error: expr.Error != nil,
+					})
+				}
+				result = append(result, exprs)
+			}
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestDryRunActionNoNetwork(t *testing.T) {
+	var requests atomic.Uint64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer srv.Close()
+
+	result := dryRunAction(&action.If{
+		Condition: action.ValueExpression(fmt.Sprintf(`{{ eq (httpGETIgnoreErrors "%s") "" }}`, srv.URL)),
+		Then:      &action.Noop{},
+	}, nil)
+	// the condition could not be evaluated, so neither branch is walked:
+	require.Len(t, result, 1)
+	require.ErrorAs(t, result[0].Expressions[0].Error, &expression.ErrNotEvaluatedInDryRun{})
+
+	for _, a := range []action.Action{
+		&action.SendChatMessage{MessageExpression: action.ValueExpression(fmt.Sprintf(`{{ httpGET "%s" }}`, srv.URL))},
+		&action.RunCommand{Args: []action.ValueExpression{action.ValueExpression(fmt.Sprintf(`{{ httpGETIgnoreErrors "%s" }}`, srv.URL))}},
+	} {
+		result := dryRunAction(a, nil)
+		require.Len(t, result, 1)
+		require.Len(t, result[0].Expressions, 1)
+		require.ErrorAs(t, result[0].Expressions[0].Error, &expression.ErrNotEvaluatedInDryRun{})
+	}
+	require.Zero(t, requests.Load())
+}
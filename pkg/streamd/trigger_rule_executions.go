@@ -0,0 +1,53 @@
+package streamd
+
+import (
+	"context"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/xsync"
+)
+
+const triggerRuleExecutionsLimit = 100
+
+// triggerRuleExecutions is a ring buffer of the latest executions
+// of trigger rules.
+type triggerRuleExecutions struct {
+	locker xsync.Mutex
+	items  []api.TriggerRuleExecution
+	next   int
+}
+
+func (h *triggerRuleExecutions) add(
+	ctx context.Context,
+	item api.TriggerRuleExecution,
+) {
+	h.locker.Do(ctx, func() {
+		if len(h.items) < triggerRuleExecutionsLimit {
+			h.items = append(h.items, item)
+			return
+		}
+		h.items[h.next] = item
+		h.next = (h.next + 1) % len(h.items)
+	})
+}
+
+// list returns the executions from the oldest to the newest.
+func (h *triggerRuleExecutions) list(
+	ctx context.Context,
+) []api.TriggerRuleExecution {
+	return xsync.DoR1(ctx, &h.locker, func() []api.TriggerRuleExecution {
+		result := make([]api.TriggerRuleExecution, 0, len(h.items))
+		result = append(result, h.items[h.next:]...)
+		result = append(result, h.items[:h.next]...)
+		return result
+	})
+}
+
+func (d *StreamD) ListTriggerRuleExecutions(
+	ctx context.Context,
+) ([]api.TriggerRuleExecution, error) {
+	logger.Debugf(ctx, "ListTriggerRuleExecutions(ctx)")
+	defer logger.Debugf(ctx, "/ListTriggerRuleExecutions(ctx)")
+	return d.triggerRuleHistory.list(ctx), nil
+}
@@ -0,0 +1,36 @@
+package streamd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+)
+
+func TestTriggerRuleExecutions(t *testing.T) {
+	ctx := context.Background()
+	for _, count := range []int{
+		0,
+		1,
+		triggerRuleExecutionsLimit - 1,
+		triggerRuleExecutionsLimit,
+		triggerRuleExecutionsLimit + 1,
+		2*triggerRuleExecutionsLimit + 7,
+	} {
+		t.Run(fmt.Sprint(count), func(t *testing.T) {
+			var h triggerRuleExecutions
+			for i := 0; i < count; i++ {
+				h.add(ctx, api.TriggerRuleExecution{RuleID: api.TriggerRuleID(i)})
+			}
+
+			list := h.list(ctx)
+			require.Len(t, list, min(count, triggerRuleExecutionsLimit))
+			// from the oldest to the newest, without the overwritten ones:
+			for idx, item := range list {
+				require.Equal(t, api.TriggerRuleID(count-len(list)+idx), item.RuleID)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package streamd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/nicklaw5/helix/v2"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol/twitch"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config/event"
+	"github.com/xaionaro-go/xsync"
+)
+
+func (d *StreamD) withTwitch(
+	ctx context.Context,
+	method string,
+	callback func(context.Context, *twitch.Twitch) error,
+) error {
+	return xsync.RDoR1(ctx, &d.ControllersLocker, func() (_err error) {
+		t := d.StreamControllers.Twitch
+		if t == nil {
+			return fmt.Errorf("twitch controller is not initialized")
+		}
+		defer func(startedAt time.Time) {
+			d.metrics.observePlatformCall(twitch.ID, method, startedAt, _err)
+		}(time.Now())
+		return callback(d.ctxForController(ctx), t)
+	})
+}
+
+func (d *StreamD) TwitchCreateStreamMarker(
+	ctx context.Context,
+	description string,
+) (_ret *api.TwitchStreamMarker, _err error) {
+	logger.Debugf(ctx, "TwitchCreateStreamMarker(ctx, '%s')", description)
+	defer func() { logger.Debugf(ctx, "/TwitchCreateStreamMarker(ctx, '%s'): %v %v", description, _ret, _err) }()
+
+	err := d.withTwitch(ctx, "CreateStreamMarker", func(ctx context.Context, t *twitch.Twitch) error {
+		var err error
+		_ret, err = t.CreateStreamMarker(ctx, description)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a stream marker on Twitch: %w", err)
+	}
+	return _ret, nil
+}
+
+func (d *StreamD) TwitchGetAdSchedule(
+	ctx context.Context,
+) (_ret *api.TwitchAdSchedule, _err error) {
+	logger.Debugf(ctx, "TwitchGetAdSchedule(ctx)")
+	defer func() { logger.Debugf(ctx, "/TwitchGetAdSchedule(ctx): %v %v", _ret, _err) }()
+
+	err := d.withTwitch(ctx, "GetAdSchedule", func(ctx context.Context, t *twitch.Twitch) error {
+		var err error
+		_ret, err = t.GetAdSchedule(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the ad schedule from Twitch: %w", err)
+	}
+	return _ret, nil
+}
+
+// startListeningForTwitchEventSub submits the events received through
+// Twitch EventSub (follows, subscriptions, raids, etc).
+func (d *StreamD) startListeningForTwitchEventSub(
+	ctx context.Context,
+) error {
+	logger.Debugf(ctx, "startListeningForTwitchEventSub")
+	var ch <-chan twitch.EventSubNotification
+	err := d.withTwitch(ctx, "GetEventSubChan", func(_ context.Context, t *twitch.Twitch) error {
+		var err error
+		ch, err = t.GetEventSubChan(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start receiving EventSub events: %w", err)
+	}
+	observability.Go(ctx, func() {
+		defer logger.Debugf(ctx, "/startListeningForTwitchEventSub")
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case notification, ok := <-ch:
+				if !ok {
+					return
+				}
+				ev := twitchEventSubToEvent(notification)
+				if ev == nil {
+					logger.Debugf(ctx, "no event for EventSub notification '%s'", notification.Type)
+					continue
+				}
+				if err := d.SubmitEvent(ctx, ev); err != nil {
+					logger.Errorf(ctx, "unable to submit the %T event: %v", ev, err)
+				}
+			}
+		}
+	})
+	return nil
+}
+
+func twitchEventSubToEvent(
+	notification twitch.EventSubNotification,
+) event.Event {
+	switch ev := notification.Event.(type) {
+	case *helix.EventSubChannelFollowEvent:
+		return &event.TwitchFollow{
+			UserID:   ptr(streamcontrol.ChatUserID(ev.UserID)),
+			Username: ptr(ev.UserName),
+		}
+	case *helix.EventSubChannelSubscribeEvent:
+		return &event.TwitchSubscription{
+			UserID:   ptr(streamcontrol.ChatUserID(ev.UserID)),
+			Username: ptr(ev.UserName),
+			Tier:     ptr(ev.Tier),
+			IsGift:   ptr(ev.IsGift),
+		}
+	case *helix.EventSubChannelSubscriptionMessageEvent:
+		return &event.TwitchSubscription{
+			UserID:           ptr(streamcontrol.ChatUserID(ev.UserID)),
+			Username:         ptr(ev.UserName),
+			Tier:             ptr(ev.Tier),
+			IsGift:           ptr(false),
+			CumulativeMonths: ptr(uint64(ev.CumulativeMonths)),
+			Message:          ptr(ev.Message.Text),
+		}
+	case *helix.EventSubChannelSubscriptionGiftEvent:
+		result := &event.TwitchSubscriptionGift{
+			Tier:        ptr(ev.Tier),
+			Total:       ptr(uint64(ev.Total)),
+			IsAnonymous: ptr(ev.IsAnonymous),
+		}
+		if !ev.IsAnonymous {
+			result.UserID = ptr(streamcontrol.ChatUserID(ev.UserID))
+			result.Username = ptr(ev.UserName)
+		}
+		return result
+	case *helix.EventSubChannelCheerEvent:
+		result := &event.TwitchCheer{
+			IsAnonymous: ptr(ev.IsAnonymous),
+			Bits:        ptr(uint64(ev.Bits)),
+			Message:     ptr(ev.Message),
+		}
+		if !ev.IsAnonymous {
+			result.UserID = ptr(streamcontrol.ChatUserID(ev.UserID))
+			result.Username = ptr(ev.UserName)
+		}
+		return result
+	case *helix.EventSubChannelRaidEvent:
+		return &event.TwitchRaid{
+			FromUserID:   ptr(streamcontrol.ChatUserID(ev.FromBroadcasterUserID)),
+			FromUsername: ptr(ev.FromBroadcasterUserName),
+			Viewers:      ptr(uint64(ev.Viewers)),
+		}
+	case *helix.EventSubChannelPointsCustomRewardRedemptionEvent:
+		return &event.TwitchChannelPointsRedemption{
+			UserID:      ptr(streamcontrol.ChatUserID(ev.UserID)),
+			Username:    ptr(ev.UserName),
+			RewardID:    ptr(ev.Reward.ID),
+			RewardTitle: ptr(ev.Reward.Title),
+			RewardCost:  ptr(uint64(ev.Reward.Cost)),
+			UserInput:   ptr(ev.UserInput),
+		}
+	case *helix.EventSubChannelPollBeginEvent:
+		return &event.TwitchPoll{
+			PollID: ptr(ev.ID),
+			Title:  ptr(ev.Title),
+			Stage:  ptr("begin"),
+		}
+	case *helix.EventSubChannelPollEndEvent:
+		result := &event.TwitchPoll{
+			PollID: ptr(ev.ID),
+			Title:  ptr(ev.Title),
+			Stage:  ptr("end"),
+		}
+		var winner *helix.PollChoice
+		for idx := range ev.Choices {
+			choice := &ev.Choices[idx]
+			if winner == nil || choice.Votes > winner.Votes {
+				winner = choice
+			}
+		}
+		if winner != nil {
+			result.WinningChoice = ptr(winner.Title)
+		}
+		return result
+	case *helix.EventSubChannelPredictionBeginEvent:
+		return &event.TwitchPrediction{
+			PredictionID: ptr(ev.ID),
+			Title:        ptr(ev.Title),
+			Stage:        ptr("begin"),
+		}
+	case *helix.EventSubChannelPredictionLockEvent:
+		return &event.TwitchPrediction{
+			PredictionID: ptr(ev.ID),
+			Title:        ptr(ev.Title),
+			Stage:        ptr("lock"),
+		}
+	case *helix.EventSubChannelPredictionEndEvent:
+		result := &event.TwitchPrediction{
+			PredictionID: ptr(ev.ID),
+			Title:        ptr(ev.Title),
+			Stage:        ptr("end"),
+		}
+		for _, outcome := range ev.Outcomes {
+			if outcome.ID == ev.WinningOutcomeID {
+				result.WinningOutcome = ptr(outcome.Title)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
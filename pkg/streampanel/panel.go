@@ -513,7 +513,7 @@ func (p *Panel) startOAuthListenerForRemoteStreamD(
 					continue
 				}
 
-				if err := p.openBrowser(ctx, req.GetAuthURL(), "It is required to confirm access in Twitch/YouTube using browser"); err != nil {
+				if err := p.openBrowser(ctx, req.GetAuthURL(), "It is required to confirm access in Twitch/Kick/YouTube using browser"); err != nil {
 					p.DisplayError(
 						fmt.Errorf(
 							"unable to open browser with URL '%s': %w",
@@ -709,7 +709,7 @@ func (p *Panel) oauthHandler(
 		return fmt.Errorf("unable to make a code receiver: %w", err)
 	}
 
-	if err := p.openBrowser(ctx, arg.AuthURL, "It is required to confirm access in Twitch/YouTube using browser"); err != nil {
+	if err := p.openBrowser(ctx, arg.AuthURL, "It is required to confirm access in Twitch/Kick/YouTube using browser"); err != nil {
 		return fmt.Errorf("unable to open browser with URL '%s': %w", arg.AuthURL, err)
 	}
 
@@ -901,6 +901,8 @@ func (p *Panel) InputTwitchUserInfo(
 	return BackendStatusCodeReady
 }
 
+var kickAppsCreateLink, _ = url.Parse("https://kick.com/settings/developer")
+
 func (p *Panel) InputKickUserInfo(
 	ctx context.Context,
 	cfg *streamcontrol.PlatformConfig[kick.PlatformSpecificConfig, kick.StreamProfile],
@@ -912,6 +914,19 @@ func (p *Panel) InputKickUserInfo(
 	channelField.SetPlaceHolder(
 		"channel ID (copy&paste it from the browser: https://kick.com/<the channel ID is here>)",
 	)
+	clientIDField := widget.NewEntry()
+	clientIDField.SetPlaceHolder("client ID")
+	clientSecretField := widget.NewEntry()
+	clientSecretField.SetPlaceHolder("client secret")
+	instructionText := widget.NewRichText(
+		&widget.TextSegment{Text: "To be able to control the channel (not only read it), go to\n", Style: widget.RichTextStyle{Inline: true}},
+		&widget.HyperlinkSegment{Text: kickAppsCreateLink.String(), URL: kickAppsCreateLink},
+		&widget.TextSegment{
+			Text:  `,` + "\n" + `create an application (enter "http://localhost:8091/" as the "Redirect URL" value), and copy&paste client ID and client secret.`,
+			Style: widget.RichTextStyle{Inline: true},
+		},
+	)
+	instructionText.Wrapping = fyne.TextWrapWord
 
 	waitCh := make(chan struct{})
 
@@ -938,6 +953,9 @@ func (p *Panel) InputKickUserInfo(
 		nil,
 		container.NewVBox(
 			channelField,
+			clientIDField,
+			clientSecretField,
+			instructionText,
 		),
 	))
 	w.Show()
@@ -953,6 +971,8 @@ func (p *Panel) InputKickUserInfo(
 
 	channelWords := strings.Split(channelField.Text, "/")
 	cfg.Config.Channel = channelWords[len(channelWords)-1]
+	cfg.Config.ClientID = clientIDField.Text
+	cfg.Config.ClientSecret.Set(clientSecretField.Text)
 
 	return BackendStatusCodeReady
 }
@@ -190,8 +190,7 @@ func (p *Panel) profileWindow(
 	}
 	_ = backendData[obs.ID].(api.BackendDataOBS)
 	dataTwitch := backendData[twitch.ID].(api.BackendDataTwitch)
-	dataKick := backendData[kick.ID].(api.BackendDataKick)
-	_ = dataKick // TODO: delete me!
+	_ = backendData[kick.ID].(api.BackendDataKick)
 	dataYouTube := backendData[youtube.ID].(api.BackendDataYouTube)
 
 	var bottomContent []fyne.CanvasObject
@@ -331,8 +330,62 @@ func (p *Panel) profileWindow(
 
 	bottomContent = append(bottomContent, widget.NewSeparator())
 	bottomContent = append(bottomContent, widget.NewRichTextFromMarkdown("# Kick:"))
+	var getKickTags func() []string
 	if backendEnabled[kick.ID] {
-		bottomContent = append(bottomContent, widget.NewLabel("Kick configuration is not implemented, yet"))
+		kickTags := []string{}
+		if platProfile := values.PerPlatform[kick.ID]; platProfile != nil {
+			var err error
+			kickProfile, err = streamcontrol.GetStreamProfile[kick.StreamProfile](ctx, platProfile)
+			if err != nil {
+				p.DisplayError(fmt.Errorf("unable to convert the stream profile: %w", err))
+			}
+			kickTags = append(kickTags, kickProfile.Tags...)
+		} else {
+			kickProfile = &kick.StreamProfile{}
+		}
+
+		kickCategory := widget.NewEntry()
+		kickCategory.SetPlaceHolder("kick category")
+		if kickProfile.CategoryName != nil {
+			kickCategory.SetText(*kickProfile.CategoryName)
+		}
+		kickCategory.OnChanged = func(text string) {
+			if text == "" {
+				kickProfile.CategoryName = nil
+				return
+			}
+			kickProfile.CategoryName = &text
+			kickProfile.CategoryID = nil
+		}
+		bottomContent = append(bottomContent, kickCategory)
+
+		kickLanguage := widget.NewEntry()
+		kickLanguage.SetPlaceHolder("language (e.g.: English)")
+		if kickProfile.Language != nil {
+			kickLanguage.SetText(*kickProfile.Language)
+		}
+		kickLanguage.OnChanged = func(text string) {
+			if text == "" {
+				kickProfile.Language = nil
+				return
+			}
+			kickProfile.Language = &text
+		}
+		bottomContent = append(bottomContent, kickLanguage)
+
+		kickIsMature := widget.NewCheck("Mature content", nil)
+		kickIsMature.SetChecked(kickProfile.IsMature != nil && *kickProfile.IsMature)
+		// attaching the handler only after the initial value is set, so
+		// that opening the editor does not turn "unset" into "false":
+		kickIsMature.OnChanged = func(b bool) {
+			kickProfile.IsMature = &b
+		}
+		bottomContent = append(bottomContent, kickIsMature)
+
+		kickTagsEditor := newTagsEditor(kickTags, 10)
+		bottomContent = append(bottomContent, widget.NewLabel("Tags:"))
+		bottomContent = append(bottomContent, kickTagsEditor.CanvasObject)
+		getKickTags = kickTagsEditor.GetTags
 	} else {
 		bottomContent = append(bottomContent, widget.NewLabel("Kick is disabled"))
 	}
@@ -542,6 +595,9 @@ func (p *Panel) profileWindow(
 				profile.PerPlatform[twitch.ID] = twitchProfile
 			}
 			if kickProfile != nil {
+				if getKickTags != nil {
+					kickProfile.Tags = sanitizeTags(getKickTags())
+				}
 				profile.PerPlatform[kick.ID] = kickProfile
 			}
 			if youtubeProfile != nil {
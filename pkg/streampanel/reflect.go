@@ -3,12 +3,14 @@ package streampanel
 import (
 	"fmt"
 	"reflect"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/streamctl/pkg/serializable"
+	"github.com/xaionaro-go/streamctl/pkg/serializable/registry"
 )
 
 func makeFieldsFor(
@@ -48,6 +50,12 @@ func reflectMakeFieldsFor(
 		setter = func(v reflect.Value) {}
 	}
 
+	if t == reflect.TypeOf(time.Duration(0)) {
+		return []fyne.CanvasObject{newReflectDurationField(v, func(d time.Duration) {
+			setter(reflect.ValueOf(d))
+		}, namePrefix)}
+	}
+
 	switch t.Kind() {
 	case reflect.String:
 		return []fyne.CanvasObject{newReflectField(v, func(s string) {
@@ -61,6 +69,38 @@ func reflectMakeFieldsFor(
 		return []fyne.CanvasObject{newReflectField(v, func(i bool) {
 			setter(reflect.ValueOf(i).Convert(t))
 		}, namePrefix)}
+	case reflect.Float64:
+		return []fyne.CanvasObject{newReflectField(v, func(f float64) {
+			setter(reflect.ValueOf(f).Convert(t))
+		}, namePrefix)}
+	case reflect.Slice:
+		container := container.NewVBox()
+		var refreshContent func()
+		refreshContent = func() {
+			container.RemoveAll()
+			for idx := 0; idx < v.Len(); idx++ {
+				idx := idx
+				item := v.Index(idx)
+				container.Add(widget.NewButton(fmt.Sprintf("Remove %s[%d]", namePrefix, idx), func() {
+					v.Set(reflect.AppendSlice(v.Slice(0, idx), v.Slice(idx+1, v.Len())))
+					setter(v)
+					refreshContent()
+				}))
+				for _, field := range reflectMakeFieldsFor(item, t.Elem(), func(newValue reflect.Value) {
+					item.Set(newValue)
+					setter(v)
+				}, fmt.Sprintf("%s[%d]", namePrefix, idx)) {
+					container.Add(field)
+				}
+			}
+			container.Add(widget.NewButton(fmt.Sprintf("Add %s", namePrefix), func() {
+				v.Set(reflect.Append(v, reflect.Zero(t.Elem())))
+				setter(v)
+				refreshContent()
+			}))
+		}
+		refreshContent()
+		return []fyne.CanvasObject{container}
 	case reflect.Ptr:
 		return reflectMakeFieldsFor(v.Elem(), t.Elem(), func(newValue reflect.Value) {
 			if newValue.IsZero() && v.CanAddr() {
@@ -113,6 +153,13 @@ func reflectMakeFieldsFor(
 		}
 		l.Debugf("options == %v", options)
 
+		var curOption string
+		if v.IsValid() && !v.IsNil() {
+			curValue := v.Elem().Interface()
+			curOption = registry.ToTypeName(curValue)
+			values[curOption] = curValue
+		}
+
 		container := container.NewVBox()
 		var selector *widget.Select
 		refreshContent := func() {
@@ -133,7 +180,10 @@ func reflectMakeFieldsFor(
 		selector = widget.NewSelect(options, func(s string) {
 			refreshContent()
 		})
-		if len(options) > 0 {
+		switch {
+		case curOption != "":
+			selector.SetSelected(curOption)
+		case len(options) > 0:
 			selector.SetSelected(options[0])
 		}
 		return []fyne.CanvasObject{container}
@@ -158,6 +208,26 @@ func newReflectField[T any](
 	return result
 }
 
+func newReflectDurationField(
+	curValue reflect.Value,
+	setter func(time.Duration),
+	fieldName string,
+) fyne.CanvasObject {
+	result := widget.NewEntry()
+	if curValue.IsValid() {
+		result.SetText(curValue.Interface().(time.Duration).String())
+	}
+	result.SetPlaceHolder(fieldName + " (for example: 5s)")
+	result.OnChanged = func(s string) {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return
+		}
+		setter(d)
+	}
+	return result
+}
+
 func from[T any](in string) T {
 	var result T
 	if in == "" {
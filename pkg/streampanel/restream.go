@@ -19,6 +19,10 @@ import (
 	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/player/pkg/player"
 	"github.com/xaionaro-go/streamctl/pkg/consts"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol/kick"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol/twitch"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol/youtube"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/api"
 	sptypes "github.com/xaionaro-go/streamctl/pkg/streamplayer/types"
 	sstypes "github.com/xaionaro-go/streamctl/pkg/streamserver/types"
@@ -158,13 +162,64 @@ func (p *Panel) openAddStreamServerWindow(ctx context.Context) {
 	w := p.app.NewWindow(consts.AppName + ": Add Stream Server")
 	resizeWindow(w, fyne.NewSize(400, 300))
 
+	srtPassphraseEntry := widget.NewPasswordEntry()
+	srtPassphraseEntry.SetPlaceHolder("SRT passphrase (optional, 10-79 characters)")
+	srtSettings := container.NewVBox(
+		srtPassphraseEntry,
+	)
+	srtSettings.Hide()
+
+	hlsSegmentDurationEntry := xfyne.NewNumericalEntry()
+	hlsSegmentDurationEntry.SetPlaceHolder("HLS segment duration in milliseconds (optional)")
+	hlsSegmentCountEntry := xfyne.NewNumericalEntry()
+	hlsSegmentCountEntry.SetPlaceHolder("HLS segments in a playlist (optional)")
+	hlsTempDirectoryEntry := widget.NewEntry()
+	hlsTempDirectoryEntry.SetPlaceHolder("directory to keep the live HLS segments in instead of RAM (optional, not a recording)")
+	hlsSettings := container.NewVBox(
+		hlsSegmentDurationEntry,
+		hlsSegmentCountEntry,
+		hlsTempDirectoryEntry,
+	)
+	hlsSettings.Hide()
+
+	webRTCICEUDPListenAddrEntry := widget.NewEntry()
+	webRTCICEUDPListenAddrEntry.SetPlaceHolder("ICE UDP listen address, ex.: 0.0.0.0:8189 (optional)")
+	webRTCICETCPListenAddrEntry := widget.NewEntry()
+	webRTCICETCPListenAddrEntry.SetPlaceHolder("ICE TCP listen address, ex.: 0.0.0.0:8189 (optional)")
+	webRTCAdditionalHostsEntry := widget.NewEntry()
+	webRTCAdditionalHostsEntry.SetPlaceHolder("additional hosts to advertise, comma-separated (optional)")
+	webRTCSettings := container.NewVBox(
+		webRTCICEUDPListenAddrEntry,
+		webRTCICETCPListenAddrEntry,
+		webRTCAdditionalHostsEntry,
+	)
+	webRTCSettings.Hide()
+
 	currentProtocol := streamtypes.ServerTypeRTMP
 	protocolSelectLabel := widget.NewLabel("Protocol:")
 	protocolSelect := widget.NewSelect([]string{
 		ptr(streamtypes.ServerTypeRTMP).String(),
 		ptr(streamtypes.ServerTypeRTSP).String(),
+		ptr(streamtypes.ServerTypeSRT).String(),
+		ptr(streamtypes.ServerTypeHLS).String(),
+		ptr(streamtypes.ServerTypeWebRTC).String(),
 	}, func(s string) {
 		currentProtocol = streamtypes.ParseServerType(s)
+		if currentProtocol == streamtypes.ServerTypeSRT {
+			srtSettings.Show()
+		} else {
+			srtSettings.Hide()
+		}
+		if currentProtocol == streamtypes.ServerTypeHLS {
+			hlsSettings.Show()
+		} else {
+			hlsSettings.Hide()
+		}
+		if currentProtocol == streamtypes.ServerTypeWebRTC {
+			webRTCSettings.Show()
+		} else {
+			webRTCSettings.Hide()
+		}
 	})
 	protocolSelect.SetSelected(currentProtocol.String())
 
@@ -194,7 +249,45 @@ func (p *Panel) openAddStreamServerWindow(ctx context.Context) {
 
 	saveButton := widget.NewButtonWithIcon("Save", theme.DocumentSaveIcon(), func() {
 		listenHost := listenHostEntry.Text
-		err := p.addStreamServer(ctx, currentProtocol, listenHost, listenPort, enableTLS)
+		var opts streamportserver.Options
+		if currentProtocol == streamtypes.ServerTypeSRT {
+			opts = append(opts, streamportserver.OptionSRTPassphrase(srtPassphraseEntry.Text))
+		}
+		if currentProtocol == streamtypes.ServerTypeHLS {
+			if hlsSegmentDurationEntry.Text != "" {
+				durationMS, err := strconv.ParseUint(hlsSegmentDurationEntry.Text, 10, 32)
+				if err != nil {
+					p.DisplayError(fmt.Errorf("unable to parse the HLS segment duration '%s': %w", hlsSegmentDurationEntry.Text, err))
+					return
+				}
+				opts = append(opts, streamportserver.OptionHLSSegmentDuration(time.Duration(durationMS)*time.Millisecond))
+			}
+			if hlsSegmentCountEntry.Text != "" {
+				count, err := strconv.ParseUint(hlsSegmentCountEntry.Text, 10, 32)
+				if err != nil {
+					p.DisplayError(fmt.Errorf("unable to parse the HLS segment count '%s': %w", hlsSegmentCountEntry.Text, err))
+					return
+				}
+				opts = append(opts, streamportserver.OptionHLSSegmentCount(count))
+			}
+			opts = append(opts, streamportserver.OptionHLSTempDirectory(hlsTempDirectoryEntry.Text))
+		}
+		if currentProtocol == streamtypes.ServerTypeWebRTC {
+			var additionalHosts []string
+			for _, host := range strings.Split(webRTCAdditionalHostsEntry.Text, ",") {
+				host = strings.TrimSpace(host)
+				if host == "" {
+					continue
+				}
+				additionalHosts = append(additionalHosts, host)
+			}
+			opts = append(opts,
+				streamportserver.OptionWebRTCICEUDPListenAddr(webRTCICEUDPListenAddrEntry.Text),
+				streamportserver.OptionWebRTCICETCPListenAddr(webRTCICETCPListenAddrEntry.Text),
+				streamportserver.OptionWebRTCAdditionalHosts(additionalHosts),
+			)
+		}
+		err := p.addStreamServer(ctx, currentProtocol, listenHost, listenPort, enableTLS, opts...)
 		if err != nil {
 			p.DisplayError(err)
 			return
@@ -216,6 +309,9 @@ func (p *Panel) openAddStreamServerWindow(ctx context.Context) {
 			listenHostEntry,
 			listenPortEntry,
 			enableTLSCheckbox,
+			srtSettings,
+			hlsSettings,
+			webRTCSettings,
 		),
 	))
 	w.Show()
@@ -227,11 +323,13 @@ func (p *Panel) addStreamServer(
 	listenHost string,
 	listenPort uint16,
 	enableTLS bool,
+	extraOpts ...streamportserver.Option,
 ) error {
 	logger.Debugf(ctx, "addStreamServer")
 	defer logger.Debugf(ctx, "/addStreamServer")
 	var opts streamportserver.Options
 	opts = append(opts, streamportserver.OptionIsTLS(enableTLS))
+	opts = append(opts, extraOpts...)
 	return p.StreamD.StartStreamServer(
 		ctx,
 		proto,
@@ -353,6 +451,35 @@ func bwString(
 	return fmt.Sprintf("%s | %s", nReadStr, nWroteStr)
 }
 
+// streamForwardHealthString describes the health metrics of a stream
+// forwarding, which are not covered by bwString.
+func streamForwardHealthString(stats api.StreamForwardStats) string {
+	var parts []string
+	if stats.HasFrameStats {
+		parts = append(parts, fmt.Sprintf("%.1f fps", stats.FrameRate))
+		if stats.KeyframeInterval > 0 {
+			parts = append(parts, fmt.Sprintf("GOP %.1fs", stats.KeyframeInterval.Seconds()))
+		}
+		if stats.DroppedPackets > 0 || stats.LatePackets > 0 {
+			parts = append(parts, fmt.Sprintf("dropped %d, late %d", stats.DroppedPackets, stats.LatePackets))
+		}
+	}
+	if stats.Uptime > 0 {
+		parts = append(parts, "up "+stats.Uptime.Truncate(time.Second).String())
+	}
+	if stats.Reconnects > 0 {
+		parts = append(parts, fmt.Sprintf("reconnects: %d", stats.Reconnects))
+	}
+	if stats.LastError != "" {
+		parts = append(parts, fmt.Sprintf(
+			"last error (%s): %s",
+			stats.LastErrorAt.Format(time.TimeOnly),
+			stats.LastError,
+		))
+	}
+	return strings.Join(parts, " | ")
+}
+
 func (p *Panel) openAddStreamWindow(ctx context.Context) {
 	w := p.app.NewWindow(consts.AppName + ": Add incoming stream")
 	resizeWindow(w, fyne.NewSize(400, 300))
@@ -979,7 +1106,8 @@ func (p *Panel) openAddRestreamWindow(ctx context.Context) {
 		sstypes.ForwardingConfig{
 			Disabled: false,
 			Quirks: sstypes.ForwardingQuirks{
-				RestartUntilYoutubeRecognizesStream: sstypes.DefaultRestartUntilYoutubeRecognizesStreamConfig(),
+				RestartUntilPlatformRecognizesStream: sstypes.DefaultRestartUntilPlatformRecognizesStreamConfig(),
+				StartAfterPlatformRecognizedStream:   sstypes.DefaultStartAfterPlatformRecognizedStreamConfig(),
 			},
 		},
 		p.addStreamForward,
@@ -1058,87 +1186,131 @@ func (p *Panel) openAddOrEditRestreamWindow(
 		dstSelect.Disable()
 	}
 
-	var restartUntilYouTubeStarts *widget.Check
+	recognizingPlatformCaptionByDestinationURL := "<detect by the destination URL>"
+	recognizingPlatformCaptions := []string{
+		string(youtube.ID),
+		string(twitch.ID),
+		string(kick.ID),
+		recognizingPlatformCaptionByDestinationURL,
+	}
+	recognizingPlatform := fwd.Quirks.RestartUntilPlatformRecognizesStream.RecognizingPlatform
+	if fwd.Quirks.StartAfterPlatformRecognizedStream.Enabled {
+		recognizingPlatform = fwd.Quirks.StartAfterPlatformRecognizedStream.RecognizingPlatform
+	}
+	recognizingPlatformSelect := widget.NewSelect(recognizingPlatformCaptions, func(s string) {
+		if s == recognizingPlatformCaptionByDestinationURL {
+			recognizingPlatform = sstypes.RecognizingPlatform{ByDestinationURL: true}
+			return
+		}
+		recognizingPlatform = sstypes.RecognizingPlatform{PlatformID: streamcontrol.PlatformName(s)}
+	})
+	if recognizingPlatform.ByDestinationURL {
+		recognizingPlatformSelect.SetSelected(recognizingPlatformCaptionByDestinationURL)
+	} else {
+		recognizingPlatformSelect.SetSelected(string(recognizingPlatform.GetPlatformID()))
+	}
+
+	var restartUntilPlatformStarts *widget.Check
 
-	quirksStartAfterYoutube := fwd.Quirks.StartAfterYoutubeRecognizedStream
-	startAfterYoutubeCheckbox := widget.NewCheck(
-		"Start this stream only after YouTube recognized a stream",
+	quirksStartAfterPlatform := fwd.Quirks.StartAfterPlatformRecognizedStream
+	startAfterPlatformTimeout := xfyne.NewNumericalEntry()
+	startAfterPlatformTimeout.SetText(fmt.Sprintf("%v", quirksStartAfterPlatform.Timeout.Seconds()))
+	startAfterPlatformTimeout.OnChanged = func(s string) {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			p.DisplayError(err)
+			return
+		}
+		quirksStartAfterPlatform.Timeout = time.Duration(v * float64(time.Second))
+	}
+	startAfterPlatformParams := container.NewVBox(
+		widget.NewLabel("Start anyway after (seconds, 0 means never):"),
+		startAfterPlatformTimeout,
+	)
+	startAfterPlatformParams.Hide()
+
+	startAfterPlatformCheckbox := widget.NewCheck(
+		"Start this stream only after the platform recognized a stream",
 		func(b bool) {
-			quirksStartAfterYoutube.Enabled = b
+			quirksStartAfterPlatform.Enabled = b
 			if b {
-				restartUntilYouTubeStarts.SetChecked(false)
-				restartUntilYouTubeStarts.Disable()
+				startAfterPlatformParams.Show()
+				restartUntilPlatformStarts.SetChecked(false)
+				restartUntilPlatformStarts.Disable()
 			} else {
-				restartUntilYouTubeStarts.Enable()
+				startAfterPlatformParams.Hide()
+				restartUntilPlatformStarts.Enable()
 			}
 		},
 	)
 
-	quirksYoutubeRestart := fwd.Quirks.RestartUntilYoutubeRecognizesStream
+	quirksPlatformRestart := fwd.Quirks.RestartUntilPlatformRecognizesStream
 
-	if !quirksYoutubeRestart.Enabled {
-		if quirksYoutubeRestart.StartTimeout == 0 {
-			quirksYoutubeRestart.StartTimeout = sstypes.DefaultRestartUntilYoutubeRecognizesStreamConfig().StartTimeout
+	if !quirksPlatformRestart.Enabled {
+		if quirksPlatformRestart.StartTimeout == 0 {
+			quirksPlatformRestart.StartTimeout = sstypes.DefaultRestartUntilPlatformRecognizesStreamConfig().StartTimeout
 		}
-		if quirksYoutubeRestart.StopStartDelay == 0 {
-			quirksYoutubeRestart.StopStartDelay = sstypes.DefaultRestartUntilYoutubeRecognizesStreamConfig().StopStartDelay
+		if quirksPlatformRestart.StopStartDelay == 0 {
+			quirksPlatformRestart.StopStartDelay = sstypes.DefaultRestartUntilPlatformRecognizesStreamConfig().StopStartDelay
 		}
 	}
 
-	youtubeStartTimeout := xfyne.NewNumericalEntry()
-	youtubeStartTimeout.SetText(fmt.Sprintf("%v", quirksYoutubeRestart.StartTimeout.Seconds()))
-	youtubeStartTimeout.OnChanged = func(s string) {
+	platformStartTimeout := xfyne.NewNumericalEntry()
+	platformStartTimeout.SetText(fmt.Sprintf("%v", quirksPlatformRestart.StartTimeout.Seconds()))
+	platformStartTimeout.OnChanged = func(s string) {
 		v, err := strconv.ParseFloat(s, 64)
 		if err != nil {
 			p.DisplayError(err)
 			return
 		}
-		quirksYoutubeRestart.StartTimeout = time.Duration(v * float64(time.Second))
+		quirksPlatformRestart.StartTimeout = time.Duration(v * float64(time.Second))
 	}
-	youtubeStopStartDelay := xfyne.NewNumericalEntry()
-	youtubeStopStartDelay.SetText(fmt.Sprintf("%v", quirksYoutubeRestart.StopStartDelay.Seconds()))
-	youtubeStopStartDelay.OnChanged = func(s string) {
+	platformStopStartDelay := xfyne.NewNumericalEntry()
+	platformStopStartDelay.SetText(fmt.Sprintf("%v", quirksPlatformRestart.StopStartDelay.Seconds()))
+	platformStopStartDelay.OnChanged = func(s string) {
 		v, err := strconv.ParseFloat(s, 64)
 		if err != nil {
 			p.DisplayError(err)
 			return
 		}
-		quirksYoutubeRestart.StopStartDelay = time.Duration(v * float64(time.Second))
+		quirksPlatformRestart.StopStartDelay = time.Duration(v * float64(time.Second))
 	}
-	restartUntilYouTubeStartsParams := container.NewVBox(
+	restartUntilPlatformStartsParams := container.NewVBox(
 		widget.NewLabel("Wait until try restarting (seconds):"),
-		youtubeStartTimeout,
+		platformStartTimeout,
 		widget.NewLabel("Delay between stopping and starting (seconds):"),
-		youtubeStopStartDelay,
+		platformStopStartDelay,
 	)
-	restartUntilYouTubeStartsParams.Hide()
+	restartUntilPlatformStartsParams.Hide()
 
-	restartUntilYouTubeStarts = widget.NewCheck(
-		"Restart until YouTube recognizes the stream",
+	restartUntilPlatformStarts = widget.NewCheck(
+		"Restart until the platform recognizes the stream",
 		func(b bool) {
-			quirksYoutubeRestart.Enabled = b
+			quirksPlatformRestart.Enabled = b
 			if b {
-				restartUntilYouTubeStartsParams.Show()
-				startAfterYoutubeCheckbox.SetChecked(false)
-				startAfterYoutubeCheckbox.Disable()
+				restartUntilPlatformStartsParams.Show()
+				startAfterPlatformCheckbox.SetChecked(false)
+				startAfterPlatformCheckbox.Disable()
 			} else {
-				restartUntilYouTubeStartsParams.Hide()
-				startAfterYoutubeCheckbox.Enable()
+				restartUntilPlatformStartsParams.Hide()
+				startAfterPlatformCheckbox.Enable()
 			}
 		},
 	)
-	startAfterYoutubeCheckbox.SetChecked(quirksStartAfterYoutube.Enabled)
-	restartUntilYouTubeStarts.SetChecked(quirksYoutubeRestart.Enabled)
+	startAfterPlatformCheckbox.SetChecked(quirksStartAfterPlatform.Enabled)
+	restartUntilPlatformStarts.SetChecked(quirksPlatformRestart.Enabled)
 
 	saveButton := widget.NewButtonWithIcon("Save", theme.DocumentSaveIcon(), func() {
+		quirksPlatformRestart.RecognizingPlatform = recognizingPlatform
+		quirksStartAfterPlatform.RecognizingPlatform = recognizingPlatform
 		err := addOrEditStreamForward(
 			ctx,
 			streamtypes.StreamID(inStreamsSelect.Selected),
 			dstMapCaption2ID[dstSelect.Selected],
 			enabledCheck.Checked,
 			sstypes.ForwardingQuirks{
-				RestartUntilYoutubeRecognizesStream: quirksYoutubeRestart,
-				StartAfterYoutubeRecognizedStream:   quirksStartAfterYoutube,
+				RestartUntilPlatformRecognizesStream: quirksPlatformRestart,
+				StartAfterPlatformRecognizedStream:   quirksStartAfterPlatform,
 			},
 		)
 		if err != nil {
@@ -1161,9 +1333,12 @@ func (p *Panel) openAddOrEditRestreamWindow(
 			widget.NewSeparator(),
 			widget.NewSeparator(),
 			widget.NewRichTextFromMarkdown("## Quirks"),
-			startAfterYoutubeCheckbox,
-			restartUntilYouTubeStarts,
-			restartUntilYouTubeStartsParams,
+			widget.NewLabel("Platform to check if it recognized the stream:"),
+			recognizingPlatformSelect,
+			startAfterPlatformCheckbox,
+			startAfterPlatformParams,
+			restartUntilPlatformStarts,
+			restartUntilPlatformStartsParams,
 		),
 	))
 	w.Show()
@@ -1299,11 +1474,11 @@ func (p *Panel) displayStreamForwards(
 		})
 		captionStr := string(fwd.StreamID) + " -> " + string(fwd.DestinationID)
 		var quirksStrings []string
-		if fwd.Quirks.RestartUntilYoutubeRecognizesStream.Enabled {
-			quirksStrings = append(quirksStrings, "YT-restart")
+		if q := fwd.Quirks.RestartUntilPlatformRecognizesStream; q.Enabled {
+			quirksStrings = append(quirksStrings, fmt.Sprintf("restart-until-%s", q.RecognizingPlatform))
 		}
-		if fwd.Quirks.StartAfterYoutubeRecognizedStream.Enabled {
-			quirksStrings = append(quirksStrings, "after-YT")
+		if q := fwd.Quirks.StartAfterPlatformRecognizedStream; q.Enabled {
+			quirksStrings = append(quirksStrings, fmt.Sprintf("after-%s", q.RecognizingPlatform))
 		}
 		if len(quirksStrings) != 0 {
 			captionStr += fmt.Sprintf(" (%s)", strings.Join(quirksStrings, ","))
@@ -1339,6 +1514,10 @@ func (p *Panel) displayStreamForwards(
 				p.previousNumBytesTS[key] = now
 				c.Add(bwText)
 			})
+			if healthStr := streamForwardHealthString(fwd.Stats); healthStr != "" {
+				c.Add(widget.NewSeparator())
+				c.Add(widget.NewLabel(healthStr))
+			}
 		}
 		objs = append(objs, c)
 	}
@@ -19,6 +19,7 @@ import (
 	obs "github.com/xaionaro-go/streamctl/pkg/streamcontrol/obs/types"
 	twitch "github.com/xaionaro-go/streamctl/pkg/streamcontrol/twitch/types"
 	youtube "github.com/xaionaro-go/streamctl/pkg/streamcontrol/youtube/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/config"
 	"github.com/xaionaro-go/streamctl/pkg/streamd/config/action"
 	"github.com/xaionaro-go/xcontext"
 	"github.com/xaionaro-go/xfyne/widget"
@@ -131,6 +132,9 @@ func (ui *timersUI) refreshFromRemote(
 
 	var triggerAt time.Time
 	for _, timer := range timers {
+		if timer.Schedule.IsRecurring() {
+			continue
+		}
 		switch timer.Action.(type) {
 		case *action.Noop:
 			continue
@@ -256,7 +260,9 @@ func (ui *timersUI) kickOffRemotely(
 	} {
 		_, err := streamD.AddTimer(ctx, deadline, &action.EndStream{
 			PlatID: platID,
-		})
+		}, config.TimerMissedDeadlinePolicy{
+			Type: config.TimerMissedDeadlinePolicyTypeFireImmediately,
+		}, config.TimerSchedule{})
 		if err != nil {
 			result = fmt.Errorf("unable to start a timer for platform '%s': %w", platID, err)
 			break
@@ -384,6 +390,10 @@ func (ui *timersUI) stopRemoteTimer(
 
 	var result *multierror.Error
 	for _, timer := range timers {
+		if timer.Schedule.IsRecurring() {
+			// recurring timers are not managed by this UI
+			continue
+		}
 		err := streamD.RemoveTimer(ctx, timer.ID)
 		if err != nil {
 			if strings.Contains(err.Error(), "not found") {
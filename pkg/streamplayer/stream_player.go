@@ -283,6 +283,7 @@ func (p *StreamPlayerHandler) getInternalURL(ctx context.Context) (*url.URL, err
 		u.Host = "::1"
 	}
 	u.Path = string(p.StreamID)
+	streamportserver.AddInternalCredentials(&u)
 	return &u, nil
 }
 
@@ -296,7 +297,8 @@ func (p *StreamPlayerHandler) openStream(ctx context.Context) (_err error) {
 	if err != nil {
 		return fmt.Errorf("unable to get URL: %w", err)
 	}
-	logger.Debugf(ctx, "opening '%s'", u.String())
+	redactedURL := streamportserver.RedactInternalCredentials(u)
+	logger.Debugf(ctx, "opening '%s'", redactedURL)
 	err = p.withPlayer(ctx, func(ctx context.Context, player player.Player) {
 		ctx, cancelFn := context.WithTimeout(ctx, openURLTimeout)
 		defer cancelFn()
@@ -304,7 +306,7 @@ func (p *StreamPlayerHandler) openStream(ctx context.Context) (_err error) {
 		observability.Go(ctx, func() {
 			<-ctx.Done()
 			once.Do(func() {
-				logger.Errorf(ctx, "timed out, unable to open the URL '%s' within the timeout of %s", u, openURLTimeout)
+				logger.Errorf(ctx, "timed out, unable to open the URL '%s' within the timeout of %s", redactedURL, openURLTimeout)
 				err := player.Close(xcontext.DetachDone(ctx))
 				logger.Debugf(ctx, "closing timed-out player result: %v", err)
 			})
@@ -315,9 +317,9 @@ func (p *StreamPlayerHandler) openStream(ctx context.Context) (_err error) {
 			err = fmt.Errorf("unable to open the URL: %w", err)
 		}
 	})
-	logger.Debugf(ctx, "opened '%s': %v", u.String(), err)
+	logger.Debugf(ctx, "opened '%s': %v", redactedURL, err)
 	if err != nil {
-		return fmt.Errorf("unable to open '%s' in the player: %w", u.String(), err)
+		return fmt.Errorf("unable to open '%s' in the player: %w", redactedURL, err)
 	}
 
 	return nil
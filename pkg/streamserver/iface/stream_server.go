@@ -87,6 +87,19 @@ type StreamServer interface {
 		dstID types.DestinationID,
 	) error
 
+	StartStreamRecording(
+		ctx context.Context,
+		streamID types.StreamID,
+		cfg types.RecordingConfig,
+	) error
+	StopStreamRecording(
+		ctx context.Context,
+		streamID types.StreamID,
+	) error
+	ListStreamRecordings(
+		ctx context.Context,
+	) ([]types.Recording, error)
+
 	AddStreamPlayer(
 		ctx context.Context,
 		streamID types.StreamID,
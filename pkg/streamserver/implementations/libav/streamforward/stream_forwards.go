@@ -12,5 +12,7 @@ func NewStreamForwards(
 	s StreamServer,
 	platformsController types.PlatformsController,
 ) *StreamForwards {
-	return streamforward.NewStreamForwards(s, libav.NewRecoderFactory(), platformsController)
+	fwds := streamforward.NewStreamForwards(s, libav.NewRecoderFactory(), platformsController)
+	fwds.RelaySources = true
+	return fwds
 }
@@ -0,0 +1,153 @@
+package streamserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bluenviron/gortsplib/v4/pkg/headers"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/mediamtx/pkg/auth"
+	"github.com/xaionaro-go/mediamtx/pkg/conf"
+	"github.com/xaionaro-go/mediamtx/pkg/pathmanager"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+	"github.com/xaionaro-go/xsync"
+)
+
+// authManager checks the credentials of the publishers and readers
+// against the credentials configured per incoming stream.
+//
+// It keeps its own copy of the credentials (see 'SetStreams'), because
+// it is called from the path manager, which may be waited for while
+// holding the lock of the StreamServer.
+type authManager struct {
+	locker    xsync.Mutex
+	streams   map[types.StreamID]*types.StreamConfig
+	notifiers []types.FuncNotifyStreamAuthFail
+}
+
+var _ pathmanager.AuthManager = (*authManager)(nil)
+
+func newAuthManager() *authManager {
+	return &authManager{
+		streams: map[types.StreamID]*types.StreamConfig{},
+	}
+}
+
+func (m *authManager) SetStreams(
+	ctx context.Context,
+	streams map[types.StreamID]*types.StreamConfig,
+) {
+	result := make(map[types.StreamID]*types.StreamConfig, len(streams))
+	for streamID, streamCfg := range streams {
+		if streamCfg == nil {
+			continue
+		}
+		result[streamID] = &types.StreamConfig{
+			PublishAuth: copyStreamCredentials(streamCfg.PublishAuth),
+			ReadAuth:    copyStreamCredentials(streamCfg.ReadAuth),
+		}
+	}
+	m.locker.Do(ctx, func() {
+		m.streams = result
+	})
+}
+
+func copyStreamCredentials(in *types.StreamCredentials) *types.StreamCredentials {
+	if in == nil {
+		return nil
+	}
+	c := *in
+	return &c
+}
+
+func (m *authManager) SetNotifiers(
+	ctx context.Context,
+	notifiers []types.FuncNotifyStreamAuthFail,
+) {
+	m.locker.Do(ctx, func() {
+		m.notifiers = notifiers
+	})
+}
+
+func (m *authManager) Authenticate(req *auth.Request) error {
+	ctx := context.TODO()
+
+	var action types.StreamAuthAction
+	switch req.Action {
+	case conf.AuthActionPublish:
+		action = types.StreamAuthActionPublish
+	case conf.AuthActionRead, conf.AuthActionPlayback:
+		action = types.StreamAuthActionRead
+	default:
+		// we do not serve the API, metrics and pprof through mediamtx
+		return nil
+	}
+
+	// the paths are named by types.StreamID2LocalAppName (see reloadPathConfs)
+	var (
+		streamID    types.StreamID
+		credentials *types.StreamCredentials
+		notifiers   []types.FuncNotifyStreamAuthFail
+	)
+	m.locker.Do(ctx, func() {
+		streamID, credentials = types.StreamCredentialsFor(m.streams, types.AppKey(req.Path), action)
+		notifiers = m.notifiers
+	})
+	if credentials == nil {
+		return nil
+	}
+
+	user, pass, query := requestCredentials(req)
+	if streamportserver.HasInternalCredentials(query) {
+		// the stream forwards, the renditions and the stream players
+		return nil
+	}
+	if credentials.Check(user, pass, query) {
+		return nil
+	}
+
+	remoteAddr := req.IP.String()
+	logger.Warnf(
+		ctx,
+		"unable to authenticate a %s request for stream '%s' from %s (protocol: %s)",
+		action, streamID, remoteAddr, req.Protocol,
+	)
+	for _, notifier := range notifiers {
+		notifier(ctx, streamID, action, remoteAddr)
+	}
+	return &auth.Error{
+		Message:        fmt.Sprintf("invalid credentials for %s of '%s'", action, streamID),
+		AskCredentials: user == "" && pass == "",
+	}
+}
+
+// requestCredentials extracts the credentials the same way the
+// original mediamtx authentication manager does.
+func requestCredentials(req *auth.Request) (string, string, string) {
+	user, pass, query := req.User, req.Pass, req.Query
+
+	switch {
+	case req.RTSPRequest != nil:
+		var rtspAuthHeader headers.Authorization
+		err := rtspAuthHeader.Unmarshal(req.RTSPRequest.Header["Authorization"])
+		if err == nil && rtspAuthHeader.Method == headers.AuthMethodBasic {
+			user = rtspAuthHeader.BasicUser
+			pass = rtspAuthHeader.BasicPass
+		}
+	case req.HTTPRequest != nil:
+		user, pass, _ = req.HTTPRequest.BasicAuth()
+		query = req.HTTPRequest.URL.RawQuery
+		if h := req.HTTPRequest.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+			token := strings.TrimPrefix(h, "Bearer ")
+			if parts := strings.Split(token, ":"); len(parts) == 2 {
+				user, pass = parts[0], parts[1]
+			} else {
+				user, pass = "", token
+			}
+		}
+	}
+
+	return user, pass, query
+}
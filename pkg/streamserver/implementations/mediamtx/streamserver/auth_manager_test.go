@@ -0,0 +1,71 @@
+package streamserver
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/mediamtx/pkg/auth"
+	"github.com/xaionaro-go/mediamtx/pkg/conf"
+	"github.com/xaionaro-go/streamctl/pkg/secret"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+)
+
+func TestAuthManager(t *testing.T) {
+	ctx := context.Background()
+
+	var failures []types.StreamID
+	m := newAuthManager()
+	m.SetNotifiers(ctx, []types.FuncNotifyStreamAuthFail{
+		func(ctx context.Context, streamID types.StreamID, action types.StreamAuthAction, remoteAddr string) {
+			failures = append(failures, streamID)
+		},
+	})
+	m.SetStreams(ctx, map[types.StreamID]*types.StreamConfig{
+		"live/main": {
+			PublishAuth: &types.StreamCredentials{StreamKey: secret.New("publish")},
+			ReadAuth:    &types.StreamCredentials{StreamKey: secret.New("read")},
+		},
+		"open": {},
+	})
+
+	internalURL := &url.URL{}
+	streamportserver.AddInternalCredentials(internalURL)
+
+	for _, testCase := range []struct {
+		action  conf.AuthAction
+		path    string
+		query   string
+		isValid bool
+	}{
+		// the mediamtx paths are named by the local app name of the stream
+		{conf.AuthActionPublish, "main", "", false},
+		{conf.AuthActionPublish, "main", "key=read", false},
+		{conf.AuthActionPublish, "main", "key=publish", true},
+		{conf.AuthActionRead, "main", "", false},
+		{conf.AuthActionRead, "main", "key=publish", false},
+		{conf.AuthActionRead, "main", "key=read", true},
+		{conf.AuthActionPlayback, "main", "key=read", true},
+		{conf.AuthActionRead, "main", internalURL.RawQuery, true},
+		{conf.AuthActionPublish, "main", internalURL.RawQuery, true},
+		{conf.AuthActionRead, "open", "", true},
+		{conf.AuthActionPublish, "unknown", "", true},
+		{conf.AuthActionAPI, "", "", true},
+	} {
+		err := m.Authenticate(&auth.Request{
+			IP:     net.IPv4(127, 0, 0, 1),
+			Action: testCase.action,
+			Path:   testCase.path,
+			Query:  testCase.query,
+		})
+		if testCase.isValid {
+			require.NoError(t, err, testCase)
+		} else {
+			require.Error(t, err, testCase)
+		}
+	}
+	require.Equal(t, []types.StreamID{"live/main", "live/main", "live/main", "live/main"}, failures)
+}
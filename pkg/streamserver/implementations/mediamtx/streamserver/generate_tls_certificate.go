@@ -1,17 +1,142 @@
 package streamserver
 
 import (
+	"context"
 	"crypto"
 	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"math/big"
+	"os"
 	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/hashicorp/go-multierror"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
 )
 
+// serverTLSCertificateFiles returns the paths to the PEM files with the
+// TLS certificate and key for a mediamtx server (mediamtx accepts only
+// paths). If TLS is enabled, but no certificate is supplied, then
+// an ephemeral self-signed certificate is generated. The paths are empty
+// if there is no certificate.
+//
+// Note! It creates temporary files, that should be removed afterwards
+// (see removeServerTLSCertificateFiles).
+func serverTLSCertificateFiles(
+	cfg streamportserver.Config,
+	filePrefix string,
+) (string, string, error) {
+	if (cfg.ServerCert != nil) != (cfg.ServerKey != nil) {
+		return "", "", fmt.Errorf(
+			"fields 'ServerCert' and 'ServerKey' should be used together (cur values: ServerCert == %#+v; ServerKey == %#+v)",
+			cfg.ServerCert,
+			cfg.ServerKey,
+		)
+	}
+
+	if cfg.ServerCert != nil && cfg.ServerKey != nil {
+		return writeServerTLSCertificate(filePrefix, *cfg.ServerCert, cfg.ServerKey)
+	}
+	if !cfg.IsTLS {
+		return "", "", nil
+	}
+
+	logger.Warnf(
+		context.TODO(),
+		"TLS is enabled, but no certificate is supplied, generating an ephemeral self-signed certificate",
+	) // TODO: implement the support of providing the certificates in the UI
+	certificate, privateKey, err := generateServerTLSCertificate()
+	if err != nil {
+		return "", "", fmt.Errorf("unable to generate the certificate: %w", err)
+	}
+	certFile, keyFile, err := writeServerTLSCertificate(filePrefix, *certificate, privateKey)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to set the TLS certificate to an ephemeral one: %w", err)
+	}
+	return certFile, keyFile, nil
+}
+
+func writeServerTLSCertificate(
+	filePrefix string,
+	cert x509.Certificate,
+	key crypto.PrivateKey,
+) (_certFile string, _keyFile string, _err error) {
+	var certFile, keyFile *os.File
+	defer func() {
+		if _err != nil {
+			if certFile != nil {
+				os.Remove(certFile.Name())
+			}
+			if keyFile != nil {
+				os.Remove(keyFile.Name())
+			}
+		}
+	}()
+
+	var err error
+	certFile, err = os.CreateTemp("", filePrefix+"-server-cert-*.pem")
+	if err != nil {
+		return "", "", fmt.Errorf("unable to create a temporary file for a server certificate: %w", err)
+	}
+	defer certFile.Close()
+
+	certPEM := &pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: cert.Raw,
+	}
+	if err := pem.Encode(certFile, certPEM); err != nil {
+		return "", "", fmt.Errorf(
+			"unable to write the server certificate to file '%s' in PEM format: %w",
+			certFile.Name(),
+			err,
+		)
+	}
+
+	keyFile, err = os.CreateTemp("", filePrefix+"-server-certkey-*.pem")
+	if err != nil {
+		return "", "", fmt.Errorf("unable to create a temporary file for a server certificate: %w", err)
+	}
+	defer keyFile.Close()
+
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to serialize into PKCS8 the private key: %w", err)
+	}
+
+	privatePem := &pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: keyBytes,
+	}
+	if err := pem.Encode(keyFile, privatePem); err != nil {
+		return "", "", fmt.Errorf(
+			"unable to write the server key to file '%s' in PEM format: %w",
+			keyFile.Name(),
+			err,
+		)
+	}
+
+	return certFile.Name(), keyFile.Name(), nil
+}
+
+func removeServerTLSCertificateFiles(
+	certFile string,
+	keyFile string,
+) error {
+	var result *multierror.Error
+	if certFile != "" {
+		result = multierror.Append(result, os.Remove(certFile))
+	}
+	if keyFile != "" {
+		result = multierror.Append(result, os.Remove(keyFile))
+	}
+	return result.ErrorOrNil()
+}
+
 func generateServerTLSCertificate() (*x509.Certificate, crypto.PrivateKey, error) {
 	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
 	if err != nil {
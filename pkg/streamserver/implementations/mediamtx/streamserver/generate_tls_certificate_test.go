@@ -0,0 +1,42 @@
+package streamserver
+
+import (
+	"crypto/tls"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+)
+
+func TestServerTLSCertificateFiles(t *testing.T) {
+	certFile, keyFile, err := serverTLSCertificateFiles(streamportserver.Config{}, "test")
+	require.NoError(t, err)
+	require.Empty(t, certFile)
+	require.Empty(t, keyFile)
+
+	cert, key, err := generateServerTLSCertificate()
+	require.NoError(t, err)
+	_, _, err = serverTLSCertificateFiles(streamportserver.Config{
+		ProtocolSpecificConfig: streamportserver.ProtocolSpecificConfig{ServerCert: cert},
+	}, "test")
+	require.Error(t, err)
+
+	for _, psCfg := range []streamportserver.ProtocolSpecificConfig{
+		{IsTLS: true},
+		{IsTLS: true, ServerCert: cert, ServerKey: key},
+	} {
+		certFile, keyFile, err := serverTLSCertificateFiles(streamportserver.Config{
+			ProtocolSpecificConfig: psCfg,
+		}, "test")
+		require.NoError(t, err)
+		_, err = tls.LoadX509KeyPair(certFile, keyFile)
+		require.NoError(t, err)
+
+		require.NoError(t, removeServerTLSCertificateFiles(certFile, keyFile))
+		_, err = os.Stat(certFile)
+		require.True(t, os.IsNotExist(err))
+		_, err = os.Stat(keyFile)
+		require.True(t, os.IsNotExist(err))
+	}
+}
@@ -0,0 +1,158 @@
+package streamserver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bluenviron/gohlslib/v2"
+	"github.com/xaionaro-go/mediamtx/pkg/conf"
+	mediamtxlogger "github.com/xaionaro-go/mediamtx/pkg/logger"
+	"github.com/xaionaro-go/mediamtx/pkg/pathmanager"
+	"github.com/xaionaro-go/mediamtx/pkg/servers/hls"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	hlsDefaultSegmentCount    = 7
+	hlsDefaultSegmentDuration = time.Second
+	hlsDefaultPartDuration    = 200 * time.Millisecond
+	hlsSegmentMaxSize         = 50 * 1024 * 1024
+	hlsMuxerCloseAfter        = 60 * time.Second
+)
+
+// HLSServer serves the incoming streams as low-latency HLS playlists,
+// available at "http(s)://<listenAddr>/<streamID>/index.m3u8".
+type HLSServer struct {
+	*hls.Server
+
+	locker         xsync.Mutex
+	originalConfig streamportserver.Config
+	isInitialized  bool
+}
+
+func newHLSServer(
+	pathManager *pathmanager.PathManager,
+	listenAddr string,
+	logger mediamtxlogger.Writer,
+	opts ...streamportserver.Option,
+) (*HLSServer, error) {
+	psCfg := streamportserver.Options(opts).ProtocolSpecificConfig(context.Background())
+
+	segmentCount := int(psCfg.HLSSegmentCount)
+	if segmentCount == 0 {
+		segmentCount = hlsDefaultSegmentCount
+	}
+	segmentDuration := psCfg.HLSSegmentDuration
+	if segmentDuration == 0 {
+		segmentDuration = hlsDefaultSegmentDuration
+	}
+	partDuration := min(hlsDefaultPartDuration, segmentDuration)
+
+	srv := &HLSServer{
+		originalConfig: streamportserver.Config{
+			ProtocolSpecificConfig: psCfg,
+			Type:                   streamtypes.ServerTypeHLS,
+			ListenAddr:             listenAddr,
+		},
+		Server: &hls.Server{
+			Address:         listenAddr,
+			Encryption:      psCfg.IsTLS,
+			ServerKey:       "",
+			ServerCert:      "",
+			AllowOrigin:     "*",
+			TrustedProxies:  nil,
+			AlwaysRemux:     psCfg.HLSTempDirectory != "",
+			Variant:         conf.HLSVariant(gohlslib.MuxerVariantLowLatency),
+			SegmentCount:    segmentCount,
+			SegmentDuration: conf.StringDuration(segmentDuration),
+			PartDuration:    conf.StringDuration(partDuration),
+			SegmentMaxSize:  hlsSegmentMaxSize,
+			Directory:       psCfg.HLSTempDirectory,
+			ReadTimeout:     conf.StringDuration(psCfg.ReadTimeout),
+			MuxerCloseAfter: conf.StringDuration(hlsMuxerCloseAfter),
+			PathManager:     pathManager,
+			Parent:          logger,
+		},
+	}
+	if err := srv.init(srv.originalConfig); err != nil {
+		return nil, err
+	}
+	return srv, nil
+}
+
+func (srv *HLSServer) init(
+	cfg streamportserver.Config,
+) (_err error) {
+	defer func() {
+		if _err != nil {
+			_ = srv.Close()
+		}
+	}()
+	certFile, keyFile, err := serverTLSCertificateFiles(cfg, "hlss")
+	if err != nil {
+		return fmt.Errorf("unable to set the TLS certificate: %w", err)
+	}
+	srv.ServerCert, srv.ServerKey = certFile, keyFile
+
+	if cfg.HLSTempDirectory != "" {
+		if err := os.MkdirAll(cfg.HLSTempDirectory, 0o755); err != nil {
+			return fmt.Errorf("unable to create the directory '%s': %w", cfg.HLSTempDirectory, err)
+		}
+	}
+
+	if err := srv.Initialize(); err != nil {
+		return fmt.Errorf("Initialize() returned an error: %w", err)
+	}
+	srv.isInitialized = true
+
+	return nil
+}
+
+var _ streamportserver.Server = (*HLSServer)(nil)
+
+func (srv *HLSServer) ProtocolSpecificConfig() streamportserver.ProtocolSpecificConfig {
+	return srv.originalConfig.ProtocolSpecificConfig
+}
+
+func (srv *HLSServer) Close() error {
+	ctx := context.TODO()
+	return xsync.DoR1(ctx, &srv.locker, func() error {
+		if srv.Server == nil {
+			return fmt.Errorf("already closed")
+		}
+		certFile := srv.ServerCert
+		keyFile := srv.ServerKey
+
+		if srv.isInitialized {
+			srv.Server.Close()
+		}
+		srv.Server = nil
+
+		return removeServerTLSCertificateFiles(certFile, keyFile)
+	})
+}
+func (srv *HLSServer) Type() streamtypes.ServerType {
+	return streamtypes.ServerTypeHLS
+}
+func (srv *HLSServer) ListenAddr() string {
+	return srv.originalConfig.ListenAddr
+}
+func (srv *HLSServer) NumBytesConsumerWrote() uint64 {
+	// HLS is a playback-only protocol, nobody publishes through it.
+	return 0
+}
+func (srv *HLSServer) NumBytesProducerRead() uint64 {
+	result := uint64(0)
+	list, err := srv.Server.APIMuxersList()
+	if err != nil {
+		panic(err)
+	}
+	for _, item := range list.Items {
+		result += item.BytesSent
+	}
+	return result
+}
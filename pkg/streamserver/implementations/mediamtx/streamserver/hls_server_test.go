@@ -0,0 +1,106 @@
+package streamserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+)
+
+func freeTCPAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func startTestHLSServer(
+	ctx context.Context,
+	t *testing.T,
+	opts ...streamportserver.Option,
+) string {
+	pathManager := newTestPathManager(t, "main")
+
+	srtListenAddr := freeUDPAddr(t)
+	srtServer, err := newSRTServer(
+		pathManager,
+		srtListenAddr,
+		newMediamtxLogger(logger.FromCtx(ctx)),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { srtServer.Close() })
+
+	hlsListenAddr := freeTCPAddr(t)
+	hlsServer, err := newHLSServer(
+		pathManager,
+		hlsListenAddr,
+		newMediamtxLogger(logger.FromCtx(ctx)),
+		append([]streamportserver.Option{
+			streamportserver.OptionHLSSegmentDuration(200 * time.Millisecond),
+		}, opts...)...,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { hlsServer.Close() })
+	pathManager.SetHLSServer(hlsServer.Server)
+
+	publishTestH264(ctx, t, srtListenAddr, "main", "")
+	return hlsListenAddr
+}
+
+func TestHLSServerPlaylist(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	listenAddr := startTestHLSServer(ctx, t)
+
+	playlistURL := fmt.Sprintf("http://%s/main/index.m3u8", listenAddr)
+	require.Eventually(t, func() bool {
+		resp, err := http.Get(playlistURL)
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return false
+		}
+		return resp.StatusCode == http.StatusOK && strings.HasPrefix(string(b), "#EXTM3U")
+	}, 10*time.Second, 100*time.Millisecond)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/unknown/index.m3u8", listenAddr))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestHLSServerTempDirectory(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	dir := filepath.Join(t.TempDir(), "hls")
+	startTestHLSServer(ctx, t, streamportserver.OptionHLSTempDirectory(dir))
+
+	// the segments are written without any reader requesting the stream
+	require.Eventually(t, func() bool {
+		entries, err := os.ReadDir(filepath.Join(dir, "main"))
+		if err != nil {
+			return false
+		}
+		for _, entry := range entries {
+			if strings.HasSuffix(entry.Name(), ".mp4") {
+				return true
+			}
+		}
+		return false
+	}, 10*time.Second, 100*time.Millisecond)
+}
@@ -2,14 +2,8 @@ package streamserver
 
 import (
 	"context"
-	"crypto"
-	"crypto/x509"
-	"encoding/pem"
 	"fmt"
-	"os"
 
-	"github.com/facebookincubator/go-belt/tool/logger"
-	"github.com/hashicorp/go-multierror"
 	"github.com/xaionaro-go/mediamtx/pkg/conf"
 	mediamtxlogger "github.com/xaionaro-go/mediamtx/pkg/logger"
 	"github.com/xaionaro-go/mediamtx/pkg/pathmanager"
@@ -70,31 +64,11 @@ func (srv *RTMPServer) init(
 			_ = srv.Close()
 		}
 	}()
-	if (cfg.ServerCert != nil) != (cfg.ServerKey != nil) {
-		return fmt.Errorf(
-			"fields 'ServerCert' and 'ServerKey' should be used together (cur values: ServerCert == %#+v; ServerKey == %#+v)",
-			cfg.ServerCert,
-			cfg.ServerKey,
-		)
-	}
-
-	if cfg.ServerCert != nil && cfg.ServerKey != nil {
-		err := srv.setServerCertificate(*cfg.ServerCert, cfg.ServerKey)
-		if err != nil {
-			return fmt.Errorf("unable to set the TLS certificate: %w", err)
-		}
-	}
-	if cfg.IsTLS && (srv.ServerCert == "" || srv.ServerKey == "") {
-		ctx := context.TODO()
-		logger.Warnf(
-			ctx,
-			"TLS is enabled, but no certificate is supplied, generating an ephemeral self-signed certificate",
-		) // TODO: implement the support of providing the certificates in the UI
-		err := srv.generateServerCertificate()
-		if err != nil {
-			return fmt.Errorf("unable to set the TLS certificate: %w", err)
-		}
+	certFile, keyFile, err := serverTLSCertificateFiles(cfg, "rtmps")
+	if err != nil {
+		return fmt.Errorf("unable to set the TLS certificate: %w", err)
 	}
+	srv.ServerCert, srv.ServerKey = certFile, keyFile
 
 	if err := srv.Initialize(); err != nil {
 		return fmt.Errorf("Initialize() returned an error: %w", err)
@@ -104,85 +78,6 @@ func (srv *RTMPServer) init(
 	return nil
 }
 
-// Note! It create temporary files that should be cleaned up afterwards.
-func (srv *RTMPServer) generateServerCertificate() (_err error) {
-	certificate, privateKey, err := generateServerTLSCertificate()
-	if err != nil {
-		return fmt.Errorf("unable to generate the certificate: %w", err)
-	}
-
-	err = srv.setServerCertificate(*certificate, privateKey)
-	if err != nil {
-		return fmt.Errorf("unable to set the TLS certificate to an ephemeral one: %w", err)
-	}
-
-	return nil
-}
-
-// Note! It create temporary files that should be cleaned up afterwards.
-func (srv *RTMPServer) setServerCertificate(
-	cert x509.Certificate,
-	key crypto.PrivateKey,
-) (_err error) {
-	var certFile, keyFile *os.File
-	defer func() {
-		if _err != nil {
-			if certFile != nil {
-				os.Remove(certFile.Name())
-			}
-			if keyFile != nil {
-				os.Remove(keyFile.Name())
-			}
-		}
-	}()
-
-	var err error
-	certFile, err = os.CreateTemp("", "rtmps-server-cert-*.pem")
-	if err != nil {
-		return fmt.Errorf("unable to create a temporary file for a server certificate: %w", err)
-	}
-	defer certFile.Close()
-
-	certPEM := &pem.Block{
-		Type:  "CERTIFICATE",
-		Bytes: cert.Raw,
-	}
-	if err := pem.Encode(certFile, certPEM); err != nil {
-		return fmt.Errorf(
-			"unable to write the server certificate to file '%s' in PEM format: %w",
-			certFile.Name(),
-			err,
-		)
-	}
-
-	keyFile, err = os.CreateTemp("", "rtmps-server-certkey-*.pem")
-	if err != nil {
-		return fmt.Errorf("unable to create a temporary file for a server certificate: %w", err)
-	}
-	defer keyFile.Close()
-
-	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
-	if err != nil {
-		return fmt.Errorf("unable to serialize into PKCS8 the private key: %w", err)
-	}
-
-	privatePem := &pem.Block{
-		Type:  "PRIVATE KEY",
-		Bytes: keyBytes,
-	}
-	if err := pem.Encode(keyFile, privatePem); err != nil {
-		return fmt.Errorf(
-			"unable to write the server certificate to file '%s' in PEM format: %w",
-			certFile.Name(),
-			err,
-		)
-	}
-
-	srv.ServerCert = certFile.Name()
-	srv.ServerKey = keyFile.Name()
-	return nil
-}
-
 var _ streamportserver.Server = (*RTMPServer)(nil)
 
 func (srv *RTMPServer) ProtocolSpecificConfig() streamportserver.ProtocolSpecificConfig {
@@ -203,14 +98,7 @@ func (srv *RTMPServer) Close() error {
 		}
 		srv.Server = nil
 
-		var result *multierror.Error
-		if certFile != "" {
-			result = multierror.Append(result, os.Remove(certFile))
-		}
-		if keyFile != "" {
-			result = multierror.Append(result, os.Remove(keyFile))
-		}
-		return result.ErrorOrNil()
+		return removeServerTLSCertificateFiles(certFile, keyFile)
 	})
 }
 func (srv *RTMPServer) Type() streamtypes.ServerType {
@@ -2,16 +2,10 @@ package streamserver
 
 import (
 	"context"
-	"crypto"
-	"crypto/x509"
-	"encoding/pem"
 	"fmt"
-	"os"
 
 	"github.com/bluenviron/gortsplib/v4"
 	"github.com/bluenviron/gortsplib/v4/pkg/auth"
-	"github.com/facebookincubator/go-belt/tool/logger"
-	"github.com/hashicorp/go-multierror"
 	"github.com/xaionaro-go/mediamtx/pkg/conf"
 	mediamtxlogger "github.com/xaionaro-go/mediamtx/pkg/logger"
 	"github.com/xaionaro-go/mediamtx/pkg/pathmanager"
@@ -39,7 +33,7 @@ func newRTSPServer(
 	srv := &RTSPServer{
 		Server: &rtsp.Server{
 			Address:           listenAddr,
-			AuthMethods:       []auth.ValidateMethod{},
+			AuthMethods:       []auth.ValidateMethod{auth.ValidateMethodBasic},
 			ReadTimeout:       conf.StringDuration(psCfg.ReadTimeout),
 			WriteTimeout:      conf.StringDuration(psCfg.WriteTimeout),
 			WriteQueueSize:    int(psCfg.WriteQueueSize),
@@ -86,31 +80,11 @@ func (srv *RTSPServer) init(
 			_ = srv.Close()
 		}
 	}()
-	if (cfg.ServerCert != nil) != (cfg.ServerKey != nil) {
-		return fmt.Errorf(
-			"fields 'ServerCert' and 'ServerKey' should be used together (cur values: ServerCert == %#+v; ServerKey == %#+v)",
-			cfg.ServerCert,
-			cfg.ServerKey,
-		)
-	}
-
-	if cfg.ServerCert != nil && cfg.ServerKey != nil {
-		err := srv.setServerCertificate(*cfg.ServerCert, cfg.ServerKey)
-		if err != nil {
-			return fmt.Errorf("unable to set the TLS certificate: %w", err)
-		}
-	}
-	if cfg.IsTLS && (srv.ServerCert == "" || srv.ServerKey == "") {
-		ctx := context.TODO()
-		logger.Warnf(
-			ctx,
-			"TLS is enabled, but no certificate is supplied, generating an ephemeral self-signed certificate",
-		) // TODO: implement the support of providing the certificates in the UI
-		err := srv.generateServerCertificate()
-		if err != nil {
-			return fmt.Errorf("unable to set the TLS certificate: %w", err)
-		}
+	certFile, keyFile, err := serverTLSCertificateFiles(cfg, "rtsps")
+	if err != nil {
+		return fmt.Errorf("unable to set the TLS certificate: %w", err)
 	}
+	srv.ServerCert, srv.ServerKey = certFile, keyFile
 
 	if err := srv.Initialize(); err != nil {
 		return fmt.Errorf("Initialize() returned an error: %w", err)
@@ -120,85 +94,6 @@ func (srv *RTSPServer) init(
 	return nil
 }
 
-// Note! It create temporary files that should be cleaned up afterwards.
-func (srv *RTSPServer) generateServerCertificate() (_err error) {
-	certificate, privateKey, err := generateServerTLSCertificate()
-	if err != nil {
-		return fmt.Errorf("unable to generate the certificate: %w", err)
-	}
-
-	err = srv.setServerCertificate(*certificate, privateKey)
-	if err != nil {
-		return fmt.Errorf("unable to set the TLS certificate to an ephemeral one: %w", err)
-	}
-
-	return nil
-}
-
-// Note! It create temporary files that should be cleaned up afterwards.
-func (srv *RTSPServer) setServerCertificate(
-	cert x509.Certificate,
-	key crypto.PrivateKey,
-) (_err error) {
-	var certFile, keyFile *os.File
-	defer func() {
-		if _err != nil {
-			if certFile != nil {
-				os.Remove(certFile.Name())
-			}
-			if keyFile != nil {
-				os.Remove(keyFile.Name())
-			}
-		}
-	}()
-
-	var err error
-	certFile, err = os.CreateTemp("", "rtsps-server-cert-*.pem")
-	if err != nil {
-		return fmt.Errorf("unable to create a temporary file for a server certificate: %w", err)
-	}
-	defer certFile.Close()
-
-	certPEM := &pem.Block{
-		Type:  "CERTIFICATE",
-		Bytes: cert.Raw,
-	}
-	if err := pem.Encode(certFile, certPEM); err != nil {
-		return fmt.Errorf(
-			"unable to write the server certificate to file '%s' in PEM format: %w",
-			certFile.Name(),
-			err,
-		)
-	}
-
-	keyFile, err = os.CreateTemp("", "rtsps-server-certkey-*.pem")
-	if err != nil {
-		return fmt.Errorf("unable to create a temporary file for a server certificate: %w", err)
-	}
-	defer keyFile.Close()
-
-	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
-	if err != nil {
-		return fmt.Errorf("unable to serialize into PKCS8 the private key: %w", err)
-	}
-
-	privatePem := &pem.Block{
-		Type:  "PRIVATE KEY",
-		Bytes: keyBytes,
-	}
-	if err := pem.Encode(keyFile, privatePem); err != nil {
-		return fmt.Errorf(
-			"unable to write the server certificate to file '%s' in PEM format: %w",
-			certFile.Name(),
-			err,
-		)
-	}
-
-	srv.ServerCert = certFile.Name()
-	srv.ServerKey = keyFile.Name()
-	return nil
-}
-
 var _ streamportserver.Server = (*RTSPServer)(nil)
 
 func (srv *RTSPServer) ProtocolSpecificConfig() streamportserver.ProtocolSpecificConfig {
@@ -219,14 +114,7 @@ func (srv *RTSPServer) Close() error {
 		}
 		srv.Server = nil
 
-		var result *multierror.Error
-		if certFile != "" {
-			result = multierror.Append(result, os.Remove(certFile))
-		}
-		if keyFile != "" {
-			result = multierror.Append(result, os.Remove(keyFile))
-		}
-		return result.ErrorOrNil()
+		return removeServerTLSCertificateFiles(certFile, keyFile)
 	})
 }
 func (srv *RTSPServer) Type() streamtypes.ServerType {
@@ -0,0 +1,179 @@
+package streamserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xaionaro-go/mediamtx/pkg/conf"
+	"github.com/xaionaro-go/mediamtx/pkg/defs"
+	mediamtxlogger "github.com/xaionaro-go/mediamtx/pkg/logger"
+	"github.com/xaionaro-go/mediamtx/pkg/pathmanager"
+	"github.com/xaionaro-go/mediamtx/pkg/servers/srt"
+	"github.com/xaionaro-go/mediamtx/pkg/stream"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	srtUDPMaxPayloadSize = 1472
+	srtPassphraseMinLen  = 10
+	srtPassphraseMaxLen  = 79
+)
+
+type SRTServer struct {
+	*srt.Server
+
+	locker         xsync.Mutex
+	originalConfig streamportserver.Config
+	isInitialized  bool
+}
+
+func newSRTServer(
+	pathManager *pathmanager.PathManager,
+	listenAddr string,
+	logger mediamtxlogger.Writer,
+	opts ...streamportserver.Option,
+) (*SRTServer, error) {
+	psCfg := streamportserver.Options(opts).ProtocolSpecificConfig(context.Background())
+	srv := &SRTServer{
+		originalConfig: streamportserver.Config{
+			ProtocolSpecificConfig: psCfg,
+			Type:                   streamtypes.ServerTypeSRT,
+			ListenAddr:             listenAddr,
+		},
+		Server: &srt.Server{
+			Address:             listenAddr,
+			RTSPAddress:         "",
+			ReadTimeout:         conf.StringDuration(psCfg.ReadTimeout),
+			WriteTimeout:        conf.StringDuration(psCfg.WriteTimeout),
+			UDPMaxPayloadSize:   srtUDPMaxPayloadSize,
+			RunOnConnect:        "",
+			RunOnConnectRestart: false,
+			RunOnDisconnect:     "",
+			ExternalCmdPool:     nil,
+			PathManager: &srtPathManager{
+				PathManager: pathManager,
+				Passphrase:  psCfg.SRTPassphrase.Get(),
+			},
+			Parent: logger,
+		},
+	}
+	if err := srv.init(srv.originalConfig); err != nil {
+		return nil, err
+	}
+	return srv, nil
+}
+
+func (srv *SRTServer) init(
+	cfg streamportserver.Config,
+) (_err error) {
+	defer func() {
+		if _err != nil {
+			_ = srv.Close()
+		}
+	}()
+
+	if cfg.IsTLS {
+		return fmt.Errorf("TLS is not supported for SRT, use a passphrase instead")
+	}
+	if passphrase := cfg.SRTPassphrase.Get(); passphrase != "" {
+		if l := len(passphrase); l < srtPassphraseMinLen || l > srtPassphraseMaxLen {
+			return fmt.Errorf(
+				"the SRT passphrase should be from %d to %d characters long, but it is %d characters long",
+				srtPassphraseMinLen, srtPassphraseMaxLen, l,
+			)
+		}
+	}
+	// The latency is intentionally not configurable: srt.Server of mediamtx
+	// has no latency setting and listens with the default config of gosrt.
+	if err := srv.Initialize(); err != nil {
+		return fmt.Errorf("Initialize() returned an error: %w", err)
+	}
+	srv.isInitialized = true
+
+	return nil
+}
+
+var _ streamportserver.Server = (*SRTServer)(nil)
+
+func (srv *SRTServer) ProtocolSpecificConfig() streamportserver.ProtocolSpecificConfig {
+	return srv.originalConfig.ProtocolSpecificConfig
+}
+
+func (srv *SRTServer) Close() error {
+	ctx := context.TODO()
+	return xsync.DoR1(ctx, &srv.locker, func() error {
+		if srv.Server == nil {
+			return fmt.Errorf("already closed")
+		}
+		if srv.isInitialized {
+			srv.Server.Close()
+		}
+		srv.Server = nil
+		return nil
+	})
+}
+func (srv *SRTServer) Type() streamtypes.ServerType {
+	return streamtypes.ServerTypeSRT
+}
+func (srv *SRTServer) ListenAddr() string {
+	return srv.originalConfig.ListenAddr
+}
+func (srv *SRTServer) NumBytesConsumerWrote() uint64 {
+	result := uint64(0)
+	list, err := srv.Server.APIConnsList()
+	if err != nil {
+		panic(err)
+	}
+	for _, item := range list.Items {
+		result += item.BytesReceived
+	}
+	return result
+}
+func (srv *SRTServer) NumBytesProducerRead() uint64 {
+	result := uint64(0)
+	list, err := srv.Server.APIConnsList()
+	if err != nil {
+		panic(err)
+	}
+	for _, item := range list.Items {
+		result += item.BytesSent
+	}
+	return result
+}
+
+// srtPathManager overrides the SRT passphrases of the paths, since
+// mediamtx configures them per path, while we configure them per listener.
+type srtPathManager struct {
+	*pathmanager.PathManager
+	Passphrase string
+}
+
+func (pm *srtPathManager) AddPublisher(req defs.PathAddPublisherReq) (defs.Path, error) {
+	path, err := pm.PathManager.AddPublisher(req)
+	if err != nil {
+		return nil, err
+	}
+	return &srtPath{Path: path, Passphrase: pm.Passphrase}, nil
+}
+
+func (pm *srtPathManager) AddReader(req defs.PathAddReaderReq) (defs.Path, *stream.Stream, error) {
+	path, stream, err := pm.PathManager.AddReader(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &srtPath{Path: path, Passphrase: pm.Passphrase}, stream, nil
+}
+
+type srtPath struct {
+	defs.Path
+	Passphrase string
+}
+
+func (path *srtPath) SafeConf() *conf.Path {
+	cfg := *path.Path.SafeConf()
+	cfg.SRTPublishPassphrase = path.Passphrase
+	cfg.SRTReadPassphrase = path.Passphrase
+	return &cfg
+}
@@ -0,0 +1,164 @@
+package streamserver
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bluenviron/mediacommon/pkg/formats/mpegts"
+	srt "github.com/datarhei/gosrt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/mediamtx/pkg/conf"
+	"github.com/xaionaro-go/mediamtx/pkg/externalcmd"
+	"github.com/xaionaro-go/mediamtx/pkg/pathmanager"
+	"github.com/xaionaro-go/mediamtx/pkg/test"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+)
+
+const testSRTPassphrase = "0123456789abcdef"
+
+func newTestPathManager(
+	t *testing.T,
+	pathNames ...string,
+) *pathmanager.PathManager {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	t.Cleanup(cancelFn)
+
+	pathConfs := map[string]*conf.Path{}
+	for _, name := range pathNames {
+		pathConfs[name] = &conf.Path{
+			Name:   name,
+			Source: "publisher",
+		}
+	}
+
+	pathManager := pathmanager.New(
+		toConfLoggerLevel(logger.LevelError),
+		newAuthManager(),
+		"",
+		conf.StringDuration(10*time.Second),
+		conf.StringDuration(10*time.Second),
+		1024,
+		1472,
+		pathConfs,
+		externalcmd.NewPool(),
+		newMediamtxLogger(logger.FromCtx(ctx)),
+	)
+	pathManager.Initialize(ctx)
+	t.Cleanup(pathManager.Close)
+	return pathManager
+}
+
+func freeUDPAddr(t *testing.T) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	return conn.LocalAddr().String()
+}
+
+func dialSRT(
+	listenAddr string,
+	streamID string,
+	passphrase string,
+) (srt.Conn, error) {
+	srtConf := srt.DefaultConfig()
+	srtConf.StreamId = streamID
+	srtConf.Passphrase = passphrase
+	if err := srtConf.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid SRT config: %w", err)
+	}
+	return srt.Dial("srt", listenAddr, srtConf)
+}
+
+// publishTestH264 publishes an H264 stream (consisting of IDR frames only)
+// to the path through the SRT server until the context is cancelled.
+func publishTestH264(
+	ctx context.Context,
+	t *testing.T,
+	listenAddr string,
+	pathName string,
+	passphrase string,
+) {
+	publisher, err := dialSRT(listenAddr, "publish:"+pathName, passphrase)
+	require.NoError(t, err)
+	t.Cleanup(func() { publisher.Close() })
+
+	track := &mpegts.Track{
+		Codec: &mpegts.CodecH264{},
+	}
+	go func() {
+		bw := bufio.NewWriter(publisher)
+		w := mpegts.NewWriter(bw, []*mpegts.Track{track})
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for pts := int64(0); ; pts += 9000 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			err := w.WriteH264(track, pts, pts, true, [][]byte{
+				test.FormatH264.SPS,
+				test.FormatH264.PPS,
+				{0x05, 1}, // IDR
+			})
+			if err != nil {
+				return
+			}
+			if err := bw.Flush(); err != nil {
+				return
+			}
+		}
+	}()
+}
+
+func TestSRTServerLoopback(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	listenAddr := freeUDPAddr(t)
+	srv, err := newSRTServer(
+		newTestPathManager(t, "main"),
+		listenAddr,
+		newMediamtxLogger(logger.FromCtx(ctx)),
+		streamportserver.OptionSRTPassphrase(testSRTPassphrase),
+	)
+	require.NoError(t, err)
+	defer srv.Close()
+
+	_, err = dialSRT(listenAddr, "publish:main", "wrong passphrase")
+	require.Error(t, err)
+
+	publishTestH264(ctx, t, listenAddr, "main", testSRTPassphrase)
+
+	_, err = dialSRT(listenAddr, "read:main", "")
+	require.Error(t, err)
+
+	// the path becomes readable as soon as the first frame is published
+	var reader srt.Conn
+	require.Eventually(t, func() bool {
+		reader, err = dialSRT(listenAddr, "read:main", testSRTPassphrase)
+		return err == nil
+	}, 5*time.Second, 100*time.Millisecond)
+	defer reader.Close()
+	require.NoError(t, reader.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	r, err := mpegts.NewReader(reader)
+	require.NoError(t, err)
+	require.Len(t, r.Tracks(), 1)
+	require.IsType(t, &mpegts.CodecH264{}, r.Tracks()[0].Codec)
+
+	received := false
+	r.OnDataH264(r.Tracks()[0], func(pts int64, dts int64, au [][]byte) error {
+		require.Contains(t, au, []byte{0x05, 1})
+		received = true
+		return nil
+	})
+	for !received {
+		require.NoError(t, r.Read())
+	}
+}
@@ -6,6 +6,7 @@ package streamserver
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/facebookincubator/go-belt"
@@ -37,11 +38,18 @@ type StreamServer struct {
 	mutex          xsync.Gorex
 	config         *types.Config
 	pathManager    *pathmanager.PathManager
+	authManager    *authManager
 	serverHandlers []streamportserver.Server
 	isInitialized  bool
 
+	// internalStreams maps the streams published by streamd itself
+	// to their sources, see types.InternalStreamer
+	internalStreams map[types.StreamID]types.StreamID
+
 	streamsStatusLocker xsync.Mutex
 	publishers          map[types.AppKey]*PublisherClosedNotifier
+	readyPaths          map[types.AppKey]defs.Path
+	hlsServers          []*HLSServer
 	streamsChanged      chan struct{}
 }
 
@@ -53,9 +61,12 @@ func New(
 ) *StreamServer {
 
 	s := &StreamServer{
-		config:         cfg,
-		publishers:     make(map[types.AppKey]*PublisherClosedNotifier),
-		streamsChanged: make(chan struct{}),
+		config:          cfg,
+		internalStreams: map[types.StreamID]types.StreamID{},
+		publishers:      make(map[types.AppKey]*PublisherClosedNotifier),
+		readyPaths:      make(map[types.AppKey]defs.Path),
+		authManager:     newAuthManager(),
+		streamsChanged:  make(chan struct{}),
 	}
 	s.StreamForwards = streamforward.NewStreamForwards(s, platformsController)
 	s.StreamPlayers = streamplayers.NewStreamPlayers(
@@ -84,7 +95,7 @@ func (s *StreamServer) Init(
 
 func (s *StreamServer) init(
 	ctx context.Context,
-	_ ...types.InitOption,
+	opts ...types.InitOption,
 ) (_err error) {
 	if s.isInitialized {
 		return fmt.Errorf("already initialized")
@@ -94,9 +105,10 @@ func (s *StreamServer) init(
 	cfg := s.config
 	logger.Debugf(ctx, "config == %#+v", *cfg)
 
+	s.authManager.SetNotifiers(ctx, types.InitOptions(opts).Config().NotifierStreamAuthFail)
 	s.pathManager = pathmanager.New(
 		toConfLoggerLevel(logger.Default().Level()),
-		&dummyAuthManager{},
+		s.authManager,
 		"",
 		conf.StringDuration(10*time.Second),
 		conf.StringDuration(10*time.Second),
@@ -131,7 +143,7 @@ func (s *StreamServer) init(
 		}
 	}
 
-	if err := s.StreamForwards.Init(ctx); err != nil {
+	if err := s.StreamForwards.Init(ctx, opts...); err != nil {
 		return fmt.Errorf("unable to initialize stream forwardings: %w", err)
 	}
 
@@ -149,7 +161,11 @@ func (s *StreamServer) PathReady(path defs.Path) {
 
 	appKey := types.AppKey(path.Name())
 
+	var hlsServers []*HLSServer
 	s.streamsStatusLocker.Do(context.Background(), func() {
+		hlsServers = s.hlsServers
+		s.readyPaths[appKey] = path
+
 		publisher := s.publishers[appKey]
 		if publisher != nil {
 			logger.Errorf(
@@ -165,6 +181,10 @@ func (s *StreamServer) PathReady(path defs.Path) {
 		oldCh, s.streamsChanged = s.streamsChanged, make(chan struct{})
 		close(oldCh)
 	})
+
+	for _, hlsServer := range hlsServers {
+		hlsServer.PathReady(path)
+	}
 }
 
 func (s *StreamServer) PathNotReady(path defs.Path) {
@@ -174,7 +194,11 @@ func (s *StreamServer) PathNotReady(path defs.Path) {
 
 	appKey := types.AppKey(path.Name())
 
+	var hlsServers []*HLSServer
 	s.streamsStatusLocker.Do(context.Background(), func() {
+		hlsServers = s.hlsServers
+		delete(s.readyPaths, appKey)
+
 		publisher := s.publishers[appKey]
 		if publisher == nil {
 			logger.Error(ctx, "there was no registered publisher for '%s'", appKey)
@@ -187,6 +211,10 @@ func (s *StreamServer) PathNotReady(path defs.Path) {
 		oldCh, s.streamsChanged = s.streamsChanged, make(chan struct{})
 		close(oldCh)
 	})
+
+	for _, hlsServer := range hlsServers {
+		hlsServer.PathNotReady(path)
+	}
 }
 
 func (s *StreamServer) WithConfig(
@@ -195,6 +223,7 @@ func (s *StreamServer) WithConfig(
 ) {
 	s.mutex.Do(ctx, func() {
 		callback(ctx, s.config)
+		s.authManager.SetStreams(ctx, types.WithInternalStreams(s.config.Streams, s.internalStreams))
 	})
 }
 
@@ -287,6 +316,13 @@ func (s *StreamServer) stopServer(
 	}
 
 	s.serverHandlers = append(s.serverHandlers[:idx], s.serverHandlers[idx+1:]...)
+	if hlsServer, ok := server.(*HLSServer); ok {
+		s.streamsStatusLocker.Do(ctx, func() {
+			s.hlsServers = slices.DeleteFunc(slices.Clone(s.hlsServers), func(item *HLSServer) bool {
+				return item == hlsServer
+			})
+		})
+	}
 	return server.Close()
 }
 
@@ -320,9 +356,10 @@ func (s *StreamServer) reloadPathConfs(
 	ctx context.Context,
 ) {
 	// TODO: fix race condition with a client connecting to a server
-	pathConfs := make(map[string]*conf.Path, len(s.config.Streams))
+	streams := types.WithInternalStreams(s.config.Streams, s.internalStreams)
+	pathConfs := make(map[string]*conf.Path, len(streams))
 
-	for streamID := range s.config.Streams {
+	for streamID := range streams {
 		pathConfs[string(streamID)] = &conf.Path{
 			Name:   string(types.StreamID2LocalAppName(streamID)),
 			Source: "publisher",
@@ -331,9 +368,35 @@ func (s *StreamServer) reloadPathConfs(
 
 	logger.Debugf(ctx, "new pathConfs is %#+v", pathConfs)
 
+	s.authManager.SetStreams(ctx, streams)
 	s.pathManager.ReloadPathConfs(pathConfs)
 }
 
+func (s *StreamServer) AddInternalStream(
+	ctx context.Context,
+	streamID types.StreamID,
+	sourceStreamID types.StreamID,
+) error {
+	ctx = belt.WithField(ctx, "module", "StreamServer")
+	s.mutex.Do(ctx, func() {
+		s.internalStreams[streamID] = sourceStreamID
+		s.reloadPathConfs(ctx)
+	})
+	return nil
+}
+
+func (s *StreamServer) RemoveInternalStream(
+	ctx context.Context,
+	streamID types.StreamID,
+) error {
+	ctx = belt.WithField(ctx, "module", "StreamServer")
+	s.mutex.Do(ctx, func() {
+		delete(s.internalStreams, streamID)
+		s.reloadPathConfs(ctx)
+	})
+	return nil
+}
+
 type IncomingStream = types.IncomingStream
 
 func (s *StreamServer) ListIncomingStreams(
@@ -515,6 +578,75 @@ func (s *StreamServer) newServerRTMP(
 	return rtmpSrv, nil
 }
 
+func (s *StreamServer) newServerSRT(
+	ctx context.Context,
+	listenAddr string,
+	opts ...streamportserver.Option,
+) (_ streamportserver.Server, _ret error) {
+	logger.Tracef(ctx, "newServerSRT(ctx, '%s', %#+v)", listenAddr, opts)
+	srtSrv, err := newSRTServer(
+		s.pathManager,
+		listenAddr,
+		newMediamtxLogger(logger.FromCtx(ctx)),
+		opts...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct the SRT server: %w", err)
+	}
+	return srtSrv, nil
+}
+
+func (s *StreamServer) newServerHLS(
+	ctx context.Context,
+	listenAddr string,
+	opts ...streamportserver.Option,
+) (_ streamportserver.Server, _ret error) {
+	logger.Tracef(ctx, "newServerHLS(ctx, '%s', %#+v)", listenAddr, opts)
+	hlsSrv, err := newHLSServer(
+		s.pathManager,
+		listenAddr,
+		newMediamtxLogger(logger.FromCtx(ctx)),
+		opts...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct the HLS server: %w", err)
+	}
+
+	// the path manager notifies only us about the ready paths, so we
+	// relay these notifications (including the already ready paths) to
+	// the HLS server; it is needed to remux streams without viewers
+	// (see 'AlwaysRemux').
+	var readyPaths []defs.Path
+	s.streamsStatusLocker.Do(ctx, func() {
+		s.hlsServers = append(slices.Clone(s.hlsServers), hlsSrv)
+		for _, path := range s.readyPaths {
+			readyPaths = append(readyPaths, path)
+		}
+	})
+	for _, path := range readyPaths {
+		hlsSrv.PathReady(path)
+	}
+	return hlsSrv, nil
+}
+
+func (s *StreamServer) newServerWebRTC(
+	ctx context.Context,
+	listenAddr string,
+	opts ...streamportserver.Option,
+) (_ streamportserver.Server, _ret error) {
+	logger.Tracef(ctx, "newServerWebRTC(ctx, '%s', %#+v)", listenAddr, opts)
+	webrtcSrv, err := newWebRTCServer(
+		s.pathManager,
+		listenAddr,
+		newMediamtxLogger(logger.FromCtx(ctx)),
+		opts...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct the WebRTC server: %w", err)
+	}
+	return webrtcSrv, nil
+}
+
 func (s *StreamServer) newServerRTSP(
 	ctx context.Context,
 	listenAddr string,
@@ -0,0 +1,154 @@
+package streamserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/xaionaro-go/mediamtx/pkg/conf"
+	mediamtxlogger "github.com/xaionaro-go/mediamtx/pkg/logger"
+	"github.com/xaionaro-go/mediamtx/pkg/pathmanager"
+	"github.com/xaionaro-go/mediamtx/pkg/servers/webrtc"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	webRTCHandshakeTimeout   = 10 * time.Second
+	webRTCTrackGatherTimeout = 2 * time.Second
+)
+
+// WebRTCServer accepts WHIP publishers at "http(s)://<listenAddr>/<streamID>/whip"
+// and serves WHEP readers at "http(s)://<listenAddr>/<streamID>/whep".
+//
+// The HTTP endpoints are used only for the signaling, the media itself
+// goes through the ICE listeners (see WebRTCICEUDPListenAddr and
+// WebRTCICETCPListenAddr).
+type WebRTCServer struct {
+	*webrtc.Server
+
+	locker         xsync.Mutex
+	originalConfig streamportserver.Config
+	isInitialized  bool
+}
+
+func newWebRTCServer(
+	pathManager *pathmanager.PathManager,
+	listenAddr string,
+	logger mediamtxlogger.Writer,
+	opts ...streamportserver.Option,
+) (*WebRTCServer, error) {
+	psCfg := streamportserver.Options(opts).ProtocolSpecificConfig(context.Background())
+
+	iceUDPListenAddr := psCfg.WebRTCICEUDPListenAddr
+	if iceUDPListenAddr == "" && psCfg.WebRTCICETCPListenAddr == "" {
+		iceUDPListenAddr = listenAddr
+	}
+
+	srv := &WebRTCServer{
+		originalConfig: streamportserver.Config{
+			ProtocolSpecificConfig: psCfg,
+			Type:                   streamtypes.ServerTypeWebRTC,
+			ListenAddr:             listenAddr,
+		},
+		Server: &webrtc.Server{
+			Address:               listenAddr,
+			Encryption:            psCfg.IsTLS,
+			ServerKey:             "",
+			ServerCert:            "",
+			AllowOrigin:           "*",
+			TrustedProxies:        nil,
+			ReadTimeout:           conf.StringDuration(psCfg.ReadTimeout),
+			LocalUDPAddress:       iceUDPListenAddr,
+			LocalTCPAddress:       psCfg.WebRTCICETCPListenAddr,
+			IPsFromInterfaces:     true,
+			IPsFromInterfacesList: []string{},
+			AdditionalHosts:       append([]string{}, psCfg.WebRTCAdditionalHosts...),
+			ICEServers:            []conf.WebRTCICEServer{},
+			HandshakeTimeout:      conf.StringDuration(webRTCHandshakeTimeout),
+			TrackGatherTimeout:    conf.StringDuration(webRTCTrackGatherTimeout),
+			ExternalCmdPool:       nil,
+			PathManager:           pathManager,
+			Parent:                logger,
+		},
+	}
+	if err := srv.init(srv.originalConfig); err != nil {
+		return nil, err
+	}
+	return srv, nil
+}
+
+func (srv *WebRTCServer) init(
+	cfg streamportserver.Config,
+) (_err error) {
+	defer func() {
+		if _err != nil {
+			_ = srv.Close()
+		}
+	}()
+	certFile, keyFile, err := serverTLSCertificateFiles(cfg, "webrtcs")
+	if err != nil {
+		return fmt.Errorf("unable to set the TLS certificate: %w", err)
+	}
+	srv.ServerCert, srv.ServerKey = certFile, keyFile
+
+	if err := srv.Initialize(); err != nil {
+		return fmt.Errorf("Initialize() returned an error: %w", err)
+	}
+	srv.isInitialized = true
+
+	return nil
+}
+
+var _ streamportserver.Server = (*WebRTCServer)(nil)
+
+func (srv *WebRTCServer) ProtocolSpecificConfig() streamportserver.ProtocolSpecificConfig {
+	return srv.originalConfig.ProtocolSpecificConfig
+}
+
+func (srv *WebRTCServer) Close() error {
+	ctx := context.TODO()
+	return xsync.DoR1(ctx, &srv.locker, func() error {
+		if srv.Server == nil {
+			return fmt.Errorf("already closed")
+		}
+		certFile := srv.ServerCert
+		keyFile := srv.ServerKey
+
+		if srv.isInitialized {
+			srv.Server.Close()
+		}
+		srv.Server = nil
+
+		return removeServerTLSCertificateFiles(certFile, keyFile)
+	})
+}
+func (srv *WebRTCServer) Type() streamtypes.ServerType {
+	return streamtypes.ServerTypeWebRTC
+}
+func (srv *WebRTCServer) ListenAddr() string {
+	return srv.originalConfig.ListenAddr
+}
+func (srv *WebRTCServer) NumBytesConsumerWrote() uint64 {
+	result := uint64(0)
+	list, err := srv.Server.APISessionsList()
+	if err != nil {
+		panic(err)
+	}
+	for _, item := range list.Items {
+		result += item.BytesReceived
+	}
+	return result
+}
+func (srv *WebRTCServer) NumBytesProducerRead() uint64 {
+	result := uint64(0)
+	list, err := srv.Server.APISessionsList()
+	if err != nil {
+		panic(err)
+	}
+	for _, item := range list.Items {
+		result += item.BytesSent
+	}
+	return result
+}
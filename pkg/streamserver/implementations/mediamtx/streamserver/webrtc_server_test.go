@@ -0,0 +1,127 @@
+package streamserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/pion/rtp"
+	pwebrtc "github.com/pion/webrtc/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/mediamtx/pkg/protocols/webrtc"
+	"github.com/xaionaro-go/mediamtx/pkg/protocols/whip"
+	"github.com/xaionaro-go/mediamtx/pkg/test"
+)
+
+func newTestWHIPClient(
+	t *testing.T,
+	rawURL string,
+) *whip.Client {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+
+	tr := &http.Transport{}
+	t.Cleanup(tr.CloseIdleConnections)
+	return &whip.Client{
+		HTTPClient: &http.Client{Transport: tr},
+		URL:        u,
+		Log:        test.NilLogger,
+	}
+}
+
+func TestWebRTCServerWHIPAndWHEP(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	listenAddr := freeTCPAddr(t)
+	srv, err := newWebRTCServer(
+		newTestPathManager(t, "main"),
+		listenAddr,
+		newMediamtxLogger(logger.FromCtx(ctx)),
+	)
+	require.NoError(t, err)
+	defer srv.Close()
+
+	publisher := newTestWHIPClient(t, fmt.Sprintf("http://%s/main/whip", listenAddr))
+	track := &webrtc.OutgoingTrack{
+		Caps: pwebrtc.RTPCodecCapability{
+			MimeType:    pwebrtc.MimeTypeH264,
+			ClockRate:   90000,
+			SDPFmtpLine: "level-asymmetry-allowed=1;packetization-mode=1;profile-level-id=42e01f",
+		},
+	}
+	require.NoError(t, publisher.Publish(ctx, []*webrtc.OutgoingTrack{track}))
+	defer publisher.Close()
+
+	go func() {
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		seqNum := uint16(0)
+		for ts := uint32(0); ; ts += 9000 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			nalus := [][]byte{
+				test.FormatH264.SPS,
+				test.FormatH264.PPS,
+				{0x05, 1}, // IDR
+			}
+			for idx, nalu := range nalus {
+				err := track.WriteRTP(&rtp.Packet{
+					Header: rtp.Header{
+						Version:        2,
+						Marker:         idx == len(nalus)-1,
+						PayloadType:    96,
+						SequenceNumber: seqNum,
+						Timestamp:      ts,
+						SSRC:           563423,
+					},
+					Payload: nalu,
+				})
+				if err != nil {
+					return
+				}
+				seqNum++
+			}
+		}
+	}()
+
+	// the path becomes readable as soon as the publisher's tracks are gathered
+	var (
+		reader *whip.Client
+		tracks []*webrtc.IncomingTrack
+	)
+	require.Eventually(t, func() bool {
+		reader = newTestWHIPClient(t, fmt.Sprintf("http://%s/main/whep", listenAddr))
+		tracks, err = reader.Read(ctx)
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	defer reader.Close()
+	require.Len(t, tracks, 1)
+
+	received := make(chan struct{})
+	tracks[0].OnPacketRTP = func(pkt *rtp.Packet) {
+		if !bytes.HasSuffix(pkt.Payload, []byte{0x05, 1}) {
+			return
+		}
+		select {
+		case <-received:
+		default:
+			close(received)
+		}
+	}
+	reader.StartReading()
+
+	select {
+	case <-received:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the published IDR was not received by the WHEP reader")
+	}
+}
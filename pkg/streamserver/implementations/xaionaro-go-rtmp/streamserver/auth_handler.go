@@ -0,0 +1,126 @@
+package streamserver
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/go-rtmp"
+	rtmpmsg "github.com/xaionaro-go/go-rtmp/message"
+	"github.com/xaionaro-go/recoder/xaionaro-go-rtmp/yutoppgortmp"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+	"github.com/xaionaro-go/xsync"
+)
+
+// authHandler checks the credentials of the publishers and readers
+// against the credentials configured per incoming stream.
+//
+// RTMP has no separate fields for credentials, so they are expected
+// in the URL query: either "?key=<stream key>" or "?user=<user>&pass=<password>"
+// (the same as for the mediamtx backend). The query could be
+// attached to the app name (the tcUrl) or to the stream name.
+type authHandler struct {
+	*yutoppgortmp.Handler
+	StreamServer *StreamServer
+	RemoteAddr   string
+
+	connectQuery string
+}
+
+func newAuthHandler(
+	h *yutoppgortmp.Handler,
+	s *StreamServer,
+	remoteAddr string,
+) *authHandler {
+	return &authHandler{
+		Handler:      h,
+		StreamServer: s,
+		RemoteAddr:   remoteAddr,
+	}
+}
+
+var _ rtmp.Handler = (*authHandler)(nil)
+
+func (h *authHandler) OnConnect(timestamp uint32, cmd *rtmpmsg.NetConnectionConnect) error {
+	if u, err := url.Parse(cmd.Command.TCURL); err == nil {
+		h.connectQuery = u.RawQuery
+	}
+	if _, query, ok := strings.Cut(cmd.Command.App, "?"); ok {
+		h.connectQuery = query
+	}
+	return h.Handler.OnConnect(timestamp, cmd)
+}
+
+func (h *authHandler) OnPublish(
+	rtmpctx *rtmp.StreamContext,
+	timestamp uint32,
+	cmd *rtmpmsg.NetStreamPublish,
+) error {
+	name, err := h.authenticate(types.StreamAuthActionPublish, cmd.PublishingName)
+	if err != nil {
+		return err
+	}
+	cmd.PublishingName = name
+	return h.Handler.OnPublish(rtmpctx, timestamp, cmd)
+}
+
+func (h *authHandler) OnPlay(
+	rtmpctx *rtmp.StreamContext,
+	timestamp uint32,
+	cmd *rtmpmsg.NetStreamPlay,
+) error {
+	name, err := h.authenticate(types.StreamAuthActionRead, cmd.StreamName)
+	if err != nil {
+		return err
+	}
+	cmd.StreamName = name
+	return h.Handler.OnPlay(rtmpctx, timestamp, cmd)
+}
+
+// authenticate returns the stream name without the query if the
+// credentials are accepted.
+func (h *authHandler) authenticate(
+	action types.StreamAuthAction,
+	nameWithQuery string,
+) (string, error) {
+	ctx := context.TODO()
+
+	name, query, _ := strings.Cut(nameWithQuery, "?")
+	if query == "" {
+		query = h.connectQuery
+	}
+
+	streamID, credentials, notifiers := xsync.DoR3(ctx, &h.StreamServer.Mutex, func() (types.StreamID, *types.StreamCredentials, []types.FuncNotifyStreamAuthFail) {
+		streamID, credentials := types.StreamCredentialsFor(
+			types.WithInternalStreams(h.StreamServer.Config.Streams, h.StreamServer.InternalStreams),
+			types.AppKey(name),
+			action,
+		)
+		return streamID, credentials, h.StreamServer.NotifierAuthFail
+	})
+	if credentials == nil {
+		return name, nil
+	}
+	if streamportserver.HasInternalCredentials(query) {
+		// the stream forwards, the renditions and the stream players
+		return name, nil
+	}
+
+	values, _ := url.ParseQuery(query)
+	if credentials.Check(values.Get("user"), values.Get("pass"), query) {
+		return name, nil
+	}
+
+	logger.Warnf(
+		ctx,
+		"unable to authenticate a %s request for stream '%s' from %s (protocol: rtmp)",
+		action, streamID, h.RemoteAddr,
+	)
+	for _, notifier := range notifiers {
+		notifier(ctx, streamID, action, h.RemoteAddr)
+	}
+	return "", fmt.Errorf("invalid credentials for %s of '%s'", action, streamID)
+}
@@ -0,0 +1,66 @@
+package streamserver
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/secret"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+)
+
+func TestAuthHandler(t *testing.T) {
+	var failures []types.StreamID
+	s := &StreamServer{
+		Config: &types.Config{
+			Streams: map[types.StreamID]*types.StreamConfig{
+				"live/main": {
+					PublishAuth: &types.StreamCredentials{StreamKey: secret.New("publish")},
+					ReadAuth:    &types.StreamCredentials{StreamKey: secret.New("read")},
+				},
+				"open": {},
+			},
+		},
+		NotifierAuthFail: []types.FuncNotifyStreamAuthFail{
+			func(ctx context.Context, streamID types.StreamID, action types.StreamAuthAction, remoteAddr string) {
+				failures = append(failures, streamID)
+			},
+		},
+	}
+
+	internalURL := &url.URL{}
+	streamportserver.AddInternalCredentials(internalURL)
+
+	for _, testCase := range []struct {
+		action       types.StreamAuthAction
+		name         string
+		connectQuery string
+		isValid      bool
+	}{
+		// the RTMP stream names are the local app names of the streams
+		{types.StreamAuthActionPublish, "main", "", false},
+		{types.StreamAuthActionPublish, "main?key=read", "", false},
+		{types.StreamAuthActionPublish, "main?key=publish", "", true},
+		{types.StreamAuthActionPublish, "main", "key=publish", true},
+		{types.StreamAuthActionRead, "main", "", false},
+		{types.StreamAuthActionRead, "main?key=publish", "key=read", false},
+		{types.StreamAuthActionRead, "main?key=read", "", true},
+		{types.StreamAuthActionRead, "main?" + internalURL.RawQuery, "", true},
+		{types.StreamAuthActionPublish, "main?" + internalURL.RawQuery, "", true},
+		{types.StreamAuthActionRead, "open", "", true},
+		{types.StreamAuthActionPublish, "unknown", "", true},
+	} {
+		h := newAuthHandler(nil, s, "127.0.0.1:12345")
+		h.connectQuery = testCase.connectQuery
+		name, err := h.authenticate(testCase.action, testCase.name)
+		if testCase.isValid {
+			require.NoError(t, err, testCase)
+			require.NotContains(t, name, "?", testCase)
+		} else {
+			require.Error(t, err, testCase)
+		}
+	}
+	require.Equal(t, []types.StreamID{"live/main", "live/main", "live/main", "live/main"}, failures)
+}
@@ -37,9 +37,15 @@ type StreamServer struct {
 	Mutex xsync.Gorex
 	*streamplayers.StreamPlayers
 	*streamforward.StreamForwards
-	Config         *types.Config
-	RelayService   *yutoppgortmp.RelayService
-	ServerHandlers []streamportserver.Server
+	Config       *types.Config
+	RelayService *yutoppgortmp.RelayService
+
+	// InternalStreams maps the streams published by streamd itself
+	// to their sources, see types.InternalStreamer
+	InternalStreams map[types.StreamID]types.StreamID
+	ServerHandlers  []streamportserver.Server
+
+	NotifierAuthFail []types.FuncNotifyStreamAuthFail
 }
 
 var _ streamforward.StreamServer = (*StreamServer)(nil)
@@ -49,8 +55,9 @@ func New(
 	platformsController types.PlatformsController,
 ) *StreamServer {
 	s := &StreamServer{
-		RelayService: yutoppgortmp.NewRelayService(),
-		Config:       cfg,
+		RelayService:    yutoppgortmp.NewRelayService(),
+		Config:          cfg,
+		InternalStreams: map[types.StreamID]types.StreamID{},
 	}
 	s.StreamForwards = streamforward.NewStreamForwards(s, platformsController)
 	s.StreamPlayers = streamplayers.NewStreamPlayers(
@@ -79,11 +86,13 @@ func (s *StreamServer) Init(
 
 func (s *StreamServer) init(
 	ctx context.Context,
-	_ ...types.InitOption,
+	opts ...types.InitOption,
 ) (_err error) {
 	cfg := s.Config
 	logger.Debugf(ctx, "config == %#+v", *cfg)
 
+	s.NotifierAuthFail = types.InitOptions(opts).Config().NotifierStreamAuthFail
+
 	for _, srv := range cfg.PortServers {
 		{
 			srv := srv
@@ -109,7 +118,7 @@ func (s *StreamServer) init(
 		}
 	}
 
-	if err := s.StreamForwards.Init(ctx); err != nil {
+	if err := s.StreamForwards.Init(ctx, opts...); err != nil {
 		return fmt.Errorf("unable to initialize stream forwardings: %w", err)
 	}
 
@@ -218,7 +227,11 @@ func (s *StreamServer) startServer(
 		portSrv.Server = rtmp.NewServer(&rtmp.ServerConfig{
 			OnConnect: func(conn net.Conn) (io.ReadWriteCloser, *rtmp.ConnConfig) {
 				ctx := belt.WithField(ctx, "client", conn.RemoteAddr().String())
-				h := yutoppgortmp.NewHandler(s.RelayService)
+				h := newAuthHandler(
+					yutoppgortmp.NewHandler(s.RelayService),
+					s,
+					conn.RemoteAddr().String(),
+				)
 				wrcc := types.NewReaderWriterCloseCounter(
 					conn,
 					&portSrv.ReadCount,
@@ -362,6 +375,29 @@ func (s *StreamServer) removeIncomingStream(
 	return nil
 }
 
+func (s *StreamServer) AddInternalStream(
+	ctx context.Context,
+	streamID types.StreamID,
+	sourceStreamID types.StreamID,
+) error {
+	ctx = belt.WithField(ctx, "module", "StreamServer")
+	s.Mutex.Do(ctx, func() {
+		s.InternalStreams[streamID] = sourceStreamID
+	})
+	return nil
+}
+
+func (s *StreamServer) RemoveInternalStream(
+	ctx context.Context,
+	streamID types.StreamID,
+) error {
+	ctx = belt.WithField(ctx, "module", "StreamServer")
+	s.Mutex.Do(ctx, func() {
+		delete(s.InternalStreams, streamID)
+	})
+	return nil
+}
+
 func (s *StreamServer) WaitPublisherChan(
 	ctx context.Context,
 	streamID types.StreamID,
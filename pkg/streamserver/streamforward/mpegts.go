@@ -0,0 +1,376 @@
+package streamforward
+
+import (
+	"fmt"
+)
+
+const (
+	tsPacketSize = 188
+	tsSyncByte   = 0x47
+
+	// pcrFrequency is the frequency of the MPEG-TS Program Clock Reference.
+	pcrFrequency = 27_000_000
+	// pcrWrap is the value the PCR wraps around at (33 bits of the base
+	// multiplied by 300 plus the extension).
+	pcrWrap = (1 << 33) * 300
+
+	// tsClockFrequency is the frequency of PTS, DTS and the PCR base.
+	tsClockFrequency = 90_000
+	// tsClockWrap is the value PTS, DTS and the PCR base wrap around at.
+	tsClockWrap = 1 << 33
+
+	tsPIDPAT = 0x0000
+
+	tsTableIDPAT = 0x00
+	tsTableIDPMT = 0x02
+
+	// tsMaxSectionSize is the maximal size of a PSI section which fits
+	// into a single packet (after the header and the pointer field).
+	tsMaxSectionSize = tsPacketSize - 5
+)
+
+// tsVideoStreamTypes are the PMT stream types of the video streams.
+var tsVideoStreamTypes = map[byte]struct{}{
+	0x01: {}, // MPEG-1
+	0x02: {}, // MPEG-2
+	0x10: {}, // MPEG-4 Part 2
+	0x1b: {}, // H.264
+	0x20: {}, // H.264 MVC
+	0x24: {}, // H.265
+	0x33: {}, // H.266
+	0x42: {}, // AVS
+	0xd1: {}, // Dirac
+	0xea: {}, // VC-1
+}
+
+func tsPID(packet []byte) uint16 {
+	return uint16(packet[1]&0x1f)<<8 | uint16(packet[2])
+}
+
+func tsSetPID(packet []byte, pid uint16) {
+	packet[1] = packet[1]&0xe0 | byte(pid>>8)&0x1f
+	packet[2] = byte(pid)
+}
+
+// tsIsPayloadStart returns true if a PES packet or a PSI section starts
+// in the packet.
+func tsIsPayloadStart(packet []byte) bool {
+	return packet[1]&0x40 != 0
+}
+
+func tsHasPayload(packet []byte) bool {
+	return packet[3]&0x10 != 0
+}
+
+func tsContinuityCounter(packet []byte) byte {
+	return packet[3] & 0x0f
+}
+
+func tsSetContinuityCounter(packet []byte, cc byte) {
+	packet[3] = packet[3]&0xf0 | cc&0x0f
+}
+
+func tsAdaptationFieldFlags(packet []byte) byte {
+	if packet[3]&0x20 == 0 || packet[4] == 0 {
+		return 0
+	}
+	return packet[5]
+}
+
+// tsIsDiscontinuity returns true if the discontinuity indicator of
+// the packet is set (the continuity counter may jump).
+func tsIsDiscontinuity(packet []byte) bool {
+	return tsAdaptationFieldFlags(packet)&0x80 != 0
+}
+
+// tsIsRandomAccess returns true if the packet starts a keyframe.
+func tsIsRandomAccess(packet []byte) bool {
+	return tsAdaptationFieldFlags(packet)&0x40 != 0
+}
+
+// tsPayload returns the payload of the packet (nil if there is none).
+func tsPayload(packet []byte) []byte {
+	if !tsHasPayload(packet) {
+		return nil
+	}
+	offset := 4
+	if packet[3]&0x20 != 0 {
+		offset += 1 + int(packet[4])
+	}
+	if offset >= tsPacketSize {
+		return nil
+	}
+	return packet[offset:]
+}
+
+// tsPacketPCR returns the Program Clock Reference of the MPEG-TS packet
+// (in the units of pcrFrequency), if the packet has one.
+func tsPacketPCR(packet []byte) (int64, bool) {
+	if len(packet) < 12 || packet[0] != tsSyncByte {
+		return 0, false
+	}
+	hasAdaptationField := packet[3]&0x20 != 0
+	if !hasAdaptationField {
+		return 0, false
+	}
+	adaptationFieldLength := packet[4]
+	hasPCR := packet[5]&0x10 != 0
+	if adaptationFieldLength < 7 || !hasPCR {
+		return 0, false
+	}
+	base := int64(packet[6])<<25 |
+		int64(packet[7])<<17 |
+		int64(packet[8])<<9 |
+		int64(packet[9])<<1 |
+		int64(packet[10])>>7
+	extension := int64(packet[10]&0x01)<<8 | int64(packet[11])
+	return base*300 + extension, true
+}
+
+// tsSetPacketPCR overwrites the PCR of a packet which has one
+// (see tsPacketPCR).
+func tsSetPacketPCR(packet []byte, pcr int64) {
+	base, extension := pcr/300, pcr%300
+	packet[6] = byte(base >> 25)
+	packet[7] = byte(base >> 17)
+	packet[8] = byte(base >> 9)
+	packet[9] = byte(base >> 1)
+	packet[10] = byte(base<<7)&0x80 | 0x7e | byte(extension>>8)&0x01
+	packet[11] = byte(extension)
+}
+
+// pesTimestampOffsets returns the offsets of PTS and DTS within the payload
+// which starts a PES packet (-1 if there is no such timestamp).
+func pesTimestampOffsets(payload []byte) (ptsOffset, dtsOffset int) {
+	ptsOffset, dtsOffset = -1, -1
+	if len(payload) < 9 || payload[0] != 0 || payload[1] != 0 || payload[2] != 1 {
+		return
+	}
+	switch payload[3] {
+	case 0xbc, 0xbe, 0xbf, 0xf0, 0xf1, 0xf2, 0xf8, 0xff:
+		// the streams without the optional PES header
+		return
+	}
+	flags := payload[7] >> 6
+	if flags&0x2 != 0 && len(payload) >= 14 {
+		ptsOffset = 9
+	}
+	if flags == 0x3 && len(payload) >= 19 {
+		dtsOffset = 14
+	}
+	return
+}
+
+func readPESTimestamp(b []byte) int64 {
+	return int64(b[0]>>1&0x07)<<30 |
+		int64(b[1])<<22 |
+		int64(b[2]>>1)<<15 |
+		int64(b[3])<<7 |
+		int64(b[4]>>1)
+}
+
+func writePESTimestamp(b []byte, ts int64) {
+	b[0] = b[0]&0xf0 | byte(ts>>29)&0x0e | 0x01
+	b[1] = byte(ts >> 22)
+	b[2] = byte(ts>>14)&0xfe | 0x01
+	b[3] = byte(ts >> 7)
+	b[4] = byte(ts<<1) | 0x01
+}
+
+// tsClockUnwrapper converts the 33-bit timestamps (that wrap around every
+// ~26.5 hours) into the monotonic ones.
+type tsClockUnwrapper struct {
+	isSet         bool
+	lastRaw       int64
+	lastUnwrapped int64
+}
+
+func (u *tsClockUnwrapper) Unwrap(raw int64) int64 {
+	if !u.isSet {
+		u.isSet = true
+		u.lastRaw, u.lastUnwrapped = raw, raw
+		return raw
+	}
+	delta := (raw - u.lastRaw) % tsClockWrap
+	if delta < 0 {
+		delta += tsClockWrap
+	}
+	if delta >= tsClockWrap/2 {
+		// the timestamp is behind the previous one
+		delta -= tsClockWrap
+	}
+	u.lastRaw = raw
+	u.lastUnwrapped += delta
+	return u.lastUnwrapped
+}
+
+// tsPSISection returns the PSI section which starts in the packet (nil if
+// there is none or it does not fit into the packet).
+func tsPSISection(packet []byte) []byte {
+	if !tsIsPayloadStart(packet) {
+		return nil
+	}
+	payload := tsPayload(packet)
+	if len(payload) < 1 {
+		return nil
+	}
+	start := 1 + int(payload[0])
+	if start+3 > len(payload) {
+		return nil
+	}
+	section := payload[start:]
+	length := 3 + (int(section[1]&0x0f)<<8 | int(section[2]))
+	if length > len(section) {
+		return nil
+	}
+	return section[:length]
+}
+
+// parsePAT returns the PID of the PMT of the first program in the PAT.
+func parsePAT(section []byte) (uint16, bool) {
+	if len(section) < 12 || section[0] != tsTableIDPAT {
+		return 0, false
+	}
+	programs := section[8 : len(section)-4]
+	for i := 0; i+4 <= len(programs); i += 4 {
+		programNumber := uint16(programs[i])<<8 | uint16(programs[i+1])
+		if programNumber == 0 {
+			// the network information table
+			continue
+		}
+		return uint16(programs[i+2]&0x1f)<<8 | uint16(programs[i+3]), true
+	}
+	return 0, false
+}
+
+type tsPMTStream struct {
+	StreamType  byte
+	PID         uint16
+	Descriptors []byte
+}
+
+type tsPMT struct {
+	PCRPID      uint16
+	ProgramInfo []byte
+	Streams     []tsPMTStream
+}
+
+func parsePMT(section []byte) (*tsPMT, bool) {
+	if len(section) < 16 || section[0] != tsTableIDPMT {
+		return nil, false
+	}
+	end := len(section) - 4
+	pmt := &tsPMT{
+		PCRPID: uint16(section[8]&0x1f)<<8 | uint16(section[9]),
+	}
+	pos := 12 + (int(section[10]&0x0f)<<8 | int(section[11]))
+	if pos > end {
+		return nil, false
+	}
+	pmt.ProgramInfo = section[12:pos]
+	for pos+5 <= end {
+		esInfoEnd := pos + 5 + (int(section[pos+3]&0x0f)<<8 | int(section[pos+4]))
+		if esInfoEnd > end {
+			return nil, false
+		}
+		pmt.Streams = append(pmt.Streams, tsPMTStream{
+			StreamType:  section[pos],
+			PID:         uint16(section[pos+1]&0x1f)<<8 | uint16(section[pos+2]),
+			Descriptors: section[pos+5 : esInfoEnd],
+		})
+		pos = esInfoEnd
+	}
+	return pmt, true
+}
+
+func newPATSection(pmtPID uint16) []byte {
+	return newPSISection(tsTableIDPAT, 1, 0, []byte{
+		0x00, 0x01, // program number
+		0xe0 | byte(pmtPID>>8)&0x1f, byte(pmtPID),
+	})
+}
+
+func newPMTSection(version byte, pmt *tsPMT) ([]byte, error) {
+	body := []byte{
+		0xe0 | byte(pmt.PCRPID>>8)&0x1f, byte(pmt.PCRPID),
+		0xf0 | byte(len(pmt.ProgramInfo)>>8)&0x0f, byte(len(pmt.ProgramInfo)),
+	}
+	body = append(body, pmt.ProgramInfo...)
+	for _, stream := range pmt.Streams {
+		body = append(body,
+			stream.StreamType,
+			0xe0|byte(stream.PID>>8)&0x1f, byte(stream.PID),
+			0xf0|byte(len(stream.Descriptors)>>8)&0x0f, byte(len(stream.Descriptors)),
+		)
+		body = append(body, stream.Descriptors...)
+	}
+	section := newPSISection(tsTableIDPMT, 1, version, body)
+	if len(section) > tsMaxSectionSize {
+		return nil, fmt.Errorf("the PMT is too big: %d > %d", len(section), tsMaxSectionSize)
+	}
+	return section, nil
+}
+
+func newPSISection(
+	tableID byte,
+	tableIDExtension uint16,
+	version byte,
+	body []byte,
+) []byte {
+	// the length covers everything after the length field including the CRC
+	length := 5 + len(body) + 4
+	section := make([]byte, 0, 3+length)
+	section = append(section,
+		tableID,
+		0xb0|byte(length>>8)&0x0f, byte(length),
+		byte(tableIDExtension>>8), byte(tableIDExtension),
+		0xc1|(version&0x1f)<<1,
+		0x00, // section number
+		0x00, // last section number
+	)
+	section = append(section, body...)
+	crc := crc32MPEG2(section)
+	return append(section, byte(crc>>24), byte(crc>>16), byte(crc>>8), byte(crc))
+}
+
+// newPSIPacket returns a packet (without the continuity counter set) with
+// the section, which should fit into a single packet.
+func newPSIPacket(pid uint16, section []byte) []byte {
+	packet := make([]byte, tsPacketSize)
+	packet[0] = tsSyncByte
+	packet[1] = 0x40 | byte(pid>>8)&0x1f
+	packet[2] = byte(pid)
+	packet[3] = 0x10
+	packet[4] = 0x00 // pointer field
+	n := copy(packet[5:], section)
+	for i := 5 + n; i < tsPacketSize; i++ {
+		packet[i] = 0xff
+	}
+	return packet
+}
+
+var crc32MPEG2Table = func() [256]uint32 {
+	var table [256]uint32
+	for i := range table {
+		crc := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if crc&0x80000000 != 0 {
+				crc = crc<<1 ^ 0x04c11db7
+			} else {
+				crc <<= 1
+			}
+		}
+		table[i] = crc
+	}
+	return table
+}()
+
+// crc32MPEG2 returns the CRC of PSI sections (which is not the same as
+// the one in hash/crc32: it is not reflected and has no final XOR).
+func crc32MPEG2(data []byte) uint32 {
+	crc := uint32(0xffffffff)
+	for _, b := range data {
+		crc = crc<<8 ^ crc32MPEG2Table[byte(crc>>24)^b]
+	}
+	return crc
+}
@@ -0,0 +1,86 @@
+package streamforward
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTSPacket returns an MPEG-TS packet with the given PCR (or without
+// a PCR if pcr is negative).
+func newTSPacket(pcr int64) []byte {
+	packet := make([]byte, tsPacketSize)
+	packet[0] = tsSyncByte
+	if pcr < 0 {
+		packet[3] = 0x10 // payload only
+		return packet
+	}
+	packet[3] = 0x30 // adaptation field and payload
+	packet[4] = 7
+	packet[5] = 0x10 // PCR flag
+	tsSetPacketPCR(packet, pcr)
+	return packet
+}
+
+func TestTSPacketPCR(t *testing.T) {
+	for _, pcr := range []int64{0, 1, 299, 300, pcrFrequency, pcrWrap - 1} {
+		result, ok := tsPacketPCR(newTSPacket(pcr))
+		require.True(t, ok)
+		require.Equal(t, pcr, result)
+	}
+	_, ok := tsPacketPCR(newTSPacket(-1))
+	require.False(t, ok)
+}
+
+func TestPESTimestamp(t *testing.T) {
+	for _, ts := range []int64{0, 1, 90_000, 1<<32 + 12345, tsClockWrap - 1} {
+		b := []byte{0x30, 0, 0, 0, 0}
+		writePESTimestamp(b, ts)
+		require.Equal(t, ts, readPESTimestamp(b))
+		require.Equal(t, byte(0x30), b[0]&0xf0, "the prefix is kept")
+		require.Equal(t, byte(0x01), b[0]&b[2]&b[4]&0x01, "the marker bits are set")
+	}
+}
+
+func TestTSClockUnwrapper(t *testing.T) {
+	var u tsClockUnwrapper
+	require.Equal(t, int64(tsClockWrap-10), u.Unwrap(tsClockWrap-10))
+	require.Equal(t, int64(tsClockWrap+5), u.Unwrap(5))
+	require.Equal(t, int64(tsClockWrap-20), u.Unwrap(tsClockWrap-20))
+	require.Equal(t, int64(tsClockWrap+100), u.Unwrap(100))
+}
+
+func TestPSISections(t *testing.T) {
+	// the PAT written by libav
+	require.Equal(t, []byte{
+		0x00, 0xb0, 0x0d, 0x00, 0x01, 0xc1, 0x00, 0x00,
+		0x00, 0x01, 0xf0, 0x00,
+		0x2a, 0xb1, 0x04, 0xb2,
+	}, newPATSection(0x1000))
+
+	packet := newPSIPacket(tsPIDPAT, newPATSection(0x1000))
+	pmtPID, ok := parsePAT(tsPSISection(packet))
+	require.True(t, ok)
+	require.Equal(t, uint16(0x1000), pmtPID)
+
+	pmt := &tsPMT{
+		PCRPID:      0x100,
+		ProgramInfo: []byte{0x05, 0x04, 'H', 'D', 'M', 'V'},
+		Streams: []tsPMTStream{
+			{StreamType: 0x1b, PID: 0x100, Descriptors: []byte{}},
+			{StreamType: 0x0f, PID: 0x101, Descriptors: []byte{0x0a, 0x04, 'e', 'n', 'g', 0x00}},
+		},
+	}
+	section, err := newPMTSection(3, pmt)
+	require.NoError(t, err)
+	require.Equal(t, byte(0xc1|3<<1), section[5])
+	require.Zero(t, crc32MPEG2(section), "the CRC of a section with the CRC is zero")
+	parsed, ok := parsePMT(tsPSISection(newPSIPacket(relayPIDPMT, section)))
+	require.True(t, ok)
+	require.Equal(t, pmt, parsed)
+
+	_, err = newPMTSection(0, &tsPMT{
+		Streams: []tsPMTStream{{StreamType: 0x1b, PID: 0x100, Descriptors: make([]byte, tsMaxSectionSize)}},
+	})
+	require.Error(t, err)
+}
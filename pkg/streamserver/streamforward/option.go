@@ -1,5 +1,11 @@
 package streamforward
 
+import (
+	"context"
+
+	"github.com/xaionaro-go/recoder"
+)
+
 type Option interface {
 	apply(*ActiveStreamForwarding)
 }
@@ -11,3 +17,52 @@ func (opts Options) apply(cfg *ActiveStreamForwarding) {
 		opt.apply(cfg)
 	}
 }
+
+type FuncNotifyStart func(ctx context.Context, fwd *ActiveStreamForwarding)
+
+// OptionNotifierStart sets the functions to be called every time
+// the forwarding actually started to send the stream to the destination.
+type OptionNotifierStart []FuncNotifyStart
+
+func (opt OptionNotifierStart) apply(fwd *ActiveStreamForwarding) {
+	fwd.NotifierStart = append(fwd.NotifierStart, opt...)
+}
+
+type FuncNotifyFail func(ctx context.Context, fwd *ActiveStreamForwarding, err error)
+
+// OptionNotifierFail sets the functions to be called every time
+// the forwarding failed (it will be retried after that).
+type OptionNotifierFail []FuncNotifyFail
+
+func (opt OptionNotifierFail) apply(fwd *ActiveStreamForwarding) {
+	fwd.NotifierFail = append(fwd.NotifierFail, opt...)
+}
+
+type FuncNotifySourceSwitch func(ctx context.Context, fwd *ActiveStreamForwarding, source string, isFallback bool)
+
+// OptionNotifierSourceSwitch sets the functions to be called every time
+// the forwarding switched to the fallback or back to the stream.
+type OptionNotifierSourceSwitch []FuncNotifySourceSwitch
+
+func (opt OptionNotifierSourceSwitch) apply(fwd *ActiveStreamForwarding) {
+	fwd.NotifierSourceSwitch = append(fwd.NotifierSourceSwitch, opt...)
+}
+
+type FuncNotifyFallbackStop func(ctx context.Context, fwd *ActiveStreamForwarding, fallback string, reason error)
+
+// OptionNotifierFallbackStop sets the functions to be called every time
+// the forwarding stopped forwarding the fallback without the stream
+// returning (so the forwarding ends).
+type OptionNotifierFallbackStop []FuncNotifyFallbackStop
+
+func (opt OptionNotifierFallbackStop) apply(fwd *ActiveStreamForwarding) {
+	fwd.NotifierFallbackStop = append(fwd.NotifierFallbackStop, opt...)
+}
+
+// OptionEncoderConfig sets the configuration of the encoder (the default
+// is to copy the stream as is).
+type OptionEncoderConfig recoder.EncoderConfig
+
+func (opt OptionEncoderConfig) apply(fwd *ActiveStreamForwarding) {
+	fwd.EncoderConfig = recoder.EncoderConfig(opt)
+}
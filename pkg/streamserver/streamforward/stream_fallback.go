@@ -0,0 +1,192 @@
+package streamforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+)
+
+// fallbackRestartInterval is how long to wait before restarting the fallback
+// after it ended (the backup encoder disconnected or there is no backup
+// encoder at all), to avoid busy looping.
+const fallbackRestartInterval = time.Second
+
+var errFallbackMaxDuration = errors.New("the fallback has reached its maximal duration")
+
+// relayFallbackUntilPublisher feeds the fallback (if one is configured for
+// the stream) into the relay until a new publisher of the stream comes, and
+// returns the publisher. It returns a nil publisher if there is no fallback
+// or the fallback stopped (it has reached its maximal duration or failed),
+// so there is nothing to forward anymore.
+func (fwd *ActiveStreamForwarding) relayFallbackUntilPublisher(
+	ctx context.Context,
+	relay *streamRelay,
+) (_ret types.Publisher, _err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			_err = fmt.Errorf("got panic: %v", r)
+			logger.FromCtx(ctx).
+				WithField("error_event_exception_stack_trace", string(debug.Stack())).Errorf("%v", _err)
+		}
+	}()
+
+	fallback := fwd.getFallbackConfig(ctx)
+	if fallback == nil || !fallback.IsSet() {
+		return nil, nil
+	}
+	if fallback.StreamID == fwd.StreamID {
+		return nil, fmt.Errorf("stream '%s' is configured as a fallback to itself", fwd.StreamID)
+	}
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	publisherChan := make(chan types.Publisher, 1)
+	observability.Go(ctx, func() {
+		publisher, err := fwd.waitForNewPublisher(ctx, fwd.StreamID)
+		if err != nil {
+			return
+		}
+		publisherChan <- publisher
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, nil
+	case publisher := <-publisherChan:
+		logger.Debugf(ctx, "the publisher of '%s' is back, no need for the fallback", fwd.StreamID)
+		return publisher, nil
+	case <-time.After(fallback.GetSwitchDelay()):
+	}
+
+	fallbackCtx, fallbackCancelFn := context.WithCancel(ctx)
+	defer fallbackCancelFn()
+	if fallback.MaxDuration > 0 {
+		fallbackCtx, fallbackCancelFn = context.WithTimeout(fallbackCtx, fallback.MaxDuration)
+		defer fallbackCancelFn()
+	}
+
+	logger.Infof(ctx, "switching '%s' to the fallback '%s'", fwd, fallback)
+	fwd.notifySourceSwitch(ctx, fallback.String(), true)
+
+	fallbackEnded := make(chan error, 1)
+	observability.Go(fallbackCtx, func() {
+		fallbackEnded <- fwd.relayFallback(fallbackCtx, relay, fallback)
+	})
+
+	select {
+	case <-ctx.Done():
+		fallbackCancelFn()
+		<-fallbackEnded
+		return nil, nil
+	case publisher := <-publisherChan:
+		// the relay accepts a single source at a time, so the fallback
+		// should be completely stopped before the publisher is fed
+		fallbackCancelFn()
+		<-fallbackEnded
+		logger.Infof(ctx, "switching '%s' back from the fallback '%s'", fwd, fallback)
+		fwd.notifySourceSwitch(ctx, string(fwd.StreamID), false)
+		return publisher, nil
+	case err := <-fallbackEnded:
+		if errors.Is(fallbackCtx.Err(), context.DeadlineExceeded) {
+			err = errFallbackMaxDuration
+		}
+		if err == nil {
+			err = fmt.Errorf("the fallback ended unexpectedly")
+		}
+		logger.Infof(ctx, "the fallback '%s' of '%s' stopped: %v", fallback, fwd, err)
+		fwd.notifyFallbackStop(ctx, fallback.String(), err)
+		return nil, nil
+	}
+}
+
+// relayFallback feeds the fallback into the relay until ctx is cancelled
+// (or the fallback failed).
+func (fwd *ActiveStreamForwarding) relayFallback(
+	ctx context.Context,
+	relay *streamRelay,
+	fallback *types.FallbackConfig,
+) error {
+	if fallback.StreamID == "" {
+		err := relayFile(ctx, relay, fallback.File)
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	for {
+		err := fwd.relayFallbackStream(ctx, relay, fallback.StreamID)
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		if err != nil {
+			return fmt.Errorf("unable to forward the fallback '%s': %w", fallback, err)
+		}
+		// the backup encoder disconnected: waiting for it to return
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(fallbackRestartInterval):
+		}
+	}
+}
+
+func (fwd *ActiveStreamForwarding) relayFallbackStream(
+	ctx context.Context,
+	relay *streamRelay,
+	streamID types.StreamID,
+) error {
+	publisher, err := fwd.waitForNewPublisher(ctx, streamID)
+	if err != nil {
+		return err
+	}
+	return fwd.relayPublisher(ctx, relay, streamID, publisher)
+}
+
+// getFallbackConfig returns the fallback configured for the stream of
+// the forwarding. A forwarding reading a rendition has none (its stream
+// is the rendition stream), since the rendition forwards the fallback of
+// the original stream itself (see ActiveRendition).
+func (fwd *ActiveStreamForwarding) getFallbackConfig(
+	ctx context.Context,
+) *types.FallbackConfig {
+	var result *types.FallbackConfig
+	fwd.StreamForwards.WithConfig(ctx, func(ctx context.Context, cfg *types.Config) {
+		streamCfg := cfg.Streams[fwd.StreamID]
+		if streamCfg == nil || streamCfg.Fallback == nil {
+			return
+		}
+		fallback := *streamCfg.Fallback
+		result = &fallback
+	})
+	return result
+}
+
+func (fwd *ActiveStreamForwarding) notifySourceSwitch(
+	ctx context.Context,
+	source string,
+	isFallback bool,
+) {
+	for _, f := range fwd.NotifierSourceSwitch {
+		f(ctx, fwd, source, isFallback)
+	}
+}
+
+func (fwd *ActiveStreamForwarding) notifyFallbackStop(
+	ctx context.Context,
+	fallback string,
+	reason error,
+) {
+	for _, f := range fwd.NotifierFallbackStop {
+		f(ctx, fwd, fallback, reason)
+	}
+}
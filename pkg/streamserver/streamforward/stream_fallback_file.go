@@ -0,0 +1,77 @@
+package streamforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+)
+
+// relayFile feeds the MPEG-TS file into the relay in a loop (at the pace of
+// its PCR) until ctx is cancelled. Each loop is a new source of the relay,
+// so the timestamps stay continuous.
+func relayFile(
+	ctx context.Context,
+	relay *streamRelay,
+	filePath string,
+) error {
+	if err := checkMPEGTSFile(filePath); err != nil {
+		return err
+	}
+	for {
+		if err := relayFileOnce(ctx, relay, filePath); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		logger.Tracef(ctx, "the fallback file '%s' ended, starting over", filePath)
+	}
+}
+
+func relayFileOnce(
+	ctx context.Context,
+	relay *streamRelay,
+	filePath string,
+) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to open the file '%s': %w", filePath, err)
+	}
+	defer f.Close()
+
+	if err := relay.Feed(ctx, f, true); err != nil {
+		return fmt.Errorf("unable to send the file '%s': %w", filePath, err)
+	}
+	return nil
+}
+
+func checkMPEGTSFile(filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to open the file '%s': %w", filePath, err)
+	}
+	defer f.Close()
+
+	buf := make([]byte, tsPacketSize+1)
+	if _, err := io.ReadFull(f, buf); err != nil || buf[0] != tsSyncByte || buf[tsPacketSize] != tsSyncByte {
+		return fmt.Errorf("file '%s' is not an MPEG-TS file (only MPEG-TS files are supported as a fallback)", filePath)
+	}
+	return nil
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	t := time.NewTimer(duration)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
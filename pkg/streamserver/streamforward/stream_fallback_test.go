@@ -0,0 +1,424 @@
+package streamforward
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	srt "github.com/datarhei/gosrt"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/recoder"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+const testFrameDuration = 3600 // 40ms
+
+// writeTestFrame writes a video frame and an audio frame with the
+// timestamp; keyframes are preceded with the PSI.
+func writeTestFrame(t *testing.T, src *testTSSource, dts int64, isKeyframe bool) {
+	if isKeyframe {
+		src.WritePSI(t)
+	}
+	src.WritePES(0x100, dts, isKeyframe, (dts-9000)*300)
+	src.WritePES(0x101, dts+100, false, -1)
+}
+
+// writeTestFrames writes the frames (a keyframe every 10 frames) starting
+// with the timestamp.
+func writeTestFrames(t *testing.T, src *testTSSource, dts int64, count int) {
+	for i := 0; i < count; i++ {
+		writeTestFrame(t, src, dts+int64(i)*testFrameDuration, i%10 == 0)
+	}
+}
+
+func newTestSource() *testTSSource {
+	return newTestTSSource(0x1000, 0x100,
+		tsPMTStream{StreamType: testStreamTypeH264, PID: 0x100},
+		tsPMTStream{StreamType: testStreamTypeAAC, PID: 0x101},
+	)
+}
+
+// testRecoderFactory makes recoders, which do not recode anything: if
+// the output is SRT (a feeder of the relay), a generated stream is sent
+// there in real time, otherwise the input is read over HTTP (the output
+// reading the relay) and collected.
+type testRecoderFactory struct {
+	t *testing.T
+
+	locker             xsync.Mutex
+	inputURLs          []string
+	destinationOutputs int
+	received           [][]byte
+}
+
+func (f *testRecoderFactory) New(ctx context.Context) (recoder.Recoder, error) {
+	return &testRecoder{
+		factory: f,
+		ended:   make(chan struct{}),
+	}, nil
+}
+
+func (f *testRecoderFactory) DestinationOutputs() int {
+	return xsync.DoR1(context.Background(), &f.locker, func() int {
+		return f.destinationOutputs
+	})
+}
+
+func (f *testRecoderFactory) InputURLs() []string {
+	return xsync.DoR1(context.Background(), &f.locker, func() []string {
+		return append([]string{}, f.inputURLs...)
+	})
+}
+
+func (f *testRecoderFactory) Received() [][]byte {
+	return xsync.DoR1(context.Background(), &f.locker, func() [][]byte {
+		return append([][]byte{}, f.received...)
+	})
+}
+
+type testRecoder struct {
+	factory   *testRecoderFactory
+	inputURL  string
+	outputURL *url.URL
+	cancelFn  context.CancelFunc
+	ended     chan struct{}
+	closeOnce sync.Once
+}
+
+var _ recoder.Recoder = (*testRecoder)(nil)
+
+type testCloser struct{}
+
+func (testCloser) Close() error { return nil }
+
+func (r *testRecoder) NewEncoder(context.Context, recoder.EncoderConfig) (recoder.Encoder, error) {
+	return testCloser{}, nil
+}
+
+func (r *testRecoder) NewInputFromURL(
+	ctx context.Context,
+	inputURL string,
+	streamKey string,
+	cfg recoder.InputConfig,
+) (recoder.Input, error) {
+	r.inputURL = inputURL
+	r.factory.locker.Do(ctx, func() {
+		r.factory.inputURLs = append(r.factory.inputURLs, inputURL)
+	})
+	return testCloser{}, nil
+}
+
+func (r *testRecoder) NewOutputFromURL(
+	ctx context.Context,
+	outputURL string,
+	streamKey string,
+	cfg recoder.OutputConfig,
+) (recoder.Output, error) {
+	var err error
+	r.outputURL, err = url.Parse(outputURL)
+	if err != nil {
+		return nil, err
+	}
+	if r.outputURL.Scheme != "srt" {
+		r.factory.locker.Do(ctx, func() {
+			r.factory.destinationOutputs++
+		})
+	}
+	return testCloser{}, nil
+}
+
+func (r *testRecoder) StartRecoding(
+	ctx context.Context,
+	encoder recoder.Encoder,
+	input recoder.Input,
+	output recoder.Output,
+) error {
+	ctx, r.cancelFn = context.WithCancel(ctx)
+	go func() {
+		defer close(r.ended)
+		if r.outputURL.Scheme == "srt" {
+			r.feed(ctx)
+		} else {
+			r.receive(ctx)
+		}
+	}()
+	return nil
+}
+
+func (r *testRecoder) feed(ctx context.Context) {
+	srtCfg := srt.DefaultConfig()
+	srtCfg.StreamId = r.outputURL.Query().Get("streamid")
+	conn, err := srt.Dial("srt", r.outputURL.Host, srtCfg)
+	if err != nil {
+		r.factory.t.Errorf("unable to connect to the relay: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	t := time.NewTicker(time.Duration(testFrameDuration) * time.Second / tsClockFrequency)
+	defer t.Stop()
+	src := newTestSource()
+	for i := 0; ; i++ {
+		writeTestFrame(r.factory.t, src, 10*tsClockFrequency+int64(i)*testFrameDuration, i%10 == 0)
+		if _, err := conn.Write(src.Bytes()); err != nil {
+			return
+		}
+		src.Reset()
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (r *testRecoder) receive(ctx context.Context) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.inputURL, nil)
+	if err != nil {
+		r.factory.t.Errorf("unable to create a request: %v", err)
+		return
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	for {
+		packet := make([]byte, tsPacketSize)
+		if _, err := io.ReadFull(resp.Body, packet); err != nil {
+			return
+		}
+		r.factory.locker.Do(ctx, func() {
+			r.factory.received = append(r.factory.received, packet)
+		})
+	}
+}
+
+func (r *testRecoder) WaitForRecodingEnd(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-r.ended:
+		return nil
+	}
+}
+
+func (r *testRecoder) GetStats(ctx context.Context) (*recoder.Stats, error) {
+	return &recoder.Stats{}, nil
+}
+
+func (r *testRecoder) Close() error {
+	r.closeOnce.Do(func() {
+		if r.cancelFn != nil {
+			r.cancelFn()
+			<-r.ended
+		}
+	})
+	return nil
+}
+
+type testFallbackEvent struct {
+	Source     string
+	IsFallback bool
+	Stopped    error
+}
+
+func TestStreamForwardFallback(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFn()
+
+	slate := newTestSource()
+	writeTestFrames(t, slate, 0, 5)
+	slatePath := filepath.Join(t.TempDir(), "slate.ts")
+	require.NoError(t, os.WriteFile(slatePath, slate.Bytes(), 0o644))
+
+	srv := &testStreamServer{}
+	srv.config.Streams = map[types.StreamID]*types.StreamConfig{
+		"live/main": {
+			Fallback: &types.FallbackConfig{
+				File:        slatePath,
+				SwitchDelay: 100 * time.Millisecond,
+				MaxDuration: 2 * time.Second,
+			},
+		},
+	}
+	recoderFactory := &testRecoderFactory{t: t}
+	s := NewStreamForwards(srv, recoderFactory, nil)
+	s.RelaySources = true
+
+	var (
+		eventsLocker xsync.Mutex
+		events       []testFallbackEvent
+		starts       int
+	)
+	getEvents := func() []testFallbackEvent {
+		return xsync.DoR1(ctx, &eventsLocker, func() []testFallbackEvent {
+			return append([]testFallbackEvent{}, events...)
+		})
+	}
+	fwd, err := s.NewActiveStreamForward(
+		ctx,
+		"live/main",
+		"rtmp://dst.example.com/app",
+		"",
+		func(ctx context.Context, fwd *ActiveStreamForwarding) {},
+		OptionNotifierStart{func(ctx context.Context, fwd *ActiveStreamForwarding) {
+			eventsLocker.Do(ctx, func() {
+				starts++
+			})
+		}},
+		OptionNotifierSourceSwitch{func(ctx context.Context, fwd *ActiveStreamForwarding, source string, isFallback bool) {
+			eventsLocker.Do(ctx, func() {
+				events = append(events, testFallbackEvent{Source: source, IsFallback: isFallback})
+			})
+		}},
+		OptionNotifierFallbackStop{func(ctx context.Context, fwd *ActiveStreamForwarding, fallback string, reason error) {
+			eventsLocker.Do(ctx, func() {
+				events = append(events, testFallbackEvent{Source: fallback, Stopped: reason})
+			})
+		}},
+	)
+	require.NoError(t, err)
+	defer fwd.Close()
+
+	waitForMorePackets := func() {
+		count := len(recoderFactory.Received())
+		require.Eventually(t, func() bool {
+			return len(recoderFactory.Received()) > count+50
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	publisher := srv.publish("live/main")
+	waitForMorePackets()
+	require.Empty(t, getEvents())
+
+	publisher.Close()
+	require.Eventually(t, func() bool { return len(getEvents()) == 1 }, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, testFallbackEvent{Source: slatePath, IsFallback: true}, getEvents()[0])
+	waitForMorePackets()
+
+	publisher = srv.publish("live/main")
+	require.Eventually(t, func() bool { return len(getEvents()) == 2 }, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, testFallbackEvent{Source: "live/main", IsFallback: false}, getEvents()[1])
+	waitForMorePackets()
+
+	publisher.Close()
+	require.Eventually(t, func() bool { return len(getEvents()) == 4 }, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, testFallbackEvent{Source: slatePath, IsFallback: true}, getEvents()[2])
+	require.Equal(t, slatePath, getEvents()[3].Source)
+	require.True(t, errors.Is(getEvents()[3].Stopped, errFallbackMaxDuration), getEvents()[3].Stopped)
+
+	// all the switches happened within the same output session
+	require.Equal(t, 1, recoderFactory.DestinationOutputs())
+	require.Equal(t, 1, xsync.DoR1(ctx, &eventsLocker, func() int { return starts }))
+
+	// and the output is a continuous stream
+	out := parseTestRelayOutput(recoderFactory.Received())
+	require.Zero(t, out.CCProblems)
+	for pid, dts := range out.DTS {
+		for i := 1; i < len(dts); i++ {
+			require.Greater(t, dts[i], dts[i-1], "PID %d, #%d", pid, i)
+		}
+	}
+	for _, pmt := range out.PMTs {
+		require.Equal(t, out.PMTs[0], pmt)
+	}
+
+	// the publisher which did not return does not cause a switch back
+	time.Sleep(300 * time.Millisecond)
+	require.Len(t, getEvents(), 4)
+}
+
+func TestRelayFile(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	dir := t.TempDir()
+	notTS := filepath.Join(dir, "slate.mp4")
+	require.NoError(t, os.WriteFile(notTS, make([]byte, 1000), 0o644))
+	relay, err := newStreamRelay(ctx)
+	require.NoError(t, err)
+	defer relay.Close()
+	require.Error(t, relayFile(ctx, relay, notTS))
+
+	// 0.2 seconds of media
+	slate := newTestSource()
+	writeTestFrames(t, slate, 0, 5)
+	slatePath := filepath.Join(dir, "with space.ts")
+	require.NoError(t, os.WriteFile(slatePath, slate.Bytes(), 0o644))
+
+	var (
+		packets  [][]byte
+		readDone = make(chan struct{})
+	)
+	go func() {
+		defer close(readDone)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case packet := <-relay.output:
+				packets = append(packets, packet)
+			}
+		}
+	}()
+
+	const duration = 1500 * time.Millisecond
+	feedCtx, feedCancelFn := context.WithTimeout(ctx, duration)
+	defer feedCancelFn()
+	err = relayFile(feedCtx, relay, slatePath)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	cancelFn()
+	<-readDone
+
+	out := parseTestRelayOutput(packets)
+	require.Zero(t, out.CCProblems)
+	videoDTS := out.DTS[relayPIDFirstES]
+	require.Greater(t, len(videoDTS), 2*5, "the file is looped")
+	for i := 1; i < len(videoDTS); i++ {
+		require.Greater(t, videoDTS[i], videoDTS[i-1])
+	}
+
+	// the file is sent in real time (plus the prebuffer), not as fast
+	// as possible
+	mediaDuration := time.Duration(out.PCRs[len(out.PCRs)-1]-out.PCRs[0]) * time.Second / pcrFrequency
+	require.Less(t, mediaDuration, duration+relayRealTimePrebuffer+500*time.Millisecond)
+	require.Greater(t, mediaDuration, duration)
+}
+
+func TestStreamForwardWithoutFallbackIsNotRelayed(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFn()
+
+	srv := &testStreamServer{}
+	recoderFactory := &testRecoderFactory{t: t}
+	s := NewStreamForwards(srv, recoderFactory, nil)
+	s.RelaySources = true
+
+	fwd, err := s.NewActiveStreamForward(
+		ctx,
+		"live/main",
+		"rtmp://dst.example.com/app",
+		"",
+		func(ctx context.Context, fwd *ActiveStreamForwarding) {},
+	)
+	require.NoError(t, err)
+	defer fwd.Close()
+
+	srv.publish("live/main")
+	require.Eventually(t, func() bool {
+		return recoderFactory.DestinationOutputs() > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	for _, inputURL := range recoderFactory.InputURLs() {
+		require.True(t, strings.HasPrefix(inputURL, "rtmp://127.0.0.1:1935/live/main"), inputURL)
+	}
+}
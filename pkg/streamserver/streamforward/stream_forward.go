@@ -2,6 +2,7 @@ package streamforward
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"runtime/debug"
@@ -14,9 +15,22 @@ import (
 	"github.com/xaionaro-go/observability"
 	recoder "github.com/xaionaro-go/recoder"
 	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
 	"github.com/xaionaro-go/xsync"
 )
 
+const (
+	// forwardRestartInterval is how long to wait before restarting a failed
+	// forwarding.
+	forwardRestartInterval = time.Second
+
+	// publisherRecheckInterval is how often to recheck the publisher if
+	// the stream server reported a publisher which is gone already.
+	publisherRecheckInterval = time.Second
+)
+
+var errNoPublisher = errors.New("no publisher")
+
 type StreamForward struct {
 	StreamID         types.StreamID
 	DestinationID    types.DestinationID
@@ -25,6 +39,7 @@ type StreamForward struct {
 	ActiveForwarding *ActiveStreamForwarding
 	NumBytesWrote    uint64
 	NumBytesRead     uint64
+	Stats            types.StreamForwardStats
 }
 
 type ActiveStreamForwarding struct {
@@ -35,13 +50,24 @@ type ActiveStreamForwarding struct {
 	ReadCount            atomic.Uint64
 	WriteCount           atomic.Uint64
 	RecoderFactory       recoder.Factory
+	EncoderConfig        recoder.EncoderConfig
 	PauseFunc            func(ctx context.Context, fwd *ActiveStreamForwarding)
+	NotifierStart        []FuncNotifyStart
+	NotifierFail         []FuncNotifyFail
+	NotifierSourceSwitch []FuncNotifySourceSwitch
+	NotifierFallbackStop []FuncNotifyFallbackStop
 
 	cancelFunc context.CancelFunc
 
 	locker             xsync.Mutex
 	recoder            recoder.Recoder
 	recodingCancelFunc context.CancelFunc
+
+	statsLocker xsync.Mutex
+	stats       forwardingStats
+
+	// rendition is the rendition the forwarding reads from (if any)
+	rendition *ActiveRendition
 }
 
 func (fwds *StreamForwards) NewActiveStreamForward(
@@ -56,14 +82,14 @@ func (fwds *StreamForwards) NewActiveStreamForward(
 		ctx,
 		"NewActiveStreamForward(ctx, '%s', '%s', relayService, pauseFunc)",
 		streamID,
-		urlString,
+		redactURLString(urlString),
 	)
 	defer func() {
 		logger.Debugf(
 			ctx,
 			"/NewActiveStreamForward(ctx, '%s', '%s', relayService, pauseFunc): %#+v %v",
 			streamID,
-			urlString,
+			redactURLString(urlString),
 			_ret,
 			_err,
 		)
@@ -103,25 +129,57 @@ func (fwd *ActiveStreamForwarding) start(ctx context.Context) (_err error) {
 	}
 	ctx, cancelFn := context.WithCancel(ctx)
 	fwd.cancelFunc = cancelFn
+	if !fwd.RelaySources {
+		if fallback := fwd.getFallbackConfig(ctx); fallback != nil && fallback.IsSet() {
+			logger.Warnf(ctx, "the fallback '%s' of '%s' is ignored: it is not supported by this stream server", fallback, fwd.StreamID)
+		}
+	}
 	observability.Go(ctx, func() {
 		for {
-			err := fwd.waitForPublisherAndStart(
-				ctx,
-			)
+			var err error
+			if fwd.shouldRelaySources(ctx) {
+				err = fwd.waitForPublisherAndRelay(ctx)
+			} else {
+				err = fwd.waitForPublisherAndStart(ctx)
+			}
 			select {
 			case <-ctx.Done():
 				fwd.Close()
 				return
 			default:
 			}
-			if err != nil {
-				logger.Errorf(ctx, "%s", err)
+			if err == nil || errors.Is(err, context.Canceled) {
+				continue
+			}
+			logger.Errorf(ctx, "%s", err)
+			fwd.statsError(ctx, time.Now(), err)
+			for _, f := range fwd.NotifierFail {
+				f(ctx, fwd, err)
+			}
+			// not restarting immediately, to avoid busy looping if
+			// the problem persists
+			if err := sleep(ctx, forwardRestartInterval); err != nil {
+				fwd.Close()
+				return
 			}
 		}
 	})
 	return nil
 }
 
+// shouldRelaySources returns true if the stream should be forwarded through
+// a relay (see StreamForwards.RelaySources), which is needed only to
+// switch to the fallback; the relay is an extra hop, so the streams without
+// a fallback are forwarded directly. The fallback config is re-checked
+// before each forwarding session.
+func (fwd *ActiveStreamForwarding) shouldRelaySources(ctx context.Context) bool {
+	if !fwd.RelaySources {
+		return false
+	}
+	fallback := fwd.getFallbackConfig(ctx)
+	return fallback != nil && fallback.IsSet()
+}
+
 func (fwd *ActiveStreamForwarding) Stop() error {
 	return fwd.Close()
 }
@@ -129,31 +187,86 @@ func (fwd *ActiveStreamForwarding) Stop() error {
 func (fwd *ActiveStreamForwarding) WaitForPublisher(
 	ctx context.Context,
 ) (types.Publisher, error) {
-	var publisher types.Publisher
+	logger.Debugf(ctx, "wait for stream '%s'", fwd.StreamID)
+	publisher, err := fwd.waitForNewPublisher(ctx, fwd.StreamID)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debugf(ctx, "received a non-nil publisher: %#+v", publisher)
+
+	logger.Debugf(ctx, "checking if we need to pause")
+	fwd.PauseFunc(ctx, fwd)
+	logger.Debugf(ctx, "no pauses or pauses ended")
+	return publisher, nil
+}
+
+// waitForNewPublisher waits for a publisher of the stream, which is still
+// there (the publisher, which has just left, might be still registered).
+func (fwd *ActiveStreamForwarding) waitForNewPublisher(
+	ctx context.Context,
+	streamID types.StreamID,
+) (types.Publisher, error) {
 	for {
+		publisher, err := fwd.waitPublisher(ctx, streamID, false)
+		switch {
+		case err == nil:
+		case errors.Is(err, errNoPublisher):
+			logger.Debugf(ctx, "received publisher of '%s' is nil, retrying", streamID)
+			if err := sleep(ctx, publisherRecheckInterval); err != nil {
+				return nil, err
+			}
+			continue
+		default:
+			return nil, err
+		}
+		if !isPublisherClosed(publisher) {
+			return publisher, nil
+		}
+
+		// the publisher has left, but it is still registered, so waiting
+		// for the next one; with a timeout, because the next one might
+		// have come before the call (and then it would be skipped)
+		waitCtx, cancelFn := context.WithTimeout(ctx, publisherRecheckInterval)
+		publisher, err = fwd.waitPublisher(waitCtx, streamID, true)
+		cancelFn()
+		if err == nil && !isPublisherClosed(publisher) {
+			return publisher, nil
+		}
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		default:
 		}
+	}
+}
 
-		logger.Debugf(ctx, "wait for stream '%s'", fwd.StreamID)
-		publisherChan, err := fwd.StreamServer.WaitPublisherChan(ctx, fwd.StreamID, false)
-		if err != nil {
-			return nil, fmt.Errorf("unable to get a channel to wait for a publisher: %w", err)
-		}
-		publisher = <-publisherChan
-		if publisher != nil {
-			break
+func (fwd *ActiveStreamForwarding) waitPublisher(
+	ctx context.Context,
+	streamID types.StreamID,
+	waitForNext bool,
+) (types.Publisher, error) {
+	publisherChan, err := fwd.StreamServer.WaitPublisherChan(ctx, streamID, waitForNext)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get a channel to wait for a publisher of '%s': %w", streamID, err)
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case publisher := <-publisherChan:
+		if publisher == nil {
+			return nil, errNoPublisher
 		}
-		logger.Debugf(ctx, "received publisher is nil, retrying")
+		return publisher, nil
 	}
-	logger.Debugf(ctx, "received a non-nil publisher: %#+v", publisher)
+}
 
-	logger.Debugf(ctx, "checking if we need to pause")
-	fwd.PauseFunc(ctx, fwd)
-	logger.Debugf(ctx, "no pauses or pauses ended")
-	return publisher, nil
+func isPublisherClosed(publisher types.Publisher) bool {
+	select {
+	case <-publisher.ClosedChan():
+		return true
+	default:
+		return false
+	}
 }
 
 func (fwd *ActiveStreamForwarding) waitForPublisherAndStart(
@@ -175,6 +288,29 @@ func (fwd *ActiveStreamForwarding) waitForPublisherAndStart(
 		return fmt.Errorf("unable to get publisher: %w", err)
 	}
 
+	return fwd.forwardFrom(
+		ctx,
+		publisher.ClosedChan(),
+		func(ctx context.Context, recoderInstance recoder.Recoder) (recoder.Input, error) {
+			return fwd.openInputFor(ctx, recoderInstance, fwd.StreamID, publisher)
+		},
+		nil,
+		true,
+	)
+}
+
+// forwardFrom forwards the input (opened by openInput) to the destination
+// until the input ends, endChan is closed or ctx is cancelled.
+//
+// getFrameStats is optional, it provides the frame counters of the input
+// (if they are known).
+func (fwd *ActiveStreamForwarding) forwardFrom(
+	ctx context.Context,
+	endChan <-chan struct{},
+	openInput func(ctx context.Context, recoderInstance recoder.Recoder) (recoder.Input, error),
+	getFrameStats func() types.FrameStats,
+	notifyStart bool,
+) error {
 	ctx, cancelFn := context.WithCancel(ctx)
 	defer cancelFn()
 	observability.Go(ctx, func() {
@@ -182,12 +318,12 @@ func (fwd *ActiveStreamForwarding) waitForPublisherAndStart(
 		select {
 		case <-ctx.Done():
 			return
-		case <-publisher.ClosedChan():
+		case <-endChan:
 			return
 		}
 	})
 
-	logger.Debugf(ctx, "DestinationStreamingLocker.Lock(ctx, '%s')", fwd.DestinationURL)
+	logger.Debugf(ctx, "DestinationStreamingLocker.Lock(ctx, '%s')", streamportserver.RedactInternalCredentials(fwd.DestinationURL))
 	destinationUnlocker := fwd.StreamForwards.DestinationStreamingLocker.Lock(
 		ctx,
 		fwd.DestinationURL,
@@ -196,9 +332,9 @@ func (fwd *ActiveStreamForwarding) waitForPublisherAndStart(
 		if destinationUnlocker != nil { // if ctx was cancelled before we locked then the unlocker is nil
 			destinationUnlocker.Unlock()
 		}
-		logger.Debugf(ctx, "DestinationStreamingLocker.Unlock(ctx, '%s')", fwd.DestinationURL)
+		logger.Debugf(ctx, "DestinationStreamingLocker.Unlock(ctx, '%s')", streamportserver.RedactInternalCredentials(fwd.DestinationURL))
 	}()
-	logger.Debugf(ctx, "/DestinationStreamingLocker.Lock(ctx, '%s')", fwd.DestinationURL)
+	logger.Debugf(ctx, "/DestinationStreamingLocker.Lock(ctx, '%s')", streamportserver.RedactInternalCredentials(fwd.DestinationURL))
 
 	select {
 	case <-ctx.Done():
@@ -238,7 +374,7 @@ func (fwd *ActiveStreamForwarding) waitForPublisherAndStart(
 		}
 	}()
 
-	input, err := fwd.openInputFor(ctx, recoderInstance, publisher)
+	input, err := openInput(ctx, recoderInstance)
 	if err != nil {
 		errmon.ObserveErrorCtx(ctx, recoderInstance.Close())
 		return fmt.Errorf("unable to open the input: %w", err)
@@ -310,6 +446,15 @@ func (fwd *ActiveStreamForwarding) waitForPublisherAndStart(
 		return err
 	}
 
+	fwd.statsSessionStarted(ctx, time.Now())
+	defer fwd.statsSessionEnded(ctx)
+
+	if notifyStart {
+		for _, f := range fwd.NotifierStart {
+			f(ctx, fwd)
+		}
+	}
+
 	observability.Go(ctx, func() {
 		t := time.NewTicker(time.Second)
 		defer t.Stop()
@@ -326,8 +471,15 @@ func (fwd *ActiveStreamForwarding) waitForPublisherAndStart(
 				return
 			}
 
+			var frameStats *types.FrameStats
+			if getFrameStats != nil {
+				v := getFrameStats()
+				frameStats = &v
+			}
+
 			fwd.ReadCount.Store(stats.BytesCountRead)
 			fwd.WriteCount.Store(stats.BytesCountWrote)
+			fwd.statsSample(ctx, time.Now(), *stats, frameStats)
 		}
 	})
 
@@ -338,12 +490,13 @@ func (fwd *ActiveStreamForwarding) newEncoderFor(
 	ctx context.Context,
 	recoderInstance recoder.Recoder,
 ) (recoder.Encoder, error) {
-	return recoderInstance.NewEncoder(ctx, recoder.EncoderConfig{})
+	return recoderInstance.NewEncoder(ctx, fwd.EncoderConfig)
 }
 
 func (fwd *ActiveStreamForwarding) openInputFor(
 	ctx context.Context,
 	recoderInstance recoder.Recoder,
+	streamID types.StreamID,
 	publisher types.Publisher,
 ) (recoder.Input, error) {
 	inputURL, err := fwd.getLocalhostEndpoint(ctx)
@@ -351,7 +504,8 @@ func (fwd *ActiveStreamForwarding) openInputFor(
 		return nil, fmt.Errorf("unable to get a localhost endpoint: %w", err)
 	}
 
-	inputURL.Path = "/" + string(fwd.StreamID)
+	inputURL.Path = "/" + string(streamID)
+	streamportserver.AddInternalCredentials(inputURL)
 
 	var input recoder.Input
 	inputCfg := recoder.InputConfig{}
@@ -361,9 +515,9 @@ func (fwd *ActiveStreamForwarding) openInputFor(
 		input, err = recoderInstance.NewInputFromURL(ctx, inputURL.String(), "", inputCfg)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("unable to open '%s' as the input: %w", inputURL, err)
+		return nil, fmt.Errorf("unable to open '%s' as the input: %w", streamportserver.RedactInternalCredentials(inputURL), err)
 	}
-	logger.Debugf(ctx, "opened '%s' as the input", inputURL)
+	logger.Debugf(ctx, "opened '%s' as the input", streamportserver.RedactInternalCredentials(inputURL))
 	return input, nil
 }
 
@@ -371,20 +525,59 @@ func (fwd *ActiveStreamForwarding) openOutputFor(
 	ctx context.Context,
 	recoderInstance recoder.Recoder,
 ) (recoder.Output, error) {
+	destinationURL, streamKey := srtStreamKeyToStreamID(fwd.DestinationURL, fwd.DestinationStreamKey)
 	output, err := recoderInstance.NewOutputFromURL(
 		ctx,
-		fwd.DestinationURL.String(),
-		fwd.DestinationStreamKey,
+		outputURLString(destinationURL),
+		streamKey,
 		recoder.OutputConfig{},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to open '%s' as the output: %w", fwd.DestinationURL, err)
+		return nil, fmt.Errorf("unable to open '%s' as the output: %w", streamportserver.RedactInternalCredentials(fwd.DestinationURL), err)
 	}
-	logger.Debugf(ctx, "opened '%s' as the output", fwd.DestinationURL)
+	logger.Debugf(ctx, "opened '%s' as the output", streamportserver.RedactInternalCredentials(fwd.DestinationURL))
 
 	return output, nil
 }
 
+// redactURLString is RedactInternalCredentials for the URLs, which
+// are not parsed yet.
+func redactURLString(urlString string) string {
+	u, err := url.Parse(urlString)
+	if err != nil {
+		return urlString
+	}
+	return streamportserver.RedactInternalCredentials(u).String()
+}
+
+// outputURLString returns the destination as it should be passed to
+// the recoder. Local files (like recordings) are passed as plain paths,
+// because URL-escaping would break paths with spaces or Windows paths.
+func outputURLString(destinationURL *url.URL) string {
+	if destinationURL.Scheme == "" && destinationURL.Host == "" {
+		return destinationURL.Path
+	}
+	return destinationURL.String()
+}
+
+// srtStreamKeyToStreamID moves the stream key to the "streamid" parameter
+// if the destination is SRT, because SRT does not address streams by the path.
+func srtStreamKeyToStreamID(
+	destinationURL *url.URL,
+	streamKey string,
+) (*url.URL, string) {
+	if destinationURL.Scheme != "srt" || streamKey == "" {
+		return destinationURL, streamKey
+	}
+	result := *destinationURL
+	query := result.Query()
+	if query.Get("streamid") == "" {
+		query.Set("streamid", "publish:"+streamKey)
+	}
+	result.RawQuery = query.Encode()
+	return &result, ""
+}
+
 func (fwd *ActiveStreamForwarding) killRecodingProcess() error {
 	var result *multierror.Error
 
@@ -428,5 +621,5 @@ func (fwd *ActiveStreamForwarding) String() string {
 	if fwd == nil {
 		return "null"
 	}
-	return fmt.Sprintf("%s->%s", fwd.StreamID, fwd.DestinationURL)
+	return fmt.Sprintf("%s->%s", fwd.StreamID, streamportserver.RedactInternalCredentials(fwd.DestinationURL))
 }
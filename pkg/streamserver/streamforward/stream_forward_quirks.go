@@ -0,0 +1,220 @@
+package streamforward
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/streamctl/pkg/streamd/memoize"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+)
+
+// checkStreamRecognized asks the platform (defined by "platform") whether
+// it recognized the stream sent to the destination.
+func (s *StreamForwards) checkStreamRecognized(
+	ctx context.Context,
+	platform types.RecognizingPlatform,
+	destinationURL *url.URL,
+) (bool, error) {
+	if s.PlatformsController == nil {
+		return false, fmt.Errorf("PlatformsController is nil")
+	}
+	if platform.ByDestinationURL {
+		return s.PlatformsController.CheckStreamStartedByURL(ctx, destinationURL)
+	}
+	return s.PlatformsController.CheckStreamStartedByPlatformID(ctx, platform.GetPlatformID())
+}
+
+// waitUntilPlatformRecognizedStream blocks until the platform reports the stream
+// as started, or until the timeout of the quirk.
+func (s *StreamForwards) waitUntilPlatformRecognizedStream(
+	ctx context.Context,
+	streamID types.StreamID,
+	destinationID types.DestinationID,
+	destinationURL *url.URL,
+	cfg types.StartAfterPlatformRecognizedStream,
+) {
+	logger.Debugf(ctx, "fwd %s->%s is waiting for %s to recognize the stream", streamID, destinationID, cfg.RecognizingPlatform)
+	defer logger.Debugf(ctx, "/fwd %s->%s is waiting for %s to recognize the stream", streamID, destinationID, cfg.RecognizingPlatform)
+
+	if cfg.Timeout > 0 {
+		var cancelFn context.CancelFunc
+		ctx, cancelFn = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancelFn()
+	}
+
+	t := time.NewTicker(cfg.GetCheckInterval())
+	defer t.Stop()
+	for {
+		started, err := s.checkStreamRecognized(
+			memoize.SetNoCache(ctx, true),
+			cfg.RecognizingPlatform,
+			destinationURL,
+		)
+		logger.Debugf(ctx, "%s status check: %v %v", cfg.RecognizingPlatform, started, err)
+		if started {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (s *StreamForwards) restartUntilPlatformRecognizesStream(
+	ctx context.Context,
+	fwd *StreamForward,
+	destinationURL *url.URL,
+	cfg types.RestartUntilPlatformRecognizesStream,
+) {
+	ctx = belt.WithField(ctx, "module", "restartUntilPlatformRecognizesStream")
+	ctx = belt.WithField(
+		ctx,
+		"stream_forward",
+		fmt.Sprintf("%s->%s", fwd.StreamID, fwd.DestinationID),
+	)
+
+	logger.Debugf(ctx, "restartUntilPlatformRecognizesStream(ctx, %#+v, %#+v)", fwd, cfg)
+	defer func() { logger.Debugf(ctx, "restartUntilPlatformRecognizesStream(ctx, %#+v, %#+v)", fwd, cfg) }()
+
+	if !cfg.Enabled {
+		logger.Errorf(
+			ctx,
+			"an attempt to start restartUntilPlatformRecognizesStream when the hack is disabled for this stream forwarder: %#+v",
+			cfg,
+		)
+		return
+	}
+
+	if s.PlatformsController == nil {
+		logger.Errorf(ctx, "PlatformsController is nil")
+		return
+	}
+
+	if fwd.ActiveForwarding == nil {
+		logger.Error(ctx, "ActiveForwarding is nil")
+		return
+	}
+
+	_, err := fwd.ActiveForwarding.WaitForPublisher(ctx)
+	if err != nil {
+		logger.Error(ctx, err)
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cfg.StartTimeout):
+		}
+		logger.Debugf(
+			ctx,
+			"waited %v, checking if the remote platform (%s) accepted the stream",
+			cfg.StartTimeout,
+			cfg.RecognizingPlatform,
+		)
+
+		for {
+			streamOK, err := s.checkStreamRecognized(
+				memoize.SetNoCache(ctx, true),
+				cfg.RecognizingPlatform,
+				destinationURL,
+			)
+			logger.Debugf(
+				ctx,
+				"the result of checking the stream on the remote platform: %v %v",
+				streamOK,
+				err,
+			)
+			if err != nil {
+				logger.Errorf(
+					ctx,
+					"unable to check if the stream with URL '%s' is started: %v",
+					fwd.ActiveForwarding.DestinationURL,
+					err,
+				)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(time.Second):
+				}
+				continue
+			}
+			if streamOK {
+				logger.Debugf(
+					ctx,
+					"waiting %v to recheck if the stream will be still OK",
+					cfg.StopStartDelay,
+				)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(cfg.StopStartDelay):
+				}
+				streamOK, err := s.checkStreamRecognized(
+					memoize.SetNoCache(ctx, true),
+					cfg.RecognizingPlatform,
+					destinationURL,
+				)
+				logger.Debugf(
+					ctx,
+					"the result of checking the stream on the remote platform: %v %v",
+					streamOK,
+					err,
+				)
+				if err != nil {
+					logger.Errorf(
+						ctx,
+						"unable to check if the stream with URL '%s' is started: %v",
+						fwd.ActiveForwarding.DestinationURL,
+						err,
+					)
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(time.Second):
+					}
+					continue
+				}
+				if streamOK {
+					return
+				}
+			}
+			break
+		}
+
+		logger.Infof(
+			ctx,
+			"the remote platform (%s) still does not see the stream, restarting the stream forwarding: stopping...",
+			cfg.RecognizingPlatform,
+		)
+
+		err := fwd.ActiveForwarding.Stop()
+		if err != nil {
+			logger.Errorf(ctx, "unable to stop stream forwarding: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(cfg.StopStartDelay):
+		}
+
+		logger.Infof(
+			ctx,
+			"the remote platform (%s) still does not see the stream, restarting the stream forwarding: starting...",
+			cfg.RecognizingPlatform,
+		)
+
+		err = fwd.ActiveForwarding.Start(ctx)
+		if err != nil {
+			logger.Errorf(ctx, "unable to start stream forwarding: %v", err)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package streamforward
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	srt "github.com/datarhei/gosrt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/hashicorp/go-multierror"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/recoder"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+)
+
+// waitForPublisherAndRelay is the alternative to waitForPublisherAndStart
+// for the streams with a fallback (see shouldRelaySources): it waits for the publisher and
+// forwards it through a relay, so that when the publisher leaves, the
+// fallback (if configured) is forwarded within the same output session,
+// and when the publisher returns it is forwarded within the same session
+// again.
+func (fwd *ActiveStreamForwarding) waitForPublisherAndRelay(
+	ctx context.Context,
+) (_ret error) {
+	defer func() {
+		if r := recover(); r != nil {
+			_ret = fmt.Errorf("got panic: %v", r)
+		}
+		if _ret == nil {
+			return
+		}
+		logger.FromCtx(ctx).
+			WithField("error_event_exception_stack_trace", string(debug.Stack())).Errorf("%v", _ret)
+	}()
+
+	publisher, err := fwd.WaitForPublisher(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get publisher: %w", err)
+	}
+
+	relay, err := newStreamRelay(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to start the relay: %w", err)
+	}
+	defer func() {
+		if err := relay.Close(); err != nil {
+			logger.Errorf(ctx, "unable to close the relay: %v", err)
+		}
+	}()
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+
+	sourcesEnded := make(chan struct{})
+	var sourcesErr error
+	observability.Go(ctx, func() {
+		defer close(sourcesEnded)
+		sourcesErr = fwd.relaySources(ctx, relay, publisher)
+	})
+
+	err = fwd.forwardFrom(
+		ctx,
+		sourcesEnded,
+		func(ctx context.Context, recoderInstance recoder.Recoder) (recoder.Input, error) {
+			input, err := recoderInstance.NewInputFromURL(ctx, relay.URL(), "", recoder.InputConfig{})
+			if err != nil {
+				return nil, fmt.Errorf("unable to open the relay '%s' as the input: %w", relay.URL(), err)
+			}
+			return input, nil
+		},
+		relay.FrameStats,
+		true,
+	)
+	select {
+	case <-sourcesEnded:
+		// the output was stopped, because there is nothing to forward anymore
+		err = nil
+	default:
+	}
+	cancelFn()
+	<-sourcesEnded
+
+	var result *multierror.Error
+	if sourcesErr != nil {
+		result = multierror.Append(result, sourcesErr)
+	}
+	if err != nil {
+		result = multierror.Append(result, err)
+	}
+	return result.ErrorOrNil()
+}
+
+// relaySources feeds the publisher into the relay and, after it left,
+// the fallback until the publisher returns, and so on; it returns when
+// there is nothing to feed anymore.
+func (fwd *ActiveStreamForwarding) relaySources(
+	ctx context.Context,
+	relay *streamRelay,
+	publisher types.Publisher,
+) error {
+	for {
+		err := fwd.relayPublisher(ctx, relay, fwd.StreamID, publisher)
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		if err != nil {
+			return fmt.Errorf("unable to forward '%s': %w", fwd.StreamID, err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-publisher.ClosedChan():
+		case <-time.After(fallbackRestartInterval):
+			return fmt.Errorf("the stream '%s' ended, but the publisher is still there", fwd.StreamID)
+		}
+
+		publisher, err = fwd.relayFallbackUntilPublisher(ctx, relay)
+		if err != nil {
+			return err
+		}
+		if publisher == nil {
+			return nil
+		}
+	}
+}
+
+// relayPublisher feeds the stream of the publisher into the relay until
+// the publisher leaves.
+func (fwd *ActiveStreamForwarding) relayPublisher(
+	ctx context.Context,
+	relay *streamRelay,
+	streamID types.StreamID,
+	publisher types.Publisher,
+) (_err error) {
+	logger.Debugf(ctx, "relayPublisher(ctx, relay, '%s')", streamID)
+	defer func() { logger.Debugf(ctx, "/relayPublisher(ctx, relay, '%s'): %v", streamID, _err) }()
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	defer cancelFn()
+	observability.Go(ctx, func() {
+		select {
+		case <-ctx.Done():
+		case <-publisher.ClosedChan():
+			logger.Debugf(ctx, "the publisher of '%s' left", streamID)
+			cancelFn()
+		}
+	})
+
+	feederURL, connChan, forgetFeeder := relay.expectFeeder(ctx)
+	defer forgetFeeder()
+
+	recoderInstance, err := fwd.RecoderFactory.New(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to initialize a recoder: %w", err)
+	}
+	defer func() {
+		if err := recoderInstance.Close(); err != nil {
+			logger.Errorf(ctx, "unable to close the recoder: %v", err)
+		}
+	}()
+
+	// the relay expects the stream as is, the encoding (if any) is done
+	// by the output
+	encoder, err := recoderInstance.NewEncoder(ctx, recoder.EncoderConfig{})
+	if err != nil {
+		return fmt.Errorf("unable to initialize the encoder: %w", err)
+	}
+	defer func() {
+		if err := encoder.Close(); err != nil {
+			logger.Errorf(ctx, "unable to close the encoder: %v", err)
+		}
+	}()
+
+	input, err := fwd.openInputFor(ctx, recoderInstance, streamID, publisher)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := input.Close(); err != nil {
+			logger.Errorf(ctx, "unable to close the input: %v", err)
+		}
+	}()
+
+	output, err := recoderInstance.NewOutputFromURL(ctx, feederURL, "", recoder.OutputConfig{})
+	if err != nil {
+		return fmt.Errorf("unable to open the relay as the output: %w", err)
+	}
+	defer func() {
+		if err := output.Close(); err != nil {
+			logger.Errorf(ctx, "unable to close the output: %v", err)
+		}
+	}()
+
+	if err := recoderInstance.StartRecoding(ctx, encoder, input, output); err != nil {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		return fmt.Errorf("unable to Recode: %w", err)
+	}
+
+	recodingEnded := make(chan error, 1)
+	observability.Go(ctx, func() {
+		recodingEnded <- recoderInstance.WaitForRecodingEnd(ctx)
+	})
+
+	var conn srt.Conn
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-recodingEnded:
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		return fmt.Errorf("the recoding ended before connecting to the relay: %w", err)
+	case conn = <-connChan:
+	}
+	observability.Go(ctx, func() {
+		// unblocks the reading
+		<-ctx.Done()
+		conn.Close()
+	})
+
+	if err := relay.Feed(ctx, conn, false); err != nil {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		return fmt.Errorf("unable to relay: %w", err)
+	}
+	return nil
+}
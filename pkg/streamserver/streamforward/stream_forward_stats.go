@@ -0,0 +1,132 @@
+package streamforward
+
+import (
+	"context"
+	"time"
+
+	"github.com/xaionaro-go/recoder"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+)
+
+type forwardingStats struct {
+	types.StreamForwardStats
+	sessionCount     uint64
+	sessionStartedAt time.Time
+	prevSampleTS     time.Time
+	prevKeyframeTS   time.Time
+	prevStats        recoder.Stats
+	prevFrameStats   types.FrameStats
+}
+
+// Stats returns the current health metrics of the forwarding.
+func (fwd *ActiveStreamForwarding) Stats(ctx context.Context) types.StreamForwardStats {
+	var result types.StreamForwardStats
+	fwd.statsLocker.Do(ctx, func() {
+		result = fwd.stats.StreamForwardStats
+		if !fwd.stats.sessionStartedAt.IsZero() {
+			result.Uptime = time.Since(fwd.stats.sessionStartedAt)
+		}
+	})
+	return result
+}
+
+func (fwd *ActiveStreamForwarding) statsSessionStarted(
+	ctx context.Context,
+	now time.Time,
+) {
+	fwd.statsLocker.Do(ctx, func() {
+		s := &fwd.stats
+		s.sessionCount++
+		if s.sessionCount > 1 {
+			s.Reconnects++
+		}
+		s.sessionStartedAt = now
+		s.prevSampleTS = now
+		s.prevKeyframeTS = now
+		s.prevStats = recoder.Stats{}
+		s.prevFrameStats = types.FrameStats{}
+		s.HasFrameStats = false
+		s.FrameRate = 0
+		s.KeyframeInterval = 0
+		s.DroppedPackets = 0
+		s.LatePackets = 0
+	})
+}
+
+func (fwd *ActiveStreamForwarding) statsSessionEnded(
+	ctx context.Context,
+) {
+	fwd.statsLocker.Do(ctx, func() {
+		s := &fwd.stats
+		s.sessionStartedAt = time.Time{}
+		s.InputBitrate = 0
+		s.OutputBitrate = 0
+		s.HasFrameStats = false
+		s.FrameRate = 0
+		s.KeyframeInterval = 0
+		s.DroppedPackets = 0
+		s.LatePackets = 0
+	})
+}
+
+func (fwd *ActiveStreamForwarding) statsSample(
+	ctx context.Context,
+	now time.Time,
+	stats recoder.Stats,
+	frameStats *types.FrameStats,
+) {
+	fwd.statsLocker.Do(ctx, func() {
+		s := &fwd.stats
+		if s.sessionStartedAt.IsZero() {
+			// a late sample after the session has already ended
+			return
+		}
+		elapsed := now.Sub(s.prevSampleTS)
+		if elapsed <= 0 {
+			return
+		}
+		s.InputBitrate = bitrate(s.prevStats.BytesCountRead, stats.BytesCountRead, elapsed)
+		s.OutputBitrate = bitrate(s.prevStats.BytesCountWrote, stats.BytesCountWrote, elapsed)
+		s.prevStats = stats
+
+		if frameStats != nil {
+			s.HasFrameStats = true
+			frames := counterDelta(s.prevFrameStats.FramesWrote, frameStats.FramesWrote)
+			keyframes := counterDelta(s.prevFrameStats.KeyframesWrote, frameStats.KeyframesWrote)
+			s.FrameRate = float64(frames) / elapsed.Seconds()
+			if keyframes > 0 {
+				// a GOP is usually longer than the sampling period, so
+				// measuring since the previous sample with keyframes
+				s.KeyframeInterval = now.Sub(s.prevKeyframeTS) / time.Duration(keyframes)
+				s.prevKeyframeTS = now
+			}
+			s.DroppedPackets = frameStats.DroppedPackets
+			s.LatePackets = frameStats.LatePackets
+			s.prevFrameStats = *frameStats
+		}
+		s.prevSampleTS = now
+	})
+}
+
+func (fwd *ActiveStreamForwarding) statsError(
+	ctx context.Context,
+	now time.Time,
+	err error,
+) {
+	fwd.statsLocker.Do(ctx, func() {
+		fwd.stats.LastError = err.Error()
+		fwd.stats.LastErrorAt = now
+	})
+}
+
+func bitrate(prevBytes, curBytes uint64, elapsed time.Duration) uint64 {
+	return uint64(float64(counterDelta(prevBytes, curBytes)*8) / elapsed.Seconds())
+}
+
+func counterDelta(prev, cur uint64) uint64 {
+	if cur < prev {
+		// the counter was reset
+		return cur
+	}
+	return cur - prev
+}
@@ -0,0 +1,65 @@
+package streamforward
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/recoder"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+)
+
+func TestActiveStreamForwardingStats(t *testing.T) {
+	ctx := context.Background()
+	fwd := &ActiveStreamForwarding{}
+	now := time.Now()
+
+	fwd.statsSessionStarted(ctx, now)
+	fwd.statsSample(ctx, now.Add(time.Second), recoder.Stats{
+		BytesCountRead:  1000,
+		BytesCountWrote: 500,
+	}, nil)
+	stats := fwd.Stats(ctx)
+	require.Equal(t, uint64(8000), stats.InputBitrate)
+	require.Equal(t, uint64(4000), stats.OutputBitrate)
+	require.False(t, stats.HasFrameStats, "the frames are not seen on the direct path")
+	require.Zero(t, stats.FrameRate)
+	require.Zero(t, stats.Reconnects)
+	require.NotZero(t, stats.Uptime)
+
+	fwd.statsSample(ctx, now.Add(2*time.Second), recoder.Stats{
+		BytesCountRead:  3000,
+		BytesCountWrote: 1500,
+	}, &types.FrameStats{
+		FramesWrote:    30,
+		KeyframesWrote: 1,
+		DroppedPackets: 2,
+	})
+	stats = fwd.Stats(ctx)
+	require.Equal(t, uint64(16000), stats.InputBitrate)
+	require.Equal(t, uint64(8000), stats.OutputBitrate)
+	require.True(t, stats.HasFrameStats)
+	require.Equal(t, float64(30), stats.FrameRate)
+	require.Equal(t, 2*time.Second, stats.KeyframeInterval)
+	require.Equal(t, uint64(2), stats.DroppedPackets)
+
+	fwd.statsError(ctx, now.Add(3*time.Second), errors.New("connection reset"))
+	fwd.statsSessionEnded(ctx)
+	stats = fwd.Stats(ctx)
+	require.Zero(t, stats.OutputBitrate)
+	require.Zero(t, stats.Uptime)
+	require.False(t, stats.HasFrameStats)
+	require.Equal(t, "connection reset", stats.LastError)
+
+	fwd.statsSessionStarted(ctx, now.Add(4*time.Second))
+	fwd.statsSample(ctx, now.Add(5*time.Second), recoder.Stats{
+		BytesCountRead:  100,
+		BytesCountWrote: 100,
+	}, nil)
+	stats = fwd.Stats(ctx)
+	require.Equal(t, uint64(1), stats.Reconnects)
+	require.Equal(t, uint64(800), stats.OutputBitrate)
+	require.Zero(t, stats.DroppedPackets)
+}
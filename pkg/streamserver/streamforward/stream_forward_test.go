@@ -0,0 +1,51 @@
+package streamforward
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSRTStreamKeyToStreamID(t *testing.T) {
+	for _, tc := range []struct {
+		URL               string
+		StreamKey         string
+		ExpectedURL       string
+		ExpectedStreamKey string
+	}{
+		{
+			URL:               "rtmp://live.twitch.tv/app",
+			StreamKey:         "key",
+			ExpectedURL:       "rtmp://live.twitch.tv/app",
+			ExpectedStreamKey: "key",
+		},
+		{
+			URL:               "srt://ingest.example.com:9000?latency=2000",
+			StreamKey:         "key",
+			ExpectedURL:       "srt://ingest.example.com:9000?latency=2000&streamid=publish%3Akey",
+			ExpectedStreamKey: "",
+		},
+		{
+			URL:               "srt://ingest.example.com:9000?streamid=custom",
+			StreamKey:         "key",
+			ExpectedURL:       "srt://ingest.example.com:9000?streamid=custom",
+			ExpectedStreamKey: "",
+		},
+		{
+			URL:               "srt://ingest.example.com:9000",
+			StreamKey:         "",
+			ExpectedURL:       "srt://ingest.example.com:9000",
+			ExpectedStreamKey: "",
+		},
+	} {
+		t.Run(tc.URL, func(t *testing.T) {
+			u, err := url.Parse(tc.URL)
+			require.NoError(t, err)
+			resultURL, resultStreamKey := srtStreamKeyToStreamID(u, tc.StreamKey)
+			require.Equal(t, tc.ExpectedURL, resultURL.String())
+			require.Equal(t, tc.ExpectedStreamKey, resultStreamKey)
+			require.Equal(t, tc.URL, u.String())
+		})
+	}
+}
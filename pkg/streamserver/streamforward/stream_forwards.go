@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
-	"time"
 
 	"github.com/facebookincubator/go-belt"
 	"github.com/facebookincubator/go-belt/tool/logger"
@@ -13,9 +12,8 @@ import (
 	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/recoder"
 	"github.com/xaionaro-go/streamctl/pkg/secret"
-	"github.com/xaionaro-go/streamctl/pkg/streamcontrol/youtube"
-	"github.com/xaionaro-go/streamctl/pkg/streamd/memoize"
 	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
 	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
 	"github.com/xaionaro-go/typing/ordered"
 	"github.com/xaionaro-go/xsync"
@@ -31,6 +29,7 @@ type StreamServer interface {
 	types.WaitPublisherChaner
 	types.PubsubNameser
 	types.GetPortServerser
+	types.InternalStreamer
 }
 
 type StreamForwards struct {
@@ -39,8 +38,22 @@ type StreamForwards struct {
 	Mutex                      xsync.Mutex
 	DestinationStreamingLocker *lockmap.LockMap
 	ActiveStreamForwardings    map[ForwardingKey]*ActiveStreamForwarding
+	ActiveStreamRecordings     map[types.StreamID]*ActiveStreamRecording
+	ActiveRenditions           map[RenditionKey]*ActiveRendition
 	StreamDestinations         []types.StreamDestination
 	RecoderFactory             recoder.Factory
+	NotifierStart              []types.FuncNotifyStreamForwardStart
+	NotifierFail               []types.FuncNotifyStreamForwardFail
+	NotifierSourceSwitch       []types.FuncNotifyStreamForwardSourceSwitch
+	NotifierFallbackStop       []types.FuncNotifyStreamForwardFallbackStop
+
+	// RelaySources allows the forwardings of the streams with a fallback
+	// to read the stream through a local MPEG-TS relay (see streamRelay),
+	// which is required to switch to the fallback and back without
+	// reopening the output. It requires a recoder able to write MPEG-TS
+	// to "srt://" and to read it from "http://" (like the libav one).
+	// The streams without a fallback are always forwarded directly.
+	RelaySources bool
 }
 
 func NewStreamForwards(
@@ -54,6 +67,8 @@ func NewStreamForwards(
 		PlatformsController:        pc,
 		DestinationStreamingLocker: lockmap.NewLockMap(),
 		ActiveStreamForwardings:    map[ForwardingKey]*ActiveStreamForwarding{},
+		ActiveStreamRecordings:     map[types.StreamID]*ActiveStreamRecording{},
+		ActiveRenditions:           map[RenditionKey]*ActiveRendition{},
 	}
 }
 
@@ -68,8 +83,14 @@ func (s *StreamForwards) Init(
 
 func (s *StreamForwards) init(
 	ctx context.Context,
-	_ ...types.InitOption,
+	opts ...types.InitOption,
 ) (_ret error) {
+	initCfg := types.InitOptions(opts).Config()
+	s.NotifierStart = initCfg.NotifierStreamForwardStart
+	s.NotifierFail = initCfg.NotifierStreamForwardFail
+	s.NotifierSourceSwitch = initCfg.NotifierStreamForwardSourceSwitch
+	s.NotifierFallbackStop = initCfg.NotifierStreamForwardFallbackStop
+
 	s.WithConfig(ctx, func(ctx context.Context, cfg *types.Config) {
 		for dstID, dstCfg := range cfg.Destinations {
 			err := s.addActiveStreamDestination(ctx, dstID, dstCfg.URL, dstCfg.StreamKey)
@@ -89,7 +110,17 @@ func (s *StreamForwards) init(
 				if fwd.Disabled {
 					continue
 				}
-				_, err := s.newActiveStreamForward(ctx, streamID, dstID, fwd.Quirks)
+				encodingProfile, err := cfg.GetEncodingProfile(fwd)
+				if err != nil {
+					_ret = fmt.Errorf(
+						"invalid stream forward from '%s' to '%s': %w",
+						streamID,
+						dstID,
+						err,
+					)
+					return
+				}
+				_, err = s.newActiveStreamForward(ctx, streamID, dstID, fwd, encodingProfile)
 				if err != nil {
 					_ret = fmt.Errorf(
 						"unable to launch stream forward from '%s' to '%s': %w",
@@ -100,6 +131,20 @@ func (s *StreamForwards) init(
 					return
 				}
 			}
+
+			if streamCfg.Recording != nil && streamCfg.Recording.Enabled {
+				// a broken recording (e.g. an inaccessible directory) should
+				// not prevent the streams from being served and forwarded
+				_, err := s.newActiveStreamRecording(ctx, streamID, *streamCfg.Recording)
+				if err != nil {
+					logger.Errorf(
+						ctx,
+						"unable to launch the recording of stream '%s': %v",
+						streamID,
+						err,
+					)
+				}
+			}
 		}
 	})
 	return
@@ -128,6 +173,7 @@ func (s *StreamForwards) addStreamForward(
 
 	var (
 		streamConfig *types.StreamConfig
+		fwdConfig    types.ForwardingConfig
 		err          error
 	)
 	s.WithConfig(ctx, func(ctx context.Context, cfg *types.Config) {
@@ -146,17 +192,18 @@ func (s *StreamForwards) addStreamForward(
 			return
 		}
 
-		streamConfig.Forwardings[destinationID] = types.ForwardingConfig{
+		fwdConfig = types.ForwardingConfig{
 			Disabled: !enabled,
 			Quirks:   quirks,
 		}
+		streamConfig.Forwardings[destinationID] = fwdConfig
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	if enabled {
-		fwd, err := s.newActiveStreamForward(ctx, streamID, destinationID, quirks)
+		fwd, err := s.newActiveStreamForward(ctx, streamID, destinationID, fwdConfig, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -171,9 +218,9 @@ func (s *StreamForwards) addStreamForward(
 }
 
 func (s *StreamForwards) getLocalhostRTMP(ctx context.Context) (*url.URL, error) {
-	portSrvs, err := s.StreamServer.GetPortServers(ctx)
+	portSrvs, err := s.getPathAddressablePortServers(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get port servers info: %w", err)
+		return nil, err
 	}
 	portSrv := portSrvs[0]
 
@@ -186,14 +233,19 @@ func (s *StreamForwards) getLocalhostRTMP(ctx context.Context) (*url.URL, error)
 	return urlParsed, nil
 }
 
+// newActiveStreamForward starts the forwarding; encodingProfile is
+// the resolved profile of fwdCfg.EncodingProfile (see
+// Config.GetEncodingProfile), nil means to forward the stream as is.
 func (s *StreamForwards) newActiveStreamForward(
 	ctx context.Context,
 	streamID types.StreamID,
 	destinationID types.DestinationID,
-	quirks types.ForwardingQuirks,
+	fwdCfg types.ForwardingConfig,
+	encodingProfile *types.EncodingProfile,
 	opts ...Option,
-) (*StreamForward, error) {
+) (_ret *StreamForward, _err error) {
 	ctx = belt.WithField(ctx, "stream_forward", fmt.Sprintf("%s->%s", streamID, destinationID))
+	quirks := fwdCfg.Quirks
 	key := ForwardingKey{
 		StreamID:      streamID,
 		DestinationID: destinationID,
@@ -214,6 +266,7 @@ func (s *StreamForwards) newActiveStreamForward(
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse URL '%s': %w", dst.URL, err)
 	}
+	dstURL := *urlParsed
 
 	if urlParsed.Host == "" {
 		urlParsed, err = s.getLocalhostRTMP(ctx)
@@ -231,64 +284,69 @@ func (s *StreamForwards) newActiveStreamForward(
 		NumBytesRead:  0,
 	}
 
+	sourceStreamID := streamID
+	var rendition *ActiveRendition
+	if encodingProfile != nil {
+		rendition, err = s.acquireRendition(ctx, streamID, fwdCfg.EncodingProfile, *encodingProfile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the rendition '%s' of '%s': %w", fwdCfg.EncodingProfile, streamID, err)
+		}
+		defer func() {
+			if _err != nil {
+				if err := s.releaseRendition(ctx, rendition); err != nil {
+					logger.Errorf(ctx, "unable to release the rendition: %v", err)
+				}
+			}
+		}()
+		sourceStreamID = rendition.RenditionStreamID()
+	}
+
 	fwd, err := s.NewActiveStreamForward(
 		ctx,
-		streamID,
+		sourceStreamID,
 		urlParsed.String(),
 		dst.StreamKey.Get(),
 		func(
 			ctx context.Context,
 			fwd *ActiveStreamForwarding,
 		) {
-			if quirks.StartAfterYoutubeRecognizedStream.Enabled {
-				if quirks.RestartUntilYoutubeRecognizesStream.Enabled {
-					logger.Errorf(
-						ctx,
-						"StartAfterYoutubeRecognizedStream should not be used together with RestartUntilYoutubeRecognizesStream",
-					)
-				} else {
-					logger.Debugf(ctx, "fwd %s->%s is waiting for YouTube to recognize the stream", streamID, destinationID)
-					started, err := s.PlatformsController.CheckStreamStartedByPlatformID(
-						memoize.SetNoCache(ctx, true),
-						youtube.ID,
-					)
-					logger.Debugf(ctx, "youtube status check: %v %v", started, err)
-					if started {
-						return
-					}
-					t := time.NewTicker(time.Second)
-					for {
-						select {
-						case <-ctx.Done():
-							return
-						case <-t.C:
-						}
-						started, err := s.PlatformsController.CheckStreamStartedByPlatformID(
-							ctx,
-							youtube.ID,
-						)
-						logger.Debugf(ctx, "youtube status check: %v %v", started, err)
-						if started {
-							return
-						}
-					}
-				}
+			if !quirks.StartAfterPlatformRecognizedStream.Enabled {
+				return
 			}
+			if quirks.RestartUntilPlatformRecognizesStream.Enabled {
+				logger.Errorf(
+					ctx,
+					"StartAfterPlatformRecognizedStream should not be used together with RestartUntilPlatformRecognizesStream",
+				)
+				return
+			}
+			s.waitUntilPlatformRecognizedStream(
+				ctx,
+				streamID,
+				destinationID,
+				&dstURL,
+				quirks.StartAfterPlatformRecognizedStream,
+			)
 		},
-		opts...,
+		append(s.notifierOptions(streamID, destinationID), opts...)...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to run the stream forwarding: %w", err)
 	}
+	if rendition != nil {
+		fwd.rendition = rendition
+		rendition.attachForward(ctx, fwd)
+	}
 	s.ActiveStreamForwardings[key] = fwd
 	result.ActiveForwarding = fwd
 
-	if quirks.RestartUntilYoutubeRecognizesStream.Enabled {
+	if quirks.RestartUntilPlatformRecognizesStream.Enabled {
 		observability.Go(ctx, func() {
-			s.restartUntilYoutubeRecognizesStream(
+			s.restartUntilPlatformRecognizesStream(
 				ctx,
 				result,
-				quirks.RestartUntilYoutubeRecognizesStream,
+				&dstURL,
+				quirks.RestartUntilPlatformRecognizesStream,
 			)
 		})
 	}
@@ -296,143 +354,52 @@ func (s *StreamForwards) newActiveStreamForward(
 	return result, nil
 }
 
-func (s *StreamForwards) restartUntilYoutubeRecognizesStream(
-	ctx context.Context,
-	fwd *StreamForward,
-	cfg types.RestartUntilYoutubeRecognizesStream,
-) {
-	ctx = belt.WithField(ctx, "module", "restartUntilYoutubeRecognizesStream")
-	ctx = belt.WithField(
-		ctx,
-		"stream_forward",
-		fmt.Sprintf("%s->%s", fwd.StreamID, fwd.DestinationID),
-	)
-
-	logger.Debugf(ctx, "restartUntilYoutubeRecognizesStream(ctx, %#+v, %#+v)", fwd, cfg)
-	defer func() { logger.Debugf(ctx, "restartUntilYoutubeRecognizesStream(ctx, %#+v, %#+v)", fwd, cfg) }()
-
-	if !cfg.Enabled {
-		logger.Errorf(
-			ctx,
-			"an attempt to start restartUntilYoutubeRecognizesStream when the hack is disabled for this stream forwarder: %#+v",
-			cfg,
-		)
-		return
-	}
-
-	if s.PlatformsController == nil {
-		logger.Errorf(ctx, "PlatformsController is nil")
-		return
-	}
-
-	if fwd.ActiveForwarding == nil {
-		logger.Error(ctx, "ActiveForwarding is nil")
-		return
-	}
-
-	_, err := fwd.ActiveForwarding.WaitForPublisher(ctx)
-	if err != nil {
-		logger.Error(ctx, err)
-		return
+func (s *StreamForwards) notifierOptions(
+	streamID types.StreamID,
+	destinationID types.DestinationID,
+) Options {
+	var opts Options
+	if len(s.NotifierStart) > 0 {
+		notifiers := s.NotifierStart
+		opts = append(opts, OptionNotifierStart{
+			func(ctx context.Context, fwd *ActiveStreamForwarding) {
+				for _, f := range notifiers {
+					f(ctx, streamID, destinationID)
+				}
+			},
+		})
 	}
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-time.After(cfg.StartTimeout):
-		}
-		logger.Debugf(
-			ctx,
-			"waited %v, checking if the remote platform accepted the stream",
-			cfg.StartTimeout,
-		)
-
-		for {
-			streamOK, err := s.PlatformsController.CheckStreamStartedByPlatformID(
-				memoize.SetNoCache(ctx, true),
-				youtube.ID,
-			)
-			logger.Debugf(
-				ctx,
-				"the result of checking the stream on the remote platform: %v %v",
-				streamOK,
-				err,
-			)
-			if err != nil {
-				logger.Errorf(
-					ctx,
-					"unable to check if the stream with URL '%s' is started: %v",
-					fwd.ActiveForwarding.DestinationURL,
-					err,
-				)
-				time.Sleep(time.Second)
-				continue
-			}
-			if streamOK {
-				logger.Debugf(
-					ctx,
-					"waiting %v to recheck if the stream will be still OK",
-					cfg.StopStartDelay,
-				)
-				select {
-				case <-ctx.Done():
-					return
-				case <-time.After(cfg.StopStartDelay):
+	if len(s.NotifierFail) > 0 {
+		notifiers := s.NotifierFail
+		opts = append(opts, OptionNotifierFail{
+			func(ctx context.Context, fwd *ActiveStreamForwarding, err error) {
+				for _, f := range notifiers {
+					f(ctx, streamID, destinationID, err)
 				}
-				streamOK, err := s.PlatformsController.CheckStreamStartedByPlatformID(
-					memoize.SetNoCache(ctx, true),
-					youtube.ID,
-				)
-				logger.Debugf(
-					ctx,
-					"the result of checking the stream on the remote platform: %v %v",
-					streamOK,
-					err,
-				)
-				if err != nil {
-					logger.Errorf(
-						ctx,
-						"unable to check if the stream with URL '%s' is started: %v",
-						fwd.ActiveForwarding.DestinationURL,
-						err,
-					)
-					time.Sleep(time.Second)
-					continue
+			},
+		})
+	}
+	if len(s.NotifierSourceSwitch) > 0 {
+		notifiers := s.NotifierSourceSwitch
+		opts = append(opts, OptionNotifierSourceSwitch{
+			func(ctx context.Context, fwd *ActiveStreamForwarding, source string, isFallback bool) {
+				for _, f := range notifiers {
+					f(ctx, streamID, destinationID, source, isFallback)
 				}
-				if streamOK {
-					return
+			},
+		})
+	}
+	if len(s.NotifierFallbackStop) > 0 {
+		notifiers := s.NotifierFallbackStop
+		opts = append(opts, OptionNotifierFallbackStop{
+			func(ctx context.Context, fwd *ActiveStreamForwarding, fallback string, reason error) {
+				for _, f := range notifiers {
+					f(ctx, streamID, destinationID, fallback, reason)
 				}
-			}
-			break
-		}
-
-		logger.Infof(
-			ctx,
-			"the remote platform still does not see the stream, restarting the stream forwarding: stopping...",
-		)
-
-		err := fwd.ActiveForwarding.Stop()
-		if err != nil {
-			logger.Errorf(ctx, "unable to stop stream forwarding: %v", err)
-		}
-
-		select {
-		case <-ctx.Done():
-			return
-		case <-time.After(cfg.StopStartDelay):
-		}
-
-		logger.Infof(
-			ctx,
-			"the remote platform still does not see the stream, restarting the stream forwarding: starting...",
-		)
-
-		err = fwd.ActiveForwarding.Start(ctx)
-		if err != nil {
-			logger.Errorf(ctx, "unable to start stream forwarding: %v", err)
-		}
+			},
+		})
 	}
+	return opts
 }
 
 func (s *StreamForwards) UpdateStreamForward(
@@ -462,10 +429,18 @@ func (s *StreamForwards) updateStreamForward(
 			return
 		}
 
+		newFwdCfg := fwdCfg
+		newFwdCfg.Disabled = !enabled
+		newFwdCfg.Quirks = quirks
+
 		var fwd *StreamForward
 		if fwdCfg.Disabled && enabled {
-			var err error
-			fwd, err = s.newActiveStreamForward(ctx, streamID, destinationID, quirks)
+			encodingProfile, err := cfg.GetEncodingProfile(newFwdCfg)
+			if err != nil {
+				_err = fmt.Errorf("invalid stream forward: %w", err)
+				return
+			}
+			fwd, err = s.newActiveStreamForward(ctx, streamID, destinationID, newFwdCfg, encodingProfile)
 			if err != nil {
 				_err = fmt.Errorf("unable to active the stream: %w", err)
 				return
@@ -478,10 +453,7 @@ func (s *StreamForwards) updateStreamForward(
 				return
 			}
 		}
-		streamConfig.Forwardings[destinationID] = types.ForwardingConfig{
-			Disabled: !enabled,
-			Quirks:   quirks,
-		}
+		streamConfig.Forwardings[destinationID] = newFwdCfg
 
 		r := &StreamForward{
 			StreamID:      streamID,
@@ -571,6 +543,7 @@ func (s *StreamForwards) getStreamForwards(
 				}]; ok {
 					item.NumBytesWrote = activeFwd.NumBytesWrote
 					item.NumBytesRead = activeFwd.NumBytesRead
+					item.Stats = activeFwd.Stats
 				}
 				logger.Tracef(ctx, "stream forwarding '%s->%s': %#+v", streamID, dstID, cfg)
 				result = append(result, item)
@@ -581,7 +554,7 @@ func (s *StreamForwards) getStreamForwards(
 }
 
 func (s *StreamForwards) listActiveStreamForwards(
-	_ context.Context,
+	ctx context.Context,
 ) ([]StreamForward, error) {
 	var result []StreamForward
 	for key, fwd := range s.ActiveStreamForwardings {
@@ -591,6 +564,7 @@ func (s *StreamForwards) listActiveStreamForwards(
 			Enabled:       true,
 			NumBytesWrote: fwd.WriteCount.Load(),
 			NumBytesRead:  fwd.ReadCount.Load(),
+			Stats:         fwd.Stats(ctx),
 		})
 	}
 	return result, nil
@@ -623,7 +597,7 @@ func (s *StreamForwards) removeStreamForward(
 }
 
 func (s *StreamForwards) removeActiveStreamForward(
-	_ context.Context,
+	ctx context.Context,
 	streamID types.StreamID,
 	dstID types.DestinationID,
 ) error {
@@ -643,6 +617,13 @@ func (s *StreamForwards) removeActiveStreamForward(
 		return fmt.Errorf("unable to close stream forwarding: %w", err)
 	}
 
+	if fwd.rendition != nil {
+		fwd.rendition.detachForward(ctx, fwd)
+		if err := s.releaseRendition(ctx, fwd.rendition); err != nil {
+			return fmt.Errorf("unable to release the rendition: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -853,9 +834,9 @@ func (s *StreamForwards) findStreamDestinationByID(
 func (s *StreamForwards) getLocalhostEndpoint(ctx context.Context) (_ret *url.URL, _err error) {
 	defer func() { logger.Debugf(ctx, "getLocalhostEndpoint result: %v %v", _ret, _err) }()
 
-	portSrvs, err := s.StreamServer.GetPortServers(ctx)
+	portSrvs, err := s.getPathAddressablePortServers(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get port servers info: %w", err)
+		return nil, err
 	}
 
 	sort.Slice(portSrvs, func(i, j int) bool {
@@ -881,3 +862,27 @@ func (s *StreamForwards) getLocalhostEndpoint(ctx context.Context) (_ret *url.UR
 
 	return urlParsed, nil
 }
+
+// getPathAddressablePortServers returns the port servers, where a stream
+// is addressed by the URL path (unlike SRT, where it is addressed
+// by the "streamid" parameter).
+func (s *StreamForwards) getPathAddressablePortServers(
+	ctx context.Context,
+) ([]streamportserver.Config, error) {
+	portSrvs, err := s.StreamServer.GetPortServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get port servers info: %w", err)
+	}
+
+	var result []streamportserver.Config
+	for _, portSrv := range portSrvs {
+		switch portSrv.Type {
+		case streamtypes.ServerTypeRTMP, streamtypes.ServerTypeRTSP:
+			result = append(result, portSrv)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no RTMP or RTSP port servers are running")
+	}
+	return result, nil
+}
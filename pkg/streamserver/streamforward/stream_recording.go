@@ -0,0 +1,421 @@
+package streamforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	recordingFileTimeFormat = "2006-01-02_15-04-05"
+	recordingRetryInterval  = time.Second
+)
+
+// ActiveStreamRecording records an incoming stream to local files.
+//
+// It reuses the pipeline of ActiveStreamForwarding (with a local file
+// as the destination), so the stream is remuxed and not transcoded.
+type ActiveStreamRecording struct {
+	*StreamForwards
+	StreamID types.StreamID
+	Config   types.RecordingConfig
+
+	locker      xsync.Mutex
+	cancelFunc  context.CancelFunc
+	currentFile string
+}
+
+func (s *StreamForwards) StartStreamRecording(
+	ctx context.Context,
+	streamID types.StreamID,
+	recordingCfg types.RecordingConfig,
+) error {
+	ctx = belt.WithField(ctx, "module", "StreamServer")
+	return xsync.DoA3R1(ctx, &s.Mutex, s.startStreamRecording, ctx, streamID, recordingCfg)
+}
+
+func (s *StreamForwards) startStreamRecording(
+	ctx context.Context,
+	streamID types.StreamID,
+	recordingCfg types.RecordingConfig,
+) (_err error) {
+	if recordingCfg.Directory == "" {
+		return fmt.Errorf("the directory for the recordings is not set")
+	}
+	recordingCfg.Enabled = true
+
+	s.WithConfig(ctx, func(ctx context.Context, cfg *types.Config) {
+		streamCfg := cfg.Streams[streamID]
+		if streamCfg == nil {
+			_err = fmt.Errorf("stream '%s' does not exist", streamID)
+			return
+		}
+
+		if err := s.removeActiveStreamRecording(ctx, streamID); err != nil {
+			_err = fmt.Errorf("unable to stop the previous recording: %w", err)
+			return
+		}
+
+		if _, err := s.newActiveStreamRecording(ctx, streamID, recordingCfg); err != nil {
+			_err = err
+			return
+		}
+		streamCfg.Recording = &recordingCfg
+	})
+	return
+}
+
+func (s *StreamForwards) StopStreamRecording(
+	ctx context.Context,
+	streamID types.StreamID,
+) error {
+	ctx = belt.WithField(ctx, "module", "StreamServer")
+	return xsync.DoA2R1(ctx, &s.Mutex, s.stopStreamRecording, ctx, streamID)
+}
+
+func (s *StreamForwards) stopStreamRecording(
+	ctx context.Context,
+	streamID types.StreamID,
+) (_err error) {
+	s.WithConfig(ctx, func(ctx context.Context, cfg *types.Config) {
+		streamCfg := cfg.Streams[streamID]
+		if streamCfg == nil || streamCfg.Recording == nil || !streamCfg.Recording.Enabled {
+			_err = fmt.Errorf("stream '%s' is not being recorded", streamID)
+			return
+		}
+		// keeping the rest of the settings, so that the recordings
+		// are still listed and the retention rules could be reused
+		streamCfg.Recording.Enabled = false
+		_err = s.removeActiveStreamRecording(ctx, streamID)
+	})
+	return
+}
+
+// ListStreamRecordings returns the files recorded for all the streams
+// that have (or had) a recording configured.
+func (s *StreamForwards) ListStreamRecordings(
+	ctx context.Context,
+) (_ret []types.Recording, _err error) {
+	defer func() {
+		logger.Tracef(ctx, "/ListStreamRecordings(): %#+v %v", _ret, _err)
+	}()
+
+	return xsync.DoR2(ctx, &s.Mutex, func() ([]types.Recording, error) {
+		recordingCfgs := map[types.StreamID]types.RecordingConfig{}
+		s.WithConfig(ctx, func(ctx context.Context, cfg *types.Config) {
+			for streamID, streamCfg := range cfg.Streams {
+				if streamCfg.Recording == nil {
+					continue
+				}
+				recordingCfgs[streamID] = *streamCfg.Recording
+			}
+		})
+
+		var result []types.Recording
+		for streamID, recordingCfg := range recordingCfgs {
+			activeFile := ""
+			if rec := s.ActiveStreamRecordings[streamID]; rec != nil {
+				activeFile = rec.CurrentFile()
+			}
+			recordings, err := listRecordingFiles(streamID, recordingCfg, activeFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to list the recordings of stream '%s': %w", streamID, err)
+			}
+			result = append(result, recordings...)
+		}
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].StartedAt.Before(result[j].StartedAt)
+		})
+		return result, nil
+	})
+}
+
+func (s *StreamForwards) newActiveStreamRecording(
+	ctx context.Context,
+	streamID types.StreamID,
+	recordingCfg types.RecordingConfig,
+) (*ActiveStreamRecording, error) {
+	ctx = belt.WithField(ctx, "stream_recording", streamID)
+	if _, ok := s.ActiveStreamRecordings[streamID]; ok {
+		return nil, fmt.Errorf("there is already an active recording of stream '%s'", streamID)
+	}
+
+	dir, err := recordingDirectory(streamID, recordingCfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("unable to create the directory '%s': %w", dir, err)
+	}
+
+	rec := &ActiveStreamRecording{
+		StreamForwards: s,
+		StreamID:       streamID,
+		Config:         recordingCfg,
+	}
+	if err := rec.Start(ctx); err != nil {
+		return nil, fmt.Errorf("unable to start the recording: %w", err)
+	}
+	s.ActiveStreamRecordings[streamID] = rec
+	return rec, nil
+}
+
+func (s *StreamForwards) removeActiveStreamRecording(
+	_ context.Context,
+	streamID types.StreamID,
+) error {
+	rec := s.ActiveStreamRecordings[streamID]
+	if rec == nil {
+		return nil
+	}
+
+	delete(s.ActiveStreamRecordings, streamID)
+	if err := rec.Close(); err != nil {
+		return fmt.Errorf("unable to close the stream recording: %w", err)
+	}
+	return nil
+}
+
+func (rec *ActiveStreamRecording) Start(ctx context.Context) (_err error) {
+	logger.Debugf(ctx, "Start")
+	defer func() { logger.Debugf(ctx, "/Start: %v", _err) }()
+
+	return xsync.DoR1(ctx, &rec.locker, func() error {
+		if rec.cancelFunc != nil {
+			return fmt.Errorf("the stream recording is already running")
+		}
+		ctx, cancelFn := context.WithCancel(ctx)
+		rec.cancelFunc = cancelFn
+		observability.Go(ctx, func() {
+			for {
+				err := rec.recordSegment(ctx)
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+				if err != nil {
+					logger.Errorf(ctx, "unable to record stream '%s': %v", rec.StreamID, err)
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(recordingRetryInterval):
+					}
+				}
+			}
+		})
+		return nil
+	})
+}
+
+func (rec *ActiveStreamRecording) recordSegment(
+	ctx context.Context,
+) (_err error) {
+	logger.Debugf(ctx, "recordSegment")
+	defer func() { logger.Debugf(ctx, "/recordSegment: %v", _err) }()
+
+	fwd := &ActiveStreamForwarding{
+		StreamForwards: rec.StreamForwards,
+		StreamID:       rec.StreamID,
+		RecoderFactory: rec.RecoderFactory,
+		PauseFunc:      func(ctx context.Context, fwd *ActiveStreamForwarding) {},
+	}
+
+	// waiting here (and not only in waitForPublisherAndStart) to name
+	// the file by the time the stream actually started.
+	if _, err := fwd.WaitForPublisher(ctx); err != nil {
+		return fmt.Errorf("unable to get publisher: %w", err)
+	}
+
+	dir, err := recordingDirectory(rec.StreamID, rec.Config)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("unable to create the directory '%s': %w", dir, err)
+	}
+	filePath := filepath.Join(
+		dir,
+		time.Now().Format(recordingFileTimeFormat)+"."+rec.Config.GetFormat(),
+	)
+	fwd.DestinationURL = &url.URL{Path: filePath}
+
+	rec.locker.Do(ctx, func() {
+		rec.currentFile = filePath
+	})
+	defer rec.locker.Do(ctx, func() {
+		rec.currentFile = ""
+	})
+
+	if err := rec.enforceRetention(ctx, filePath); err != nil {
+		logger.Errorf(ctx, "unable to clean up the old recordings: %v", err)
+	}
+
+	logger.Infof(ctx, "recording stream '%s' to '%s'", rec.StreamID, filePath)
+	// the session is restarted for every file, so there is a gap in
+	// the footage at every split (see RecordingConfig.SegmentDuration)
+	segmentCtx, cancelFn := context.WithTimeout(ctx, rec.Config.GetSegmentDuration())
+	defer cancelFn()
+	err = fwd.waitForPublisherAndStart(segmentCtx)
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		err = nil
+	}
+
+	if err := rec.enforceRetention(ctx, ""); err != nil {
+		logger.Errorf(ctx, "unable to clean up the old recordings: %v", err)
+	}
+	return err
+}
+
+// enforceRetention deletes the recordings that are older than the retention
+// period, and then the oldest recordings until the total size fits
+// MaxDiskUsage. File activeFile is never deleted.
+func (rec *ActiveStreamRecording) enforceRetention(
+	ctx context.Context,
+	activeFile string,
+) error {
+	if rec.Config.Retention <= 0 && rec.Config.MaxDiskUsage == 0 {
+		return nil
+	}
+
+	recordings, err := listRecordingFiles(rec.StreamID, rec.Config, activeFile)
+	if err != nil {
+		return err
+	}
+
+	var totalSize uint64
+	for _, recording := range recordings {
+		totalSize += recording.Size
+	}
+
+	now := time.Now()
+	for _, recording := range recordings {
+		if recording.IsActive {
+			continue
+		}
+		tooOld := rec.Config.Retention > 0 && now.Sub(recording.ModifiedAt) > rec.Config.Retention
+		tooBig := rec.Config.MaxDiskUsage > 0 && totalSize > rec.Config.MaxDiskUsage
+		if !tooOld && !tooBig {
+			continue
+		}
+		logger.Infof(ctx, "removing old recording '%s'", recording.Path)
+		if err := os.Remove(recording.Path); err != nil {
+			return fmt.Errorf("unable to remove '%s': %w", recording.Path, err)
+		}
+		totalSize -= recording.Size
+	}
+	return nil
+}
+
+func (rec *ActiveStreamRecording) CurrentFile() string {
+	ctx := context.TODO()
+	return xsync.DoR1(ctx, &rec.locker, func() string {
+		return rec.currentFile
+	})
+}
+
+func (rec *ActiveStreamRecording) Close() error {
+	ctx := context.TODO()
+	return xsync.DoR1(ctx, &rec.locker, func() error {
+		if rec.cancelFunc == nil {
+			return fmt.Errorf("the recording was not started yet")
+		}
+		rec.cancelFunc()
+		rec.cancelFunc = nil
+		return nil
+	})
+}
+
+func (rec *ActiveStreamRecording) String() string {
+	if rec == nil {
+		return "null"
+	}
+	return fmt.Sprintf("%s->%s", rec.StreamID, rec.Config.Directory)
+}
+
+// recordingDirectory returns the directory where the recordings of the stream
+// are stored.
+func recordingDirectory(
+	streamID types.StreamID,
+	cfg types.RecordingConfig,
+) (string, error) {
+	baseDir, err := filepath.Abs(cfg.Directory)
+	if err != nil {
+		return "", fmt.Errorf("unable to get the absolute path of '%s': %w", cfg.Directory, err)
+	}
+	dir := filepath.Join(
+		baseDir,
+		strings.NewReplacer("/", "_", "\\", "_").Replace(string(streamID)),
+	)
+	return dir, nil
+}
+
+// listRecordingFiles returns the recordings of the stream sorted from
+// the oldest to the newest.
+func listRecordingFiles(
+	streamID types.StreamID,
+	cfg types.RecordingConfig,
+	activeFile string,
+) ([]types.Recording, error) {
+	dir, err := recordingDirectory(streamID, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read directory '%s': %w", dir, err)
+	}
+
+	var result []types.Recording
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		startedAt, err := time.ParseInLocation(
+			recordingFileTimeFormat,
+			strings.TrimSuffix(name, filepath.Ext(name)),
+			time.Local,
+		)
+		if err != nil {
+			// not a recording
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("unable to stat '%s': %w", name, err)
+		}
+		filePath := filepath.Join(dir, name)
+		result = append(result, types.Recording{
+			StreamID:   streamID,
+			Path:       filePath,
+			Size:       uint64(info.Size()),
+			StartedAt:  startedAt,
+			ModifiedAt: info.ModTime(),
+			IsActive:   filePath == activeFile,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartedAt.Before(result[j].StartedAt)
+	})
+	return result, nil
+}
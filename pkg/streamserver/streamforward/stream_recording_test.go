@@ -0,0 +1,67 @@
+package streamforward
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+)
+
+func TestStreamRecordingRetention(t *testing.T) {
+	cfg := types.RecordingConfig{
+		Directory:    t.TempDir(),
+		MaxDiskUsage: 250,
+	}
+	dir, err := recordingDirectory("live/test", cfg)
+	require.NoError(t, err)
+	require.Equal(t, "live_test", filepath.Base(dir))
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	startedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	var paths []string
+	for i := 0; i < 4; i++ {
+		p := filepath.Join(
+			dir,
+			startedAt.Add(time.Duration(i)*time.Hour).Format(recordingFileTimeFormat)+".ts",
+		)
+		require.NoError(t, os.WriteFile(p, make([]byte, 100), 0o644))
+		paths = append(paths, p)
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unrelated.txt"), nil, 0o644))
+
+	recordings, err := listRecordingFiles("live/test", cfg, paths[0])
+	require.NoError(t, err)
+	require.Len(t, recordings, 4)
+	require.True(t, recordings[0].IsActive)
+	require.Equal(t, startedAt, recordings[0].StartedAt)
+
+	rec := &ActiveStreamRecording{StreamID: "live/test", Config: cfg}
+	require.NoError(t, rec.enforceRetention(context.Background(), paths[0]))
+
+	recordings, err = listRecordingFiles("live/test", cfg, paths[0])
+	require.NoError(t, err)
+	require.Len(t, recordings, 2)
+	require.Equal(t, paths[0], recordings[0].Path)
+	require.Equal(t, paths[3], recordings[1].Path)
+}
+
+func TestStreamRecordingDirectoryWithSpaces(t *testing.T) {
+	baseDir := filepath.Join(t.TempDir(), "with space")
+	dir, err := recordingDirectory("test", types.RecordingConfig{
+		Directory: baseDir,
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(baseDir, "test"), dir)
+
+	filePath := filepath.Join(dir, "recording.ts")
+	require.Equal(t, filePath, outputURLString(&url.URL{Path: filePath}))
+	require.Equal(t,
+		"rtmp://example.com/live/with%20space",
+		outputURLString(&url.URL{Scheme: "rtmp", Host: "example.com", Path: "/live/with space"}),
+	)
+}
@@ -0,0 +1,708 @@
+package streamforward
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	srt "github.com/datarhei/gosrt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/hashicorp/go-multierror"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	relayPath = "/relay.ts"
+
+	relayPIDPMT = 0x1000
+	// relayPIDPCR is the PID of the PCR if it is not carried by
+	// an elementary stream.
+	relayPIDPCR = 0x1001
+	// relayPIDFirstES is the PID of the first elementary stream (video
+	// streams go first, so the video usually keeps the same PID across
+	// the sources).
+	relayPIDFirstES = 0x100
+
+	// relaySwitchGap is the minimal gap between the last timestamp of
+	// a source and the first timestamp of the next source.
+	relaySwitchGap = 100 * time.Millisecond
+
+	// relayRealTimePrebuffer is how much ahead of the real time a source
+	// fed in real time (see Feed) is sent, to let the recoder probe
+	// the streams without a delay and to absorb jitter.
+	relayRealTimePrebuffer = time.Second
+
+	// relayMaxPendingPackets is how many packets are kept while waiting
+	// for the first timestamp of a source.
+	relayMaxPendingPackets = 4096
+
+	relayOutputQueueSize = 4096
+
+	// relayFeederLatency is the latency of the SRT connections from
+	// the feeders; they are local, so it could be small.
+	relayFeederLatency = 20 * time.Millisecond
+)
+
+var errRelayClosed = errors.New("the relay is closed")
+
+// streamRelay merges the sources fed one after another into a single
+// continuous MPEG-TS stream, so that the output reading it (through
+// a localhost HTTP endpoint, see URL) is not reopened when the source
+// changes (like on a switch to the fallback and back). For that
+// the relay:
+//   - writes its own PAT and PMT, mapping the elementary streams of each
+//     source to the same PIDs (the video ones go first);
+//   - shifts the timestamps of each source to continue the timestamps of
+//     the previous source;
+//   - rewrites the continuity counters;
+//   - drops the video until the first keyframe of each source.
+//
+// It also counts the frames and the lost packets (see FrameStats).
+//
+// The sources are expected to have the same codecs (otherwise the output
+// would need to be reopened anyway).
+//
+// Recoders feed the relay through a localhost SRT endpoint (see
+// expectFeeder), since the recoders can write MPEG-TS only to SRT.
+type streamRelay struct {
+	srtListener  srt.Listener
+	httpListener net.Listener
+	httpServer   *http.Server
+	output       chan []byte
+	isServing    atomic.Bool
+	closeChan    chan struct{}
+	closeOnce    sync.Once
+
+	feedersLocker  xsync.Mutex
+	pendingFeeders map[string]chan srt.Conn
+
+	feedLocker xsync.Mutex
+	out        relayOutputState
+
+	framesWrote    atomic.Uint64
+	keyframesWrote atomic.Uint64
+	droppedPackets atomic.Uint64
+	latePackets    atomic.Uint64
+}
+
+// relayOutputState is the state of the output, which is kept across
+// the sources.
+type relayOutputState struct {
+	continuityCounters map[uint16]byte
+	patSection         []byte
+	pmtSection         []byte
+	pmtVersion         byte
+	lastDTS            map[uint16]int64
+
+	hasTimestamp    bool
+	lastTimestamp   int64
+	lastTimestampAt time.Time
+	hasPCR          bool
+	lastPCR         int64
+
+	isPacing         bool
+	pacingStartedAt  time.Time
+	pacingStartedPCR int64
+}
+
+// relaySource is the state of the remuxing of a single source.
+type relaySource struct {
+	hasPMTPID  bool
+	pmtPID     uint16
+	pmtSection []byte
+	pids       map[uint16]uint16
+	videoPIDs  map[uint16]struct{}
+
+	waitingKeyframe    map[uint16]bool
+	droppingPES        map[uint16]bool
+	continuityCounters map[uint16]byte
+
+	clock     tsClockUnwrapper
+	isRebased bool
+	offset    int64
+	pending   [][]byte
+}
+
+func newStreamRelay(
+	ctx context.Context,
+) (_ret *streamRelay, _err error) {
+	logger.Debugf(ctx, "newStreamRelay")
+	defer func() { logger.Debugf(ctx, "/newStreamRelay: %v", _err) }()
+
+	srtCfg := srt.DefaultConfig()
+	srtCfg.ReceiverLatency = relayFeederLatency
+	srtCfg.PeerLatency = relayFeederLatency
+	srtCfg.PayloadSize = 7 * tsPacketSize
+	srtListener, err := srt.Listen("srt", "127.0.0.1:0", srtCfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to start listening for SRT on a localhost port: %w", err)
+	}
+
+	httpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		srtListener.Close()
+		return nil, fmt.Errorf("unable to start listening on a localhost port: %w", err)
+	}
+
+	r := &streamRelay{
+		srtListener:    srtListener,
+		httpListener:   httpListener,
+		output:         make(chan []byte, relayOutputQueueSize),
+		closeChan:      make(chan struct{}),
+		pendingFeeders: map[string]chan srt.Conn{},
+		out: relayOutputState{
+			continuityCounters: map[uint16]byte{},
+			patSection:         newPATSection(relayPIDPMT),
+			lastDTS:            map[uint16]int64{},
+		},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(relayPath, r.serveOutput)
+	r.httpServer = &http.Server{
+		Handler:     mux,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	observability.Go(ctx, func() {
+		err := r.httpServer.Serve(httpListener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf(ctx, "unable to serve the relay: %v", err)
+		}
+	})
+	observability.Go(ctx, func() {
+		r.acceptFeeders(ctx)
+	})
+	return r, nil
+}
+
+// FrameStats returns the counters of the resulting stream since the relay
+// was created.
+func (r *streamRelay) FrameStats() types.FrameStats {
+	return types.FrameStats{
+		FramesWrote:    r.framesWrote.Load(),
+		KeyframesWrote: r.keyframesWrote.Load(),
+		DroppedPackets: r.droppedPackets.Load(),
+		LatePackets:    r.latePackets.Load(),
+	}
+}
+
+// URL returns the URL of the resulting stream to be used as the input.
+func (r *streamRelay) URL() string {
+	return "http://" + r.httpListener.Addr().String() + relayPath
+}
+
+func (r *streamRelay) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.closeChan)
+	})
+	var result *multierror.Error
+	if err := r.httpServer.Close(); err != nil {
+		result = multierror.Append(result, fmt.Errorf("unable to close the HTTP server: %w", err))
+	}
+	r.srtListener.Close()
+	return result.ErrorOrNil()
+}
+
+func (r *streamRelay) serveOutput(w http.ResponseWriter, req *http.Request) {
+	if !r.isServing.CompareAndSwap(false, true) {
+		http.Error(w, "the relay is already being read", http.StatusConflict)
+		return
+	}
+	defer r.isServing.Store(false)
+
+	w.Header().Set("Content-Type", "video/mp2t")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	if flusher != nil {
+		// the reader should not wait for the first packet to get the response
+		flusher.Flush()
+	}
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-r.closeChan:
+			return
+		case packet := <-r.output:
+			if _, err := w.Write(packet); err != nil {
+				logger.Debugf(req.Context(), "unable to write to the reader of the relay: %v", err)
+				return
+			}
+			if len(r.output) == 0 && flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// expectFeeder returns the URL a recoder should write the MPEG-TS stream
+// to, and the channel to receive the connection of the recoder from.
+// The returned function should be called when the connection is not
+// expected anymore.
+func (r *streamRelay) expectFeeder(
+	ctx context.Context,
+) (string, <-chan srt.Conn, func()) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		panic(fmt.Errorf("unable to generate a random token: %w", err))
+	}
+	streamID := hex.EncodeToString(token)
+
+	connChan := make(chan srt.Conn, 1)
+	r.feedersLocker.Do(ctx, func() {
+		r.pendingFeeders[streamID] = connChan
+	})
+
+	query := url.Values{}
+	query.Set("streamid", streamID)
+	// libav expects the latency in microseconds
+	query.Set("latency", strconv.FormatInt(relayFeederLatency.Microseconds(), 10))
+	feederURL := url.URL{
+		Scheme:   "srt",
+		Host:     r.srtListener.Addr().String(),
+		RawQuery: query.Encode(),
+	}
+
+	return feederURL.String(), connChan, func() {
+		r.feedersLocker.Do(ctx, func() {
+			delete(r.pendingFeeders, streamID)
+		})
+		select {
+		case conn := <-connChan:
+			conn.Close()
+		default:
+		}
+	}
+}
+
+func (r *streamRelay) acceptFeeders(ctx context.Context) {
+	for {
+		req, err := r.srtListener.Accept2()
+		if err != nil {
+			logger.Debugf(ctx, "stopped accepting the feeders of the relay: %v", err)
+			return
+		}
+
+		connChan := xsync.DoR1(ctx, &r.feedersLocker, func() chan srt.Conn {
+			connChan := r.pendingFeeders[req.StreamId()]
+			delete(r.pendingFeeders, req.StreamId())
+			return connChan
+		})
+		if connChan == nil {
+			logger.Warnf(ctx, "an unexpected connection to the relay from %s", req.RemoteAddr())
+			req.Reject(srt.REJ_PEER)
+			continue
+		}
+
+		conn, err := req.Accept()
+		if err != nil {
+			logger.Errorf(ctx, "unable to accept a connection to the relay: %v", err)
+			continue
+		}
+		connChan <- conn
+	}
+}
+
+// Feed remuxes the MPEG-TS stream from the reader into the output until
+// the reader ends. Only one source is fed at a time.
+//
+// If realTime is true, the stream is sent at the pace of its PCR (like for
+// a file), otherwise it is sent as fast as it is read (like for a live
+// stream).
+func (r *streamRelay) Feed(
+	ctx context.Context,
+	reader io.Reader,
+	realTime bool,
+) error {
+	return xsync.DoR1(ctx, &r.feedLocker, func() error {
+		src := &relaySource{
+			pids:               map[uint16]uint16{},
+			videoPIDs:          map[uint16]struct{}{},
+			waitingKeyframe:    map[uint16]bool{},
+			droppingPES:        map[uint16]bool{},
+			continuityCounters: map[uint16]byte{},
+		}
+		if !realTime {
+			r.out.isPacing = false
+		}
+
+		bufReader := bufio.NewReaderSize(reader, 64*tsPacketSize)
+		for {
+			packet := make([]byte, tsPacketSize)
+			err := readTSPacket(bufReader, packet)
+			switch {
+			case err == nil:
+			case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+				return nil
+			default:
+				return fmt.Errorf("unable to read: %w", err)
+			}
+
+			if err := r.processPacket(ctx, src, packet, realTime); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// readTSPacket reads the next packet, skipping the garbage before it.
+func readTSPacket(r *bufio.Reader, packet []byte) error {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if b == tsSyncByte {
+			break
+		}
+	}
+	packet[0] = tsSyncByte
+	_, err := io.ReadFull(r, packet[1:])
+	return err
+}
+
+func (r *streamRelay) processPacket(
+	ctx context.Context,
+	src *relaySource,
+	packet []byte,
+	realTime bool,
+) error {
+	pid := tsPID(packet)
+	switch {
+	case pid == tsPIDPAT:
+		if section := tsPSISection(packet); section != nil {
+			if pmtPID, ok := parsePAT(section); ok {
+				src.hasPMTPID, src.pmtPID = true, pmtPID
+			}
+		}
+		// the relay writes its own PAT along with each PMT
+		return nil
+	case src.hasPMTPID && pid == src.pmtPID:
+		section := tsPSISection(packet)
+		if section == nil {
+			// PMTs spanning multiple packets are not supported
+			return nil
+		}
+		if !bytes.Equal(section, src.pmtSection) {
+			pmt, ok := parsePMT(section)
+			if !ok {
+				logger.Debugf(ctx, "unable to parse the PMT of the source")
+				return nil
+			}
+			if err := r.mapStreams(src, pmt); err != nil {
+				return err
+			}
+			src.pmtSection = bytes.Clone(section)
+		}
+		return r.writePSI(ctx)
+	}
+
+	relayPID, ok := src.pids[pid]
+	if !ok {
+		// not a part of the program
+		return nil
+	}
+	r.checkContinuity(src, packet)
+	tsSetPID(packet, relayPID)
+
+	if src.waitingKeyframe[relayPID] {
+		if !tsIsPayloadStart(packet) || !tsIsRandomAccess(packet) {
+			return nil
+		}
+		src.waitingKeyframe[relayPID] = false
+	}
+
+	if !src.isRebased {
+		firstTS, ok := packetTimestamp(packet)
+		if !ok {
+			if len(src.pending) >= relayMaxPendingPackets {
+				src.pending = src.pending[1:]
+			}
+			src.pending = append(src.pending, packet)
+			return nil
+		}
+		r.rebase(src, firstTS)
+		pending := src.pending
+		src.pending = nil
+		for _, pendingPacket := range pending {
+			if err := r.writeES(ctx, src, pendingPacket, realTime); err != nil {
+				return err
+			}
+		}
+	}
+	return r.writeES(ctx, src, packet, realTime)
+}
+
+// checkContinuity counts the packets of the source lost before the packet
+// (by the gap in its continuity counters).
+func (r *streamRelay) checkContinuity(
+	src *relaySource,
+	packet []byte,
+) {
+	if !tsHasPayload(packet) {
+		return
+	}
+	pid := tsPID(packet)
+	cc := tsContinuityCounter(packet)
+	prevCC, ok := src.continuityCounters[pid]
+	src.continuityCounters[pid] = cc
+	if !ok || cc == prevCC || tsIsDiscontinuity(packet) {
+		// the first packet, a duplicate or an intended discontinuity
+		return
+	}
+	if lost := (cc - prevCC - 1) & 0x0f; lost > 0 {
+		r.droppedPackets.Add(uint64(lost))
+	}
+}
+
+// mapStreams maps the elementary streams of the source to the PIDs of
+// the relay and updates the PMT of the relay.
+func (r *streamRelay) mapStreams(
+	src *relaySource,
+	pmt *tsPMT,
+) error {
+	streams := make([]tsPMTStream, len(pmt.Streams))
+	copy(streams, pmt.Streams)
+	sort.SliceStable(streams, func(i, j int) bool {
+		_, isVideoI := tsVideoStreamTypes[streams[i].StreamType]
+		_, isVideoJ := tsVideoStreamTypes[streams[j].StreamType]
+		return isVideoI && !isVideoJ
+	})
+
+	relayPMT := &tsPMT{
+		ProgramInfo: pmt.ProgramInfo,
+	}
+	src.pids = map[uint16]uint16{}
+	for idx, stream := range streams {
+		relayPID := relayPIDFirstES + uint16(idx)
+		src.pids[stream.PID] = relayPID
+		if _, isVideo := tsVideoStreamTypes[stream.StreamType]; isVideo {
+			if _, ok := src.videoPIDs[relayPID]; !ok {
+				src.videoPIDs[relayPID] = struct{}{}
+				src.waitingKeyframe[relayPID] = true
+			}
+		}
+		relayPMT.Streams = append(relayPMT.Streams, tsPMTStream{
+			StreamType:  stream.StreamType,
+			PID:         relayPID,
+			Descriptors: stream.Descriptors,
+		})
+	}
+	pcrPID, ok := src.pids[pmt.PCRPID]
+	if !ok {
+		pcrPID = relayPIDPCR
+		src.pids[pmt.PCRPID] = pcrPID
+	}
+	relayPMT.PCRPID = pcrPID
+
+	section, err := newPMTSection(r.out.pmtVersion, relayPMT)
+	if err != nil {
+		// the descriptors are not essential, and they are the only
+		// thing which could make the PMT this big
+		relayPMT.ProgramInfo = nil
+		for idx := range relayPMT.Streams {
+			relayPMT.Streams[idx].Descriptors = nil
+		}
+		section, err = newPMTSection(r.out.pmtVersion, relayPMT)
+		if err != nil {
+			return err
+		}
+	}
+	if r.out.pmtSection != nil && !bytes.Equal(section, r.out.pmtSection) {
+		r.out.pmtVersion = (r.out.pmtVersion + 1) & 0x1f
+		section, err = newPMTSection(r.out.pmtVersion, relayPMT)
+		if err != nil {
+			return err
+		}
+	}
+	r.out.pmtSection = section
+	return nil
+}
+
+// rebase chooses the offset of the timestamps of the source, so that
+// they continue the timestamps of the output.
+func (r *streamRelay) rebase(
+	src *relaySource,
+	firstTimestamp int64,
+) {
+	first := src.clock.Unwrap(firstTimestamp)
+	if !r.out.hasTimestamp {
+		// the first source keeps its timestamps
+		src.offset = 0
+	} else {
+		gap := time.Since(r.out.lastTimestampAt)
+		if gap < relaySwitchGap {
+			gap = relaySwitchGap
+		}
+		src.offset = r.out.lastTimestamp + durationToTSClock(gap) - first
+	}
+
+	if r.out.hasPCR {
+		// PCR should not go back either
+		for _, packet := range src.pending {
+			pcr, ok := tsPacketPCR(packet)
+			if !ok {
+				continue
+			}
+			pcrBase := src.clock.Unwrap(pcr/300) + src.offset
+			if minBase := r.out.lastPCR/300 + 1; pcrBase < minBase {
+				src.offset += minBase - pcrBase
+			}
+		}
+	}
+	src.isRebased = true
+}
+
+func (r *streamRelay) writeES(
+	ctx context.Context,
+	src *relaySource,
+	packet []byte,
+	realTime bool,
+) error {
+	relayPID := tsPID(packet)
+	if tsIsPayloadStart(packet) {
+		payload := tsPayload(packet)
+		ptsOffset, dtsOffset := pesTimestampOffsets(payload)
+		src.droppingPES[relayPID] = false
+		if ptsOffset >= 0 {
+			pts := src.clock.Unwrap(readPESTimestamp(payload[ptsOffset:])) + src.offset
+			dts := pts
+			if dtsOffset >= 0 {
+				dts = src.clock.Unwrap(readPESTimestamp(payload[dtsOffset:])) + src.offset
+			}
+			if lastDTS, ok := r.out.lastDTS[relayPID]; ok && dts <= lastDTS {
+				// would go back in time (the streams of the source are
+				// too far from each other), dropping the whole PES packet
+				src.droppingPES[relayPID] = true
+				r.latePackets.Add(1)
+				return nil
+			}
+			r.out.lastDTS[relayPID] = dts
+			writePESTimestamp(payload[ptsOffset:], positiveMod(pts, tsClockWrap))
+			if dtsOffset >= 0 {
+				writePESTimestamp(payload[dtsOffset:], positiveMod(dts, tsClockWrap))
+			}
+			if !r.out.hasTimestamp || dts > r.out.lastTimestamp {
+				r.out.hasTimestamp = true
+				r.out.lastTimestamp = dts
+				r.out.lastTimestampAt = time.Now()
+			}
+		}
+		if _, isVideo := src.videoPIDs[relayPID]; isVideo {
+			r.framesWrote.Add(1)
+			if tsIsRandomAccess(packet) {
+				r.keyframesWrote.Add(1)
+			}
+		}
+	} else if src.droppingPES[relayPID] {
+		r.latePackets.Add(1)
+		return nil
+	}
+
+	if pcr, ok := tsPacketPCR(packet); ok {
+		pcr = (src.clock.Unwrap(pcr/300)+src.offset)*300 + pcr%300
+		tsSetPacketPCR(packet, positiveMod(pcr, pcrWrap))
+		r.out.hasPCR = true
+		r.out.lastPCR = pcr
+		if realTime {
+			if err := r.pace(ctx, pcr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return r.write(ctx, packet)
+}
+
+// pace holds the packet with the PCR until the wall clock reaches it
+// (minus relayRealTimePrebuffer).
+func (r *streamRelay) pace(
+	ctx context.Context,
+	pcr int64,
+) error {
+	if !r.out.isPacing {
+		r.out.isPacing = true
+		r.out.pacingStartedAt = time.Now()
+		r.out.pacingStartedPCR = pcr
+		return nil
+	}
+	mediaTime := time.Duration((pcr-r.out.pacingStartedPCR)/(pcrFrequency/1_000_000)) * time.Microsecond
+	waitDuration := time.Until(r.out.pacingStartedAt.Add(mediaTime - relayRealTimePrebuffer))
+	if waitDuration <= 0 {
+		return nil
+	}
+	return sleep(ctx, waitDuration)
+}
+
+func (r *streamRelay) writePSI(ctx context.Context) error {
+	if err := r.write(ctx, newPSIPacket(tsPIDPAT, r.out.patSection)); err != nil {
+		return err
+	}
+	return r.write(ctx, newPSIPacket(relayPIDPMT, r.out.pmtSection))
+}
+
+func (r *streamRelay) write(
+	ctx context.Context,
+	packet []byte,
+) error {
+	pid := tsPID(packet)
+	cc, ok := r.out.continuityCounters[pid]
+	if ok && tsHasPayload(packet) {
+		cc = (cc + 1) & 0x0f
+	}
+	r.out.continuityCounters[pid] = cc
+	tsSetContinuityCounter(packet, cc)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-r.closeChan:
+		return errRelayClosed
+	case r.output <- packet:
+		return nil
+	}
+}
+
+// packetTimestamp returns DTS (or PTS if there is no DTS) of the PES packet
+// starting in the packet.
+func packetTimestamp(packet []byte) (int64, bool) {
+	if !tsIsPayloadStart(packet) {
+		return 0, false
+	}
+	payload := tsPayload(packet)
+	ptsOffset, dtsOffset := pesTimestampOffsets(payload)
+	switch {
+	case dtsOffset >= 0:
+		return readPESTimestamp(payload[dtsOffset:]), true
+	case ptsOffset >= 0:
+		return readPESTimestamp(payload[ptsOffset:]), true
+	default:
+		return 0, false
+	}
+}
+
+func durationToTSClock(d time.Duration) int64 {
+	return int64(d/time.Microsecond) * tsClockFrequency / 1_000_000
+}
+
+func positiveMod(v, m int64) int64 {
+	v %= m
+	if v < 0 {
+		v += m
+	}
+	return v
+}
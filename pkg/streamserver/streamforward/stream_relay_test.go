@@ -0,0 +1,386 @@
+package streamforward
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	srt "github.com/datarhei/gosrt"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+)
+
+const (
+	testStreamTypeH264 = 0x1b
+	testStreamTypeAAC  = 0x0f
+)
+
+// testTSSource generates an MPEG-TS stream with a single program.
+type testTSSource struct {
+	bytes.Buffer
+	PMTPID  uint16
+	PCRPID  uint16
+	Streams []tsPMTStream
+
+	continuityCounters map[uint16]byte
+}
+
+func newTestTSSource(pmtPID uint16, pcrPID uint16, streams ...tsPMTStream) *testTSSource {
+	return &testTSSource{
+		PMTPID:             pmtPID,
+		PCRPID:             pcrPID,
+		Streams:            streams,
+		continuityCounters: map[uint16]byte{},
+	}
+}
+
+func (s *testTSSource) writePacket(packet []byte) {
+	pid := tsPID(packet)
+	tsSetContinuityCounter(packet, s.continuityCounters[pid])
+	s.continuityCounters[pid]++
+	s.Write(packet)
+}
+
+func (s *testTSSource) WritePSI(t *testing.T) {
+	s.writePacket(newPSIPacket(tsPIDPAT, newPATSection(s.PMTPID)))
+	section, err := newPMTSection(0, &tsPMT{PCRPID: s.PCRPID, Streams: s.Streams})
+	require.NoError(t, err)
+	s.writePacket(newPSIPacket(s.PMTPID, section))
+}
+
+// WritePES writes a PES packet of two TS packets; pcr is ignored
+// if negative.
+func (s *testTSSource) WritePES(pid uint16, dts int64, keyframe bool, pcr int64) {
+	packet := make([]byte, tsPacketSize)
+	packet[0] = tsSyncByte
+	packet[1] = 0x40 | byte(pid>>8)
+	packet[2] = byte(pid)
+	packet[3] = 0x30 // adaptation field and payload
+	packet[4] = 1
+	if pcr >= 0 {
+		packet[4] = 7
+		packet[5] |= 0x10
+		tsSetPacketPCR(packet, pcr)
+	}
+	if keyframe {
+		packet[5] |= 0x40
+	}
+	payload := packet[5+packet[4]:]
+	copy(payload, []byte{0x00, 0x00, 0x01, 0xe0, 0x00, 0x00, 0x80, 0xc0, 10, 0x30, 0, 0, 0, 0, 0x10, 0, 0, 0, 0})
+	writePESTimestamp(payload[9:], dts+3600)
+	writePESTimestamp(payload[14:], dts)
+	for i := 19; i < len(payload); i++ {
+		payload[i] = 0xff
+	}
+	s.writePacket(packet)
+
+	continuation := make([]byte, tsPacketSize)
+	continuation[0] = tsSyncByte
+	continuation[1] = byte(pid >> 8)
+	continuation[2] = byte(pid)
+	continuation[3] = 0x10 // payload only
+	s.writePacket(continuation)
+}
+
+type testRelayOutput struct {
+	Packets    [][]byte
+	DTS        map[uint16][]int64
+	PCRs       []int64
+	PMTs       [][]byte
+	Keyframes  int
+	PIDs       map[uint16]int
+	CCProblems int
+}
+
+func parseTestRelayOutput(packets [][]byte) *testRelayOutput {
+	result := &testRelayOutput{
+		Packets: packets,
+		DTS:     map[uint16][]int64{},
+		PIDs:    map[uint16]int{},
+	}
+	continuityCounters := map[uint16]byte{}
+	for _, packet := range packets {
+		pid := tsPID(packet)
+		result.PIDs[pid]++
+		cc := tsContinuityCounter(packet)
+		if prevCC, ok := continuityCounters[pid]; ok && cc != (prevCC+1)&0x0f {
+			result.CCProblems++
+		}
+		continuityCounters[pid] = cc
+		if pid == relayPIDPMT {
+			result.PMTs = append(result.PMTs, tsPSISection(packet))
+		}
+		if pcr, ok := tsPacketPCR(packet); ok {
+			result.PCRs = append(result.PCRs, pcr)
+		}
+		if dts, ok := packetTimestamp(packet); ok {
+			result.DTS[pid] = append(result.DTS[pid], dts)
+			if tsIsRandomAccess(packet) {
+				result.Keyframes++
+			}
+		}
+	}
+	return result
+}
+
+func drainRelayOutput(relay *streamRelay) [][]byte {
+	var packets [][]byte
+	for {
+		select {
+		case packet := <-relay.output:
+			packets = append(packets, packet)
+		default:
+			return packets
+		}
+	}
+}
+
+func TestStreamRelay(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	relay, err := newStreamRelay(ctx)
+	require.NoError(t, err)
+	defer relay.Close()
+
+	// the stream: video goes first
+	srcA := newTestTSSource(0x1000, 0x100,
+		tsPMTStream{StreamType: testStreamTypeH264, PID: 0x100},
+		tsPMTStream{StreamType: testStreamTypeAAC, PID: 0x101},
+	)
+	srcA.WritePSI(t)
+	const startA = 10 * tsClockFrequency
+	for i := int64(0); i < 10; i++ {
+		dts := startA + i*3600
+		srcA.WritePES(0x100, dts, i == 0, (dts-9000)*300)
+		srcA.WritePES(0x101, dts+100, false, -1)
+	}
+	require.NoError(t, relay.Feed(ctx, &srcA.Buffer, false))
+
+	// the fallback: audio goes first, other PIDs and timestamps, and it
+	// starts not from a keyframe
+	srcB := newTestTSSource(0x20, 0x45,
+		tsPMTStream{StreamType: testStreamTypeAAC, PID: 0x44},
+		tsPMTStream{StreamType: testStreamTypeH264, PID: 0x45},
+	)
+	srcB.WritePSI(t)
+	const startB = 500 * tsClockFrequency
+	for i := int64(0); i < 5; i++ {
+		dts := startB + i*3600
+		srcB.WritePES(0x45, dts, i == 1, (dts-9000)*300)
+		srcB.WritePES(0x44, dts+100, false, -1)
+	}
+	// an SDT, which is not a part of the program
+	srcB.writePacket(newPSIPacket(0x11, newPSISection(0x42, 1, 0, nil)))
+	require.NoError(t, relay.Feed(ctx, &srcB.Buffer, false))
+
+	out := parseTestRelayOutput(drainRelayOutput(relay))
+	require.Zero(t, out.CCProblems)
+	require.Equal(t, map[uint16]int{
+		tsPIDPAT:            2,
+		relayPIDPMT:         2,
+		relayPIDFirstES:     2 * (10 + 4),
+		relayPIDFirstES + 1: 2 * (10 + 5),
+	}, out.PIDs)
+
+	// the layout is the same, so is the PMT
+	require.Len(t, out.PMTs, 2)
+	require.Equal(t, out.PMTs[0], out.PMTs[1])
+	pmt, ok := parsePMT(out.PMTs[0])
+	require.True(t, ok)
+	require.Equal(t, uint16(relayPIDFirstES), pmt.PCRPID)
+	require.Equal(t, byte(testStreamTypeH264), pmt.Streams[0].StreamType)
+	require.Equal(t, byte(testStreamTypeAAC), pmt.Streams[1].StreamType)
+
+	// the video of the fallback starts from the keyframe
+	require.Equal(t, 2, out.Keyframes)
+
+	videoDTS := out.DTS[relayPIDFirstES]
+	audioDTS := out.DTS[relayPIDFirstES+1]
+	require.Len(t, videoDTS, 14)
+	require.Len(t, audioDTS, 15)
+	// the first source keeps its timestamps
+	require.Equal(t, int64(startA), videoDTS[0])
+	require.Equal(t, int64(startA+100), audioDTS[0])
+	for _, dts := range [][]int64{videoDTS, audioDTS} {
+		for i := 1; i < len(dts); i++ {
+			require.Greater(t, dts[i], dts[i-1])
+		}
+	}
+	// the fallback continues the timeline
+	lastA := audioDTS[9]
+	firstB := audioDTS[10]
+	require.GreaterOrEqual(t, firstB-lastA, durationToTSClock(relaySwitchGap))
+	require.Less(t, firstB-lastA, durationToTSClock(relaySwitchGap+time.Second))
+	require.Equal(t, firstB+3600-100, videoDTS[10], "the streams of the fallback keep their relative timing")
+	for i := 1; i < len(out.PCRs); i++ {
+		require.Greater(t, out.PCRs[i], out.PCRs[i-1])
+	}
+}
+
+func TestStreamRelayLatePackets(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	relay, err := newStreamRelay(ctx)
+	require.NoError(t, err)
+	defer relay.Close()
+
+	src := newTestTSSource(0x1000, 0x100,
+		tsPMTStream{StreamType: testStreamTypeH264, PID: 0x100},
+	)
+	src.WritePSI(t)
+	src.WritePES(0x100, 3600, true, 0)
+	src.WritePES(0x100, 7200, false, -1)
+	src.WritePES(0x100, 7200, false, -1)
+	src.WritePES(0x100, 3600, false, -1)
+	src.WritePES(0x100, 10800, false, -1)
+	require.NoError(t, relay.Feed(ctx, &src.Buffer, false))
+
+	out := parseTestRelayOutput(drainRelayOutput(relay))
+	require.Zero(t, out.CCProblems)
+	require.Equal(t, []int64{3600, 7200, 10800}, out.DTS[relayPIDFirstES])
+	require.Equal(t, 2*3, out.PIDs[relayPIDFirstES], "the continuations of the late PES packets are dropped, too")
+	require.Equal(t, types.FrameStats{
+		FramesWrote:    3,
+		KeyframesWrote: 1,
+		LatePackets:    2 * 2,
+	}, relay.FrameStats())
+}
+
+func TestStreamRelayLostPackets(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	relay, err := newStreamRelay(ctx)
+	require.NoError(t, err)
+	defer relay.Close()
+
+	src := newTestTSSource(0x1000, 0x100,
+		tsPMTStream{StreamType: testStreamTypeH264, PID: 0x100},
+	)
+	src.WritePSI(t)
+	src.WritePES(0x100, 3600, true, 0)
+	src.continuityCounters[0x100] += 3
+	src.WritePES(0x100, 7200, false, -1)
+	src.WritePES(0x100, 10800, false, -1)
+	src.continuityCounters[0x100] += 5
+	src.WritePES(0x100, 14400, true, -1)
+	require.NoError(t, relay.Feed(ctx, &src.Buffer, false))
+
+	out := parseTestRelayOutput(drainRelayOutput(relay))
+	require.Zero(t, out.CCProblems, "the lost packets are not visible in the output")
+	require.Equal(t, types.FrameStats{
+		FramesWrote:    4,
+		KeyframesWrote: 2,
+		DroppedPackets: 3 + 5,
+	}, relay.FrameStats())
+}
+
+func TestStreamRelayFeeder(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	relay, err := newStreamRelay(ctx)
+	require.NoError(t, err)
+	defer relay.Close()
+
+	feederURL, connChan, forgetFeeder := relay.expectFeeder(ctx)
+	defer forgetFeeder()
+	feederURLParsed, err := url.Parse(feederURL)
+	require.NoError(t, err)
+	require.Equal(t, "srt", feederURLParsed.Scheme)
+	require.Equal(t, "20000", feederURLParsed.Query().Get("latency"))
+
+	srtCfg := srt.DefaultConfig()
+	srtCfg.StreamId = "unexpected"
+	_, err = srt.Dial("srt", feederURLParsed.Host, srtCfg)
+	require.Error(t, err)
+
+	srtCfg.StreamId = feederURLParsed.Query().Get("streamid")
+	conn, err := srt.Dial("srt", feederURLParsed.Host, srtCfg)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var feederConn srt.Conn
+	select {
+	case feederConn = <-connChan:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the feeder was not accepted")
+	}
+	feedEnded := make(chan error, 1)
+	go func() {
+		feedEnded <- relay.Feed(ctx, feederConn, false)
+	}()
+
+	src := newTestTSSource(0x1000, 0x100,
+		tsPMTStream{StreamType: testStreamTypeH264, PID: 0x100},
+	)
+	src.WritePSI(t)
+	src.WritePES(0x100, 3600, true, 0)
+	for b := src.Bytes(); len(b) > 0; {
+		n := min(len(b), 7*tsPacketSize)
+		_, err := conn.Write(b[:n])
+		require.NoError(t, err)
+		b = b[n:]
+	}
+
+	var packets [][]byte
+	for len(packets) < 4 {
+		select {
+		case packet := <-relay.output:
+			packets = append(packets, packet)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received only %d packets", len(packets))
+		}
+	}
+	out := parseTestRelayOutput(packets)
+	require.Equal(t, []int64{3600}, out.DTS[relayPIDFirstES])
+
+	feederConn.Close()
+	select {
+	case err := <-feedEnded:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the feed did not end")
+	}
+}
+
+func TestStreamRelayOutput(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	relay, err := newStreamRelay(ctx)
+	require.NoError(t, err)
+	defer relay.Close()
+
+	resp, err := http.Get(relay.URL())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// only one reader at a time
+	resp2, err := http.Get(relay.URL())
+	require.NoError(t, err)
+	resp2.Body.Close()
+	require.Equal(t, http.StatusConflict, resp2.StatusCode)
+
+	var expected bytes.Buffer
+	for i := 0; i < 3; i++ {
+		packet := newTSPacket(int64(i))
+		require.NoError(t, relay.write(ctx, packet))
+		expected.Write(packet)
+	}
+	b := make([]byte, expected.Len())
+	_, err = io.ReadFull(resp.Body, b)
+	require.NoError(t, err)
+	require.Equal(t, expected.Bytes(), b)
+
+	require.NoError(t, relay.Close())
+	_, err = resp.Body.Read(b)
+	require.Error(t, err)
+}
@@ -0,0 +1,214 @@
+package streamforward
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/hashicorp/go-multierror"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+	"github.com/xaionaro-go/xsync"
+)
+
+type RenditionKey struct {
+	StreamID    types.StreamID
+	ProfileName types.EncodingProfileName
+}
+
+// ActiveRendition encodes a stream with an encoding profile and publishes
+// the result back to the stream server, so that any amount of forwardings
+// could share the same encoder.
+//
+// The fallback of the stream (if configured) is forwarded by the rendition
+// (so it is encoded with the profile, too), while the forwardings reading
+// the rendition have no fallback of their own; the events of the rendition
+// are reported on behalf of each of these forwardings.
+type ActiveRendition struct {
+	*ActiveStreamForwarding
+	ProfileName types.EncodingProfileName
+	Profile     types.EncodingProfile
+
+	refCount uint
+
+	forwardsLocker xsync.Mutex
+	forwards       []*ActiveStreamForwarding
+}
+
+// RenditionStreamID returns the ID of the local stream with the result
+// of the encoding.
+func (r *ActiveRendition) RenditionStreamID() types.StreamID {
+	return types.RenditionStreamID(r.StreamID, r.ProfileName)
+}
+
+func (r *ActiveRendition) String() string {
+	return fmt.Sprintf("%s->%s", r.StreamID, r.RenditionStreamID())
+}
+
+// attachForward makes the events of the rendition to be reported on
+// behalf of the forwarding.
+func (r *ActiveRendition) attachForward(
+	ctx context.Context,
+	fwd *ActiveStreamForwarding,
+) {
+	r.forwardsLocker.Do(ctx, func() {
+		r.forwards = append(r.forwards, fwd)
+	})
+}
+
+func (r *ActiveRendition) detachForward(
+	ctx context.Context,
+	fwd *ActiveStreamForwarding,
+) {
+	r.forwardsLocker.Do(ctx, func() {
+		for idx, candidate := range r.forwards {
+			if candidate == fwd {
+				r.forwards = append(r.forwards[:idx], r.forwards[idx+1:]...)
+				return
+			}
+		}
+	})
+}
+
+func (r *ActiveRendition) getForwards(
+	ctx context.Context,
+) []*ActiveStreamForwarding {
+	return xsync.DoR1(ctx, &r.forwardsLocker, func() []*ActiveStreamForwarding {
+		return append([]*ActiveStreamForwarding{}, r.forwards...)
+	})
+}
+
+func (r *ActiveRendition) notifierOptions() Options {
+	return Options{
+		OptionNotifierFail{
+			func(ctx context.Context, renditionFwd *ActiveStreamForwarding, err error) {
+				// r.ActiveStreamForwarding might be not set yet
+				err = fmt.Errorf(
+					"the rendition '%s' failed: %w",
+					types.RenditionStreamID(renditionFwd.StreamID, r.ProfileName),
+					err,
+				)
+				for _, fwd := range r.getForwards(ctx) {
+					for _, f := range fwd.NotifierFail {
+						f(ctx, fwd, err)
+					}
+				}
+			},
+		},
+		OptionNotifierSourceSwitch{
+			func(ctx context.Context, _ *ActiveStreamForwarding, source string, isFallback bool) {
+				for _, fwd := range r.getForwards(ctx) {
+					fwd.notifySourceSwitch(ctx, source, isFallback)
+				}
+			},
+		},
+		OptionNotifierFallbackStop{
+			func(ctx context.Context, _ *ActiveStreamForwarding, fallback string, reason error) {
+				for _, fwd := range r.getForwards(ctx) {
+					fwd.notifyFallbackStop(ctx, fallback, reason)
+				}
+			},
+		},
+	}
+}
+
+// acquireRendition returns the running rendition of the stream in
+// the profile (starting it if it is not running, yet). Every call should
+// be paired with a releaseRendition call.
+func (s *StreamForwards) acquireRendition(
+	ctx context.Context,
+	streamID types.StreamID,
+	profileName types.EncodingProfileName,
+	profile types.EncodingProfile,
+) (_ret *ActiveRendition, _err error) {
+	logger.Debugf(ctx, "acquireRendition(ctx, '%s', '%s')", streamID, profileName)
+	defer func() {
+		logger.Debugf(ctx, "/acquireRendition(ctx, '%s', '%s'): %v %v", streamID, profileName, _ret, _err)
+	}()
+
+	key := RenditionKey{
+		StreamID:    streamID,
+		ProfileName: profileName,
+	}
+	if r, ok := s.ActiveRenditions[key]; ok {
+		if r.Profile != profile {
+			return nil, fmt.Errorf(
+				"encoding profile '%s' was changed while stream '%s' is being encoded with it; please restart the forwardings using it",
+				profileName,
+				streamID,
+			)
+		}
+		r.refCount++
+		return r, nil
+	}
+
+	encoderCfg, err := profile.EncoderConfig()
+	if err != nil {
+		return nil, fmt.Errorf("invalid encoding profile '%s': %w", profileName, err)
+	}
+
+	outputURL, err := s.getLocalhostEndpoint(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get a localhost endpoint: %w", err)
+	}
+	renditionStreamID := types.RenditionStreamID(streamID, profileName)
+	outputURL.Path = "/" + string(renditionStreamID)
+	streamportserver.AddInternalCredentials(outputURL)
+
+	if err := s.StreamServer.AddInternalStream(ctx, renditionStreamID, streamID); err != nil {
+		return nil, fmt.Errorf("unable to register stream '%s': %w", renditionStreamID, err)
+	}
+
+	r := &ActiveRendition{
+		ProfileName: profileName,
+		Profile:     profile,
+		refCount:    1,
+	}
+
+	ctx = belt.WithField(ctx, "stream_rendition", fmt.Sprintf("%s/%s", streamID, profileName))
+	fwd, err := s.NewActiveStreamForward(
+		ctx,
+		streamID,
+		outputURL.String(),
+		"",
+		func(ctx context.Context, fwd *ActiveStreamForwarding) {},
+		append(r.notifierOptions(), OptionEncoderConfig(encoderCfg))...,
+	)
+	if err != nil {
+		if err := s.StreamServer.RemoveInternalStream(ctx, renditionStreamID); err != nil {
+			logger.Errorf(ctx, "unable to unregister stream '%s': %v", renditionStreamID, err)
+		}
+		return nil, fmt.Errorf("unable to start encoding '%s' with profile '%s': %w", streamID, profileName, err)
+	}
+	r.ActiveStreamForwarding = fwd
+	s.ActiveRenditions[key] = r
+	return r, nil
+}
+
+// releaseRendition stops the rendition if nobody else uses it.
+func (s *StreamForwards) releaseRendition(
+	ctx context.Context,
+	r *ActiveRendition,
+) error {
+	logger.Debugf(ctx, "releaseRendition(ctx, %s)", r)
+	defer logger.Debugf(ctx, "/releaseRendition(ctx, %s)", r)
+
+	r.refCount--
+	if r.refCount > 0 {
+		return nil
+	}
+
+	delete(s.ActiveRenditions, RenditionKey{
+		StreamID:    r.StreamID,
+		ProfileName: r.ProfileName,
+	})
+	var result *multierror.Error
+	if err := r.Close(); err != nil {
+		result = multierror.Append(result, fmt.Errorf("unable to stop the rendition %s: %w", r, err))
+	}
+	if err := s.StreamServer.RemoveInternalStream(ctx, r.RenditionStreamID()); err != nil {
+		result = multierror.Append(result, fmt.Errorf("unable to unregister stream '%s': %w", r.RenditionStreamID(), err))
+	}
+	return result.ErrorOrNil()
+}
@@ -0,0 +1,304 @@
+package streamforward
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
+	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
+	"github.com/xaionaro-go/xsync"
+)
+
+type testStreamServer struct {
+	locker          xsync.Mutex
+	config          types.Config
+	internalStreams map[types.StreamID]types.StreamID
+	publishers      map[types.StreamID]types.Publisher
+	publishersChan  chan struct{}
+}
+
+var _ StreamServer = (*testStreamServer)(nil)
+
+func (s *testStreamServer) WithConfig(
+	ctx context.Context,
+	callback func(context.Context, *types.Config),
+) {
+	callback(ctx, &s.config)
+}
+
+type testPublisher struct {
+	closedChan chan struct{}
+}
+
+func (p *testPublisher) ClosedChan() <-chan struct{} {
+	return p.closedChan
+}
+
+// Close only marks the publisher as closed; like in the real stream
+// server, it stays registered until the next publisher replaces it.
+func (p *testPublisher) Close() {
+	close(p.closedChan)
+}
+
+func (s *testStreamServer) publish(streamID types.StreamID) *testPublisher {
+	publisher := &testPublisher{closedChan: make(chan struct{})}
+	s.locker.Do(context.Background(), func() {
+		if s.publishers == nil {
+			s.publishers = map[types.StreamID]types.Publisher{}
+		}
+		s.publishers[streamID] = publisher
+		if s.publishersChan != nil {
+			close(s.publishersChan)
+			s.publishersChan = nil
+		}
+	})
+	return publisher
+}
+
+// WaitPublisherChan works like the one of the real stream server, but
+// the channel is closed when ctx is cancelled.
+func (s *testStreamServer) WaitPublisherChan(
+	ctx context.Context,
+	streamID types.StreamID,
+	waitForNext bool,
+) (<-chan types.Publisher, error) {
+	getPublisher := func() (types.Publisher, chan struct{}) {
+		return xsync.DoR2(ctx, &s.locker, func() (types.Publisher, chan struct{}) {
+			if s.publishersChan == nil {
+				s.publishersChan = make(chan struct{})
+			}
+			return s.publishers[streamID], s.publishersChan
+		})
+	}
+	var curPublisher types.Publisher
+	if waitForNext {
+		curPublisher, _ = getPublisher()
+	}
+	ch := make(chan types.Publisher, 1)
+	go func() {
+		for {
+			publisher, changedChan := getPublisher()
+			if publisher != nil && publisher != curPublisher {
+				ch <- publisher
+				close(ch)
+				return
+			}
+			select {
+			case <-ctx.Done():
+				close(ch)
+				return
+			case <-changedChan:
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (s *testStreamServer) PubsubNames() (types.AppKeys, error) {
+	return nil, nil
+}
+
+func (s *testStreamServer) GetPortServers(
+	ctx context.Context,
+) ([]streamportserver.Config, error) {
+	return []streamportserver.Config{{
+		Type:       streamtypes.ServerTypeRTMP,
+		ListenAddr: "127.0.0.1:1935",
+	}}, nil
+}
+
+func (s *testStreamServer) AddInternalStream(
+	ctx context.Context,
+	streamID types.StreamID,
+	sourceStreamID types.StreamID,
+) error {
+	s.locker.Do(ctx, func() {
+		s.internalStreams[streamID] = sourceStreamID
+	})
+	return nil
+}
+
+func (s *testStreamServer) RemoveInternalStream(
+	ctx context.Context,
+	streamID types.StreamID,
+) error {
+	s.locker.Do(ctx, func() {
+		delete(s.internalStreams, streamID)
+	})
+	return nil
+}
+
+func (s *testStreamServer) InternalStreams() map[types.StreamID]types.StreamID {
+	return xsync.DoR1(context.Background(), &s.locker, func() map[types.StreamID]types.StreamID {
+		result := map[types.StreamID]types.StreamID{}
+		for k, v := range s.internalStreams {
+			result[k] = v
+		}
+		return result
+	})
+}
+
+func TestRenditionIsShared(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	srv := &testStreamServer{
+		internalStreams: map[types.StreamID]types.StreamID{},
+	}
+	s := NewStreamForwards(srv, nil, nil)
+	s.StreamDestinations = []types.StreamDestination{
+		{ID: "a", URL: "rtmp://a.example.com/app"},
+		{ID: "b", URL: "rtmp://b.example.com/app"},
+	}
+
+	profile := types.EncodingProfile{
+		VideoCodec:   "h264",
+		Width:        1280,
+		Height:       720,
+		VideoBitrate: 3_000_000,
+	}
+	fwdCfg := types.ForwardingConfig{EncodingProfile: "720p"}
+	fwdA, err := s.newActiveStreamForward(ctx, "live/main", "a", fwdCfg, &profile)
+	require.NoError(t, err)
+	fwdB, err := s.newActiveStreamForward(ctx, "live/main", "b", fwdCfg, &profile)
+	require.NoError(t, err)
+
+	require.Len(t, s.ActiveRenditions, 1)
+	rendition := fwdA.ActiveForwarding.rendition
+	require.NotNil(t, rendition)
+	require.Same(t, rendition, fwdB.ActiveForwarding.rendition)
+	require.Equal(t, types.StreamID("live/main~720p"), fwdA.ActiveForwarding.StreamID)
+	require.Equal(t, types.StreamID("live/main~720p"), fwdB.ActiveForwarding.StreamID)
+	require.Equal(t, "/live/main~720p", rendition.DestinationURL.Path)
+	require.True(t, streamportserver.HasInternalCredentials(rendition.DestinationURL.RawQuery))
+	require.Equal(t, map[types.StreamID]types.StreamID{
+		"live/main~720p": "live/main",
+	}, srv.InternalStreams())
+
+	// a profile cannot be changed while it is in use
+	changedProfile := profile
+	changedProfile.VideoBitrate = 1_000_000
+	s.StreamDestinations = append(s.StreamDestinations, types.StreamDestination{ID: "c", URL: "rtmp://c.example.com/app"})
+	_, err = s.newActiveStreamForward(ctx, "live/main", "c", fwdCfg, &changedProfile)
+	require.Error(t, err)
+	require.Len(t, s.ActiveRenditions, 1)
+
+	require.NoError(t, s.removeActiveStreamForward(ctx, "live/main", "a"))
+	require.Len(t, s.ActiveRenditions, 1)
+	require.Len(t, srv.InternalStreams(), 1)
+
+	require.NoError(t, s.removeActiveStreamForward(ctx, "live/main", "b"))
+	require.Empty(t, s.ActiveRenditions)
+	require.Empty(t, srv.InternalStreams())
+}
+
+type testStreamForwardEvent struct {
+	StreamID      types.StreamID
+	DestinationID types.DestinationID
+	Source        string
+	IsFallback    bool
+	Stopped       bool
+}
+
+func TestRenditionFallback(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFn()
+
+	slate := newTestSource()
+	writeTestFrames(t, slate, 0, 5)
+	slatePath := filepath.Join(t.TempDir(), "slate.ts")
+	require.NoError(t, os.WriteFile(slatePath, slate.Bytes(), 0o644))
+
+	srv := &testStreamServer{
+		internalStreams: map[types.StreamID]types.StreamID{},
+	}
+	srv.config.Streams = map[types.StreamID]*types.StreamConfig{
+		"live/main": {
+			Fallback: &types.FallbackConfig{
+				File:        slatePath,
+				SwitchDelay: 100 * time.Millisecond,
+				MaxDuration: time.Second,
+			},
+		},
+	}
+	s := NewStreamForwards(srv, &testRecoderFactory{t: t}, nil)
+	s.RelaySources = true
+	s.StreamDestinations = []types.StreamDestination{
+		{ID: "a", URL: "rtmp://a.example.com/app"},
+		{ID: "b", URL: "rtmp://b.example.com/app"},
+	}
+
+	var (
+		eventsLocker xsync.Mutex
+		events       []testStreamForwardEvent
+	)
+	getEvents := func() []testStreamForwardEvent {
+		return xsync.DoR1(ctx, &eventsLocker, func() []testStreamForwardEvent {
+			return append([]testStreamForwardEvent{}, events...)
+		})
+	}
+	s.NotifierSourceSwitch = []types.FuncNotifyStreamForwardSourceSwitch{
+		func(ctx context.Context, streamID types.StreamID, destinationID types.DestinationID, source string, isFallback bool) {
+			eventsLocker.Do(ctx, func() {
+				events = append(events, testStreamForwardEvent{
+					StreamID:      streamID,
+					DestinationID: destinationID,
+					Source:        source,
+					IsFallback:    isFallback,
+				})
+			})
+		},
+	}
+	s.NotifierFallbackStop = []types.FuncNotifyStreamForwardFallbackStop{
+		func(ctx context.Context, streamID types.StreamID, destinationID types.DestinationID, fallback string, reason error) {
+			require.ErrorIs(t, reason, errFallbackMaxDuration)
+			eventsLocker.Do(ctx, func() {
+				events = append(events, testStreamForwardEvent{
+					StreamID:      streamID,
+					DestinationID: destinationID,
+					Source:        fallback,
+					Stopped:       true,
+				})
+			})
+		},
+	}
+
+	profile := types.EncodingProfile{
+		VideoCodec:   "h264",
+		VideoBitrate: 3_000_000,
+	}
+	fwdCfg := types.ForwardingConfig{EncodingProfile: "3M"}
+	for _, dstID := range []types.DestinationID{"a", "b"} {
+		_, err := s.newActiveStreamForward(ctx, "live/main", dstID, fwdCfg, &profile)
+		require.NoError(t, err)
+	}
+	defer func() {
+		for _, dstID := range []types.DestinationID{"a", "b"} {
+			require.NoError(t, s.removeActiveStreamForward(ctx, "live/main", dstID))
+		}
+	}()
+
+	publisher := srv.publish("live/main")
+	// the rendition publishes the result to the stream server, and it
+	// stays published while the rendition switches to the fallback
+	srv.publish("live/main~3M")
+	time.Sleep(300 * time.Millisecond)
+	publisher.Close()
+
+	// the fallback is forwarded (and stopped) once by the rendition, but
+	// reported for each forwarding using it
+	require.Eventually(t, func() bool { return len(getEvents()) == 4 }, 10*time.Second, 10*time.Millisecond)
+	require.ElementsMatch(t, []testStreamForwardEvent{
+		{StreamID: "live/main", DestinationID: "a", Source: slatePath, IsFallback: true},
+		{StreamID: "live/main", DestinationID: "b", Source: slatePath, IsFallback: true},
+		{StreamID: "live/main", DestinationID: "a", Source: slatePath, Stopped: true},
+		{StreamID: "live/main", DestinationID: "b", Source: slatePath, Stopped: true},
+	}, getEvents())
+	time.Sleep(300 * time.Millisecond)
+	require.Len(t, getEvents(), 4)
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	player "github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/streamctl/pkg/streamcontrol"
+	youtube "github.com/xaionaro-go/streamctl/pkg/streamcontrol/youtube/types"
 	sptypes "github.com/xaionaro-go/streamctl/pkg/streamplayer/types"
 	"github.com/xaionaro-go/streamctl/pkg/streamserver/types/streamportserver"
 	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
@@ -13,7 +15,11 @@ type Config struct {
 	PortServers  []streamportserver.Config            `yaml:"servers"`
 	Streams      map[StreamID]*StreamConfig           `yaml:"streams"`
 	Destinations map[DestinationID]*DestinationConfig `yaml:"destinations"`
-	VideoPlayer  struct {
+
+	// EncodingProfiles are the renditions the forwardings may refer to.
+	EncodingProfiles map[EncodingProfileName]EncodingProfile `yaml:"encoding_profiles,omitempty"`
+
+	VideoPlayer struct {
 		MPV struct {
 			Path string `yaml:"path"`
 		} `yaml:"mpv"`
@@ -24,6 +30,11 @@ type ForwardingConfig struct {
 	Disabled bool               `yaml:"disabled,omitempty"`
 	Quirks   ForwardingQuirks   `yaml:"quirks,omitempty"`
 	Convert  VideoConvertConfig `yaml:"convert,omitempty"`
+
+	// EncodingProfile is the name of the encoding profile (see
+	// Config.EncodingProfiles) to forward the stream in; empty means
+	// to forward the stream as is.
+	EncodingProfile EncodingProfileName `yaml:"encoding_profile,omitempty"`
 }
 
 type PlayerConfig struct {
@@ -35,6 +46,20 @@ type PlayerConfig struct {
 type StreamConfig struct {
 	Forwardings map[DestinationID]ForwardingConfig `yaml:"forwardings"`
 	Player      *PlayerConfig                      `yaml:"player,omitempty"`
+
+	// PublishAuth are the credentials required to publish the stream
+	// (nil means anybody may publish).
+	PublishAuth *StreamCredentials `yaml:"publish_auth,omitempty"`
+
+	// ReadAuth are the credentials required to read the stream (nil means
+	// anybody may read). Loopback addresses are not exempt; only streamd
+	// itself is (see streamportserver.AddInternalCredentials).
+	ReadAuth *StreamCredentials `yaml:"read_auth,omitempty"`
+
+	Recording *RecordingConfig `yaml:"recording,omitempty"`
+
+	// Fallback is what to forward while the stream is not published.
+	Fallback *FallbackConfig `yaml:"fallback,omitempty"`
 }
 
 type DestinationConfig struct {
@@ -42,33 +67,82 @@ type DestinationConfig struct {
 	StreamKey string `yaml:"stream_key"`
 }
 
-type RestartUntilYoutubeRecognizesStream struct {
-	Enabled        bool          `yaml:"enabled,omitempty"`
-	StartTimeout   time.Duration `yaml:"start_timeout,omitempty"`
-	StopStartDelay time.Duration `yaml:"stop_start_delay,omitempty"`
+// RecognizingPlatform defines which platform is asked whether
+// it recognized (started) the stream.
+type RecognizingPlatform struct {
+	// PlatformID is the platform to ask; empty means YouTube (for
+	// compatibility with the configs from before the quirks supported
+	// other platforms).
+	PlatformID streamcontrol.PlatformName `yaml:"platform_id,omitempty"`
+
+	// ByDestinationURL makes it detect the platform by the URL of
+	// the destination (PlatformID is ignored then).
+	ByDestinationURL bool `yaml:"by_destination_url,omitempty"`
+}
+
+func (p RecognizingPlatform) GetPlatformID() streamcontrol.PlatformName {
+	if p.PlatformID == "" {
+		return youtube.ID
+	}
+	return p.PlatformID
 }
 
-func DefaultRestartUntilYoutubeRecognizesStreamConfig() RestartUntilYoutubeRecognizesStream {
-	return RestartUntilYoutubeRecognizesStream{
+func (p RecognizingPlatform) String() string {
+	if p.ByDestinationURL {
+		return "<by destination URL>"
+	}
+	return string(p.GetPlatformID())
+}
+
+type RestartUntilPlatformRecognizesStream struct {
+	Enabled             bool `yaml:"enabled,omitempty"`
+	RecognizingPlatform `yaml:",inline"`
+	StartTimeout        time.Duration `yaml:"start_timeout,omitempty"`
+	StopStartDelay      time.Duration `yaml:"stop_start_delay,omitempty"`
+}
+
+func DefaultRestartUntilPlatformRecognizesStreamConfig() RestartUntilPlatformRecognizesStream {
+	return RestartUntilPlatformRecognizesStream{
 		Enabled:        false,
 		StartTimeout:   20 * time.Second,
 		StopStartDelay: 10 * time.Second,
 	}
 }
 
-type StartAfterYoutubeRecognizedStream struct {
-	Enabled bool `yaml:"enabled,omitempty"`
+type StartAfterPlatformRecognizedStream struct {
+	Enabled             bool `yaml:"enabled,omitempty"`
+	RecognizingPlatform `yaml:",inline"`
+
+	// CheckInterval is how often to ask the platform.
+	CheckInterval time.Duration `yaml:"check_interval,omitempty"`
+
+	// Timeout is for how long to wait for the platform before starting
+	// the forwarding anyway (zero means forever).
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+func DefaultStartAfterPlatformRecognizedStreamConfig() StartAfterPlatformRecognizedStream {
+	return StartAfterPlatformRecognizedStream{
+		Enabled:       false,
+		CheckInterval: time.Second,
+	}
 }
 
-func DefaultStartAfterYoutubeRecognizedStreamConfig() StartAfterYoutubeRecognizedStream {
-	return StartAfterYoutubeRecognizedStream{
-		Enabled: false,
+func (q StartAfterPlatformRecognizedStream) GetCheckInterval() time.Duration {
+	if q.CheckInterval <= 0 {
+		return DefaultStartAfterPlatformRecognizedStreamConfig().CheckInterval
 	}
+	return q.CheckInterval
 }
 
+// ForwardingQuirks are workarounds for platforms that do not handle
+// the incoming streams reliably.
+//
+// The YAML keys still mention YouTube for compatibility with the configs
+// from before the quirks supported other platforms.
 type ForwardingQuirks struct {
-	RestartUntilYoutubeRecognizesStream RestartUntilYoutubeRecognizesStream `yaml:"restart_until_youtube_recognizes_stream,omitempty"`
-	StartAfterYoutubeRecognizedStream   StartAfterYoutubeRecognizedStream   `yaml:"start_after_youtube_recognizes_stream"`
+	RestartUntilPlatformRecognizesStream RestartUntilPlatformRecognizesStream `yaml:"restart_until_youtube_recognizes_stream,omitempty"`
+	StartAfterPlatformRecognizedStream   StartAfterPlatformRecognizedStream   `yaml:"start_after_youtube_recognizes_stream"`
 }
 
 type VideoConvertConfig = streamtypes.VideoConvertConfig
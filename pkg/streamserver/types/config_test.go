@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+	twitch "github.com/xaionaro-go/streamctl/pkg/streamcontrol/twitch/types"
+	youtube "github.com/xaionaro-go/streamctl/pkg/streamcontrol/youtube/types"
+)
+
+func TestForwardingQuirksYAML(t *testing.T) {
+	// a config from before the quirks supported other platforms
+	var quirks ForwardingQuirks
+	require.NoError(t, yaml.Unmarshal([]byte(`
+restart_until_youtube_recognizes_stream:
+  enabled: true
+  start_timeout: 20s
+  stop_start_delay: 10s
+`), &quirks))
+	require.True(t, quirks.RestartUntilPlatformRecognizesStream.Enabled)
+	require.Equal(t, 20*time.Second, quirks.RestartUntilPlatformRecognizesStream.StartTimeout)
+	require.Equal(t, youtube.ID, quirks.RestartUntilPlatformRecognizesStream.GetPlatformID())
+
+	quirks.StartAfterPlatformRecognizedStream = StartAfterPlatformRecognizedStream{
+		Enabled:             true,
+		RecognizingPlatform: RecognizingPlatform{PlatformID: twitch.ID},
+		Timeout:             time.Minute,
+	}
+	b, err := yaml.Marshal(quirks)
+	require.NoError(t, err)
+
+	var parsed ForwardingQuirks
+	require.NoError(t, yaml.Unmarshal(b, &parsed))
+	require.Equal(t, quirks, parsed)
+	require.Equal(t, twitch.ID, parsed.StartAfterPlatformRecognizedStream.GetPlatformID())
+}
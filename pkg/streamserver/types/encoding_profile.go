@@ -0,0 +1,172 @@
+package types
+
+import (
+	"fmt"
+	"image"
+	"regexp"
+	"strings"
+
+	"github.com/xaionaro-go/recoder"
+)
+
+type EncodingProfileName string
+
+var encodingProfileNameRegexp = regexp.MustCompile(`^[0-9A-Za-z_-]+$`)
+
+// EncodingProfile is a rendition of a stream (e.g. "720p"), declared once
+// in Config.EncodingProfiles and referenced by any amount of forwardings.
+//
+// A stream is encoded into a profile only once, however many forwardings
+// reference it: the rendition is published back to the stream server as
+// the stream RenditionStreamID(streamID, profileName), and the forwardings
+// just copy it to their destinations.
+type EncodingProfile struct {
+	// VideoCodec is one of "h264", "hevc" and "av1"; empty means
+	// to copy the video as is.
+	VideoCodec string `yaml:"video_codec,omitempty"`
+
+	// Width and Height are the resolution to scale the video to; zeros
+	// mean to keep the resolution.
+	Width  uint `yaml:"width,omitempty"`
+	Height uint `yaml:"height,omitempty"`
+
+	// VideoBitrate is the bitrate of the video (in bits per second).
+	VideoBitrate uint `yaml:"video_bitrate,omitempty"`
+
+	// AudioCodec is one of "aac", "vorbis" and "opus"; empty means
+	// to copy the audio as is.
+	AudioCodec string `yaml:"audio_codec,omitempty"`
+
+	// AudioBitrate is the bitrate of the audio (in bits per second).
+	AudioBitrate uint `yaml:"audio_bitrate,omitempty"`
+}
+
+// RenditionStreamID returns the ID of the local stream, which the stream
+// is published to after encoding it with the profile.
+func RenditionStreamID(
+	streamID StreamID,
+	profileName EncodingProfileName,
+) StreamID {
+	return StreamID(fmt.Sprintf("%s~%s", streamID, profileName))
+}
+
+// Validate returns an error if the profile cannot be encoded.
+func (p EncodingProfile) Validate() error {
+	_, err := p.EncoderConfig()
+	return err
+}
+
+// EncoderConfig returns the configuration of the encoder for the profile.
+func (p EncodingProfile) EncoderConfig() (recoder.EncoderConfig, error) {
+	var result recoder.EncoderConfig
+
+	videoCodec, err := parseVideoCodec(p.VideoCodec)
+	if err != nil {
+		return result, err
+	}
+	audioCodec, err := parseAudioCodec(p.AudioCodec)
+	if err != nil {
+		return result, err
+	}
+
+	if videoCodec == recoder.VideoCodecCopy {
+		if p.Width != 0 || p.Height != 0 || p.VideoBitrate != 0 {
+			return result, fmt.Errorf("the resolution and the video bitrate cannot be changed without re-encoding the video: 'video_codec' is not set")
+		}
+	} else {
+		if (p.Width == 0) != (p.Height == 0) {
+			return result, fmt.Errorf("'width' and 'height' should be set together (cur values: %d and %d)", p.Width, p.Height)
+		}
+		if p.Width%2 != 0 || p.Height%2 != 0 {
+			return result, fmt.Errorf("the resolution should be even (cur value: %dx%d)", p.Width, p.Height)
+		}
+		if p.VideoBitrate == 0 {
+			return result, fmt.Errorf("'video_bitrate' is required to re-encode the video")
+		}
+	}
+
+	if audioCodec == recoder.AudioCodecCopy {
+		if p.AudioBitrate != 0 {
+			return result, fmt.Errorf("the audio bitrate cannot be changed without re-encoding the audio: 'audio_codec' is not set")
+		}
+	} else if p.AudioBitrate == 0 {
+		return result, fmt.Errorf("'audio_bitrate' is required to re-encode the audio")
+	}
+
+	videoTrack := recoder.VideoTrackConfig{
+		EncodeVideoConfig: recoder.EncodeVideoConfig{
+			Codec: videoCodec,
+		},
+	}
+	if videoCodec != recoder.VideoCodecCopy {
+		if p.Width != 0 {
+			videoTrack.Scale = &image.Point{X: int(p.Width), Y: int(p.Height)}
+		}
+		videoTrack.Quality = ptr(recoder.VideoQualityConstantBitrate(p.VideoBitrate))
+	}
+	audioTrack := recoder.AudioTrackConfig{
+		EncodeAudioConfig: recoder.EncodeAudioConfig{
+			Codec: audioCodec,
+		},
+	}
+	if audioCodec != recoder.AudioCodecCopy {
+		audioTrack.Quality = ptr(recoder.AudioQualityConstantBitrate(p.AudioBitrate))
+	}
+	result.OutputVideoTracks = []recoder.VideoTrackConfig{videoTrack}
+	result.OutputAudioTracks = []recoder.AudioTrackConfig{audioTrack}
+	return result, nil
+}
+
+func parseVideoCodec(s string) (recoder.VideoCodec, error) {
+	if s == "" {
+		return recoder.VideoCodecCopy, nil
+	}
+	s = strings.ToLower(s)
+	for c := recoder.VideoCodecH264; c < recoder.EndOfVideoCodec; c++ {
+		if c.String() == s {
+			return c, nil
+		}
+	}
+	return recoder.VideoCodecUndefined, fmt.Errorf("unknown video codec '%s'", s)
+}
+
+func parseAudioCodec(s string) (recoder.AudioCodec, error) {
+	if s == "" {
+		return recoder.AudioCodecCopy, nil
+	}
+	s = strings.ToLower(s)
+	for c := recoder.AudioCodecAAC; c < recoder.EndOfAudioCodec; c++ {
+		if c.String() == s {
+			return c, nil
+		}
+	}
+	return recoder.AudioCodecUndefined, fmt.Errorf("unknown audio codec '%s'", s)
+}
+
+// GetEncodingProfile returns the validated encoding profile referenced
+// by the forwarding (or nil if the forwarding does not reference any).
+func (cfg *Config) GetEncodingProfile(
+	fwdCfg ForwardingConfig,
+) (*EncodingProfile, error) {
+	if fwdCfg.EncodingProfile == "" {
+		return nil, nil
+	}
+	if len(fwdCfg.Convert.OutputVideoTracks) != 0 || len(fwdCfg.Convert.OutputAudioTracks) != 0 {
+		return nil, fmt.Errorf("'convert' and 'encoding_profile' cannot be used together")
+	}
+	if !encodingProfileNameRegexp.MatchString(string(fwdCfg.EncodingProfile)) {
+		return nil, fmt.Errorf("invalid encoding profile name '%s': only latin letters, digits, '_' and '-' are allowed", fwdCfg.EncodingProfile)
+	}
+	profile, ok := cfg.EncodingProfiles[fwdCfg.EncodingProfile]
+	if !ok {
+		return nil, fmt.Errorf("encoding profile '%s' is not defined", fwdCfg.EncodingProfile)
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid encoding profile '%s': %w", fwdCfg.EncodingProfile, err)
+	}
+	return &profile, nil
+}
+
+func ptr[T any](in T) *T {
+	return &in
+}
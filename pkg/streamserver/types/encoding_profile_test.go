@@ -0,0 +1,115 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/recoder"
+)
+
+func TestEncodingProfileValidate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		Profile EncodingProfile
+		IsValid bool
+	}{
+		"copy": {
+			Profile: EncodingProfile{},
+			IsValid: true,
+		},
+		"720p": {
+			Profile: EncodingProfile{
+				VideoCodec:   "h264",
+				Width:        1280,
+				Height:       720,
+				VideoBitrate: 3_000_000,
+				AudioCodec:   "aac",
+				AudioBitrate: 128_000,
+			},
+			IsValid: true,
+		},
+		"scale_without_codec": {
+			Profile: EncodingProfile{Width: 1280, Height: 720},
+		},
+		"only_width": {
+			Profile: EncodingProfile{VideoCodec: "h264", Width: 1280, VideoBitrate: 3_000_000},
+		},
+		"odd_resolution": {
+			Profile: EncodingProfile{VideoCodec: "h264", Width: 1281, Height: 721, VideoBitrate: 3_000_000},
+		},
+		"no_video_bitrate": {
+			Profile: EncodingProfile{VideoCodec: "h264"},
+		},
+		"unknown_video_codec": {
+			Profile: EncodingProfile{VideoCodec: "mpeg2", VideoBitrate: 3_000_000},
+		},
+		"audio_bitrate_without_codec": {
+			Profile: EncodingProfile{AudioBitrate: 128_000},
+		},
+		"no_audio_bitrate": {
+			Profile: EncodingProfile{AudioCodec: "opus"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := tc.Profile.Validate()
+			if tc.IsValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestEncodingProfileEncoderConfig(t *testing.T) {
+	cfg, err := EncodingProfile{
+		VideoCodec:   "H264",
+		Width:        1280,
+		Height:       720,
+		VideoBitrate: 3_000_000,
+	}.EncoderConfig()
+	require.NoError(t, err)
+	require.Len(t, cfg.OutputVideoTracks, 1)
+	require.Equal(t, recoder.VideoCodecH264, cfg.OutputVideoTracks[0].Codec)
+	require.NotNil(t, cfg.OutputVideoTracks[0].Scale)
+	require.Equal(t, 1280, cfg.OutputVideoTracks[0].Scale.X)
+	require.Len(t, cfg.OutputAudioTracks, 1)
+	require.Equal(t, recoder.AudioCodecCopy, cfg.OutputAudioTracks[0].Codec)
+}
+
+func TestConfigGetEncodingProfile(t *testing.T) {
+	var cfg Config
+	require.NoError(t, yaml.Unmarshal([]byte(`
+encoding_profiles:
+  720p:
+    video_codec: h264
+    width: 1280
+    height: 720
+    video_bitrate: 3000000
+  broken:
+    width: 1280
+    height: 720
+`), &cfg))
+
+	profile, err := cfg.GetEncodingProfile(ForwardingConfig{})
+	require.NoError(t, err)
+	require.Nil(t, profile)
+
+	profile, err = cfg.GetEncodingProfile(ForwardingConfig{EncodingProfile: "720p"})
+	require.NoError(t, err)
+	require.NotNil(t, profile)
+	require.Equal(t, uint(720), profile.Height)
+
+	_, err = cfg.GetEncodingProfile(ForwardingConfig{EncodingProfile: "1080p"})
+	require.Error(t, err)
+
+	_, err = cfg.GetEncodingProfile(ForwardingConfig{EncodingProfile: "broken"})
+	require.Error(t, err)
+
+	_, err = cfg.GetEncodingProfile(ForwardingConfig{EncodingProfile: "a/b"})
+	require.Error(t, err)
+}
+
+func TestRenditionStreamID(t *testing.T) {
+	require.Equal(t, StreamID("live~720p"), RenditionStreamID("live", "720p"))
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+)
+
+const (
+	DefaultFallbackSwitchDelay = 2 * time.Second
+)
+
+// FallbackConfig defines what to forward instead of an incoming stream
+// while its publisher is gone (for example, OBS crashed or the uplink
+// has a hiccup). Exactly one of StreamID and File should be set.
+//
+// The fallback is switched to within the same output session, so it
+// should have the same codecs as the stream itself.
+type FallbackConfig struct {
+	// StreamID is another incoming stream (for example, from a backup encoder).
+	StreamID StreamID `yaml:"stream_id,omitempty"`
+
+	// File is a local MPEG-TS file (for example, a "be right back" slate),
+	// it is played in real time in a loop.
+	File string `yaml:"file,omitempty"`
+
+	// SwitchDelay is for how long to wait for the publisher to return
+	// before switching to the fallback.
+	SwitchDelay time.Duration `yaml:"switch_delay,omitempty"`
+
+	// MaxDuration is for how long to forward the fallback before giving
+	// up and ending the stream on the destinations (zero means until
+	// the publisher returns).
+	MaxDuration time.Duration `yaml:"max_duration,omitempty"`
+}
+
+func (cfg FallbackConfig) IsSet() bool {
+	return cfg.StreamID != "" || cfg.File != ""
+}
+
+func (cfg FallbackConfig) GetSwitchDelay() time.Duration {
+	if cfg.SwitchDelay <= 0 {
+		return DefaultFallbackSwitchDelay
+	}
+	return cfg.SwitchDelay
+}
+
+// String returns the source being forwarded as the fallback.
+func (cfg FallbackConfig) String() string {
+	if cfg.StreamID != "" {
+		return string(cfg.StreamID)
+	}
+	return cfg.File
+}
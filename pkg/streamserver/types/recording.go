@@ -0,0 +1,72 @@
+package types
+
+import (
+	"time"
+)
+
+const (
+	DefaultRecordingFormat          = "ts"
+	DefaultRecordingSegmentDuration = time.Hour
+)
+
+// RecordingConfig defines how an incoming stream is recorded to disk.
+//
+// The stream is remuxed (not transcoded) into files
+// "<Directory>/<StreamID>/<start time>.<Format>", a new file is started
+// every SegmentDuration and every time the stream is re-published.
+type RecordingConfig struct {
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// Directory is where to store the recordings to. It should not
+	// contain characters that require escaping in URLs (like spaces).
+	Directory string `yaml:"directory"`
+
+	// Format is the container format defined by the file extension,
+	// for example "ts", "mkv" or "flv". Formats that need finalization
+	// (like "mp4") are not recommended, since the recordings might
+	// be interrupted abruptly.
+	Format string `yaml:"format,omitempty"`
+
+	// SegmentDuration is how often to start a new file (zero means
+	// DefaultRecordingSegmentDuration).
+	//
+	// Each file is recorded by a separate session (the recoder does not
+	// support splitting the output inside the muxer), so the footage
+	// between closing a file and opening the next one (usually up to
+	// a few seconds, until the next keyframe) is missing from
+	// the recordings. Use a long SegmentDuration if that matters.
+	SegmentDuration time.Duration `yaml:"segment_duration,omitempty"`
+
+	// MaxDiskUsage is the maximal total size of the recordings of the
+	// stream in bytes; the oldest recordings are deleted first
+	// (zero means unlimited).
+	MaxDiskUsage uint64 `yaml:"max_disk_usage,omitempty"`
+
+	// Retention is for how long to keep the recordings
+	// (zero means forever).
+	Retention time.Duration `yaml:"retention,omitempty"`
+}
+
+func (cfg RecordingConfig) GetFormat() string {
+	if cfg.Format == "" {
+		return DefaultRecordingFormat
+	}
+	return cfg.Format
+}
+
+func (cfg RecordingConfig) GetSegmentDuration() time.Duration {
+	if cfg.SegmentDuration <= 0 {
+		return DefaultRecordingSegmentDuration
+	}
+	return cfg.SegmentDuration
+}
+
+// Recording is a file with a recorded fragment of an incoming stream.
+type Recording struct {
+	StreamID   StreamID
+	Path       string
+	Size       uint64
+	StartedAt  time.Time
+	ModifiedAt time.Time
+	IsActive   bool
+}
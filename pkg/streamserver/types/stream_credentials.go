@@ -0,0 +1,142 @@
+package types
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/url"
+	"slices"
+
+	"github.com/xaionaro-go/streamctl/pkg/secret"
+)
+
+// StreamCredentialsQueryKeyStreamKey is the name of the URL query parameter
+// to pass the stream key through (for example "rtmp://host/app/stream?key=XXX").
+const StreamCredentialsQueryKeyStreamKey = "key"
+
+// StreamCredentials are the credentials required to publish or read
+// an incoming stream. A client is accepted if it provides either the
+// matching user/password pair or the matching stream key; fields
+// that are not set are not accepted.
+type StreamCredentials struct {
+	User      string        `yaml:"user,omitempty"`
+	Password  secret.String `yaml:"password,omitempty"`
+	StreamKey secret.String `yaml:"stream_key,omitempty"`
+}
+
+// Check returns true if the provided credentials are accepted. The stream
+// key is accepted both as the password (with an empty user) and as the
+// "key" parameter of the URL query.
+func (c *StreamCredentials) Check(
+	user string,
+	pass string,
+	query string,
+) bool {
+	if c == nil {
+		return true
+	}
+
+	if c.User != "" {
+		password := c.Password.Get()
+		if password != "" && user == c.User && secureCompare(pass, password) {
+			return true
+		}
+	}
+
+	streamKey := c.StreamKey.Get()
+	if streamKey == "" {
+		return false
+	}
+	if user == "" && secureCompare(pass, streamKey) {
+		return true
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return false
+	}
+	return secureCompare(values.Get(StreamCredentialsQueryKeyStreamKey), streamKey)
+}
+
+func secureCompare(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// StreamAuthAction is the kind of the access to an incoming stream.
+type StreamAuthAction string
+
+const (
+	StreamAuthActionPublish = StreamAuthAction("publish")
+	StreamAuthActionRead    = StreamAuthAction("read")
+)
+
+// StreamCredentialsFor returns the incoming stream served under the given
+// local app name (see StreamID2LocalAppName) and the credentials required
+// for the action on it. The credentials are nil if no credentials are
+// required.
+//
+// All the stream server backends should look up the credentials here,
+// so that a stream is protected the same way regardless of the backend.
+func StreamCredentialsFor(
+	streams map[StreamID]*StreamConfig,
+	appKey AppKey,
+	action StreamAuthAction,
+) (StreamID, *StreamCredentials) {
+	streamID := StreamID(appKey)
+	streamCfg, ok := streams[streamID]
+	if !ok {
+		// "app/name" stream IDs are served as "name"; sorting makes
+		// the result deterministic if the app name is not unique
+		streamIDs := make([]StreamID, 0, len(streams))
+		for candidate := range streams {
+			if StreamID2LocalAppName(candidate) == appKey {
+				streamIDs = append(streamIDs, candidate)
+			}
+		}
+		if len(streamIDs) == 0 {
+			return streamID, nil
+		}
+		slices.Sort(streamIDs)
+		streamID = streamIDs[0]
+		streamCfg = streams[streamID]
+	}
+	if streamCfg == nil {
+		return streamID, nil
+	}
+	switch action {
+	case StreamAuthActionPublish:
+		return streamID, streamCfg.PublishAuth
+	case StreamAuthActionRead:
+		return streamID, streamCfg.ReadAuth
+	}
+	return streamID, nil
+}
+
+// WithInternalStreams returns the configs of the streams together with
+// the configs of the internal streams (see InternalStreamer), given
+// the source stream of each internal stream.
+func WithInternalStreams(
+	streams map[StreamID]*StreamConfig,
+	internalStreams map[StreamID]StreamID,
+) map[StreamID]*StreamConfig {
+	result := make(map[StreamID]*StreamConfig, len(streams)+len(internalStreams))
+	for streamID, streamCfg := range streams {
+		result[streamID] = streamCfg
+	}
+	for streamID, sourceStreamID := range internalStreams {
+		streamCfg := &StreamConfig{
+			// nothing but the internal credentials matches empty credentials
+			PublishAuth: &StreamCredentials{},
+		}
+		if sourceCfg := streams[sourceStreamID]; sourceCfg != nil {
+			streamCfg.ReadAuth = sourceCfg.ReadAuth
+		}
+		result[streamID] = streamCfg
+	}
+	return result
+}
+
+type FuncNotifyStreamAuthFail func(
+	ctx context.Context,
+	streamID StreamID,
+	action StreamAuthAction,
+	remoteAddr string,
+)
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xaionaro-go/streamctl/pkg/secret"
+)
+
+func TestStreamCredentialsCheck(t *testing.T) {
+	require.True(t, (*StreamCredentials)(nil).Check("", "", ""))
+	require.False(t, (&StreamCredentials{}).Check("", "", ""))
+
+	creds := &StreamCredentials{
+		User:      "obs",
+		Password:  secret.New("hunter22"),
+		StreamKey: secret.New("abcdef"),
+	}
+	require.True(t, creds.Check("obs", "hunter22", ""))
+	require.True(t, creds.Check("", "abcdef", ""))
+	require.True(t, creds.Check("", "", "key=abcdef"))
+	require.True(t, creds.Check("someone", "wrong", "foo=bar&key=abcdef"))
+
+	require.False(t, creds.Check("obs", "wrong", ""))
+	require.False(t, creds.Check("other", "hunter22", ""))
+	require.False(t, creds.Check("obs", "abcdef", ""))
+	require.False(t, creds.Check("", "", "key=abcde"))
+	require.False(t, creds.Check("", "", ""))
+
+	keyOnly := &StreamCredentials{StreamKey: secret.New("abcdef")}
+	require.False(t, keyOnly.Check("", "", ""))
+	require.True(t, keyOnly.Check("", "", "key=abcdef"))
+}
+
+func TestStreamCredentialsFor(t *testing.T) {
+	publishAuth := &StreamCredentials{StreamKey: secret.New("publish")}
+	readAuth := &StreamCredentials{StreamKey: secret.New("read")}
+	streams := map[StreamID]*StreamConfig{
+		"live/main": {PublishAuth: publishAuth, ReadAuth: readAuth},
+		"open":      {},
+		"nil":       nil,
+	}
+
+	streamID, creds := StreamCredentialsFor(streams, "main", StreamAuthActionPublish)
+	require.Equal(t, StreamID("live/main"), streamID)
+	require.Equal(t, publishAuth, creds)
+
+	streamID, creds = StreamCredentialsFor(streams, "main", StreamAuthActionRead)
+	require.Equal(t, StreamID("live/main"), streamID)
+	require.Equal(t, readAuth, creds)
+
+	for _, appKey := range []AppKey{"open", "nil", "unknown"} {
+		streamID, creds = StreamCredentialsFor(streams, appKey, StreamAuthActionRead)
+		require.Equal(t, StreamID(appKey), streamID)
+		require.Nil(t, creds)
+	}
+}
+
+func TestWithInternalStreams(t *testing.T) {
+	readAuth := &StreamCredentials{StreamKey: secret.New("read")}
+	streams := map[StreamID]*StreamConfig{
+		"live/main": {ReadAuth: readAuth},
+	}
+	result := WithInternalStreams(streams, map[StreamID]StreamID{
+		"live/main~720p": "live/main",
+		"unknown~720p":   "unknown",
+	})
+	require.Len(t, result, 3)
+	require.Equal(t, streams["live/main"], result["live/main"])
+
+	_, creds := StreamCredentialsFor(result, "main~720p", StreamAuthActionRead)
+	require.Equal(t, readAuth, creds)
+	_, creds = StreamCredentialsFor(result, "main~720p", StreamAuthActionPublish)
+	require.NotNil(t, creds)
+	require.False(t, creds.Check("", "", ""))
+
+	_, creds = StreamCredentialsFor(result, "unknown~720p", StreamAuthActionRead)
+	require.Nil(t, creds)
+	_, creds = StreamCredentialsFor(result, "unknown~720p", StreamAuthActionPublish)
+	require.NotNil(t, creds)
+}
@@ -1 +1,41 @@
 package types
+
+import (
+	"context"
+)
+
+type FuncNotifyStreamForwardStart func(
+	ctx context.Context,
+	streamID StreamID,
+	destinationID DestinationID,
+)
+
+type FuncNotifyStreamForwardFail func(
+	ctx context.Context,
+	streamID StreamID,
+	destinationID DestinationID,
+	err error,
+)
+
+// FuncNotifyStreamForwardSourceSwitch is called every time a stream forwarding
+// switches between the stream and its fallback; "source" is the stream ID or
+// the file being forwarded.
+type FuncNotifyStreamForwardSourceSwitch func(
+	ctx context.Context,
+	streamID StreamID,
+	destinationID DestinationID,
+	source string,
+	isFallback bool,
+)
+
+// FuncNotifyStreamForwardFallbackStop is called every time a stream
+// forwarding stops forwarding the fallback without the stream returning
+// (the fallback reached its maximal duration or failed), so the forwarding
+// ends; "fallback" is the stream ID or the file which was forwarded.
+type FuncNotifyStreamForwardFallbackStop func(
+	ctx context.Context,
+	streamID StreamID,
+	destinationID DestinationID,
+	fallback string,
+	reason error,
+)
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"time"
+)
+
+// StreamForwardStats is the health of a stream forwarding, as seen
+// by the stream server.
+type StreamForwardStats struct {
+	// InputBitrate is the bitrate (in bits per second) of the data read
+	// from the source during the last sampling period.
+	InputBitrate uint64
+
+	// OutputBitrate is the bitrate (in bits per second) of the data written
+	// to the destination during the last sampling period.
+	OutputBitrate uint64
+
+	// HasFrameStats tells if FrameRate, KeyframeInterval, DroppedPackets
+	// and LatePackets are known; they are not known (and are zero) if
+	// the forwarding does not see the frames (see FrameStats) or is not
+	// running.
+	HasFrameStats bool
+
+	// FrameRate is the amount of frames per second written to the destination.
+	FrameRate float64
+
+	// KeyframeInterval is the average interval between keyframes written
+	// to the destination.
+	KeyframeInterval time.Duration
+
+	// DroppedPackets is the amount of packets lost before reaching
+	// the destination since the current forwarding session has started.
+	DroppedPackets uint64
+
+	// LatePackets is the amount of packets received too late (and thus
+	// not written to the destination) since the current forwarding
+	// session has started.
+	LatePackets uint64
+
+	// Reconnects is the amount of times the forwarding was restarted
+	// since the forwarding was enabled.
+	Reconnects uint64
+
+	// LastError is the last error the forwarding failed with (if any).
+	LastError string
+
+	// LastErrorAt is the moment when LastError happened.
+	LastErrorAt time.Time
+
+	// Uptime is the duration of the current forwarding session (zero if
+	// the forwarding is not running right now, e.g. is waiting for
+	// a publisher).
+	Uptime time.Duration
+}
+
+// FrameStats is the frame counters of the stream written to a destination
+// since the forwarding session has started. They are counted by
+// the forwarding itself only while it relays the sources (a stream with
+// a fallback, see streamforward.StreamForwards.RelaySources), since
+// recoders do not provide them.
+type FrameStats struct {
+	FramesWrote    uint64
+	KeyframesWrote uint64
+	DroppedPackets uint64
+	LatePackets    uint64
+}
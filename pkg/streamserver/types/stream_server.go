@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"io"
 	"strings"
 
@@ -18,6 +19,12 @@ type GetPortServerser = streamportserver.GetPortServerser
 
 type InitConfig struct {
 	DefaultStreamPlayerOptions streamplayer.Options
+	NotifierStreamForwardStart []FuncNotifyStreamForwardStart
+	NotifierStreamForwardFail  []FuncNotifyStreamForwardFail
+	NotifierStreamAuthFail     []FuncNotifyStreamAuthFail
+
+	NotifierStreamForwardSourceSwitch []FuncNotifyStreamForwardSourceSwitch
+	NotifierStreamForwardFallbackStop []FuncNotifyStreamForwardFallbackStop
 }
 
 type InitOption interface {
@@ -40,6 +47,46 @@ func (opt InitOptionDefaultStreamPlayerOptions) apply(cfg *InitConfig) {
 	cfg.DefaultStreamPlayerOptions = (streamplayer.Options)(opt)
 }
 
+type InitOptionNotifierStreamForwardStart []FuncNotifyStreamForwardStart
+
+func (opt InitOptionNotifierStreamForwardStart) apply(cfg *InitConfig) {
+	cfg.NotifierStreamForwardStart = ([]FuncNotifyStreamForwardStart)(opt)
+}
+
+type InitOptionNotifierStreamForwardFail []FuncNotifyStreamForwardFail
+
+func (opt InitOptionNotifierStreamForwardFail) apply(cfg *InitConfig) {
+	cfg.NotifierStreamForwardFail = ([]FuncNotifyStreamForwardFail)(opt)
+}
+
+type InitOptionNotifierStreamAuthFail []FuncNotifyStreamAuthFail
+
+func (opt InitOptionNotifierStreamAuthFail) apply(cfg *InitConfig) {
+	cfg.NotifierStreamAuthFail = ([]FuncNotifyStreamAuthFail)(opt)
+}
+
+type InitOptionNotifierStreamForwardSourceSwitch []FuncNotifyStreamForwardSourceSwitch
+
+func (opt InitOptionNotifierStreamForwardSourceSwitch) apply(cfg *InitConfig) {
+	cfg.NotifierStreamForwardSourceSwitch = ([]FuncNotifyStreamForwardSourceSwitch)(opt)
+}
+
+type InitOptionNotifierStreamForwardFallbackStop []FuncNotifyStreamForwardFallbackStop
+
+func (opt InitOptionNotifierStreamForwardFallbackStop) apply(cfg *InitConfig) {
+	cfg.NotifierStreamForwardFallbackStop = ([]FuncNotifyStreamForwardFallbackStop)(opt)
+}
+
+// InternalStreamer registers the streams, which are published by streamd
+// itself (for example, the renditions), without adding them to the config.
+type InternalStreamer interface {
+	// AddInternalStream registers the stream produced from sourceStreamID:
+	// only streamd could publish it, and it could be read with the read
+	// credentials of the source stream.
+	AddInternalStream(ctx context.Context, streamID StreamID, sourceStreamID StreamID) error
+	RemoveInternalStream(ctx context.Context, streamID StreamID) error
+}
+
 type Sub interface {
 	io.Closer
 	ClosedChan() <-chan struct{}
@@ -0,0 +1,58 @@
+package streamportserver
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/url"
+)
+
+// InternalCredentialsQueryKey is the name of the URL query parameter,
+// through which streamd itself (the stream forwards, the renditions and
+// the stream players) authenticates to its own port servers, so that
+// the per-stream credentials do not apply to it.
+const InternalCredentialsQueryKey = "streamd_key"
+
+// internalKey is generated on start and never leaves the process
+// (use RedactInternalCredentials before logging or returning a URL).
+var internalKey = func() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}()
+
+// AddInternalCredentials adds the internal credentials to the URL of
+// a local stream.
+func AddInternalCredentials(u *url.URL) {
+	query := u.Query()
+	query.Set(InternalCredentialsQueryKey, internalKey)
+	u.RawQuery = query.Encode()
+}
+
+// HasInternalCredentials returns true if the URL query contains
+// the internal credentials.
+func HasInternalCredentials(query string) bool {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(values.Get(InternalCredentialsQueryKey)), []byte(internalKey)) == 1
+}
+
+// RedactInternalCredentials returns a copy of the URL without the internal
+// credentials, to be logged or returned in an error.
+func RedactInternalCredentials(u *url.URL) *url.URL {
+	if u == nil {
+		return nil
+	}
+	query := u.Query()
+	if !query.Has(InternalCredentialsQueryKey) {
+		return u
+	}
+	query.Del(InternalCredentialsQueryKey)
+	result := *u
+	result.RawQuery = query.Encode()
+	return &result
+}
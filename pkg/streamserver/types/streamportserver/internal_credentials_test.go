@@ -0,0 +1,35 @@
+package streamportserver
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternalCredentials(t *testing.T) {
+	u, err := url.Parse("rtmp://127.0.0.1:1935/live/stream?key=abc")
+	require.NoError(t, err)
+	require.False(t, HasInternalCredentials(u.RawQuery))
+
+	AddInternalCredentials(u)
+	require.Equal(t, "abc", u.Query().Get("key"))
+	require.True(t, HasInternalCredentials(u.RawQuery))
+
+	require.False(t, HasInternalCredentials(InternalCredentialsQueryKey+"=wrong"))
+	require.False(t, HasInternalCredentials(InternalCredentialsQueryKey+"="))
+}
+
+func TestRedactInternalCredentials(t *testing.T) {
+	u, err := url.Parse("rtmp://127.0.0.1:1935/live/stream?key=abc")
+	require.NoError(t, err)
+	require.Equal(t, u, RedactInternalCredentials(u))
+
+	AddInternalCredentials(u)
+	redacted := RedactInternalCredentials(u)
+	require.Equal(t, "rtmp://127.0.0.1:1935/live/stream?key=abc", redacted.String())
+	require.NotContains(t, redacted.String(), internalKey)
+	require.True(t, HasInternalCredentials(u.RawQuery))
+
+	require.Nil(t, RedactInternalCredentials(nil))
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto"
 	"crypto/x509"
+	"slices"
 	"time"
 
+	"github.com/xaionaro-go/streamctl/pkg/secret"
 	"github.com/xaionaro-go/streamctl/pkg/streamtypes"
 )
 
@@ -38,6 +40,42 @@ type ProtocolSpecificConfig struct {
 	ReadTimeout    time.Duration
 	ServerCert     *x509.Certificate
 	ServerKey      crypto.PrivateKey
+
+	// SRTPassphrase if set, then SRT publishers and readers are required
+	// to encrypt the connection with this passphrase.
+	//
+	// There is no SRT latency setting: the SRT server of mediamtx always
+	// listens with the default latency of gosrt (120ms).
+	SRTPassphrase secret.String
+
+	// HLSSegmentDuration is the minimal duration of an HLS segment
+	// (zero means the default one).
+	HLSSegmentDuration time.Duration
+
+	// HLSSegmentCount is the amount of segments in an HLS playlist
+	// (zero means the default one).
+	HLSSegmentCount uint64
+
+	// HLSTempDirectory if set, then the HLS segments of the live playlists
+	// are kept on disk (a subdirectory per stream) instead of RAM, and
+	// the streams are remuxed even if nobody watches them.
+	//
+	// It is not a recording: a segment is deleted as soon as it leaves
+	// the playlist (see HLSSegmentCount). To record the streams use
+	// the stream recordings (see types.RecordingConfig).
+	HLSTempDirectory string
+
+	// WebRTCICEUDPListenAddr is the address to accept the WebRTC media
+	// over UDP at (empty means the UDP port of the listen address).
+	WebRTCICEUDPListenAddr string
+
+	// WebRTCICETCPListenAddr is the address to accept the WebRTC media
+	// over TCP at (empty means disabled).
+	WebRTCICETCPListenAddr string
+
+	// WebRTCAdditionalHosts are the additional hosts/IPs to advertise
+	// as ICE candidates (for example, the public IP address behind a NAT).
+	WebRTCAdditionalHosts []string
 }
 
 var DefaultConfig = func(
@@ -50,6 +88,15 @@ var DefaultConfig = func(
 		ReadTimeout:    10 * time.Second,
 		ServerCert:     nil,
 		ServerKey:      nil,
+		SRTPassphrase:  secret.String{},
+
+		HLSSegmentDuration: 0,
+		HLSSegmentCount:    0,
+		HLSTempDirectory:   "",
+
+		WebRTCICEUDPListenAddr: "",
+		WebRTCICETCPListenAddr: "",
+		WebRTCAdditionalHosts:  nil,
 	}
 }
 
@@ -61,6 +108,13 @@ func (cfg ProtocolSpecificConfig) Options() Options {
 		OptionReadTimeout((time.Duration)(cfg.ReadTimeout)),
 		OptionServerCert{cfg.ServerCert},
 		OptionServerKey{cfg.ServerKey},
+		OptionSRTPassphrase(cfg.SRTPassphrase.Get()),
+		OptionHLSSegmentDuration(cfg.HLSSegmentDuration),
+		OptionHLSSegmentCount(cfg.HLSSegmentCount),
+		OptionHLSTempDirectory(cfg.HLSTempDirectory),
+		OptionWebRTCICEUDPListenAddr(cfg.WebRTCICEUDPListenAddr),
+		OptionWebRTCICETCPListenAddr(cfg.WebRTCICETCPListenAddr),
+		OptionWebRTCAdditionalHosts(cfg.WebRTCAdditionalHosts),
 	}
 }
 
@@ -104,6 +158,48 @@ func (opt OptionServerKey) apply(cfg *ProtocolSpecificConfig) {
 	cfg.ServerKey = opt.PrivateKey
 }
 
+type OptionSRTPassphrase string
+
+func (opt OptionSRTPassphrase) apply(cfg *ProtocolSpecificConfig) {
+	cfg.SRTPassphrase = secret.New((string)(opt))
+}
+
+type OptionHLSSegmentDuration time.Duration
+
+func (opt OptionHLSSegmentDuration) apply(cfg *ProtocolSpecificConfig) {
+	cfg.HLSSegmentDuration = (time.Duration)(opt)
+}
+
+type OptionHLSSegmentCount uint64
+
+func (opt OptionHLSSegmentCount) apply(cfg *ProtocolSpecificConfig) {
+	cfg.HLSSegmentCount = (uint64)(opt)
+}
+
+type OptionHLSTempDirectory string
+
+func (opt OptionHLSTempDirectory) apply(cfg *ProtocolSpecificConfig) {
+	cfg.HLSTempDirectory = (string)(opt)
+}
+
+type OptionWebRTCICEUDPListenAddr string
+
+func (opt OptionWebRTCICEUDPListenAddr) apply(cfg *ProtocolSpecificConfig) {
+	cfg.WebRTCICEUDPListenAddr = (string)(opt)
+}
+
+type OptionWebRTCICETCPListenAddr string
+
+func (opt OptionWebRTCICETCPListenAddr) apply(cfg *ProtocolSpecificConfig) {
+	cfg.WebRTCICETCPListenAddr = (string)(opt)
+}
+
+type OptionWebRTCAdditionalHosts []string
+
+func (opt OptionWebRTCAdditionalHosts) apply(cfg *ProtocolSpecificConfig) {
+	cfg.WebRTCAdditionalHosts = slices.Clone(opt)
+}
+
 type Config struct {
 	ProtocolSpecificConfig `yaml:"config"`
 	Type                   streamtypes.ServerType `yaml:"protocol"`